@@ -42,14 +42,153 @@ func optimize(log *logrus.Entry, ctx *session.Context, node ast.Node, params par
 
 	var optimizeResult []*OptimizeResult
 	for _, meta := range AdvisorMetaList {
-		optimizeResult = append(
-			optimizeResult,
-			meta.newFunction(ctx, log, node, params).GiveAdvices()...,
-		)
+		advices := meta.newFunction(ctx, log, node, params).GiveAdvices()
+		if meta.checkExistingIndex {
+			advices = reviseAdvicesByExistingIndexes(log, ctx, node, advices)
+		}
+		optimizeResult = append(optimizeResult, advices...)
 	}
 	return optimizeResult
 }
 
+/*
+reviseAdvicesByExistingIndexes 根据表上已有的索引修正索引建议
+
+ 1. 建议的索引与已有索引重复，或是已有索引的最左前缀时，不给出该建议
+ 2. 建议的索引以已有普通索引的全部列开头时，建议在已有索引上追加列，而不是新建索引
+*/
+func reviseAdvicesByExistingIndexes(log *logrus.Entry, ctx *session.Context, node ast.Node, advices []*OptimizeResult) []*OptimizeResult {
+	if len(advices) == 0 {
+		return advices
+	}
+	extractor := util.TableSourceExtractor{TableSources: make(map[string]*ast.TableSource)}
+	node.Accept(&extractor)
+
+	revised := make([]*OptimizeResult, 0, len(advices))
+	for _, advice := range advices {
+		createTable, err := getCreateTableStmtOfAdvice(ctx, extractor.TableSources, advice)
+		if err != nil {
+			log.Warnf("get create table statement of table %s failed, err: %v", advice.TableName, err)
+			revised = append(revised, advice)
+			continue
+		}
+		indexes := getExistingIndexes(createTable)
+		columnMap := make(map[string]struct{}, len(advice.IndexedColumns))
+		for _, column := range advice.IndexedColumns {
+			columnMap[strings.ToLower(column)] = struct{}{}
+		}
+		if util.IsIndex(columnMap, indexes) {
+			continue
+		}
+		if index := getExtendableIndex(advice.IndexedColumns, indexes); index != nil {
+			advice = &OptimizeResult{
+				TableName:      advice.TableName,
+				IndexedColumns: advice.IndexedColumns,
+				Reason: plocale.Bundle.LocalizeAllWithArgs(plocale.ExtendIndexAdviceFormat, advice.TableName, getIndexName(index),
+					strings.Join(advice.IndexedColumns[len(index.Keys):], "，")),
+			}
+		}
+		revised = append(revised, advice)
+	}
+	return revised
+}
+
+// getCreateTableStmtOfAdvice 获取索引建议针对的表的建表语句，索引建议中的表名可能是表的别名
+func getCreateTableStmtOfAdvice(ctx *session.Context, tableSources map[string]*ast.TableSource, advice *OptimizeResult) (*ast.CreateTableStmt, error) {
+	var tableName *ast.TableName
+	for name, tableSource := range tableSources {
+		if !strings.EqualFold(name, advice.TableName) {
+			continue
+		}
+		if t, ok := tableSource.Source.(*ast.TableName); ok {
+			tableName = t
+			break
+		}
+	}
+	if tableName == nil {
+		return nil, fmt.Errorf("table %s is not referred by the statement", advice.TableName)
+	}
+	createTable, exist, err := ctx.GetCreateTableStmt(tableName)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, fmt.Errorf("table %s not exist", advice.TableName)
+	}
+	return createTable, nil
+}
+
+// getExistingIndexes 获取表上已有的列索引，包括在列定义中声明的主键和唯一键，不包括全文索引、空间索引和函数索引
+func getExistingIndexes(createTable *ast.CreateTableStmt) []*ast.Constraint {
+	indexes := make([]*ast.Constraint, 0, len(createTable.Constraints))
+	for _, col := range createTable.Cols {
+		for _, option := range col.Options {
+			switch option.Tp {
+			case ast.ColumnOptionPrimaryKey:
+				indexes = append(indexes, &ast.Constraint{
+					Tp:   ast.ConstraintPrimaryKey,
+					Keys: []*ast.IndexPartSpecification{{Column: col.Name}},
+				})
+			case ast.ColumnOptionUniqKey:
+				indexes = append(indexes, &ast.Constraint{
+					Tp:   ast.ConstraintUniq,
+					Keys: []*ast.IndexPartSpecification{{Column: col.Name}},
+				})
+			}
+		}
+	}
+	for _, constraint := range createTable.Constraints {
+		switch constraint.Tp {
+		case ast.ConstraintPrimaryKey, ast.ConstraintKey, ast.ConstraintIndex, ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
+		default:
+			continue
+		}
+		isColumnIndex := true
+		for _, key := range constraint.Keys {
+			if key.Column == nil {
+				isColumnIndex = false
+				break
+			}
+		}
+		if isColumnIndex {
+			indexes = append(indexes, constraint)
+		}
+	}
+	return indexes
+}
+
+// getExtendableIndex 获取列数最多的、全部列按顺序构成建议索引的最左前缀的普通索引，主键和唯一键追加列会改变约束，不作考虑
+func getExtendableIndex(indexedColumns []string, indexes []*ast.Constraint) *ast.Constraint {
+	var extendable *ast.Constraint
+	for _, index := range indexes {
+		if index.Tp != ast.ConstraintKey && index.Tp != ast.ConstraintIndex {
+			continue
+		}
+		if len(index.Keys) >= len(indexedColumns) {
+			continue
+		}
+		isPrefix := true
+		for i, key := range index.Keys {
+			if !strings.EqualFold(key.Column.Name.L, indexedColumns[i]) {
+				isPrefix = false
+				break
+			}
+		}
+		if isPrefix && (extendable == nil || len(index.Keys) > len(extendable.Keys)) {
+			extendable = index
+		}
+	}
+	return extendable
+}
+
+// getIndexName 获取索引名，未指定索引名时，MySQL使用索引的第一列作为索引名
+func getIndexName(index *ast.Constraint) string {
+	if index.Name != "" {
+		return index.Name
+	}
+	return index.Keys[0].Column.Name.O
+}
+
 func canOptimize(log *logrus.Entry, ctx *session.Context, node ast.Node) bool {
 	canNotOptimizeWarnf := "can not optimize node: %v, reason: %v"
 	if ctx == nil {
@@ -99,6 +238,8 @@ func canOptimize(log *logrus.Entry, ctx *session.Context, node ast.Node) bool {
 type AdvisorMeta struct {
 	advisorName string
 	newFunction func(ctx *session.Context, log *logrus.Entry, originNode ast.Node, params params.Params) CreateIndexAdvisor
+	// checkExistingIndex 为true时，建议的索引为普通的列索引，需要根据表上已有的索引修正建议
+	checkExistingIndex bool
 }
 
 var AdvisorMetaList []AdvisorMeta = []AdvisorMeta{
@@ -107,20 +248,23 @@ var AdvisorMetaList []AdvisorMeta = []AdvisorMeta{
 		newFunction: newPrefixIndexAdvisor,
 	},
 	{
-		advisorName: "join_index_advisor",
-		newFunction: newJoinIndexAdvisor,
+		advisorName:        "join_index_advisor",
+		newFunction:        newJoinIndexAdvisor,
+		checkExistingIndex: true,
 	},
 	// {
-	// 	advisorName: "extremal_index_advisor",
-	// 	newFunction: newExtremalIndexAdvisor,
+	// 	advisorName:        "extremal_index_advisor",
+	// 	newFunction:        newExtremalIndexAdvisor,
+	// 	checkExistingIndex: true,
 	// },
 	{
 		advisorName: "function_index_advisor",
 		newFunction: newFunctionIndexAdvisor,
 	},
 	{
-		advisorName: "three_star_index_advisor",
-		newFunction: newThreeStarIndexAdvisor,
+		advisorName:        "three_star_index_advisor",
+		newFunction:        newThreeStarIndexAdvisor,
+		checkExistingIndex: true,
 	},
 }
 
@@ -346,20 +490,113 @@ func (a *threeStarIndexAdvisor) GiveAdvices() []*OptimizeResult {
 	if a.adviceColumns.len() == 0 {
 		return nil
 	}
-	if util.IsIndex(a.adviceColumns.columnNameMap, a.drivingTableCreateStmt.Constraints) {
+	tableName := util.GetTableNameFromTableSource(a.drivingTableSource)
+	var advices []*OptimizeResult
+	if !util.IsIndex(a.adviceColumns.columnNameMap, a.drivingTableCreateStmt.Constraints) {
+		indexColumns := a.adviceColumns.stringSlice()
+		var indexType = plocale.AdvisorIndexTypeComposite
+		if len(indexColumns) == 1 {
+			indexType = plocale.AdvisorIndexTypeSingle
+		}
+		advices = append(advices, &OptimizeResult{
+			TableName:      tableName,
+			IndexedColumns: indexColumns,
+			Reason:         plocale.Bundle.LocalizeAllWithArgs(plocale.ThreeStarIndexAdviceFormat, tableName, indexType, strings.Join(indexColumns, "，")),
+		})
+	}
+	if advice := a.giveCoverIndexAdvice(tableName); advice != nil {
+		advices = append(advices, advice)
+	}
+	return advices
+}
+
+/*
+覆盖索引建议
+
+	当三星索引建议的列未包含SQL引用的驱动表的全部列时，在建议的列后追加其余的列，形成覆盖索引:
+	1. 追加的列按照索引区分度由高到低排序
+	2. InnoDB的二级索引包含主键列，主键列无需追加
+	3. WHERE等值条件包含主键的全部列、存在不适合作为索引的列，或已有索引能够覆盖时，不给出建议
+	4. 覆盖索引的列数超过复合索引列的上限时，仅提示超过了上限
+*/
+func (a *threeStarIndexAdvisor) giveCoverIndexAdvice(tableName string) *OptimizeResult {
+	primaryKey, hasPrimaryKey := util.GetPrimaryKey(a.drivingTableCreateStmt)
+	if hasPrimaryKey && a.isPrimaryKeyEqualInWhere(primaryKey) {
 		return nil
 	}
-	tableName := util.GetTableNameFromTableSource(a.drivingTableSource)
-	indexColumns := a.adviceColumns.stringSlice()
-	var indexType = plocale.AdvisorIndexTypeComposite
-	if len(indexColumns) == 1 {
-		indexType = plocale.AdvisorIndexTypeSingle
+	remainColumns := newColumnGroup()
+	for _, column := range a.columnsReferredInDrivingTable().columns {
+		if a.adviceColumns.has(column.columnName) {
+			continue
+		}
+		if _, ok := primaryKey[column.columnName.Name.Name.L]; ok {
+			continue
+		}
+		switch column.columnDefine.Tp.Tp {
+		case mysql.TypeBlob, mysql.TypeLongBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeJSON:
+			return nil
+		}
+		remainColumns.add(column)
+	}
+	if remainColumns.len() == 0 {
+		return nil
+	}
+	remainColumns.sort()
+	coverColumns := newColumnGroup()
+	for _, column := range a.adviceColumns.columns {
+		coverColumns.add(column)
+	}
+	for _, column := range remainColumns.columns {
+		coverColumns.add(column)
+	}
+	if util.IsIndex(coverColumns.columnNameMap, a.drivingTableCreateStmt.Constraints) {
+		return nil
+	}
+	indexColumns := coverColumns.stringSlice()
+	if coverColumns.len() > a.maxColumns {
+		return &OptimizeResult{
+			TableName:      tableName,
+			IndexedColumns: indexColumns,
+			Reason:         plocale.Bundle.LocalizeAllWithArgs(plocale.ThreeStarCoverIndexExceedMaxColumnFormat, tableName, len(indexColumns), strings.Join(indexColumns, "，"), a.maxColumns),
+		}
 	}
-	return []*OptimizeResult{{
+	return &OptimizeResult{
 		TableName:      tableName,
 		IndexedColumns: indexColumns,
-		Reason:         plocale.Bundle.LocalizeAllWithArgs(plocale.ThreeStarIndexAdviceFormat, tableName, indexType, strings.Join(indexColumns, "，")),
-	}}
+		Reason:         plocale.Bundle.LocalizeAllWithArgs(plocale.ThreeStarCoverIndexAdviceFormat, tableName, strings.Join(indexColumns, "，")),
+	}
+}
+
+// isPrimaryKeyEqualInWhere 判断WHERE等值条件是否包含主键的全部列，此时最多查询一行，无需覆盖索引
+func (a *threeStarIndexAdvisor) isPrimaryKeyEqualInWhere(primaryKey map[string]struct{}) bool {
+	for name := range primaryKey {
+		if _, ok := a.drivingTableColumn.equalColumnInWhere.columnNameMap[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// columnsReferredInDrivingTable 获取SELECT语句中引用的驱动表的全部列，包括通配符展开的列
+func (a *threeStarIndexAdvisor) columnsReferredInDrivingTable() columnGroup {
+	referred := newColumnGroup()
+	for _, column := range a.drivingTableColumn.columnInFieldList.columns {
+		referred.add(column)
+	}
+	visitor := util.ColumnNameVisitor{}
+	a.originNode.Accept(&visitor)
+	for _, col := range visitor.ColumnNameList {
+		if !a.isColumnInDrivingTable(col) {
+			continue
+		}
+		c := a.drivingTableColumnMap[col.Name.Name.L]
+		referred.add(&column{
+			columnName:   col,
+			columnDefine: c.columnDefine,
+			selectivity:  c.selectivity,
+		})
+	}
+	return referred
 }
 
 func (a *threeStarIndexAdvisor) loadEssentials() (err error) {
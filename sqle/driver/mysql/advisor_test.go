@@ -53,6 +53,22 @@ func newThreeStarOptimizeResult(columns []string, tableName string) *OptimizeRes
 	}
 }
 
+func newThreeStarCoverIndexOptimizeResult(columns []string, tableName string) *OptimizeResult {
+	return &OptimizeResult{
+		Reason:         plocale.Bundle.LocalizeAllWithArgs(plocale.ThreeStarCoverIndexAdviceFormat, tableName, strings.Join(columns, "，")),
+		IndexedColumns: columns,
+		TableName:      tableName,
+	}
+}
+
+func newThreeStarCoverIndexExceedOptimizeResult(columns []string, tableName string, maxColumn int) *OptimizeResult {
+	return &OptimizeResult{
+		Reason:         plocale.Bundle.LocalizeAllWithArgs(plocale.ThreeStarCoverIndexExceedMaxColumnFormat, tableName, len(columns), strings.Join(columns, "，"), maxColumn),
+		IndexedColumns: columns,
+		TableName:      tableName,
+	}
+}
+
 func mockFunctionOptimizeResult(caseName string, c optimizerTestContent, t *testing.T) []*OptimizeResult {
 	return mockOptimizeResultWithAdvisor(c.sql, c.maxColumn, c.queryResults, caseName, t, newFunctionIndexAdvisor)
 }
@@ -89,6 +105,34 @@ func newJoinIndexOptimizeResult(indexColumn []string, drivenTableName string) *O
 	}
 }
 
+func mockOptimizeResult(caseName string, c optimizerTestContent, t *testing.T) []*OptimizeResult {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoErrorf(t, err, caseName)
+	for _, expect := range c.queryResults {
+		handler.ExpectQuery(expect.query).WillReturnRows(expect.result)
+	}
+
+	impl := NewMockInspectWithIsExecutedSQL(e)
+	node, err := util.ParseOneSql(c.sql)
+	assert.NoErrorf(t, err, caseName)
+
+	return optimize(impl.log, impl.Ctx, node, params.Params{
+		{
+			Key:   MAX_INDEX_COLUMN,
+			Value: fmt.Sprint(c.maxColumn),
+			Type:  params.ParamTypeInt,
+		},
+	})
+}
+
+func newExtendIndexOptimizeResult(columns []string, tableName, indexName string, extendColumns []string) *OptimizeResult {
+	return &OptimizeResult{
+		Reason:         plocale.Bundle.LocalizeAllWithArgs(plocale.ExtendIndexAdviceFormat, tableName, indexName, strings.Join(extendColumns, "，")),
+		IndexedColumns: columns,
+		TableName:      tableName,
+	}
+}
+
 func mockOptimizeResultWithAdvisor(sql string, maxColumn int, queryResults []*queryResult, caseName string, t *testing.T, f func(ctx *session.Context, log *logrus.Entry, originNode ast.Node, params params.Params) CreateIndexAdvisor) []*OptimizeResult {
 	e, handler, err := executor.NewMockExecutor()
 	assert.NoErrorf(t, err, caseName)
@@ -228,8 +272,8 @@ func TestFunctionIndexOptimize(t *testing.T) {
 				query:  regexp.QuoteMeta(showWarnings),
 				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
 			}, {
-				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES LIKE 'version'"),
-				result: sqlmock.NewRows([]string{"Value"}).AddRow("8.0.13"),
+				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version')"),
+				result: sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "8.0.13"),
 			},
 		},
 		expectResults: []*OptimizeResult{
@@ -247,8 +291,8 @@ func TestFunctionIndexOptimize(t *testing.T) {
 				query:  regexp.QuoteMeta(showWarnings),
 				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
 			}, {
-				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES LIKE 'version'"),
-				result: sqlmock.NewRows([]string{"Value"}).AddRow("5.7.1"),
+				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version')"),
+				result: sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "5.7.1"),
 			},
 		},
 		expectResults: []*OptimizeResult{
@@ -266,8 +310,8 @@ func TestFunctionIndexOptimize(t *testing.T) {
 				query:  regexp.QuoteMeta(showWarnings),
 				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
 			}, {
-				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES LIKE 'version'"),
-				result: sqlmock.NewRows([]string{"Value"}).AddRow("5.2.1"),
+				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version')"),
+				result: sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "5.2.1"),
 			},
 		},
 		maxColumn: 4,
@@ -282,8 +326,8 @@ func TestFunctionIndexOptimize(t *testing.T) {
 				query:  regexp.QuoteMeta(showWarnings),
 				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
 			}, {
-				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES LIKE 'version'"),
-				result: sqlmock.NewRows([]string{"Value"}).AddRow("5.2.1"),
+				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version')"),
+				result: sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "5.2.1"),
 			},
 		},
 		maxColumn: 4,
@@ -298,8 +342,8 @@ func TestFunctionIndexOptimize(t *testing.T) {
 				query:  regexp.QuoteMeta(showWarnings),
 				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
 			}, {
-				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES LIKE 'version'"),
-				result: sqlmock.NewRows([]string{"Value"}).AddRow("?,?,?"),
+				query:  regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version')"),
+				result: sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "?,?,?"),
 			},
 		},
 		expectResults: []*OptimizeResult{
@@ -360,6 +404,7 @@ func TestThreeStarOptimize(t *testing.T) {
 		},
 		expectResults: []*OptimizeResult{
 			newThreeStarOptimizeResult([]string{"v1", "v3"}, "exist_tb_3"),
+			newThreeStarCoverIndexOptimizeResult([]string{"v1", "v3", "v2"}, "exist_tb_3"),
 		},
 		maxColumn: 3,
 	}
@@ -547,6 +592,7 @@ func TestThreeStarOptimize(t *testing.T) {
 		},
 		expectResults: []*OptimizeResult{
 			newThreeStarOptimizeResult([]string{"v1"}, "exist_tb_10"),
+			newThreeStarCoverIndexOptimizeResult([]string{"v1", "v2", "v5"}, "exist_tb_10"),
 		},
 		maxColumn: 3,
 	}
@@ -566,6 +612,7 @@ func TestThreeStarOptimize(t *testing.T) {
 		},
 		expectResults: []*OptimizeResult{
 			newThreeStarOptimizeResult([]string{"v2", "v1"}, "t10"),
+			newThreeStarCoverIndexOptimizeResult([]string{"v2", "v1", "v5"}, "t10"),
 		},
 		maxColumn: 3,
 	}
@@ -623,6 +670,64 @@ func TestThreeStarOptimize(t *testing.T) {
 		},
 		maxColumn: 6,
 	}
+	testCases["test16-覆盖索引超过列数上限"] = optimizerTestContent{
+		sql: `SELECT id,v1,v2,v3 FROM exist_tb_3 WHERE v1 = "s" ORDER BY v3`,
+		queryResults: []*queryResult{
+			{
+				query:  regexp.QuoteMeta(fmt.Sprintf(explainFormat, `SELECT id,v1,v2,v3 FROM exist_tb_3 WHERE v1 = "s" ORDER BY v3`)),
+				result: sqlmock.NewRows(explainColumns).AddRow(explainTypeAll, "exist_tb_3"),
+			}, {
+				query:  regexp.QuoteMeta(showWarnings),
+				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
+			}, {
+				query:  regexp.QuoteMeta(`SELECT COUNT`),
+				result: sqlmock.NewRows([]string{"v1", "v3", "id", "v2"}).AddRow(100.00, 23.56, 70.12, 80.98),
+			},
+		},
+		expectResults: []*OptimizeResult{
+			newThreeStarOptimizeResult([]string{"v1", "v3"}, "exist_tb_3"),
+			newThreeStarCoverIndexExceedOptimizeResult([]string{"v1", "v3", "v2"}, "exist_tb_3", 2),
+		},
+		maxColumn: 2,
+	}
+	testCases["test17-不适合作为索引的列无法形成覆盖索引"] = optimizerTestContent{
+		sql: `SELECT v1,v3 FROM exist_tb_10 WHERE v5 = 1`,
+		queryResults: []*queryResult{
+			{
+				query:  regexp.QuoteMeta(fmt.Sprintf(explainFormat, `SELECT v1,v3 FROM exist_tb_10 WHERE v5 = 1`)),
+				result: sqlmock.NewRows(explainColumns).AddRow(explainTypeAll, "exist_tb_10"),
+			}, {
+				query:  regexp.QuoteMeta(showWarnings),
+				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
+			}, {
+				query:  regexp.QuoteMeta(`SELECT COUNT`),
+				result: sqlmock.NewRows([]string{"id", "v1", "v2", "v3", "v4", "v5"}).AddRow(100.00, 23.56, 70.12, 2, 23.4, 30.1),
+			},
+		},
+		expectResults: []*OptimizeResult{
+			newThreeStarOptimizeResult([]string{"v5"}, "exist_tb_10"),
+		},
+		maxColumn: 3,
+	}
+	testCases["test18-主键等值查询不给出覆盖索引"] = optimizerTestContent{
+		sql: `SELECT v1,v2 FROM exist_tb_10 WHERE id = 1 AND v5 = 1`,
+		queryResults: []*queryResult{
+			{
+				query:  regexp.QuoteMeta(fmt.Sprintf(explainFormat, `SELECT v1,v2 FROM exist_tb_10 WHERE id = 1 AND v5 = 1`)),
+				result: sqlmock.NewRows(explainColumns).AddRow(explainTypeAll, "exist_tb_10"),
+			}, {
+				query:  regexp.QuoteMeta(showWarnings),
+				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
+			}, {
+				query:  regexp.QuoteMeta(`SELECT COUNT`),
+				result: sqlmock.NewRows([]string{"id", "v1", "v2", "v3", "v4", "v5"}).AddRow(100.00, 23.56, 70.12, 2, 23.4, 30.1),
+			},
+		},
+		expectResults: []*OptimizeResult{
+			newThreeStarOptimizeResult([]string{"v5"}, "exist_tb_10"),
+		},
+		maxColumn: 1,
+	}
 	testCases.testAll(mockThreeStarOptimizeResult, t)
 }
 
@@ -755,3 +860,78 @@ func TestJoinOptimize(t *testing.T) {
 	}
 	testCases.testAll(mockJoinOptimizeResult, t)
 }
+
+func TestOptimizeWithExistingIndexes(t *testing.T) {
+	testCases := make(optimizerTestCaseMap)
+	testCases["test1-建议的索引为已有的主键"] = optimizerTestContent{
+		sql: `SELECT id FROM exist_tb_3 WHERE id > 10`,
+		queryResults: []*queryResult{
+			{
+				query:  regexp.QuoteMeta(fmt.Sprintf(explainFormat, `SELECT id FROM exist_tb_3 WHERE id > 10`)),
+				result: sqlmock.NewRows(explainColumns).AddRow(explainTypeAll, "exist_tb_3"),
+			}, {
+				query:  regexp.QuoteMeta(showWarnings),
+				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
+			}, {
+				query:  regexp.QuoteMeta(`SELECT COUNT`),
+				result: sqlmock.NewRows([]string{"id", "v1", "v2", "v3"}).AddRow(100.00, 23.56, 70.12, 80.98),
+			},
+		},
+		maxColumn: 3,
+	}
+	testCases["test2-在已有的单列索引上追加列"] = optimizerTestContent{
+		sql: `SELECT v3,v5 FROM exist_tb_9 WHERE v3 = 1`,
+		queryResults: []*queryResult{
+			{
+				query:  regexp.QuoteMeta(fmt.Sprintf(explainFormat, `SELECT v3,v5 FROM exist_tb_9 WHERE v3 = 1`)),
+				result: sqlmock.NewRows(explainColumns).AddRow(explainTypeAll, "exist_tb_9"),
+			}, {
+				query:  regexp.QuoteMeta(showWarnings),
+				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
+			}, {
+				query:  regexp.QuoteMeta(`SELECT COUNT`),
+				result: sqlmock.NewRows([]string{"id", "v1", "v2", "v3", "v4", "v5"}).AddRow(100.00, 23.56, 70.12, 30.5, 23.4, 30.1),
+			},
+		},
+		expectResults: []*OptimizeResult{
+			newExtendIndexOptimizeResult([]string{"v3", "v5"}, "exist_tb_9", "idx_100", []string{"v5"}),
+		},
+		maxColumn: 3,
+	}
+	testCases["test3-建议的索引为已有的单列索引"] = optimizerTestContent{
+		sql: `SELECT v3 FROM exist_tb_9 WHERE v3 = 1`,
+		queryResults: []*queryResult{
+			{
+				query:  regexp.QuoteMeta(fmt.Sprintf(explainFormat, `SELECT v3 FROM exist_tb_9 WHERE v3 = 1`)),
+				result: sqlmock.NewRows(explainColumns).AddRow(explainTypeAll, "exist_tb_9"),
+			}, {
+				query:  regexp.QuoteMeta(showWarnings),
+				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
+			}, {
+				query:  regexp.QuoteMeta(`SELECT COUNT`),
+				result: sqlmock.NewRows([]string{"id", "v1", "v2", "v3", "v4", "v5"}).AddRow(100.00, 23.56, 70.12, 30.5, 23.4, 30.1),
+			},
+		},
+		maxColumn: 3,
+	}
+	testCases["test4-已有索引不是建议索引的前缀"] = optimizerTestContent{
+		sql: `SELECT v5 FROM exist_tb_9 WHERE v5 = 1`,
+		queryResults: []*queryResult{
+			{
+				query:  regexp.QuoteMeta(fmt.Sprintf(explainFormat, `SELECT v5 FROM exist_tb_9 WHERE v5 = 1`)),
+				result: sqlmock.NewRows(explainColumns).AddRow(explainTypeAll, "exist_tb_9"),
+			}, {
+				query:  regexp.QuoteMeta(showWarnings),
+				result: sqlmock.NewRows([]string{"Level", "Code", "Message"}),
+			}, {
+				query:  regexp.QuoteMeta(`SELECT COUNT`),
+				result: sqlmock.NewRows([]string{"id", "v1", "v2", "v3", "v4", "v5"}).AddRow(100.00, 23.56, 70.12, 30.5, 23.4, 30.1),
+			},
+		},
+		expectResults: []*OptimizeResult{
+			newThreeStarOptimizeResult([]string{"v5"}, "exist_tb_9"),
+		},
+		maxColumn: 3,
+	}
+	testCases.testAll(mockOptimizeResult, t)
+}
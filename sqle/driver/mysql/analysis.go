@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/actiontech/sqle/sqle/driver"
@@ -61,7 +63,7 @@ import (
 // }
 
 func (i *MysqlDriverImpl) getTableMetaByTableName(ctx context.Context, schema, table string) (driverV2.ColumnsInfo, driverV2.IndexesInfo, string, error) {
-	conn, err := i.getDbConn()
+	conn, err := i.getReadDbConn()
 	if err != nil {
 		return driverV2.ColumnsInfo{}, driverV2.IndexesInfo{}, "", err
 	}
@@ -209,6 +211,97 @@ func (i *MysqlDriverImpl) getTableIndexesInfo(conn *executor.Executor, schema, t
 	return ret, nil
 }
 
+// getTableStatisticsInfo gets the cardinality and selectivity of index columns, the
+// selectivity is the percentage of cardinality to table rows, which is low if it is
+// not greater than the minimum selectivity used by the index optimizer.
+func (i *MysqlDriverImpl) getTableStatisticsInfo(schema, tableName string) (driverV2.StatisticsInfo, error) {
+	conn, err := i.getReadDbConn()
+	if err != nil {
+		return driverV2.StatisticsInfo{}, err
+	}
+
+	columns := []driverV2.TabularDataHead{
+		{
+			Name:     "Key_name",
+			I18nDesc: plocale.Bundle.LocalizeAll(plocale.AnalysisDescKeyName),
+		}, {
+			Name:     "Column_name",
+			I18nDesc: plocale.Bundle.LocalizeAll(plocale.AnalysisDescColumnName),
+		}, {
+			Name:     "Seq_in_index",
+			I18nDesc: plocale.Bundle.LocalizeAll(plocale.AnalysisDescSeqInIndex),
+		}, {
+			Name:     "Cardinality",
+			I18nDesc: plocale.Bundle.LocalizeAll(plocale.AnalysisDescCardinality),
+		}, {
+			Name:     "Table_rows",
+			I18nDesc: plocale.Bundle.LocalizeAll(plocale.AnalysisDescTableRows),
+		}, {
+			// the percentage of Cardinality to Table_rows, it is empty if Table_rows is unknown
+			Name:     "Selectivity",
+			I18nDesc: plocale.Bundle.LocalizeAll(plocale.AnalysisDescSelectivity),
+		}, {
+			// set the row's value as YES if Selectivity is not greater than the minimum selectivity
+			Name:     "Low_selectivity",
+			I18nDesc: plocale.Bundle.LocalizeAll(plocale.AnalysisDescLowSelectivity),
+		},
+	}
+
+	records, err := conn.GetTableIndexStatistics(schema, tableName)
+	if err != nil {
+		return driverV2.StatisticsInfo{}, err
+	}
+	// 没有 INFORMATION_SCHEMA 中表的权限时结果为空，使用 SHOW INDEX 的基数，表行数未知
+	if len(records) == 0 {
+		indexRecords, err := conn.GetTableIndexesInfo(schema, tableName)
+		if err != nil {
+			return driverV2.StatisticsInfo{}, err
+		}
+		for _, record := range indexRecords {
+			records = append(records, &executor.TableIndexStatistics{
+				KeyName:     record.KeyName,
+				ColumnName:  record.ColumnName,
+				SeqInIndex:  record.SeqInIndex,
+				Cardinality: record.Cardinality,
+			})
+		}
+	}
+
+	minSelectivity := i.cnf.indexSelectivityMinValue
+	if minSelectivity == 0 {
+		minSelectivity = MIN_COLUMN_SELECTIVITY_DEFAULT_VALUE
+	}
+	rows := make([][]string, len(records))
+	for i, record := range records {
+		selectivity, lowSelectivity := "", ""
+		cardinality, cardinalityErr := strconv.ParseFloat(record.Cardinality, 64)
+		tableRows, tableRowsErr := strconv.ParseFloat(record.TableRows, 64)
+		if cardinalityErr == nil && tableRowsErr == nil && tableRows > 0 {
+			// 基数和表行数都是估算值，基数可能大于表行数
+			value := math.Min(cardinality/tableRows*100, 100)
+			selectivity = strconv.FormatFloat(value, 'f', 2, 64)
+			lowSelectivity = "NO"
+			if value <= minSelectivity {
+				lowSelectivity = "YES"
+			}
+		}
+		rows[i] = []string{
+			record.KeyName,
+			record.ColumnName,
+			record.SeqInIndex,
+			record.Cardinality,
+			record.TableRows,
+			selectivity,
+			lowSelectivity,
+		}
+	}
+
+	ret := driverV2.StatisticsInfo{}
+	ret.Columns = columns
+	ret.Rows = rows
+	return ret, nil
+}
+
 // GetTableMetaBySQL get table's metadata by SQL.
 func (i *MysqlDriverImpl) GetTableMetaBySQL(ctx context.Context, conf *driver.GetTableMetaBySQLConf) (*driver.GetTableMetaBySQLResult, error) {
 	schemaTableList, err := i.ExtractSchemaTableList(conf.Sql)
@@ -218,7 +311,7 @@ func (i *MysqlDriverImpl) GetTableMetaBySQL(ctx context.Context, conf *driver.Ge
 
 	tableMetas := make([]*driver.TableMeta, 0, len(schemaTableList))
 	for _, schemaTable := range schemaTableList {
-		tableMeta := i.GetTableMeta(ctx, schemaTable)
+		tableMeta := i.GetTableMeta(ctx, schemaTable, conf.WithStatistics)
 		tableMetas = append(tableMetas, tableMeta)
 	}
 
@@ -227,13 +320,22 @@ func (i *MysqlDriverImpl) GetTableMetaBySQL(ctx context.Context, conf *driver.Ge
 	}, nil
 }
 
-func (i *MysqlDriverImpl) GetTableMeta(ctx context.Context, schemaTable SchemaTable) *driver.TableMeta {
+func (i *MysqlDriverImpl) GetTableMeta(ctx context.Context, schemaTable SchemaTable, withStatistics bool) *driver.TableMeta {
 	msg := ""
 	columnsInfo, indexesInfo, sql, err := i.getTableMetaByTableName(ctx, schemaTable.Schema, schemaTable.Table)
 	if err != nil {
 		msg = err.Error()
 	}
 
+	var statisticsInfo driverV2.StatisticsInfo
+	if withStatistics && err == nil {
+		// 统计信息是可选的，获取失败时不影响其他元数据
+		statisticsInfo, err = i.getTableStatisticsInfo(schemaTable.Schema, schemaTable.Table)
+		if err != nil {
+			msg = fmt.Sprintf("get statistics failed: %v", err)
+		}
+	}
+
 	tm := &driver.TableMeta{}
 	tm.Name = schemaTable.Table
 	tm.Schema = schemaTable.Schema
@@ -241,6 +343,7 @@ func (i *MysqlDriverImpl) GetTableMeta(ctx context.Context, schemaTable SchemaTa
 	tm.IndexesInfo = indexesInfo
 	tm.CreateTableSQL = sql
 	tm.Message = msg
+	tm.StatisticsInfo = statisticsInfo
 
 	return tm
 }
@@ -360,7 +463,7 @@ func (i *MysqlDriverImpl) Explain(ctx context.Context, conf *driverV2.ExplainCon
 		return nil, driverV2.ErrSQLIsNotSupported
 	}
 
-	conn, err := i.getDbConn()
+	conn, err := i.getReadDbConn()
 	if err != nil {
 		return nil, err
 	}
@@ -400,7 +503,7 @@ func (i *MysqlDriverImpl) ExplainJSONFormat(ctx context.Context, conf *driverV2.
 	} else if !isDML {
 		return nil, driverV2.ErrSQLIsNotSupported
 	}
-	conn, err := i.getDbConn()
+	conn, err := i.getReadDbConn()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,85 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTableMetaWithStatistics(t *testing.T) {
+	expectTableMeta := func(handler sqlmock.Sqlmock) {
+		handler.ExpectQuery("FROM INFORMATION_SCHEMA.COLUMNS").WithArgs("exist_db", "t1").
+			WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "COLUMN_TYPE"}).AddRow("id", "int").AddRow("v1", "varchar(255)"))
+		handler.ExpectQuery("SHOW INDEX FROM exist_db.t1").
+			WillReturnRows(sqlmock.NewRows([]string{"Column_name", "Key_name", "Non_unique", "Seq_in_index", "Cardinality"}).
+				AddRow("id", "PRIMARY", "0", "1", "1000").
+				AddRow("v1", "idx_v1", "1", "1", "10"))
+		handler.ExpectQuery("show create table `exist_db`.`t1`").
+			WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("t1", "CREATE TABLE `t1` (...)"))
+	}
+
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i := NewMockInspectWithIsExecutedSQL(e)
+	expectTableMeta(handler)
+	handler.ExpectQuery("FROM INFORMATION_SCHEMA.STATISTICS s JOIN INFORMATION_SCHEMA.TABLES t").WithArgs("exist_db", "t1").
+		WillReturnRows(sqlmock.NewRows([]string{"INDEX_NAME", "COLUMN_NAME", "SEQ_IN_INDEX", "CARDINALITY", "TABLE_ROWS"}).
+			AddRow("PRIMARY", "id", "1", "1000", "1000").
+			AddRow("idx_v1", "v1", "1", "10", "1000").
+			AddRow("idx_v2", "v2", "1", "1200", "1000").
+			AddRow("idx_v3", "v3", "1", nil, "0"))
+	tm := i.GetTableMeta(context.TODO(), SchemaTable{Schema: "exist_db", Table: "t1"}, true)
+	assert.NoError(t, handler.ExpectationsWereMet())
+	assert.Empty(t, tm.Message)
+	assert.Len(t, tm.ColumnsInfo.Rows, 2)
+	assert.Len(t, tm.StatisticsInfo.Columns, 7)
+	assert.Equal(t, [][]string{
+		{"PRIMARY", "id", "1", "1000", "1000", "100.00", "NO"},
+		{"idx_v1", "v1", "1", "10", "1000", "1.00", "YES"},
+		// the cardinality is greater than the table rows
+		{"idx_v2", "v2", "1", "1200", "1000", "100.00", "NO"},
+		{"idx_v3", "v3", "1", "", "0", "", ""},
+	}, tm.StatisticsInfo.Rows)
+
+	// the cardinality of SHOW INDEX is used if the statistics are not visible
+	e, handler, err = executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i = NewMockInspectWithIsExecutedSQL(e)
+	expectTableMeta(handler)
+	handler.ExpectQuery("FROM INFORMATION_SCHEMA.STATISTICS s JOIN INFORMATION_SCHEMA.TABLES t").WithArgs("exist_db", "t1").
+		WillReturnRows(sqlmock.NewRows([]string{"INDEX_NAME", "COLUMN_NAME", "SEQ_IN_INDEX", "CARDINALITY", "TABLE_ROWS"}))
+	handler.ExpectQuery("SHOW INDEX FROM exist_db.t1").
+		WillReturnRows(sqlmock.NewRows([]string{"Column_name", "Key_name", "Non_unique", "Seq_in_index", "Cardinality"}).
+			AddRow("id", "PRIMARY", "0", "1", "1000"))
+	tm = i.GetTableMeta(context.TODO(), SchemaTable{Schema: "exist_db", Table: "t1"}, true)
+	assert.NoError(t, handler.ExpectationsWereMet())
+	assert.Empty(t, tm.Message)
+	assert.Equal(t, [][]string{{"PRIMARY", "id", "1", "1000", "", "", ""}}, tm.StatisticsInfo.Rows)
+
+	// the other metadata is kept if the statistics can not be got
+	e, handler, err = executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i = NewMockInspectWithIsExecutedSQL(e)
+	expectTableMeta(handler)
+	handler.ExpectQuery("FROM INFORMATION_SCHEMA.STATISTICS s JOIN INFORMATION_SCHEMA.TABLES t").
+		WillReturnError(errors.New("SELECT command denied"))
+	tm = i.GetTableMeta(context.TODO(), SchemaTable{Schema: "exist_db", Table: "t1"}, true)
+	assert.NoError(t, handler.ExpectationsWereMet())
+	assert.Contains(t, tm.Message, "SELECT command denied")
+	assert.Len(t, tm.ColumnsInfo.Rows, 2)
+	assert.Len(t, tm.IndexesInfo.Rows, 2)
+	assert.Empty(t, tm.StatisticsInfo.Rows)
+
+	// the statistics are not got if they are not requested
+	e, handler, err = executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i = NewMockInspectWithIsExecutedSQL(e)
+	expectTableMeta(handler)
+	tm = i.GetTableMeta(context.TODO(), SchemaTable{Schema: "exist_db", Table: "t1"}, false)
+	assert.NoError(t, handler.ExpectationsWereMet())
+	assert.Empty(t, tm.StatisticsInfo.Columns)
+}
@@ -13,10 +13,16 @@ import (
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
 	"github.com/actiontech/sqle/sqle/utils"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/pingcap/parser/ast"
+	"github.com/pkg/errors"
 )
 
 func (i *MysqlDriverImpl) CheckInvalid(node ast.Node) error {
+	if i.checkMariaDBSyntax(node) {
+		return nil
+	}
+	i.checkCheckConstraintEnforced(node)
 	var err error
 	switch stmt := node.(type) {
 	case *ast.UseStmt:
@@ -65,7 +71,8 @@ func (i *MysqlDriverImpl) CheckExplain(node ast.Node) error {
 		}
 		_, err = i.Ctx.GetExecutionPlan(node.Text())
 	}
-	if err != nil {
+	// 预处理语句的参数值未知，无法获取执行计划，不作为预检查失败
+	if err != nil && !errors.Is(err, util.ErrSqlWithParamMarker) {
 		i.result.Add(driverV2.RuleLevelWarn, rulepkg.ConfigDMLExplainPreCheckEnable,
 			plocale.Bundle.LocalizeAll(plocale.CheckInvalidErrorFormat), err)
 	}
@@ -74,6 +81,10 @@ func (i *MysqlDriverImpl) CheckExplain(node ast.Node) error {
 }
 
 func (i *MysqlDriverImpl) CheckInvalidOffline(node ast.Node) error {
+	if i.checkMariaDBSyntax(node) {
+		return nil
+	}
+	i.checkCheckConstraintEnforced(node)
 	var err error
 	switch stmt := node.(type) {
 	case *ast.CreateTableStmt:
@@ -1156,6 +1167,100 @@ func (i *MysqlDriverImpl) checkInvalidSelect(stmt *ast.SelectStmt) error {
 }
 
 // checkUnparsedStmt might add more check in future.
+// checkMariaDBSyntax checks the statement using MariaDB-only syntax, see util.GetMariaDBSyntax.
+// It returns true if the other checks should be skipped, i.e. the syntax is unsupported
+// by the server which is not MariaDB, or the statement can not be parsed.
+func (i *MysqlDriverImpl) checkMariaDBSyntax(node ast.Node) bool {
+	syntax, ok := util.GetMariaDBSyntax(node.Text())
+	if !ok {
+		return false
+	}
+	// 离线审核且未指定目标版本时无法得知是否为 MariaDB，按 MariaDB 处理
+	isMariaDB := true
+	if !i.IsOfflineAudit() || i.Ctx.HasTargetVersion() {
+		var err error
+		if isMariaDB, err = i.Ctx.IsMariaDB(); err != nil {
+			i.Logger().Warnf("check whether the server is MariaDB failed: %v", err)
+			isMariaDB = true
+		}
+	}
+	if !isMariaDB {
+		i.result.Add(driverV2.RuleLevelWarn, "", plocale.Bundle.LocalizeAll(plocale.UnsupportedSyntaxError))
+		return true
+	}
+	if _, ok := node.(*ast.UnparsedStmt); ok {
+		i.result.Add(driverV2.RuleLevelNotice, "", plocale.Bundle.LocalizeAllWithArgs(plocale.MariaDBSyntaxSkipped, syntax))
+		return true
+	}
+	return false
+}
+
+// checkCheckConstraintEnforced adds a notice if the statement defines CHECK constraints
+// which are parsed but not enforced by the target version, i.e. MySQL before 8.0.16 or
+// MariaDB before 10.2.1. It is skipped if the target version is unknown.
+func (i *MysqlDriverImpl) checkCheckConstraintEnforced(node ast.Node) {
+	if !hasCheckConstraint(node) {
+		return
+	}
+	version, err := i.Ctx.GetTargetVersion()
+	if err != nil {
+		i.Logger().Warnf("get target version failed: %v", err)
+		return
+	}
+	if version == "" {
+		return
+	}
+	v, err := session.ParseVersion(version)
+	if err != nil {
+		i.Logger().Warnf("parse target version failed: %v", err)
+		return
+	}
+	minVersion := checkConstraintMinMySQLVersion
+	if isMariaDB, _ := i.Ctx.IsMariaDB(); isMariaDB {
+		minVersion = checkConstraintMinMariaDBVersion
+	}
+	if v.LessThan(minVersion) {
+		i.result.Add(driverV2.RuleLevelNotice, "", plocale.Bundle.LocalizeAllWithArgs(plocale.CheckConstraintNotEnforced, version))
+	}
+}
+
+var (
+	// ref: https://dev.mysql.com/doc/refman/8.0/en/create-table-check-constraints.html
+	checkConstraintMinMySQLVersion = semver.MustParse("8.0.16")
+	// ref: https://mariadb.com/kb/en/constraint/#check-constraints
+	checkConstraintMinMariaDBVersion = semver.MustParse("10.2.1")
+)
+
+func hasCheckConstraint(node ast.Node) bool {
+	hasColumnCheck := func(cols []*ast.ColumnDef) bool {
+		for _, col := range cols {
+			if util.HasOneInOptions(col.Options, ast.ColumnOptionCheck) {
+				return true
+			}
+		}
+		return false
+	}
+	switch stmt := node.(type) {
+	case *ast.CreateTableStmt:
+		for _, constraint := range stmt.Constraints {
+			if constraint.Tp == ast.ConstraintCheck {
+				return true
+			}
+		}
+		return hasColumnCheck(stmt.Cols)
+	case *ast.AlterTableStmt:
+		for _, spec := range stmt.Specs {
+			if spec.Constraint != nil && spec.Constraint.Tp == ast.ConstraintCheck {
+				return true
+			}
+			if hasColumnCheck(spec.NewColumns) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (i *MysqlDriverImpl) checkUnparsedStmt(stmt *ast.UnparsedStmt) error {
 	i.result.Add(driverV2.RuleLevelWarn, "", plocale.Bundle.LocalizeAll(plocale.UnsupportedSyntaxError))
 	return nil
@@ -2400,6 +2400,45 @@ SELECT * FROM exist_db.exist_tb_2;
 		newTestResult().addResult(rulepkg.DMLCheckWhereIsInvalid))
 }
 
+func Test_MariaDBSyntaxOffline(t *testing.T) {
+	runSingleRuleInspectCase(rulepkg.RuleHandlerMap[rulepkg.DMLCheckWhereIsInvalid].Rule, t, "", DefaultMysqlInspectOffline(), `
+DELETE FROM exist_db.exist_tb_1 RETURNING id;
+CREATE OR REPLACE TABLE exist_db.exist_tb_1 (id INT);
+ALTER SEQUENCE exist_db.s1 RESTART 10;
+`, newTestResult().addResult(rulepkg.DMLCheckWhereIsInvalid),
+		newTestResult().add(driverV2.RuleLevelNotice, "", "解析器不支持MariaDB语法 CREATE OR REPLACE TABLE，已跳过审核，请人工确认SQL正确性"),
+		newTestResult().add(driverV2.RuleLevelNotice, "", "解析器不支持MariaDB语法 SEQUENCE，已跳过审核，请人工确认SQL正确性"))
+}
+
+func Test_CheckConstraintTargetVersionOffline(t *testing.T) {
+	rule := rulepkg.RuleHandlerMap[rulepkg.DDLCheckPKWithoutIfNotExists].Rule
+	inspectWithVersion := func(version string) *MysqlDriverImpl {
+		inspect := DefaultMysqlInspectOffline()
+		inspect.Ctx = session.NewContext(nil, session.WithTargetVersion(version))
+		return inspect
+	}
+	notEnforced := func(version string) *testResult {
+		return newTestResult().add(driverV2.RuleLevelNotice, "",
+			"目标版本 %v 会解析但不会强制执行CHECK约束（MySQL 8.0.16、MariaDB 10.2.1及以上版本才会生效），请在应用中校验数据", version)
+	}
+
+	for _, sql := range []string{
+		"create table if not exists exist_db.t1(id int primary key, age int, constraint chk_age check (age > 0))",
+		"create table if not exists exist_db.t1(id int primary key, age int check (age > 0))",
+		"alter table exist_db.exist_tb_1 add constraint chk_id check (id > 0)",
+		"alter table exist_db.exist_tb_1 add column age int check (age > 0)",
+	} {
+		runSingleRuleInspectCase(rule, t, "mysql 5.7", inspectWithVersion("5.7.44-log"), sql, notEnforced("5.7.44-log"))
+		runSingleRuleInspectCase(rule, t, "mariadb 10.1", inspectWithVersion("10.1.48-MariaDB"), sql, notEnforced("10.1.48-MariaDB"))
+		runSingleRuleInspectCase(rule, t, "mysql 8.0.16", inspectWithVersion("8.0.16"), sql, newTestResult())
+		runSingleRuleInspectCase(rule, t, "mariadb 10.6", inspectWithVersion("10.6.12-MariaDB"), sql, newTestResult())
+		runSingleRuleInspectCase(rule, t, "unknown version", DefaultMysqlInspectOffline(), sql, newTestResult())
+	}
+
+	runSingleRuleInspectCase(rule, t, "without check constraint", inspectWithVersion("5.7.44"),
+		"create table if not exists exist_db.t1(id int primary key)", newTestResult())
+}
+
 func Test_DDLCheckCreateViewOffline(t *testing.T) {
 	for _, sql := range []string{
 		`create view v as select * from t1`,
@@ -2585,6 +2624,37 @@ func TestDMLCheckLimitOffsetNum(t *testing.T) {
 		`SELECT * FROM tbl LIMIT 10 OFFSET 5`,
 		newTestResult().addResult(rulepkg.DMLCheckLimitOffsetNum, 5, 4))
 
+	runSingleRuleInspectCase(
+		rule,
+		t,
+		`(3)select with limit offset equal to threshold`,
+		DefaultMysqlInspectOffline(),
+		`SELECT * FROM tbl LIMIT 4,10`,
+		newTestResult())
+
+	runSingleRuleInspectCase(
+		rule,
+		t,
+		`(4)subquery with limit offset`,
+		DefaultMysqlInspectOffline(),
+		`SELECT * FROM (SELECT * FROM tbl LIMIT 10 OFFSET 6) t1 WHERE id IN (SELECT id FROM tbl LIMIT 8, 10) LIMIT 1, 10`,
+		newTestResult().addResult(rulepkg.DMLCheckLimitOffsetNum, 8, 4))
+
+	runSingleRuleInspectCase(
+		rule,
+		t,
+		`(5)insert select with limit offset`,
+		DefaultMysqlInspectOffline(),
+		`INSERT INTO tbl2 SELECT * FROM tbl LIMIT 5,10`,
+		newTestResult().addResult(rulepkg.DMLCheckLimitOffsetNum, 5, 4))
+
+	runSingleRuleInspectCase(
+		rule,
+		t,
+		`(6)select with limit without offset`,
+		DefaultMysqlInspectOffline(),
+		`SELECT * FROM tbl LIMIT 100`,
+		newTestResult())
 }
 
 func TestDMLCheckUpdateOrDeleteHasWhere(t *testing.T) {
@@ -2652,6 +2722,64 @@ func TestDMLCheckUpdateOrDeleteHasWhere(t *testing.T) {
 			`DELETE FROM t1`,
 			newTestResult().addResult(rulepkg.DMLCheckUpdateOrDeleteHasWhere))
 	})
+	t.Run(`(8)multi-table delete without where`, func(t *testing.T) {
+		runSingleRuleInspectCase(
+			rule,
+			t,
+			``,
+			DefaultMysqlInspectOffline(),
+			`DELETE t1, t2 FROM t1 JOIN t2 ON t1.id=t2.id`,
+			newTestResult().addResult(rulepkg.DMLCheckUpdateOrDeleteHasWhere))
+	})
+	t.Run(`(9)multi-table update without where`, func(t *testing.T) {
+		runSingleRuleInspectCase(
+			rule,
+			t,
+			``,
+			DefaultMysqlInspectOffline(),
+			`UPDATE t1 JOIN t2 ON t1.id=t2.id SET t1.col1 = t2.col1`,
+			newTestResult().addResult(rulepkg.DMLCheckUpdateOrDeleteHasWhere))
+	})
+	t.Run(`(10)delete with limit but without where`, func(t *testing.T) {
+		runSingleRuleInspectCase(
+			rule,
+			t,
+			``,
+			DefaultMysqlInspectOffline(),
+			`DELETE FROM t1 LIMIT 1000`,
+			newTestResult().addResult(rulepkg.DMLCheckUpdateOrDeleteHasWhere))
+	})
+
+	allowLimitRule := rule
+	allowLimitRule.Params = rule.Params.Copy()
+	allowLimitRule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "true")
+	t.Run(`(11)delete with limit but without where, limit is allowed`, func(t *testing.T) {
+		runSingleRuleInspectCase(
+			allowLimitRule,
+			t,
+			``,
+			DefaultMysqlInspectOffline(),
+			`DELETE FROM t1 ORDER BY id LIMIT 1000`,
+			newTestResult())
+	})
+	t.Run(`(12)update with limit but without where, limit is allowed`, func(t *testing.T) {
+		runSingleRuleInspectCase(
+			allowLimitRule,
+			t,
+			``,
+			DefaultMysqlInspectOffline(),
+			`UPDATE t1 SET col1 = 1 LIMIT 1000`,
+			newTestResult())
+	})
+	t.Run(`(13)update without where and limit, limit is allowed`, func(t *testing.T) {
+		runSingleRuleInspectCase(
+			allowLimitRule,
+			t,
+			``,
+			DefaultMysqlInspectOffline(),
+			`UPDATE t1 SET col1 = 1`,
+			newTestResult().addResult(rulepkg.DMLCheckUpdateOrDeleteHasWhere))
+	})
 }
 
 func TestDMLCheckJoinHasOn(t *testing.T) {
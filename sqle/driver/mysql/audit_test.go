@@ -105,7 +105,8 @@ func NewMockInspect(e *executor.Executor) *MysqlDriverImpl {
 			DDLGhostMinSize:    -1,
 			DMLRollbackMaxRows: 1000,
 		},
-		dbConn: e,
+		dbConn:      e,
+		isConnected: e != nil,
 	}
 }
 
@@ -1467,6 +1468,30 @@ PRIMARY KEY (id)
 	)
 }
 
+func TestCheckCreateTableAsSelect(t *testing.T) {
+	newInspect := func(i *MysqlDriverImpl) *MysqlDriverImpl {
+		ifNotExists := rulepkg.RuleHandlerMap[rulepkg.DDLCheckPKWithoutIfNotExists].Rule
+		selectAll := rulepkg.RuleHandlerMap[rulepkg.DMLDisableSelectAllColumn].Rule
+		i.rules = []*driverV2.Rule{&ifNotExists, &selectAll}
+		return i
+	}
+
+	inspectCase(t, "create table as select: check both ddl and select rules", newInspect(DefaultMysqlInspect()),
+		"CREATE TABLE exist_db.not_exist_tb_1 AS SELECT * FROM exist_db.exist_tb_1 WHERE id > 1;",
+		newTestResult().addResult(rulepkg.DDLCheckPKWithoutIfNotExists).addResult(rulepkg.DMLDisableSelectAllColumn),
+	)
+
+	inspectCase(t, "create table as select: select rule is not triggered", newInspect(DefaultMysqlInspect()),
+		"CREATE TABLE IF NOT EXISTS exist_db.not_exist_tb_1 AS SELECT id, v1 FROM exist_db.exist_tb_1 WHERE id > 1;",
+		newTestResult(),
+	)
+
+	inspectCase(t, "create table as select: offline", newInspect(DefaultMysqlInspectOffline()),
+		"CREATE TABLE not_exist_tb_1 AS SELECT * FROM exist_tb_1;",
+		newTestResult().addResult(rulepkg.DDLCheckPKWithoutIfNotExists).addResult(rulepkg.DMLDisableSelectAllColumn),
+	)
+}
+
 func TestCheckObjectNameUsingKeyword(t *testing.T) {
 	runDefaultRulesInspectCase(t, "create_table: using keyword", DefaultMysqlInspect(),
 		"CREATE TABLE if not exists exist_db.`select` ("+
@@ -3841,6 +3866,12 @@ PARTITION p1 VALUES IN(1, 2, 3),
 PARTITION p2 VALUES IN(4, 5, 6),
 PARTITION p3 VALUES IN(7, 8, 9)
 )
+`,
+		`coalesce partition should error`: `
+ALTER TABLE exist_db.exist_tb_3 COALESCE PARTITION 2;
+`,
+		`truncate all partitions should error`: `
+ALTER TABLE exist_db.exist_tb_3 TRUNCATE PARTITION ALL;
 `,
 	} {
 		runSingleRuleInspectCase(
@@ -3860,6 +3891,9 @@ c2 INT)
 `,
 		`alter table should not error`: `
 ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT;
+`,
+		`remove partitioning should not error`: `
+ALTER TABLE exist_db.exist_tb_3 REMOVE PARTITIONING;
 `,
 	} {
 		runSingleRuleInspectCase(
@@ -8059,3 +8093,33 @@ func TestNotAllowInsertAutoincrement(t *testing.T) {
 		)
 	}
 }
+
+func TestMariaDBSyntax(t *testing.T) {
+	rule := rulepkg.RuleHandlerMap[rulepkg.DMLCheckWhereIsInvalid].Rule
+
+	// MariaDB-only syntax is unsupported by MySQL
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT VERSION()")).
+		WillReturnRows(sqlmock.NewRows([]string{"VERSION()"}).AddRow("8.0.30"))
+	runSingleRuleInspectCase(rule, t, "", NewMockInspect(e), `
+DELETE FROM exist_db.exist_tb_1 RETURNING id;
+CREATE OR REPLACE TABLE exist_db.exist_tb_1 (id INT);
+`, newTestResult().add(driverV2.RuleLevelWarn, "", "语法错误或者解析器不支持，请人工确认SQL正确性").addResult(rulepkg.DMLCheckWhereIsInvalid),
+		newTestResult().add(driverV2.RuleLevelWarn, "", "语法错误或者解析器不支持，请人工确认SQL正确性"))
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the server version is queried once
+	e, handler, err = executor.NewMockExecutor()
+	assert.NoError(t, err)
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT VERSION()")).
+		WillReturnRows(sqlmock.NewRows([]string{"VERSION()"}).AddRow("10.6.12-MariaDB-log"))
+	runSingleRuleInspectCase(rule, t, "", NewMockInspect(e), `
+DELETE FROM exist_db.exist_tb_1 WHERE id = 1 RETURNING id;
+DELETE FROM exist_db.exist_tb_1 RETURNING id;
+CREATE OR REPLACE TABLE exist_db.exist_tb_1 (id INT);
+`, newTestResult(),
+		newTestResult().addResult(rulepkg.DMLCheckWhereIsInvalid),
+		newTestResult().add(driverV2.RuleLevelNotice, "", "解析器不支持MariaDB语法 CREATE OR REPLACE TABLE，已跳过审核，请人工确认SQL正确性"))
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
@@ -4,24 +4,102 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	e "errors"
 	"fmt"
 	"net"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
 	"github.com/actiontech/sqle/sqle/errors"
+	"github.com/actiontech/sqle/sqle/pkg/params"
 	"github.com/go-sql-driver/mysql"
 	"github.com/sirupsen/logrus"
 )
 
 const DAIL_TIMEOUT = 5 * time.Second
 
+// keys of DSN additional params which tune the connection pool.
+const (
+	ParamKeyMaxOpenConns    = "max_open_conns"
+	ParamKeyMaxIdleConns    = "max_idle_conns"
+	ParamKeyConnMaxLifetime = "conn_max_lifetime" // in seconds
+	ParamKeyQueryTimeout    = "query_timeout"     // in seconds
+)
+
+// ErrQueryTimeout is returned if the query or exec is canceled because of the query timeout,
+// callers can check it by errors.Is to distinguish it from the connection errors.
+var ErrQueryTimeout = e.New("query timeout")
+
+// PoolConfig is applied to the underlying *sql.DB of an executor.
+type PoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// DefaultPoolConfig keeps a single connection per executor, the audit and
+// execute process rely on running all statements in the same session.
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		MaxOpenConns: 1,
+		MaxIdleConns: 1,
+	}
+}
+
+// PoolConfigFromParams overrides the default pool config with the DSN
+// additional params, the params which are missing or invalid are ignored.
+func PoolConfigFromParams(p params.Params) PoolConfig {
+	cfg := DefaultPoolConfig()
+	if v := p.GetParam(ParamKeyMaxOpenConns).Int(); v > 0 {
+		cfg.MaxOpenConns = v
+	}
+	if v := p.GetParam(ParamKeyMaxIdleConns).Int(); v > 0 {
+		cfg.MaxIdleConns = v
+	}
+	if v := p.GetParam(ParamKeyConnMaxLifetime).Int(); v > 0 {
+		cfg.ConnMaxLifetime = time.Duration(v) * time.Second
+	}
+	return cfg
+}
+
+// QueryTimeoutFromParams returns the query timeout in the DSN additional params,
+// it is 0 which means no timeout if the param is missing or invalid.
+func QueryTimeoutFromParams(p params.Params) time.Duration {
+	if v := p.GetParam(ParamKeyQueryTimeout).Int(); v > 0 {
+		return time.Duration(v) * time.Second
+	}
+	return 0
+}
+
+type executorOption func(*Executor)
+
+func (o executorOption) apply(c *Executor) {
+	o(c)
+}
+
+func WithPoolConfig(cfg PoolConfig) executorOption {
+	return func(c *Executor) {
+		c.poolConfig = cfg
+	}
+}
+
+// WithQueryTimeout limits the time of each query and exec of the executor, 0 means no timeout.
+func WithQueryTimeout(timeout time.Duration) executorOption {
+	return func(c *Executor) {
+		c.queryTimeout = timeout
+	}
+}
+
 type Db interface {
 	Close()
 	Ping() error
 	Exec(query string) (driver.Result, error)
+	ExecContext(ctx context.Context, query string) (driver.Result, error)
 	Transact(qs ...string) ([]driver.Result, error)
 	Query(query string, args ...interface{}) ([]map[string]sql.NullString, error)
 	QueryWithContext(ctx context.Context, query string, args ...interface{}) (column []string, row [][]sql.NullString, err error)
@@ -30,17 +108,64 @@ type Db interface {
 }
 
 type BaseConn struct {
-	log    *logrus.Entry
-	host   string
-	port   string
-	user   string
-	db     *sql.DB
-	conn   *sql.Conn
-	connID string
+	log  *logrus.Entry
+	host string
+	port string
+	user string
+	db   *sql.DB
+	// pool limits the sessions sharing db, it is nil if the sessions are not limited.
+	pool *connPool
+	// isSession represents the connection is created by Executor.NewSession, db is
+	// owned by the executor creating it and is not closed by Close.
+	isSession bool
+	// connID is the thread id of conn on the server, it is read by KillProcess concurrently.
+	connID atomic.Value
+	// queryMu serializes the statements on conn, conn can not be used by the rules audited
+	// in parallel concurrently. The fields below are guarded by it.
+	queryMu sync.Mutex
+	conn    *sql.Conn
+	// connAcquiredAt is the time conn is acquired, conn is re-acquired from db once it is
+	// used for longer than PoolConfig.ConnMaxLifetime, see sessionConn.
+	connAcquiredAt time.Time
+	// schema is the current schema changed by "USE", which is restored on the re-acquired conn.
+	schema string
+	// sessionChanged represents the session state except the current schema is changed by
+	// the statements executed on conn, e.g. SET or CREATE TEMPORARY TABLE, conn is kept then.
+	sessionChanged bool
+	// tablesLocked and inTransaction represent the tables are locked by LOCK TABLES and the
+	// transaction is started by BEGIN, conn is kept until UNLOCK TABLES and COMMIT.
+	tablesLocked  bool
+	inTransaction bool
+	// queryTimeout limits the time of each query and exec, 0 means no timeout.
+	queryTimeout time.Duration
+}
+
+// connPool counts the sessions pinning a connection of the *sql.DB of an executor, a new
+// session is not created once PoolConfig.MaxOpenConns is reached, otherwise it would block
+// until another session is closed.
+type connPool struct {
+	config   PoolConfig
+	mu       sync.Mutex
+	sessions int
 }
 
-func newConn(entry *logrus.Entry, instance *driverV2.DSN, schema string) (*BaseConn, error) {
-	var db *sql.DB
+func (p *connPool) acquire() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.config.MaxOpenConns > 0 && p.sessions >= p.config.MaxOpenConns {
+		return false
+	}
+	p.sessions++
+	return true
+}
+
+func (p *connPool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sessions--
+}
+
+func newConn(entry *logrus.Entry, instance *driverV2.DSN, schema string, pool PoolConfig, queryTimeout time.Duration) (*BaseConn, error) {
 	var err error
 
 	config := mysql.NewConfig()
@@ -54,68 +179,170 @@ func newConn(entry *logrus.Entry, instance *driverV2.DSN, schema string) (*BaseC
 	config.Params = map[string]string{
 		"charset": "utf8mb4",
 	}
-	driver, err := mysql.NewConnector(config)
+	config.TLSConfig, err = registerTLSConfig(instance)
+	if err != nil {
+		entry.Error(err)
+		return nil, errors.New(errors.ConnectRemoteDatabaseError, err)
+	}
+	connector, err := mysql.NewConnector(config)
 	if err != nil {
 		entry.Error(err)
 		return nil, errors.New(errors.ConnectRemoteDatabaseError, err)
 	}
-	db = sql.OpenDB(driver)
-	db.SetMaxOpenConns(1)
-	db.SetMaxIdleConns(1)
 
 	entry.Infof("connecting to %s:%s with user(%s)", instance.Host, instance.Port, config.User)
-	conn, err := db.Conn(context.Background())
+	baseConn, err := newConnWithConnector(entry, connector, instance.Host, instance.Port, instance.User, pool, queryTimeout)
 	if err != nil {
+		if isTLSHandshakeError(err) {
+			err = fmt.Errorf("tls handshake with %s:%s failed, check the ca and cert of the instance: %w", instance.Host, instance.Port, err)
+		}
 		entry.Error(err)
 		return nil, errors.New(errors.ConnectRemoteDatabaseError, err)
 	}
 	entry.Infof("connected to %s:%s", instance.Host, instance.Port)
+	return baseConn, nil
+}
 
-	baseConn := &BaseConn{
-		log:  entry,
-		host: instance.Host,
-		port: instance.Port,
-		user: instance.User,
-		db:   db,
-		conn: conn,
-	}
-	baseConn.connID, err = baseConn.getConnectionID()
+// newConnWithConnector opens the *sql.DB configured by pool, and pins a connection of it as the session.
+func newConnWithConnector(entry *logrus.Entry, connector driver.Connector, host, port, user string, pool PoolConfig, queryTimeout time.Duration) (*BaseConn, error) {
+	db := sql.OpenDB(connector)
+	db.SetMaxOpenConns(pool.MaxOpenConns)
+	db.SetMaxIdleConns(pool.MaxIdleConns)
+	db.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	entry.Infof("connection pool of %s:%s, max open conns: %d, max idle conns: %d, conn max lifetime: %v",
+		host, port, pool.MaxOpenConns, pool.MaxIdleConns, pool.ConnMaxLifetime)
+
+	conn, err := db.Conn(context.Background())
 	if err != nil {
-		entry.Errorf("get conn id failed, err: %v", err)
-		// ignore the error to continue main process
+		db.Close()
+		return nil, err
 	}
+	baseConn := &BaseConn{
+		log:            entry,
+		host:           host,
+		port:           port,
+		user:           user,
+		db:             db,
+		pool:           &connPool{config: pool, sessions: 1},
+		conn:           conn,
+		connAcquiredAt: time.Now(),
+		queryTimeout:   queryTimeout,
+	}
+	baseConn.setConnectionID(context.Background(), conn)
 	return baseConn, nil
 }
 
-func (c *BaseConn) getConnectionID() (connID string, err error) {
-	res, err := c.Query("SELECT connection_id() AS conn_id")
+// setConnectionID queries the thread id of conn, the error is ignored to continue main process.
+func (c *BaseConn) setConnectionID(ctx context.Context, conn *sql.Conn) {
+	var connID sql.NullString
+	if err := conn.QueryRowContext(ctx, "SELECT connection_id() AS conn_id").Scan(&connID); err != nil {
+		c.Logger().Errorf("get conn id failed, err: %v", err)
+		connID = sql.NullString{}
+	}
+	c.connID.Store(connID.String)
+}
+
+// sessionConn returns the connection of the session, which is re-acquired from db if it is used
+// for longer than PoolConfig.ConnMaxLifetime, so that the server is not connected by a long-lived
+// connection. The current schema is restored on the re-acquired connection, and the connection
+// whose session state is changed otherwise is kept. queryMu must be held.
+func (c *BaseConn) sessionConn(ctx context.Context) (*sql.Conn, error) {
+	if c.conn != nil && !c.connExpired() {
+		return c.conn, nil
+	}
+	if c.conn != nil {
+		c.Logger().Infof("re-acquire the connection to %s:%s used for longer than %v", c.host, c.port, c.pool.config.ConnMaxLifetime)
+		// the expired connection is closed by db instead of returning to the pool
+		c.conn.Close()
+		c.conn = nil
+	}
+	conn, err := c.db.Conn(ctx)
 	if err != nil {
-		return "", err
+		return nil, errors.New(errors.ConnectRemoteDatabaseError, err)
 	}
-	for i := range res {
-		row := res[i]
-		if row["conn_id"].String != "" {
-			return row["conn_id"].String, nil
+	if c.schema != "" {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE `%s`", c.schema)); err != nil {
+			conn.Close()
+			return nil, errors.New(errors.ConnectRemoteDatabaseError, err)
 		}
 	}
+	c.setConnectionID(ctx, conn)
+	c.conn, c.connAcquiredAt = conn, time.Now()
+	return conn, nil
+}
+
+func (c *BaseConn) connExpired() bool {
+	if c.pool == nil || c.pool.config.ConnMaxLifetime <= 0 || c.sessionChanged || c.tablesLocked || c.inTransaction {
+		return false
+	}
+	return time.Since(c.connAcquiredAt) >= c.pool.config.ConnMaxLifetime
+}
 
-	return "", nil
+var (
+	useSchemaRegex = regexp.MustCompile("(?is)^\\s*use\\s+`?([^`;\\s]+)`?\\s*;?\\s*$")
+	// SET GLOBAL and SET PERSIST do not change the session state, the other SET statements do,
+	// e.g. SET SESSION, SET @@var, SET NAMES or the user-defined variables.
+	setRegex        = regexp.MustCompile(`(?is)^\s*set\s`)
+	setGlobalRegex  = regexp.MustCompile(`(?is)^\s*set\s+(global|persist|persist_only)\s|^\s*set\s+@@(global|persist|persist_only)\.`)
+	tempTableRegex  = regexp.MustCompile(`(?is)^\s*(create|drop)\s+temporary\s+tables?\s`)
+	lockTablesRegex = regexp.MustCompile(`(?is)^\s*lock\s+tables?\s`)
+	unlockRegex     = regexp.MustCompile(`(?is)^\s*unlock\s+tables?\s*;?\s*$`)
+	beginRegex      = regexp.MustCompile(`(?is)^\s*(begin|start\s+transaction)\b`)
+	endTxRegex      = regexp.MustCompile(`(?is)^\s*(commit|rollback)\b`)
+	rollbackToRegex = regexp.MustCompile(`(?is)^\s*rollback\s+(work\s+)?to\s`)
+)
+
+// trackSessionState records the current schema changed by "USE" and the session state changed
+// by the statement, e.g. SET, LOCK TABLES, CREATE TEMPORARY TABLE or BEGIN. The other statements,
+// e.g. the DML, do not change the session state.
+func (c *BaseConn) trackSessionState(query string) {
+	if matches := useSchemaRegex.FindStringSubmatch(query); len(matches) == 2 {
+		c.schema = matches[1]
+		return
+	}
+	switch {
+	case setRegex.MatchString(query) && !setGlobalRegex.MatchString(query), tempTableRegex.MatchString(query):
+		c.sessionChanged = true
+	case lockTablesRegex.MatchString(query):
+		c.tablesLocked = true
+	case unlockRegex.MatchString(query):
+		c.tablesLocked = false
+	case beginRegex.MatchString(query):
+		c.inTransaction = true
+	case endTxRegex.MatchString(query) && !rollbackToRegex.MatchString(query):
+		c.inTransaction = false
+	}
 }
 
 func (c *BaseConn) Close() {
-	c.conn.Close()
+	c.queryMu.Lock()
+	defer c.queryMu.Unlock()
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+	if c.isSession {
+		c.pool.release()
+		return
+	}
 	c.db.Close()
 }
 
 func (c *BaseConn) GetConnectionID() string {
-	return c.connID
+	connID, _ := c.connID.Load().(string)
+	return connID
 }
 
 func (c *BaseConn) Ping() error {
 	c.Logger().Infof("ping %s:%s", c.host, c.port)
 	ctx, cancel := context.WithTimeout(context.Background(), DAIL_TIMEOUT)
 	defer cancel()
-	err := c.conn.PingContext(ctx)
+	c.queryMu.Lock()
+	defer c.queryMu.Unlock()
+	conn, err := c.sessionConn(ctx)
+	if err == nil {
+		err = conn.PingContext(ctx)
+	}
 	if err != nil {
 		c.Logger().Infof("ping %s:%s failed, %s", c.host, c.port, err)
 	} else {
@@ -125,7 +352,19 @@ func (c *BaseConn) Ping() error {
 }
 
 func (c *BaseConn) Exec(query string) (driver.Result, error) {
-	result, err := c.conn.ExecContext(context.Background(), query)
+	return c.ExecContext(context.Background(), query)
+}
+
+func (c *BaseConn) ExecContext(ctx context.Context, query string) (driver.Result, error) {
+	c.queryMu.Lock()
+	defer c.queryMu.Unlock()
+	ctx, cancel := c.withQueryTimeout(ctx)
+	defer cancel()
+	conn, err := c.sessionConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := conn.ExecContext(ctx, query)
 	if err != nil {
 		c.Logger().Errorf("exec sql failed; host: %s, port: %s, user: %s, query: %s, error: %s",
 			c.host, c.port, c.user, query, err.Error())
@@ -133,7 +372,29 @@ func (c *BaseConn) Exec(query string) (driver.Result, error) {
 		c.Logger().Infof("exec sql success; host: %s, port: %s, user: %s, query: %s",
 			c.host, c.port, c.user, query)
 	}
-	return result, errors.New(errors.ConnectRemoteDatabaseError, err)
+	// the failed statement may change the session state as well, e.g. the statement canceled after executed
+	c.trackSessionState(query)
+	return result, c.wrapQueryError(ctx, err)
+}
+
+// withQueryTimeout returns the context limited by the query timeout of the connection.
+func (c *BaseConn) withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.queryTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.queryTimeout)
+}
+
+// wrapQueryError wraps the error of query or exec, it is ErrQueryTimeout if the deadline
+// of ctx is exceeded, otherwise it is ConnectRemoteDatabaseError.
+func (c *BaseConn) wrapQueryError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if e.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w, host: %s, port: %s, timeout: %v, error: %v", ErrQueryTimeout, c.host, c.port, c.queryTimeout, err)
+	}
+	return errors.New(errors.ConnectRemoteDatabaseError, err)
 }
 
 func (c *BaseConn) Transact(qs ...string) ([]driver.Result, error) {
@@ -141,7 +402,13 @@ func (c *BaseConn) Transact(qs ...string) ([]driver.Result, error) {
 	var tx *sql.Tx
 	var results []driver.Result
 	c.Logger().Infof("doing sql transact, host: %s, port: %s, user: %s", c.host, c.port, c.user)
-	tx, err = c.conn.BeginTx(context.Background(), nil)
+	c.queryMu.Lock()
+	defer c.queryMu.Unlock()
+	conn, err := c.sessionConn(context.Background())
+	if err != nil {
+		return results, err
+	}
+	tx, err = conn.BeginTx(context.Background(), nil)
 	if err != nil {
 		return results, err
 	}
@@ -169,7 +436,7 @@ func (c *BaseConn) Transact(qs ...string) ([]driver.Result, error) {
 	}()
 	for _, query := range qs {
 		var txResult driver.Result
-		txResult, err = tx.Exec(query)
+		txResult, err = c.execInTx(tx, query)
 		if err != nil {
 			c.Logger().Errorf("exec sql failed, error: %s, query: %s", err, query)
 			return results, err
@@ -180,12 +447,31 @@ func (c *BaseConn) Transact(qs ...string) ([]driver.Result, error) {
 	}
 	return results, nil
 }
+
+// execInTx executes the query of Transact, it is limited by the query timeout and tracks the
+// session state as ExecContext does. queryMu must be held.
+func (c *BaseConn) execInTx(tx *sql.Tx, query string) (driver.Result, error) {
+	ctx, cancel := c.withQueryTimeout(context.Background())
+	defer cancel()
+	result, err := tx.ExecContext(ctx, query)
+	c.trackSessionState(query)
+	return result, c.wrapQueryError(ctx, err)
+}
+
 func (c *BaseConn) QueryWithContext(ctx context.Context, query string, args ...interface{}) (column []string, row [][]sql.NullString, err error) {
-	rows, err := c.conn.QueryContext(ctx, query, args...)
+	c.queryMu.Lock()
+	defer c.queryMu.Unlock()
+	ctx, cancel := c.withQueryTimeout(ctx)
+	defer cancel()
+	conn, err := c.sessionConn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		c.Logger().Errorf("query sql failed; host: %s, port: %s, user: %s, query: %s, error: %s\n",
 			c.host, c.port, c.user, query, err.Error())
-		return nil, nil, errors.New(errors.ConnectRemoteDatabaseError, err)
+		return nil, nil, c.wrapQueryError(ctx, err)
 	} else {
 		c.Logger().Infof("query sql success; host: %s, port: %s, user: %s, query: %s\n",
 			c.host, c.port, c.user, query)
@@ -206,7 +492,7 @@ func (c *BaseConn) QueryWithContext(ctx context.Context, query string, args ...i
 		}
 		if err := rows.Scan(buf...); err != nil {
 			c.Logger().Error(err)
-			return nil, nil, err
+			return nil, nil, c.wrapQueryError(ctx, err)
 		}
 		value := make([]sql.NullString, len(columns))
 		for i := 0; i < len(columns); i++ {
@@ -215,7 +501,7 @@ func (c *BaseConn) QueryWithContext(ctx context.Context, query string, args ...i
 		result = append(result, value)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, nil, err
+		return nil, nil, c.wrapQueryError(ctx, err)
 	}
 	return columns, result, nil
 }
@@ -243,6 +529,18 @@ func (c *BaseConn) Logger() *logrus.Entry {
 type Executor struct {
 	Db                  Db
 	lowerCaseTableNames bool
+	poolConfig          PoolConfig
+	queryTimeout        time.Duration
+}
+
+// PoolConfig returns the effective connection pool config of the executor.
+func (c *Executor) PoolConfig() PoolConfig {
+	return c.poolConfig
+}
+
+// QueryTimeout returns the query timeout of the executor, 0 means no timeout.
+func (c *Executor) QueryTimeout() time.Duration {
+	return c.queryTimeout
 }
 
 func (c *Executor) IsLowerCaseTableNames() bool {
@@ -253,11 +551,16 @@ func (c *Executor) SetLowerCaseTableNames(lowerCaseTableNames bool) {
 	c.lowerCaseTableNames = lowerCaseTableNames
 }
 
-func NewExecutor(entry *logrus.Entry, instance *driverV2.DSN, schema string) (*Executor, error) {
-	var executor = &Executor{}
+func NewExecutor(entry *logrus.Entry, instance *driverV2.DSN, schema string, opts ...executorOption) (*Executor, error) {
+	var executor = &Executor{
+		poolConfig: DefaultPoolConfig(),
+	}
+	for _, opt := range opts {
+		opt.apply(executor)
+	}
 	var conn Db
 	var err error
-	conn, err = newConn(entry, instance, schema)
+	conn, err = newConn(entry, instance, schema, executor.poolConfig, executor.queryTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -265,6 +568,46 @@ func NewExecutor(entry *logrus.Entry, instance *driverV2.DSN, schema string) (*E
 	return executor, nil
 }
 
+// NewSession returns an executor with its own connection from the pool of c, the current schema
+// of c is used by the session. It is used to run the statements concurrently, e.g. by the rules
+// audited in parallel. It returns false if the sessions reach PoolConfig.MaxOpenConns, and the
+// caller should use c instead. The session must be closed by Db.Close, which returns the
+// connection to the pool.
+func (c *Executor) NewSession(ctx context.Context) (*Executor, bool, error) {
+	base, ok := c.Db.(*BaseConn)
+	if !ok || base.pool == nil || !base.pool.acquire() {
+		return nil, false, nil
+	}
+	base.queryMu.Lock()
+	schema := base.schema
+	base.queryMu.Unlock()
+
+	session := &BaseConn{
+		log:          base.log,
+		host:         base.host,
+		port:         base.port,
+		user:         base.user,
+		db:           base.db,
+		pool:         base.pool,
+		isSession:    true,
+		schema:       schema,
+		queryTimeout: base.queryTimeout,
+	}
+	session.queryMu.Lock()
+	_, err := session.sessionConn(ctx)
+	session.queryMu.Unlock()
+	if err != nil {
+		base.pool.release()
+		return nil, false, err
+	}
+	return &Executor{
+		Db:                  session,
+		lowerCaseTableNames: c.lowerCaseTableNames,
+		poolConfig:          c.poolConfig,
+		queryTimeout:        c.queryTimeout,
+	}, true, nil
+}
+
 func Ping(entry *logrus.Entry, instance *driverV2.DSN) error {
 	conn, err := NewExecutor(entry, instance, "")
 	if err != nil {
@@ -282,6 +625,81 @@ func (c *Executor) UseSchema(schemaName string) error {
 	return nil
 }
 
+// SessionVariable is a session system variable set on the connection before executing statements,
+// e.g. sql_require_primary_key=OFF or foreign_key_checks=0.
+type SessionVariable struct {
+	Name  string
+	Value string
+}
+
+var sessionVariableNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// formatSessionVariableValue returns the value used in the SET statement, the numbers and the
+// keywords are kept as they are, the other values are quoted as strings.
+func formatSessionVariableValue(value string) string {
+	value = strings.TrimSpace(value)
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	switch strings.ToUpper(value) {
+	case "ON", "OFF", "DEFAULT", "NULL":
+		return strings.ToUpper(value)
+	}
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), "'", "''"))
+}
+
+func buildSetSessionVariablesSql(vars []SessionVariable) string {
+	assignments := make([]string, 0, len(vars))
+	for _, v := range vars {
+		assignments = append(assignments, fmt.Sprintf("SESSION %s = %s", v.Name, formatSessionVariableValue(v.Value)))
+	}
+	return "SET " + strings.Join(assignments, ", ")
+}
+
+// SetSessionVariables sets the session variables on the connection of the executor, the statements
+// executed by the executor afterwards are affected by them. The returned restore function sets the
+// variables back to the values before, it should be called once the statements are executed, so that
+// the variables do not leak to the other statements in the lifetime of the connection.
+func (c *Executor) SetSessionVariables(ctx context.Context, vars ...SessionVariable) (restore func() error, err error) {
+	if len(vars) == 0 {
+		return func() error { return nil }, nil
+	}
+	fields := make([]string, 0, len(vars))
+	for _, v := range vars {
+		if !sessionVariableNameRegex.MatchString(v.Name) {
+			return nil, fmt.Errorf("invalid session variable name: %s", v.Name)
+		}
+		fields = append(fields, fmt.Sprintf("@@SESSION.%s", v.Name))
+	}
+
+	_, rows, err := c.Db.QueryWithContext(ctx, fmt.Sprintf("SELECT %s", strings.Join(fields, ", ")))
+	if err != nil {
+		return nil, fmt.Errorf("get session variables failed: %w", err)
+	}
+	if len(rows) != 1 || len(rows[0]) != len(vars) {
+		return nil, fmt.Errorf("unexpected result when getting session variables")
+	}
+	origins := make([]SessionVariable, 0, len(vars))
+	for i, v := range vars {
+		value := "NULL"
+		if rows[0][i].Valid {
+			value = rows[0][i].String
+		}
+		origins = append(origins, SessionVariable{Name: v.Name, Value: value})
+	}
+
+	if _, err := c.Db.ExecContext(ctx, buildSetSessionVariablesSql(vars)); err != nil {
+		return nil, fmt.Errorf("set session variables failed: %w", err)
+	}
+	return func() error {
+		// the context of the statements may be canceled, the variables are restored anyway.
+		if _, err := c.Db.ExecContext(context.Background(), buildSetSessionVariablesSql(origins)); err != nil {
+			return fmt.Errorf("restore session variables failed: %w", err)
+		}
+		return nil
+	}, nil
+}
+
 // When using keywords as table names, you need to pay attention to wrapping them in quotation marks
 func (c *Executor) ShowCreateTable(schema, tableName string) (string, error) {
 	query := fmt.Sprintf("show create table %s", tableName)
@@ -784,6 +1202,46 @@ type TableIndexesInfo struct {
 	Expression  string
 }
 
+type TableIndexStatistics struct {
+	KeyName     string
+	ColumnName  string
+	SeqInIndex  string
+	Cardinality string
+	TableRows   string
+}
+
+// GetTableIndexStatistics gets the cardinality of index columns and the rows of table from
+// INFORMATION_SCHEMA.STATISTICS and INFORMATION_SCHEMA.TABLES. The result is empty if the
+// user has no privileges on the table.
+func (c *Executor) GetTableIndexStatistics(schema, tableName string) ([]*TableIndexStatistics, error) {
+	where := "s.TABLE_SCHEMA=? AND s.TABLE_NAME=?"
+	if c.IsLowerCaseTableNames() {
+		schema = strings.ToLower(schema)
+		tableName = strings.ToLower(tableName)
+		where = "lower(s.TABLE_SCHEMA)=? AND lower(s.TABLE_NAME)=?"
+	}
+	query := fmt.Sprintf("SELECT s.INDEX_NAME, s.COLUMN_NAME, s.SEQ_IN_INDEX, s.CARDINALITY, t.TABLE_ROWS "+
+		"FROM INFORMATION_SCHEMA.STATISTICS s JOIN INFORMATION_SCHEMA.TABLES t ON s.TABLE_SCHEMA = t.TABLE_SCHEMA AND s.TABLE_NAME = t.TABLE_NAME "+
+		"WHERE %s ORDER BY s.INDEX_NAME, s.SEQ_IN_INDEX", where)
+
+	records, err := c.Db.Query(query, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*TableIndexStatistics, len(records))
+	for i, record := range records {
+		ret[i] = &TableIndexStatistics{
+			KeyName:     record["INDEX_NAME"].String,
+			ColumnName:  record["COLUMN_NAME"].String,
+			SeqInIndex:  record["SEQ_IN_INDEX"].String,
+			Cardinality: record["CARDINALITY"].String,
+			TableRows:   record["TABLE_ROWS"].String,
+		}
+	}
+	return ret, nil
+}
+
 // When using keywords as view names, you need to pay attention to wrapping them in quotation marks
 func (c *Executor) GetTableIndexesInfo(schema, tableName string) ([]*TableIndexesInfo, error) {
 	records, err := c.Db.Query(fmt.Sprintf("SHOW INDEX FROM %s.%s", schema, tableName))
@@ -0,0 +1,311 @@
+package executor
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingConnector creates the fake connections which record the statements executed on them,
+// so that the tests can tell which physical connection is used.
+type countingConnector struct {
+	mu    sync.Mutex
+	conns []*countingConn
+}
+
+func (c *countingConnector) Connect(context.Context) (driver.Conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	conn := &countingConn{id: len(c.conns) + 1}
+	c.conns = append(c.conns, conn)
+	return conn, nil
+}
+
+func (c *countingConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c *countingConnector) opened() []*countingConn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*countingConn(nil), c.conns...)
+}
+
+type countingConn struct {
+	id         int
+	mu         sync.Mutex
+	statements []string
+	closed     bool
+}
+
+func (c *countingConn) record(query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statements = append(c.statements, query)
+}
+
+func (c *countingConn) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+func (c *countingConn) executed() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.statements...)
+}
+
+func (c *countingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare is not supported")
+}
+
+func (c *countingConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *countingConn) Begin() (driver.Tx, error) {
+	c.record("BEGIN")
+	return countingTx{conn: c}, nil
+}
+
+// ExecContext blocks "DO SLEEP(1)" until ctx is done, so that the query timeout can be tested.
+func (c *countingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.record(query)
+	if query == "DO SLEEP(1)" {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return driver.RowsAffected(0), nil
+}
+
+type countingTx struct {
+	conn *countingConn
+}
+
+func (tx countingTx) Commit() error {
+	tx.conn.record("COMMIT")
+	return nil
+}
+
+func (tx countingTx) Rollback() error {
+	tx.conn.record("ROLLBACK")
+	return nil
+}
+
+// QueryContext returns the id of the connection as the result of any query.
+func (c *countingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.record(query)
+	return &singleValueRows{value: fmt.Sprint(c.id)}, nil
+}
+
+type singleValueRows struct {
+	value string
+	read  bool
+}
+
+func (r *singleValueRows) Columns() []string {
+	return []string{"conn_id"}
+}
+
+func (r *singleValueRows) Close() error {
+	return nil
+}
+
+func (r *singleValueRows) Next(dest []driver.Value) error {
+	if r.read {
+		return io.EOF
+	}
+	r.read = true
+	dest[0] = r.value
+	return nil
+}
+
+func newCountingConn(t *testing.T, pool PoolConfig) (*Executor, *countingConnector) {
+	connector := &countingConnector{}
+	conn, err := newConnWithConnector(logrus.WithField("unittest", "unittest"), connector, "mockhost", "mockport", "mockuser", pool, 0)
+	assert.NoError(t, err)
+	return &Executor{Db: conn, poolConfig: pool}, connector
+}
+
+func TestBaseConn_ConnMaxLifetime(t *testing.T) {
+	lifetime := 100 * time.Millisecond
+	e, connector := newCountingConn(t, PoolConfig{MaxOpenConns: 1, MaxIdleConns: 1, ConnMaxLifetime: lifetime})
+	defer e.Db.Close()
+
+	assert.NoError(t, e.UseSchema("db1"))
+	_, err := e.Db.Query("SELECT 1")
+	assert.NoError(t, err)
+	assert.Len(t, connector.opened(), 1)
+	assert.Equal(t, "1", e.Db.GetConnectionID())
+
+	// the expired connection is closed, and the current schema is restored on the new connection
+	time.Sleep(lifetime)
+	_, err = e.Db.Query("SELECT 2")
+	assert.NoError(t, err)
+	conns := connector.opened()
+	assert.Len(t, conns, 2)
+	assert.True(t, conns[0].isClosed())
+	assert.Equal(t, []string{"SELECT connection_id() AS conn_id", "use `db1`", "SELECT 1"}, conns[0].executed())
+	assert.Equal(t, []string{"USE `db1`", "SELECT connection_id() AS conn_id", "SELECT 2"}, conns[1].executed())
+	assert.Equal(t, "2", e.Db.GetConnectionID())
+
+	// the connection whose session state is changed is kept
+	_, err = e.Db.Exec("SET SESSION foreign_key_checks = 0")
+	assert.NoError(t, err)
+	time.Sleep(lifetime)
+	_, err = e.Db.Query("SELECT 3")
+	assert.NoError(t, err)
+	assert.Len(t, connector.opened(), 2)
+	assert.False(t, connector.opened()[1].isClosed())
+}
+
+func TestBaseConn_ConnMaxLifetimeAfterDML(t *testing.T) {
+	lifetime := 100 * time.Millisecond
+	e, connector := newCountingConn(t, PoolConfig{MaxOpenConns: 1, MaxIdleConns: 1, ConnMaxLifetime: lifetime})
+	defer e.Db.Close()
+
+	// the DML does not change the session state, the connection is still re-acquired
+	_, err := e.Db.Exec("UPDATE t1 SET v1 = 'a' WHERE id = 1")
+	assert.NoError(t, err)
+	_, err = e.Db.Transact("DELETE FROM t1 WHERE id = 2")
+	assert.NoError(t, err)
+	time.Sleep(lifetime)
+	_, err = e.Db.Query("SELECT 1")
+	assert.NoError(t, err)
+	conns := connector.opened()
+	assert.Len(t, conns, 2)
+	assert.True(t, conns[0].isClosed())
+	assert.Equal(t, []string{"SELECT connection_id() AS conn_id", "SELECT 1"}, conns[1].executed())
+}
+
+func TestBaseConn_trackSessionState(t *testing.T) {
+	for _, tt := range []struct {
+		queries []string
+		changed bool
+	}{
+		{[]string{"INSERT INTO t1 VALUES (1)", "update t1 set v1 = 1", "DELETE FROM t1", "ALTER TABLE t1 ADD COLUMN v2 INT"}, false},
+		{[]string{"SET GLOBAL max_connections = 100", "set @@global.max_connections = 100", "SET PERSIST max_connections = 100"}, false},
+		{[]string{"SET SESSION foreign_key_checks = 0"}, true},
+		{[]string{"set @@foreign_key_checks = 0"}, true},
+		{[]string{"SET NAMES utf8mb4"}, true},
+		{[]string{"SET @a = 1"}, true},
+		{[]string{"CREATE TEMPORARY TABLE t2 (id INT)"}, true},
+		{[]string{"DROP TEMPORARY TABLE t2"}, true},
+		{[]string{"LOCK TABLES t1 WRITE"}, true},
+		{[]string{"LOCK TABLES t1 WRITE", "UNLOCK TABLES"}, false},
+		{[]string{"BEGIN"}, true},
+		{[]string{"START TRANSACTION", "INSERT INTO t1 VALUES (1)", "ROLLBACK TO SAVEPOINT s1"}, true},
+		{[]string{"START TRANSACTION READ ONLY", "COMMIT"}, false},
+		{[]string{"begin", "rollback"}, false},
+	} {
+		c := &BaseConn{pool: &connPool{config: PoolConfig{ConnMaxLifetime: time.Nanosecond}}}
+		for _, query := range tt.queries {
+			c.trackSessionState(query)
+		}
+		// the connection is kept if the session state is changed
+		assert.Equal(t, !tt.changed, c.connExpired(), tt.queries)
+	}
+}
+
+func TestBaseConn_TransactQueryTimeout(t *testing.T) {
+	e, connector := newCountingConn(t, DefaultPoolConfig())
+	defer e.Db.Close()
+	e.Db.(*BaseConn).queryTimeout = 10 * time.Millisecond
+
+	_, err := e.Db.Transact("SET @a = 1", "DO SLEEP(1)")
+	assert.ErrorIs(t, err, ErrQueryTimeout)
+	assert.Equal(t, []string{"SELECT connection_id() AS conn_id", "BEGIN", "SET @a = 1", "DO SLEEP(1)", "ROLLBACK"}, connector.opened()[0].executed())
+	assert.True(t, e.Db.(*BaseConn).sessionChanged)
+}
+
+func TestBaseConn_WithoutConnMaxLifetime(t *testing.T) {
+	e, connector := newCountingConn(t, DefaultPoolConfig())
+	defer e.Db.Close()
+
+	time.Sleep(10 * time.Millisecond)
+	_, err := e.Db.Query("SELECT 1")
+	assert.NoError(t, err)
+	assert.Len(t, connector.opened(), 1)
+}
+
+func TestExecutor_NewSession(t *testing.T) {
+	t.Run("sessions are limited by max open conns", func(t *testing.T) {
+		e, connector := newCountingConn(t, PoolConfig{MaxOpenConns: 3, MaxIdleConns: 1})
+		defer e.Db.Close()
+		assert.NoError(t, e.UseSchema("db1"))
+
+		s1, ok, err := e.NewSession(context.TODO())
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		s2, ok, err := e.NewSession(context.TODO())
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		_, ok, err = e.NewSession(context.TODO())
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		// each session runs on its own connection with the current schema of the executor
+		_, err = s1.Db.Query("SELECT 1")
+		assert.NoError(t, err)
+		_, err = s2.Db.Query("SELECT 2")
+		assert.NoError(t, err)
+		conns := connector.opened()
+		assert.Len(t, conns, 3)
+		assert.Equal(t, []string{"USE `db1`", "SELECT connection_id() AS conn_id", "SELECT 1"}, conns[1].executed())
+		assert.Equal(t, []string{"USE `db1`", "SELECT connection_id() AS conn_id", "SELECT 2"}, conns[2].executed())
+		assert.NotEqual(t, s1.Db.GetConnectionID(), s2.Db.GetConnectionID())
+
+		// the closed session returns the connection to the pool, the connections more than
+		// max idle conns are closed
+		s1.Db.Close()
+		s2.Db.Close()
+		assert.Equal(t, 1, countClosed(connector.opened()))
+		s3, ok, err := e.NewSession(context.TODO())
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		defer s3.Db.Close()
+		assert.Len(t, connector.opened(), 3)
+
+		// closing the sessions does not close the executor
+		_, err = e.Db.Query("SELECT 3")
+		assert.NoError(t, err)
+	})
+
+	t.Run("no session with the default pool config", func(t *testing.T) {
+		e, connector := newCountingConn(t, DefaultPoolConfig())
+		defer e.Db.Close()
+		_, ok, err := e.NewSession(context.TODO())
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Len(t, connector.opened(), 1)
+	})
+
+	t.Run("no session of the mock executor", func(t *testing.T) {
+		e, _, err := NewMockExecutor()
+		assert.NoError(t, err)
+		_, ok, err := e.NewSession(context.TODO())
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func countClosed(conns []*countingConn) int {
+	var closed int
+	for _, conn := range conns {
+		if conn.isClosed() {
+			closed++
+		}
+	}
+	return closed
+}
@@ -0,0 +1,122 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolConfigFromParams(t *testing.T) {
+	assert.Equal(t, DefaultPoolConfig(), PoolConfigFromParams(nil))
+
+	cfg := PoolConfigFromParams(params.Params{
+		{Key: ParamKeyMaxOpenConns, Value: "10", Type: params.ParamTypeInt},
+		{Key: ParamKeyMaxIdleConns, Value: "5", Type: params.ParamTypeInt},
+		{Key: ParamKeyConnMaxLifetime, Value: "60", Type: params.ParamTypeInt},
+	})
+	assert.Equal(t, PoolConfig{
+		MaxOpenConns:    10,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 60 * time.Second,
+	}, cfg)
+
+	// invalid value falls back to the default
+	cfg = PoolConfigFromParams(params.Params{
+		{Key: ParamKeyMaxOpenConns, Value: "-1", Type: params.ParamTypeInt},
+		{Key: ParamKeyMaxIdleConns, Value: "abc", Type: params.ParamTypeInt},
+	})
+	assert.Equal(t, DefaultPoolConfig(), cfg)
+}
+
+func TestNewMockExecutorPoolConfig(t *testing.T) {
+	e, _, err := NewMockExecutor()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultPoolConfig(), e.PoolConfig())
+}
+
+func TestQueryTimeoutFromParams(t *testing.T) {
+	assert.Equal(t, time.Duration(0), QueryTimeoutFromParams(nil))
+	assert.Equal(t, 30*time.Second, QueryTimeoutFromParams(params.Params{
+		{Key: ParamKeyQueryTimeout, Value: "30", Type: params.ParamTypeInt},
+	}))
+	assert.Equal(t, time.Duration(0), QueryTimeoutFromParams(params.Params{
+		{Key: ParamKeyQueryTimeout, Value: "-1", Type: params.ParamTypeInt},
+	}))
+}
+
+func TestBaseConnQueryTimeout(t *testing.T) {
+	e, handler, err := NewMockExecutor()
+	assert.NoError(t, err)
+	//nolint:forcetypeassert
+	e.Db.(*BaseConn).queryTimeout = 50 * time.Millisecond
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT SLEEP(1)")).WillDelayFor(time.Second).WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow("0"))
+	_, _, err = e.Db.QueryWithContext(context.TODO(), "SELECT SLEEP(1)")
+	assert.True(t, errors.Is(err, ErrQueryTimeout))
+
+	handler.ExpectExec(regexp.QuoteMeta("DO SLEEP(1)")).WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = e.Db.Exec("DO SLEEP(1)")
+	assert.True(t, errors.Is(err, ErrQueryTimeout))
+
+	// the error which is not caused by the timeout
+	handler.ExpectQuery("SELECT 1").WillReturnError(errors.New("connection refused"))
+	_, _, err = e.Db.QueryWithContext(context.TODO(), "SELECT 1")
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrQueryTimeout))
+
+	handler.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow("1"))
+	_, rows, err := e.Db.QueryWithContext(context.TODO(), "SELECT 1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", rows[0][0].String)
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestExecutorSetSessionVariables(t *testing.T) {
+	e, handler, err := NewMockExecutor()
+	assert.NoError(t, err)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT @@SESSION.sql_require_primary_key, @@SESSION.sql_mode")).
+		WillReturnRows(sqlmock.NewRows([]string{"a", "b"}).AddRow("1", "STRICT_TRANS_TABLES"))
+	handler.ExpectExec(regexp.QuoteMeta("SET SESSION sql_require_primary_key = OFF, SESSION sql_mode = 'NO_ENGINE_SUBSTITUTION'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	restore, err := e.SetSessionVariables(context.TODO(),
+		SessionVariable{Name: "sql_require_primary_key", Value: "off"},
+		SessionVariable{Name: "sql_mode", Value: "NO_ENGINE_SUBSTITUTION"},
+	)
+	assert.NoError(t, err)
+
+	handler.ExpectExec(regexp.QuoteMeta("SET SESSION sql_require_primary_key = 1, SESSION sql_mode = 'STRICT_TRANS_TABLES'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.NoError(t, restore())
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// no variables
+	restore, err = e.SetSessionVariables(context.TODO())
+	assert.NoError(t, err)
+	assert.NoError(t, restore())
+
+	_, err = e.SetSessionVariables(context.TODO(), SessionVariable{Name: "a = 1; DROP TABLE t1", Value: "0"})
+	assert.Error(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestFormatSessionVariableValue(t *testing.T) {
+	for value, expected := range map[string]string{
+		"0":           "0",
+		" 1024 ":      "1024",
+		"off":         "OFF",
+		"Default":     "DEFAULT",
+		"READ-ONLY":   "'READ-ONLY'",
+		"a'b":         "'a''b'",
+		`C:\tmp`:      `'C:\\tmp'`,
+		"utf8mb4_bin": "'utf8mb4_bin'",
+	} {
+		assert.Equal(t, expected, formatSessionVariableValue(value), value)
+	}
+}
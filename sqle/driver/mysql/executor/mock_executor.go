@@ -18,13 +18,16 @@ func NewMockExecutor() (*Executor, sqlmock.Sqlmock, error) {
 		return nil, nil, err
 	}
 
-	var executor = &Executor{}
+	var executor = &Executor{
+		poolConfig: DefaultPoolConfig(),
+	}
 	executor.Db = &BaseConn{
 		log:  logrus.WithField("unittest", "unittest"),
 		host: "mockhost",
 		port: "mockport",
 		user: "mockuser",
 		db:   mockDB,
+		pool: &connPool{config: executor.poolConfig, sessions: 1},
 		conn: mockConn,
 	}
 	return executor, handler, nil
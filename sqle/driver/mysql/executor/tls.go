@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	e "errors"
+	"fmt"
+	"os"
+
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/go-sql-driver/mysql"
+)
+
+// registerTLSConfig returns the tls config name used by the mysql driver.
+// If the cert files are set, a new tls config built from them is registered
+// with the name of instance.TLSConfigName (or a name generated from the instance),
+// otherwise instance.TLSConfigName is expected to be registered already.
+func registerTLSConfig(instance *driverV2.DSN) (string, error) {
+	if instance.CAPath == "" && instance.ClientCertPath == "" && instance.ClientKeyPath == "" {
+		return instance.TLSConfigName, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if instance.CAPath != "" {
+		pem, err := os.ReadFile(instance.CAPath)
+		if err != nil {
+			return "", fmt.Errorf("read ca file failed, %v", err)
+		}
+		rootCertPool := x509.NewCertPool()
+		if ok := rootCertPool.AppendCertsFromPEM(pem); !ok {
+			return "", fmt.Errorf("append ca file %s failed, no valid PEM cert found", instance.CAPath)
+		}
+		tlsConfig.RootCAs = rootCertPool
+	}
+	if instance.ClientCertPath != "" || instance.ClientKeyPath != "" {
+		if instance.ClientCertPath == "" || instance.ClientKeyPath == "" {
+			return "", fmt.Errorf("client cert and client key should be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(instance.ClientCertPath, instance.ClientKeyPath)
+		if err != nil {
+			return "", fmt.Errorf("load client cert failed, %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	name := instance.TLSConfigName
+	if name == "" {
+		name = fmt.Sprintf("sqle_%s_%s_%s", instance.Host, instance.Port, instance.User)
+	}
+	if err := mysql.RegisterTLSConfig(name, tlsConfig); err != nil {
+		return "", fmt.Errorf("register tls config %s failed, %v", name, err)
+	}
+	return name, nil
+}
+
+// isTLSHandshakeError reports whether the error is caused by verifying the server cert.
+func isTLSHandshakeError(err error) bool {
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certInvalidErr x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+	return e.As(err, &unknownAuthorityErr) ||
+		e.As(err, &hostnameErr) ||
+		e.As(err, &certInvalidErr) ||
+		e.As(err, &recordHeaderErr)
+}
@@ -0,0 +1,177 @@
+package executor
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// genSelfSignedCert writes a self-signed cert and key for 127.0.0.1 into dir.
+func genSelfSignedCert(t *testing.T, dir, name string) (certPath, keyPath string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPath = filepath.Join(dir, name+".pem")
+	keyPath = filepath.Join(dir, name+"-key.pem")
+	assert.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certPath, keyPath
+}
+
+// startFakeTLSServer accepts one connection, sends a MySQL handshake which supports SSL,
+// and reports the result of the tls handshake. The connection is closed after that.
+func startFakeTLSServer(t *testing.T, cert tls.Certificate) (host, port string, handshakeErr chan error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	handshakeErr = make(chan error, 1)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			handshakeErr <- err
+			return
+		}
+		defer conn.Close()
+
+		// protocol 41 | ssl | secure connection | long password | transactions
+		capLower := uint16(0x0200 | 0x0800 | 0x8000 | 0x0001 | 0x2000)
+		// plugin auth
+		capUpper := uint16(0x0008)
+		payload := []byte{10}
+		payload = append(payload, []byte("8.0.0\x00")...)
+		payload = append(payload, 1, 0, 0, 0)
+		payload = append(payload, []byte("12345678")...)
+		payload = append(payload, 0)
+		payload = binary.LittleEndian.AppendUint16(payload, capLower)
+		payload = append(payload, 0x21)
+		payload = append(payload, 2, 0)
+		payload = binary.LittleEndian.AppendUint16(payload, capUpper)
+		payload = append(payload, 21)
+		payload = append(payload, make([]byte, 10)...)
+		payload = append(payload, []byte("123456789012\x00")...)
+		payload = append(payload, []byte("mysql_native_password\x00")...)
+		header := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), 0}
+		if _, err := conn.Write(append(header, payload...)); err != nil {
+			handshakeErr <- err
+			return
+		}
+
+		// read the SSL request packet
+		if _, err := io.ReadFull(conn, header); err != nil {
+			handshakeErr <- err
+			return
+		}
+		length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+		if _, err := io.ReadFull(conn, make([]byte, length)); err != nil {
+			handshakeErr <- err
+			return
+		}
+		tlsConn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{cert}})
+		handshakeErr <- tlsConn.Handshake()
+	}()
+
+	host, port, err = net.SplitHostPort(ln.Addr().String())
+	assert.NoError(t, err)
+	return host, port, handshakeErr
+}
+
+func TestNewExecutorWithTLS(t *testing.T) {
+	dir := t.TempDir()
+	serverCertPath, serverKeyPath := genSelfSignedCert(t, dir, "server")
+	otherCAPath, _ := genSelfSignedCert(t, dir, "other")
+	clientCertPath, clientKeyPath := genSelfSignedCert(t, dir, "client")
+	serverCert, err := tls.LoadX509KeyPair(serverCertPath, serverKeyPath)
+	assert.NoError(t, err)
+	entry := logrus.WithField("unittest", "unittest")
+
+	t.Run("trusted server cert", func(t *testing.T) {
+		host, port, handshakeErr := startFakeTLSServer(t, serverCert)
+		_, err := NewExecutor(entry, &driverV2.DSN{
+			Host:           host,
+			Port:           port,
+			User:           "root",
+			CAPath:         serverCertPath,
+			ClientCertPath: clientCertPath,
+			ClientKeyPath:  clientKeyPath,
+		}, "")
+		// the fake server closes the connection after tls handshake
+		assert.Error(t, err)
+		assert.NoError(t, <-handshakeErr)
+		assert.NotContains(t, err.Error(), "tls handshake")
+	})
+
+	t.Run("untrusted server cert", func(t *testing.T) {
+		host, port, handshakeErr := startFakeTLSServer(t, serverCert)
+		err := Ping(entry, &driverV2.DSN{
+			Host:   host,
+			Port:   port,
+			User:   "root",
+			CAPath: otherCAPath,
+		})
+		assert.Error(t, err)
+		assert.Error(t, <-handshakeErr)
+		assert.Contains(t, err.Error(), "tls handshake")
+	})
+
+	t.Run("registered tls config name", func(t *testing.T) {
+		leaf, err := x509.ParseCertificate(serverCert.Certificate[0])
+		assert.NoError(t, err)
+		pool := x509.NewCertPool()
+		pool.AddCert(leaf)
+		assert.NoError(t, mysql.RegisterTLSConfig("sqle_unittest", &tls.Config{RootCAs: pool}))
+		defer mysql.DeregisterTLSConfig("sqle_unittest")
+
+		host, port, handshakeErr := startFakeTLSServer(t, serverCert)
+		_, err = NewExecutor(entry, &driverV2.DSN{
+			Host:          host,
+			Port:          port,
+			User:          "root",
+			TLSConfigName: "sqle_unittest",
+		}, "")
+		assert.Error(t, err)
+		assert.NoError(t, <-handshakeErr)
+	})
+
+	t.Run("invalid cert files", func(t *testing.T) {
+		_, err := NewExecutor(entry, &driverV2.DSN{
+			Host:           "127.0.0.1",
+			Port:           "3306",
+			User:           "root",
+			ClientCertPath: clientCertPath,
+		}, "")
+		assert.Error(t, err)
+	})
+}
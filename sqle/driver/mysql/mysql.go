@@ -5,7 +5,11 @@ import (
 	"database/sql"
 	_driver "database/sql/driver"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
 	"github.com/actiontech/sqle/sqle/driver"
@@ -20,8 +24,10 @@ import (
 	"github.com/actiontech/sqle/sqle/log"
 	"github.com/actiontech/sqle/sqle/pkg/params"
 	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/text/language"
 )
 
 // MysqlDriverImpl implements driver.Driver interface
@@ -32,6 +38,8 @@ type MysqlDriverImpl struct {
 	cnf *Config
 
 	rules []*driverV2.Rule
+	// levelOverrides overrides the level of rules by rule name, see driverV2.Config.LevelOverrides.
+	levelOverrides map[string]driverV2.RuleLevel
 
 	// result keep inspect result for single audited SQL.
 	// It refresh on every Audit.
@@ -46,15 +54,46 @@ type MysqlDriverImpl struct {
 	dbConn *executor.Executor
 	// isConnected represent dbConn has Connected.
 	isConnected bool
+	// readInst is the DSN of the read replica, see driverV2.Config.ReadDSN. It is nil if
+	// the read-only queries of audit are sent to inst.
+	readInst *driverV2.DSN
+	// readConn is the executor connected to readInst, it is used by Ctx.
+	readConn *executor.Executor
+	// isReadConnected represent readConn has Connected.
+	isReadConnected bool
 	// isOfflineAudit represent Audit without instance.
 	isOfflineAudit bool
+
+	// poolConfig is shared by all executors created by the driver.
+	poolConfig executor.PoolConfig
+	// queryTimeout limits each query and exec of the executors created by the driver, 0 means no timeout.
+	queryTimeout time.Duration
+	// killProcessRetryTimes is the times to kill the connection again if it still exists after killed.
+	killProcessRetryTimes int
+	// affectRowsOptions are the options to estimate the affected rows by EstimateSQLAffectRows.
+	affectRowsOptions util.AffectedRowNumOptions
+	// affectRowsEstimator is used by EstimateSQLAffectRows and the rules checking the affected rows,
+	// it is created by the name of ParamKeyAffectRowsEstimator. The default estimator is used if it
+	// is nil, including the name is not registered.
+	affectRowsEstimator util.AffectedRowsEstimator
+	// ruleTimings is the time spent by each rule in the last Audit, it is only
+	// recorded if driverV2.Config.ProfileRules is enabled.
+	ruleTimings map[string]time.Duration
+	// captureWarnings enables "SHOW WARNINGS" after Exec, see ParamKeyCaptureWarnings.
+	captureWarnings bool
 }
 
 func NewInspectWithExecutor(log *logrus.Entry, cfg *driverV2.Config, conn *executor.Executor) (*MysqlDriverImpl, error) {
+	return NewInspectWithExecutors(log, cfg, conn, nil)
+}
+
+// NewInspectWithExecutors is like NewInspectWithExecutor, but the read-only queries of audit
+// are sent to readConn if it is not nil, see driverV2.Config.ReadDSN.
+func NewInspectWithExecutors(log *logrus.Entry, cfg *driverV2.Config, conn, readConn *executor.Executor) (*MysqlDriverImpl, error) {
 	var inspect = &MysqlDriverImpl{}
 
 	if conn != nil {
-		inspect.initializeInspectWithConn(conn, log, cfg)
+		inspect.initializeInspectWithConn(conn, readConn, log, cfg)
 	} else {
 		inspect.initializeInspectWithoutConn(log, cfg)
 	}
@@ -64,12 +103,21 @@ func NewInspectWithExecutor(log *logrus.Entry, cfg *driverV2.Config, conn *execu
 func NewInspect(log *logrus.Entry, cfg *driverV2.Config) (*MysqlDriverImpl, error) {
 	var inspect = &MysqlDriverImpl{}
 
-	if cfg.DSN != nil {
-		conn, err := executor.NewExecutor(log, cfg.DSN, cfg.DSN.DatabaseName)
+	readDSN, writeDSN := cfg.ReadWriteDSN()
+	if writeDSN != nil {
+		conn, err := newExecutorByDSN(log, writeDSN, writeDSN.DatabaseName)
 		if err != nil {
 			return nil, errors.Wrap(err, "new executor in inspect")
 		}
-		inspect.initializeInspectWithConn(conn, log, cfg)
+		var readConn *executor.Executor
+		if readDSN != writeDSN {
+			readConn, err = newExecutorByDSN(log, readDSN, writeDSN.DatabaseName)
+			if err != nil {
+				conn.Db.Close()
+				return nil, errors.Wrap(err, "new read executor in inspect")
+			}
+		}
+		inspect.initializeInspectWithConn(conn, readConn, log, cfg)
 	} else {
 		inspect.initializeInspectWithoutConn(log, cfg)
 	}
@@ -77,33 +125,84 @@ func NewInspect(log *logrus.Entry, cfg *driverV2.Config) (*MysqlDriverImpl, erro
 	return inspect, nil
 }
 
-func (inspect *MysqlDriverImpl) initializeInspectWithConn(conn *executor.Executor, log *logrus.Entry, cfg *driverV2.Config) {
+func newExecutorByDSN(log *logrus.Entry, dsn *driverV2.DSN, schema string) (*executor.Executor, error) {
+	return executor.NewExecutor(log, dsn, schema,
+		executor.WithPoolConfig(executor.PoolConfigFromParams(dsn.AdditionalParams)),
+		executor.WithQueryTimeout(executor.QueryTimeoutFromParams(dsn.AdditionalParams)))
+}
+
+func (inspect *MysqlDriverImpl) initializeInspectWithConn(conn, readConn *executor.Executor, log *logrus.Entry, cfg *driverV2.Config) {
+	readDSN, writeDSN := cfg.ReadWriteDSN()
 	inspect.log = log
 	inspect.isConnected = true
 	inspect.dbConn = conn
-	inspect.inst = cfg.DSN
-	inspect.Ctx = session.NewContext(nil, session.WithExecutor(conn))
-	inspect.Ctx.SetCurrentSchema(cfg.DSN.DatabaseName)
+	inspect.inst = writeDSN
+	// the metadata and EXPLAIN queries of Ctx are sent to the read replica if it is configured,
+	// the SQL is always executed on inst, including by gh-ost and pt-online-schema-change.
+	ctxConn := conn
+	if readConn != nil {
+		inspect.isReadConnected = true
+		inspect.readConn = readConn
+		inspect.readInst = readDSN
+		ctxConn = readConn
+	}
+	inspect.Ctx = session.NewContext(nil, session.WithExecutor(ctxConn), session.WithTargetVersion(cfg.TargetVersion))
+	inspect.Ctx.SetCurrentSchema(writeDSN.DatabaseName)
 	inspect.applyConfig(cfg)
 }
 
 func (inspect *MysqlDriverImpl) initializeInspectWithoutConn(log *logrus.Entry, cfg *driverV2.Config) {
-	inspect.Ctx = session.NewContext(nil)
+	inspect.Ctx = session.NewContext(nil, session.WithTargetVersion(cfg.TargetVersion))
 	inspect.log = log
 	inspect.applyConfig(cfg)
 }
 
 func (inspect *MysqlDriverImpl) applyConfig(cfg *driverV2.Config) {
 
-	inspect.rules = cfg.Rules
+	inspect.levelOverrides = cfg.LevelOverrides
+	inspect.rules = cfg.RulesWithLevelOverrides()
 	inspect.result = driverV2.NewAuditResults()
-	inspect.isOfflineAudit = cfg.DSN == nil
+	_, dsn := cfg.ReadWriteDSN()
+	inspect.isOfflineAudit = dsn == nil
+	inspect.poolConfig = executor.DefaultPoolConfig()
+	inspect.killProcessRetryTimes = DefaultKillProcessRetryTimes
+	inspect.affectRowsOptions = util.AffectedRowNumOptions{CountMaxTableRows: DefaultAffectRowsCountMaxTableRows}
+	if dsn != nil {
+		inspect.poolConfig = executor.PoolConfigFromParams(dsn.AdditionalParams)
+		inspect.queryTimeout = executor.QueryTimeoutFromParams(dsn.AdditionalParams)
+		// the param which is missing or invalid is ignored
+		if v, err := strconv.Atoi(dsn.AdditionalParams.GetParam(ParamKeyKillProcessRetryTimes).String()); err == nil && v >= 0 {
+			inspect.killProcessRetryTimes = v
+		}
+		inspect.affectRowsOptions.AccurateMode = dsn.AdditionalParams.GetParam(ParamKeyAffectRowsAccurateMode).Bool()
+		if v := dsn.AdditionalParams.GetParam(ParamKeyAffectRowsCountMaxTableRows).Int(); v > 0 {
+			inspect.affectRowsOptions.CountMaxTableRows = int64(v)
+		}
+		name := dsn.AdditionalParams.GetParam(ParamKeyAffectRowsEstimator).String()
+		if estimator, err := util.NewAffectedRowsEstimator(name, inspect.affectRowsOptions); err != nil {
+			inspect.Logger().Errorf("use the default affected rows estimator: %v", err)
+		} else {
+			inspect.affectRowsEstimator = estimator
+		}
+		inspect.captureWarnings = dsn.AdditionalParams.GetParam(ParamKeyCaptureWarnings).Bool()
+	}
 
 	inspect.cnf = &Config{
-		DDLOSCMinSize:   -1,
-		DDLGhostMinSize: -1,
+		DMLRollbackMaxRows: -1,
+		DDLOSCMinSize:      -1,
+		DDLGhostMinSize:    -1,
+		profileRules:       cfg.ProfileRules,
+		ruleParallelism:    cfg.RuleParallelism,
+		includeRuleTags:    cfg.IncludeRuleTags,
+		excludeRuleTags:    cfg.ExcludeRuleTags,
+		locale:             cfg.Locale,
+		maxAuditStatements: cfg.GetMaxAuditStatements(),
 	}
-	for _, rule := range cfg.Rules {
+	for _, rule := range inspect.rules {
+		if rule.Name == rulepkg.ConfigDMLRollbackMaxRows {
+			max := rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Int()
+			inspect.cnf.DMLRollbackMaxRows = int64(max)
+		}
 		if rule.Name == rulepkg.ConfigDDLOSCMinSize {
 			min := rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Int()
 			inspect.cnf.DDLOSCMinSize = int64(min)
@@ -127,7 +226,7 @@ func (inspect *MysqlDriverImpl) applyConfig(cfg *driverV2.Config) {
 }
 
 func (i *MysqlDriverImpl) SetRules(rules []*driverV2.Rule) {
-	i.rules = rules
+	i.rules = driverV2.ApplyRuleLevelOverrides(rules, i.levelOverrides)
 }
 
 func (i *MysqlDriverImpl) SetExecutor(dbConn *executor.Executor) {
@@ -153,6 +252,11 @@ func (i *MysqlDriverImpl) executeByGhost(ctx context.Context, query string, isDr
 		return nil, errors.New("type assertion failed, unable to convert to expected type")
 	}
 	schema := i.Ctx.GetSchemaName(stmt.Table)
+	if isDryRun {
+		if err := i.checkOnlineDDLPrerequisites(ctx, onlineDDLToolGhost, schema); err != nil {
+			return nil, err
+		}
+	}
 
 	run := func(dryRun bool) error {
 		executor, err := onlineddl.NewExecutor(i.log, i.inst, schema, query)
@@ -185,24 +289,101 @@ func (i *MysqlDriverImpl) Exec(ctx context.Context, query string) (_driver.Resul
 	if i.IsOfflineAudit() {
 		return nil, nil
 	}
+	return i.exec(ctx, query)
+}
 
-	useGhost, err := i.onlineddlWithGhost(query)
+// ExecWithSessionVariables is like Exec, but the session variables are set on the connection
+// before executing the query and restored after it, e.g. "sql_require_primary_key=OFF" for the
+// ALTER of the legacy tables without primary key. The variables are not applied to the query
+// executed by gh-ost or pt-osc, which runs on the connections of the tool.
+func (i *MysqlDriverImpl) ExecWithSessionVariables(ctx context.Context, vars []executor.SessionVariable, query string) (_driver.Result, error) {
+	if i.IsOfflineAudit() {
+		return nil, nil
+	}
+	var result _driver.Result
+	err := i.withSessionVariables(ctx, vars, func() (err error) {
+		result, err = i.exec(ctx, query)
+		return err
+	})
+	return result, err
+}
+
+func (i *MysqlDriverImpl) exec(ctx context.Context, query string) (_driver.Result, error) {
+
+	tool, err := i.selectOnlineDDLTool(query)
 	if err != nil {
-		return nil, errors.Wrap(err, "check whether use ghost or not")
+		return nil, err
 	}
 
-	if useGhost {
+	switch tool {
+	case onlineDDLToolGhost:
 		if _, err := i.executeByGhost(ctx, query, true); err != nil {
 			return nil, err
 		}
 		return i.executeByGhost(ctx, query, false)
+	case onlineDDLToolPtOSC:
+		if _, err := i.executeByPtOSC(ctx, query, true); err != nil {
+			return nil, err
+		}
+		return i.executeByPtOSC(ctx, query, false)
 	}
 
 	conn, err := i.getDbConn()
 	if err != nil {
 		return nil, err
 	}
-	return conn.Db.Exec(query)
+	result, err := conn.Db.ExecContext(ctx, query)
+	if err != nil && ctx.Err() != nil {
+		return nil, errors.Wrap(ctx.Err(), "exec sql canceled")
+	}
+	if err != nil || !i.captureWarnings {
+		return result, err
+	}
+	// the query has been executed, failing to get the warnings does not fail it
+	warnings, warnErr := queryWarnings(ctx, conn.Db)
+	if warnErr != nil {
+		i.log.Errorf("show warnings after exec sql failed: %v", warnErr)
+	}
+	return &ExecResult{Result: result, Warnings: warnings}, nil
+}
+
+// ParamKeyCaptureWarnings is the key of DSN additional param which enables capturing the
+// warnings by "SHOW WARNINGS" after Exec if it is "true", the result of Exec is *ExecResult
+// then. It is disabled by default to avoid an extra round trip for each query.
+const ParamKeyCaptureWarnings = "capture_warnings"
+
+// Warning is a row of "SHOW WARNINGS", e.g. {"Warning", 1265, "Data truncated for column 'a' at row 1"}.
+type Warning struct {
+	Level   string
+	Code    int
+	Message string
+}
+
+// ExecResult is the result of Exec if the warnings are captured, see ParamKeyCaptureWarnings.
+// The warnings are not captured for the query executed by gh-ost or pt-osc.
+type ExecResult struct {
+	_driver.Result
+	Warnings []Warning
+}
+
+// queryWarnings returns the warnings of the last query executed on the connection.
+func queryWarnings(ctx context.Context, db executor.Db) ([]Warning, error) {
+	_, rows, err := db.QueryWithContext(ctx, "SHOW WARNINGS")
+	if err != nil {
+		return nil, err
+	}
+	warnings := make([]Warning, 0, len(rows))
+	for _, row := range rows {
+		if len(row) < 3 {
+			return nil, fmt.Errorf("unexpected results of show warnings")
+		}
+		code, err := strconv.Atoi(row[1].String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code %q of show warnings", row[1].String)
+		}
+		warnings = append(warnings, Warning{Level: row[0].String, Code: code, Message: row[2].String})
+	}
+	return warnings, nil
 }
 
 func (i *MysqlDriverImpl) ExecBatch(ctx context.Context, queries ...string) ([]_driver.Result, error) {
@@ -217,27 +398,180 @@ func (i *MysqlDriverImpl) ExecBatch(ctx context.Context, queries ...string) ([]_
 	return results, nil
 }
 
+// ExecBatchWithSessionVariables is like ExecBatch, but the session variables are set on the connection
+// once before executing the queries and restored after them, see ExecWithSessionVariables.
+func (i *MysqlDriverImpl) ExecBatchWithSessionVariables(ctx context.Context, vars []executor.SessionVariable, queries ...string) ([]_driver.Result, error) {
+	if i.IsOfflineAudit() {
+		return i.ExecBatch(ctx, queries...)
+	}
+	var results []_driver.Result
+	err := i.withSessionVariables(ctx, vars, func() (err error) {
+		results, err = i.ExecBatch(ctx, queries...)
+		return err
+	})
+	return results, err
+}
+
+// withSessionVariables sets the session variables on the connection, calls fn and restores the variables.
+func (i *MysqlDriverImpl) withSessionVariables(ctx context.Context, vars []executor.SessionVariable, fn func() error) error {
+	if len(vars) == 0 {
+		return fn()
+	}
+	conn, err := i.getDbConn()
+	if err != nil {
+		return err
+	}
+	restore, err := conn.SetSessionVariables(ctx, vars...)
+	if err != nil {
+		return err
+	}
+	err = fn()
+	if restoreErr := restore(); restoreErr != nil {
+		i.log.Error(restoreErr)
+		if err == nil {
+			err = restoreErr
+		}
+	}
+	return err
+}
+
+// DryRunResult is the result of one statement checked by DryRunBatch.
+type DryRunResult struct {
+	SQL     string
+	SQLType string
+	// ParseErr is not nil if the statement can not be parsed, the other checks are skipped.
+	ParseErr error
+	// InvalidResult is the result of the invalid SQL checks used in audit.
+	InvalidResult *driverV2.AuditResults
+}
+
+// WouldFail reports whether the statement would fail in ExecBatch.
+func (r *DryRunResult) WouldFail() bool {
+	return r.ParseErr != nil || (r.InvalidResult != nil && r.InvalidResult.HasResult())
+}
+
+// DryRunBatch parses the queries and runs the invalid SQL checks on them without executing,
+// it doesn't stop on the first failed statement like ExecBatch.
+// The checks read the metadata from the instance if it is connected, and the changes made
+// by the previous statements are applied to a copy of the context only.
+func (i *MysqlDriverImpl) DryRunBatch(ctx context.Context, queries ...string) ([]*DryRunResult, error) {
+	originCtx, originResult := i.Ctx, i.result
+	defer func() {
+		i.Ctx, i.result = originCtx, originResult
+	}()
+	i.Ctx = session.NewContext(originCtx)
+
+	results := make([]*DryRunResult, 0, len(queries))
+	for _, query := range queries {
+		nodes, err := i.ParseSql(query)
+		if err != nil {
+			results = append(results, &DryRunResult{SQL: query, ParseErr: err})
+			continue
+		}
+		for _, node := range nodes {
+			i.result = driverV2.NewAuditResults()
+			if i.IsOfflineAudit() || i.IsExecutedSQL() {
+				err = i.CheckInvalidOffline(node)
+			} else {
+				err = i.CheckInvalid(node)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "check invalid sql: %s", node.Text())
+			}
+			results = append(results, &DryRunResult{
+				SQL:           node.Text(),
+				SQLType:       i.assertSQLType(node),
+				InvalidResult: i.result,
+			})
+			i.Ctx.UpdateContext(node)
+		}
+	}
+	return results, nil
+}
+
+type onlineDDLTool string
+
+const (
+	onlineDDLToolNone  onlineDDLTool = ""
+	onlineDDLToolGhost onlineDDLTool = "gh-ost"
+	onlineDDLToolPtOSC onlineDDLTool = "pt-online-schema-change"
+)
+
+// selectOnlineDDLTool selects the tool to execute the query by the configured
+// DDLGhostMinSize and DDLOSCMinSize. gh-ost takes precedence over
+// pt-online-schema-change when both of them are configured and matched.
+func (i *MysqlDriverImpl) selectOnlineDDLTool(query string) (onlineDDLTool, error) {
+	useGhost, err := i.onlineddlWithGhost(query)
+	if err != nil {
+		return onlineDDLToolNone, errors.Wrap(err, "check whether use ghost or not")
+	}
+	if useGhost {
+		return onlineDDLToolGhost, nil
+	}
+
+	usePtOSC, _, err := i.ShouldUsePtOSC(query)
+	if err != nil {
+		return onlineDDLToolNone, errors.Wrap(err, "check whether use pt-online-schema-change or not")
+	}
+	if usePtOSC {
+		return onlineDDLToolPtOSC, nil
+	}
+	return onlineDDLToolNone, nil
+}
+
+// checkOnlineDDLPrerequisites checks the binlog settings and the privileges of the current user
+// required by the tool to alter the tables of the schema, all the missing ones are listed in the
+// error. It is called before the dry-run of the tool, the server info is cached on the context.
+func (i *MysqlDriverImpl) checkOnlineDDLPrerequisites(ctx context.Context, tool onlineDDLTool, schema string) error {
+	info, err := i.ServerInfo(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "check the prerequisites of %s", tool)
+	}
+	var issues []string
+	switch tool {
+	case onlineDDLToolGhost:
+		issues = info.GhostIssues(schema)
+	case onlineDDLToolPtOSC:
+		issues = info.PtOscIssues(schema)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("the prerequisites of %s are not met: %s", tool, strings.Join(issues, "; "))
+	}
+	return nil
+}
+
 func (i *MysqlDriverImpl) onlineddlWithGhost(query string) (bool, error) {
+	useGhost, _, err := i.ShouldUseGhost(query)
+	return useGhost, err
+}
+
+// ShouldUseGhost returns whether the query will be executed by gh-ost and
+// the size(MB) of the altered table. gh-ost is used when the query is an
+// ALTER TABLE statement and the table size is larger than DDLGhostMinSize.
+func (i *MysqlDriverImpl) ShouldUseGhost(query string) (bool, int64, error) {
 	if i.cnf.DDLGhostMinSize == -1 {
-		return false, nil
+		return false, 0, nil
 	}
 
 	node, err := i.ParseSql(query)
 	if err != nil {
-		return false, errors.Wrap(err, "parse SQL")
+		return false, 0, errors.Wrap(err, "parse SQL")
+	}
+	if len(node) == 0 {
+		return false, 0, nil
 	}
 
 	stmt, ok := node[0].(*ast.AlterTableStmt)
 	if !ok {
-		return false, nil
+		return false, 0, nil
 	}
 
 	tableSize, err := i.Ctx.GetTableSize(stmt.Table)
 	if err != nil {
-		return false, errors.Wrap(err, "get table size")
+		return false, 0, errors.Wrap(err, "get table size")
 	}
 
-	return int64(tableSize) > i.cnf.DDLGhostMinSize, nil
+	return int64(tableSize) > i.cnf.DDLGhostMinSize, int64(tableSize), nil
 }
 
 func (i *MysqlDriverImpl) Tx(ctx context.Context, queries ...string) ([]_driver.Result, error) {
@@ -251,20 +585,55 @@ func (i *MysqlDriverImpl) Tx(ctx context.Context, queries ...string) ([]_driver.
 	return conn.Db.Transact(queries...)
 }
 
+// ParamKeyKillProcessRetryTimes is the key of DSN additional param which sets the times
+// to kill the connection again if it still exists after killed by KillProcess.
+const ParamKeyKillProcessRetryTimes = "kill_process_retry_times"
+
+const DefaultKillProcessRetryTimes = 3
+
+// keys of DSN additional params which tune EstimateSQLAffectRows, see util.AffectedRowNumOptions.
+const (
+	// ParamKeyAffectRowsAccurateMode enables the accurate mode if it is "true".
+	ParamKeyAffectRowsAccurateMode = "affect_rows_accurate_mode"
+	// ParamKeyAffectRowsCountMaxTableRows is the max table rows to count the affected rows
+	// by SELECT COUNT(*) in the accurate mode.
+	ParamKeyAffectRowsCountMaxTableRows = "affect_rows_count_max_table_rows"
+	// ParamKeyAffectRowsEstimator is the name of util.AffectedRowsEstimator, e.g. "explain" which
+	// never executes SELECT COUNT, or the name registered by util.RegisterAffectedRowsEstimator.
+	ParamKeyAffectRowsEstimator = "affect_rows_estimator"
+)
+
+const DefaultAffectRowsCountMaxTableRows = 1000000
+
+// GetConnectionID returns the id of the connection used by the driver, which is the
+// thread id on the server. It connects to the instance first if not connected.
+func (i *MysqlDriverImpl) GetConnectionID(ctx context.Context) (string, error) {
+	if i.IsOfflineAudit() {
+		return "", fmt.Errorf("cannot get mysql conn_id in offline audit")
+	}
+	conn, err := i.getDbConn()
+	if err != nil {
+		return "", err
+	}
+	connID := conn.Db.GetConnectionID()
+	if connID == "" {
+		return "", fmt.Errorf("cannot find mysql conn_id, check logs")
+	}
+	return connID, nil
+}
+
 func (i *MysqlDriverImpl) KillProcess(ctx context.Context) error {
 	connID := i.dbConn.Db.GetConnectionID()
 	if connID == "" {
 		return fmt.Errorf("cannot find mysql conn_id, check logs")
 	}
 	logEntry := log.NewEntry().WithField("mysql_driver", "kill_process")
-	killConn, err := executor.NewExecutor(logEntry, i.inst, i.inst.DatabaseName)
+	killConn, err := executor.NewExecutor(logEntry, i.inst, i.inst.DatabaseName, executor.WithPoolConfig(i.poolConfig), executor.WithQueryTimeout(i.queryTimeout))
 	if err != nil {
 		return err
 	}
 	defer killConn.Db.Close()
-	killSQL := fmt.Sprintf("KILL %v", connID)
-	err = util.KillProcess(ctx, killSQL, killConn, logEntry)
-	return err
+	return util.KillProcess(ctx, connID, killConn, i.killProcessRetryTimes, logEntry)
 }
 
 func (i *MysqlDriverImpl) query(ctx context.Context, query string, args ...interface{}) ([]map[string]sql.NullString, error) {
@@ -288,22 +657,64 @@ func (i *MysqlDriverImpl) Parse(ctx context.Context, sqlText string) ([]driverV2
 
 	ns := make([]driverV2.Node, len(nodes))
 	for idx := range nodes {
-		n := driverV2.Node{}
+		n := i.newDriverNode(idx, nodes[idx])
 		fingerprint, err := util.Fingerprint(nodes[idx].Text(), lowerCaseTableNames == "0")
 		if err != nil {
 			return nil, err
 		}
 		n.Fingerprint = fingerprint
-		n.Text = nodes[idx].Text()
-		n.StartLine = uint64(nodes[idx].StartLine())
-		n.Type = i.assertSQLType(nodes[idx])
-		n.ExecBatchId = uint64(idx)
 
 		ns[idx] = n
 	}
 	return ns, nil
 }
 
+// ParseWithErrors splits the sql text into statements by delimiter and parses each
+// of them independently. Unlike Parse, a statement with syntax error doesn't fail the
+// others, errs[idx] is the parse error of nodes[idx] and it is nil if the statement is
+// parsed successfully. The nodes is nil if the sql text can't be split.
+func (i *MysqlDriverImpl) ParseWithErrors(ctx context.Context, sqlText string) (nodes []driverV2.Node, errs []error) {
+	stmts, err := i.ParseSql(sqlText)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	lowerCaseTableNames, err := i.Ctx.GetSystemVariable(session.SysVarLowerCaseTableNames)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	nodes = make([]driverV2.Node, len(stmts))
+	errs = make([]error, len(stmts))
+	for idx, stmt := range stmts {
+		nodes[idx] = i.newDriverNode(idx, stmt)
+		if _, ok := stmt.(*ast.UnparsedStmt); ok {
+			// the splitter keeps the statement with syntax error as unparsed statement,
+			// parse it again to get the error.
+			_, err = util.ParseOneSql(stmt.Text())
+			if err == nil {
+				err = errors.New("unparsed statement")
+			}
+			errs[idx] = fmt.Errorf("parse sql at line %d failed: %w", stmt.StartLine(), err)
+			continue
+		}
+		nodes[idx].Fingerprint, err = util.Fingerprint(stmt.Text(), lowerCaseTableNames == "0")
+		if err != nil {
+			errs[idx] = fmt.Errorf("get fingerprint of sql at line %d failed: %w", stmt.StartLine(), err)
+		}
+	}
+	return nodes, errs
+}
+
+func (i *MysqlDriverImpl) newDriverNode(idx int, stmt ast.Node) driverV2.Node {
+	return driverV2.Node{
+		Text:        stmt.Text(),
+		StartLine:   uint64(stmt.StartLine()),
+		Type:        i.assertSQLType(stmt),
+		ExecBatchId: uint64(idx),
+	}
+}
+
 func (i *MysqlDriverImpl) assertSQLType(stmt ast.Node) string {
 	switch stmt.(type) {
 	case ast.DMLNode:
@@ -319,22 +730,205 @@ func (i *MysqlDriverImpl) assertSQLType(stmt ast.Node) string {
 }
 
 func (i *MysqlDriverImpl) Audit(ctx context.Context, sqls []string) ([]*driverV2.AuditResults, error) {
+	if max := i.cnf.maxAuditStatements; max > 0 && len(sqls) > max {
+		return nil, errors.Wrapf(driverV2.ErrTooManyStatements, "%d statements exceed the limit %d, audit them in smaller batches or by AuditStream",
+			len(sqls), max)
+	}
+	results := make([]*driverV2.AuditResults, 0, len(sqls))
+	err := i.AuditStream(ctx, sqls, func(idx int, result *driverV2.AuditResults) {
+		results = append(results, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// AuditStream is like Audit, but the result of each statement is passed to fn with the index of
+// the statement once it is audited instead of being accumulated, so the memory is bounded for the
+// huge batches, which are not limited by driverV2.Config.MaxAuditStatements. The statements after
+// the one failed to audit are not audited.
+func (i *MysqlDriverImpl) AuditStream(ctx context.Context, sqls []string, fn func(idx int, result *driverV2.AuditResults)) error {
 	for _, sql := range sqls {
 		if sql == "" {
-			return nil, errors.New("has empty sql")
+			return errors.New("has empty sql")
 		}
 	}
-	results := make([]*driverV2.AuditResults, 0, len(sqls))
-	for _, sql := range sqls {
+	i.resetRuleTimings()
+	for idx, sql := range sqls {
 		result, err := i.audit(ctx, sql)
 		if err != nil {
+			return err
+		}
+		fn(idx, result)
+	}
+	return nil
+}
+
+// ScriptAuditResult is the result of one statement audited by AuditScript.
+type ScriptAuditResult struct {
+	// Node is the statement split from the script, with its start line.
+	Node driverV2.Node
+	// ParseErr is the error of the statement returned by ParseWithErrors, the statement
+	// which can not be parsed is still audited as an unparsed statement.
+	ParseErr error
+	Result   *driverV2.AuditResults
+}
+
+// AuditScript splits the script into statements and audits them in order, the results
+// are aligned to the statements. Like Audit, the context is updated by each statement,
+// so that the later statements see the changes made by the previous ones, e.g. the
+// tables created by the script. A statement with syntax error doesn't stop auditing
+// the others.
+func (i *MysqlDriverImpl) AuditScript(ctx context.Context, script string) ([]*ScriptAuditResult, error) {
+	nodes, errs := i.ParseWithErrors(ctx, script)
+	if nodes == nil {
+		return nil, errs[0]
+	}
+	i.resetRuleTimings()
+	results := make([]*ScriptAuditResult, 0, len(nodes))
+	for idx, node := range nodes {
+		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
-		results = append(results, result)
+		result, err := i.audit(ctx, node.Text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "audit sql at line %d", node.StartLine)
+		}
+		results = append(results, &ScriptAuditResult{
+			Node:     node,
+			ParseErr: errs[idx],
+			Result:   result,
+		})
 	}
 	return results, nil
 }
 
+// DeduplicatedResult is the identical violations collapsed by DeduplicateResults.
+type DeduplicatedResult struct {
+	// Result is the first one of the identical violations.
+	Result *driverV2.AuditResult
+	// Count is the number of the identical violations.
+	Count int
+	// StatementIndexes are the indexes of the statements which have the violation,
+	// in ascending order.
+	StatementIndexes []int
+}
+
+var (
+	resultMessageQuotedRegexp = regexp.MustCompile("`[^`]*`|'(?:[^'\\\\]|\\\\.)*'|\"(?:[^\"\\\\]|\\\\.)*\"")
+	resultMessageWordRegexp   = regexp.MustCompile(`[A-Za-z0-9_$.]+`)
+	resultMessageListRegexp   = regexp.MustCompile(`\?(\s*[,，]\s*\?)+`)
+)
+
+// fingerprintResultMessage is like util.Fingerprint, but for the message of audit result. The
+// quoted strings, identifiers and numbers are replaced by "?", and the list of them is replaced
+// by one "?", so that the messages which only differ in identifiers have the same fingerprint,
+// e.g. "字段 v1 已存在" and "字段 v2 已存在".
+func fingerprintResultMessage(message string) string {
+	message = resultMessageQuotedRegexp.ReplaceAllString(message, "?")
+	message = resultMessageWordRegexp.ReplaceAllString(message, "?")
+	return resultMessageListRegexp.ReplaceAllString(message, "?")
+}
+
+// DeduplicateResults collapses the identical violations in the results returned by Audit, the
+// index of results is the index of statement. The violations are identical if they have the same
+// rule name, level and message fingerprint, see fingerprintResultMessage. The collapsed results
+// are in the order they first appear. It is an optional post-processing for the large batch of
+// statements, the results passed in are not modified.
+func DeduplicateResults(results []*driverV2.AuditResults) []*DeduplicatedResult {
+	deduplicated := []*DeduplicatedResult{}
+	byKey := map[string]*DeduplicatedResult{}
+	for idx, result := range results {
+		if result == nil {
+			continue
+		}
+		for _, r := range result.Results {
+			message := r.GetAuditResultInfoByLangTag(i18nPkg.DefaultLang).Message
+			key := fmt.Sprintf("%s|%s|%s", r.RuleName, r.Level, fingerprintResultMessage(message))
+			d, ok := byKey[key]
+			if !ok {
+				d = &DeduplicatedResult{Result: r}
+				byKey[key] = d
+				deduplicated = append(deduplicated, d)
+			}
+			d.Count++
+			if n := len(d.StatementIndexes); n == 0 || d.StatementIndexes[n-1] != idx {
+				d.StatementIndexes = append(d.StatementIndexes, idx)
+			}
+		}
+	}
+	return deduplicated
+}
+
+// DefaultSelectMaxRepeat is the default max times which the SELECT statements of the same
+// fingerprint are allowed to appear in a batch, see AnalyzeBatchPatterns.
+const DefaultSelectMaxRepeat = 10
+
+// RepeatedSelectPattern is the SELECT statements of the same fingerprint repeated in a batch.
+type RepeatedSelectPattern struct {
+	Fingerprint string
+	// Count is the number of the statements of the fingerprint.
+	Count int
+	// StatementIndexes are the indexes of the statements of the fingerprint, in ascending order.
+	StatementIndexes []int
+	// Suggestion is the advice to batch the statements.
+	Suggestion i18nPkg.I18nStr
+}
+
+// AnalyzeBatchPatterns finds the SELECT statements of the same fingerprint which appear more than
+// maxRepeat times in the batch, it usually indicates the N+1 queries issued by the application in
+// a loop, which should be rewritten as a JOIN or a batched IN query. The nodes are returned by
+// Parse, it works on the fingerprints of them only and doesn't need the connection to instance.
+// The patterns are in the order they first appear.
+func AnalyzeBatchPatterns(nodes []driverV2.Node, maxRepeat int) []*RepeatedSelectPattern {
+	patterns := []*RepeatedSelectPattern{}
+	byFingerprint := map[string]*RepeatedSelectPattern{}
+	for idx, node := range nodes {
+		if node.Type != driverV2.SQLTypeDQL || node.Fingerprint == "" {
+			continue
+		}
+		p, ok := byFingerprint[node.Fingerprint]
+		if !ok {
+			p = &RepeatedSelectPattern{Fingerprint: node.Fingerprint}
+			byFingerprint[node.Fingerprint] = p
+			patterns = append(patterns, p)
+		}
+		p.Count++
+		p.StatementIndexes = append(p.StatementIndexes, idx)
+	}
+
+	repeated := []*RepeatedSelectPattern{}
+	for _, p := range patterns {
+		if p.Count > maxRepeat {
+			p.Suggestion = plocale.Bundle.LocalizeAllWithArgs(plocale.RepeatedSelectSuggestion, p.Count)
+			repeated = append(repeated, p)
+		}
+	}
+	return repeated
+}
+
+func (i *MysqlDriverImpl) resetRuleTimings() {
+	i.ruleTimings = nil
+	if i.cnf != nil && i.cnf.profileRules {
+		i.ruleTimings = map[string]time.Duration{}
+	}
+}
+
+// LastAuditRuleTimings returns the time spent by each rule in the last Audit or AuditScript,
+// which is aggregated over all the statements audited. It is nil if the profiling is not
+// enabled by driverV2.Config.ProfileRules.
+func (i *MysqlDriverImpl) LastAuditRuleTimings() map[string]time.Duration {
+	if i.ruleTimings == nil {
+		return nil
+	}
+	timings := make(map[string]time.Duration, len(i.ruleTimings))
+	for name, d := range i.ruleTimings {
+		timings[name] = d
+	}
+	return timings
+}
+
 func (i *MysqlDriverImpl) audit(ctx context.Context, sql string) (*driverV2.AuditResults, error) {
 	i.result = driverV2.NewAuditResults()
 
@@ -374,34 +968,19 @@ func (i *MysqlDriverImpl) audit(ctx context.Context, sql string) (*driverV2.Audi
 		if rule.Name == rulepkg.ConfigDDLGhostMinSize {
 			ghostRule = rule
 		}
+	}
+	rules, handlers := i.filterRules(nodes[0])
+	i.auditRules(nodes[0], rules, handlers)
 
-		handler, ok := rulepkg.GetRuleHandlerFromAllRules(rule.Name)
-		if !ok || handler.Func == nil {
-			continue
-		}
-		if i.IsOfflineAudit() && !handler.IsAllowOfflineRule(nodes[0]) {
-			continue
-		}
-		if i.cnf.isExecutedSQL {
-			if handler.OnlyAuditNotExecutedSQL {
-				continue
-			}
-			if handler.IsDisableExecutedSQLRule(nodes[0]) {
-				continue
-			}
-		}
-
-		input := &rulepkg.RuleHandlerInput{
-			Ctx:  i.Ctx,
-			Rule: *rule,
-			Res:  i.result,
-			Node: nodes[0],
-		}
+	// CREATE TABLE ... AS SELECT 的查询部分作为独立的 SELECT 语句再审核一次，使 SELECT 相关的规则生效
+	if selectNode, ok := getCreateTableSelectNode(nodes[0]); ok {
+		rules, handlers := i.filterRules(selectNode)
+		i.auditRules(selectNode, rules, handlers)
+	}
 
-		if err := handler.Func(input); err != nil {
-			i.result.AddResultWithError(rule.Level, rule.Name, err.Error(), true, plocale.Bundle.LocalizeAll(handler.Message))
-			i.Logger().Errorf("rule_desc_name=%v rule_desc=%v err:%v", rule.Name, rule.I18nRuleInfo[i18nPkg.DefaultLang].Desc, err.Error())
-		}
+	// 存储过程体中的语句逐条审核，审核结果标注存储过程名
+	if procedure, ok := getProcedureStmt(nodes[0]); ok {
+		i.auditProcedureBody(procedure)
 	}
 
 	if i.cnf.optimizeIndexEnabled {
@@ -450,17 +1029,344 @@ func (i *MysqlDriverImpl) audit(ctx context.Context, sql string) (*driverV2.Audi
 	}
 	if oscCommandLine != nil {
 		i.result.Add(driverV2.RuleLevelNotice, rulepkg.ConfigDDLOSCMinSize, oscCommandLine)
+		// gh-ost takes precedence over pt-online-schema-change when executing
+		if stmt, ok := nodes[0].(*ast.AlterTableStmt); ok && !useGhost {
+			if err := i.checkOnlineDDLPrerequisites(ctx, onlineDDLToolPtOSC, i.Ctx.GetSchemaName(stmt.Table)); err != nil {
+				i.result.Add(driverV2.RuleLevelError, rulepkg.ConfigDDLOSCMinSize, plocale.Bundle.LocalizeAllWithArgs(plocale.PTOSCPrerequisitesError, err))
+			}
+		}
 	}
 
 	if !i.IsExecutedSQL() {
 		i.Ctx.UpdateContext(nodes[0])
 	}
 
+	if lang, ok := i.auditLocale(ctx); ok {
+		i.result.ResolveLang(lang)
+	}
 	return i.result, nil
 }
 
+// auditLocale returns the language which the audit messages are resolved to, the locale in
+// ctx takes precedence over Config.Locale. It returns false if neither is set.
+func (i *MysqlDriverImpl) auditLocale(ctx context.Context) (language.Tag, bool) {
+	if lang, ok := driverV2.AuditLocaleFromContext(ctx); ok {
+		return lang, true
+	}
+	if i.cnf.locale != language.Und {
+		return i.cnf.locale, true
+	}
+	return language.Und, false
+}
+
+// auditRules audits the node by the rules, which are evaluated by at most
+// Config.ruleParallelism goroutines concurrently.
+func (i *MysqlDriverImpl) auditRules(node ast.Node, rules []*driverV2.Rule, handlers []*rulepkg.RuleHandler) {
+	if i.cnf.ruleParallelism <= 1 || len(rules) <= 1 {
+		i.auditRulesSequentially(node, rules, handlers)
+		return
+	}
+
+	workers := i.cnf.ruleParallelism
+	if workers > len(rules) {
+		workers = len(rules)
+	}
+	// 规则遍历语法树时会修改节点，规则读取上下文时会缓存从数据库加载的信息，
+	// 因此每个协程使用各自解析的语法树和上下文副本，无法重新解析时规则依次执行
+	nodes := make([]ast.Node, workers)
+	for w := 0; w < workers; w++ {
+		n, ok := reparseNode(node)
+		if !ok {
+			i.auditRulesSequentially(node, rules, handlers)
+			return
+		}
+		nodes[w] = n
+	}
+	// 连接池允许时，除第一个协程外每个协程使用各自的数据库连接，否则共用上下文的连接
+	ctxs := make([]*session.Context, workers)
+	var sessions []*executor.Executor
+	defer func() {
+		for _, s := range sessions {
+			s.Db.Close()
+		}
+	}()
+	for w := 0; w < workers; w++ {
+		if w > 0 && i.Ctx.GetExecutor() != nil {
+			s, ok, err := i.Ctx.GetExecutor().NewSession(context.TODO())
+			if err != nil {
+				i.log.Warnf("create session for auditing rules in parallel failed: %v", err)
+			}
+			if ok {
+				sessions = append(sessions, s)
+				ctxs[w] = i.Ctx.CloneWithExecutor(s)
+				continue
+			}
+		}
+		ctxs[w] = i.Ctx.Clone()
+	}
+
+	// 规则并行执行时，每个规则使用各自的审核结果，执行结束后按规则顺序合并
+	results := make([]*driverV2.AuditResults, len(rules))
+	durations := make([]time.Duration, len(rules))
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(node ast.Node, ctx *session.Context) {
+			defer wg.Done()
+			for idx := range jobs {
+				results[idx] = driverV2.NewAuditResults()
+				durations[idx] = i.auditRule(ctx, results[idx], node, rules[idx], handlers[idx])
+			}
+		}(nodes[w], ctxs[w])
+	}
+	for idx := range rules {
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+
+	// 逐条合并规则的审核结果，结果的顺序与规则依次执行时相同
+	for idx, rule := range rules {
+		for _, result := range results[idx].Results {
+			i.result.Merge(&driverV2.AuditResults{Results: []*driverV2.AuditResult{result}})
+		}
+		if i.ruleTimings != nil {
+			i.ruleTimings[rule.Name] += durations[idx]
+		}
+	}
+}
+
+func (i *MysqlDriverImpl) auditRulesSequentially(node ast.Node, rules []*driverV2.Rule, handlers []*rulepkg.RuleHandler) {
+	for idx, rule := range rules {
+		d := i.auditRule(i.Ctx, i.result, node, rule, handlers[idx])
+		if i.ruleTimings != nil {
+			i.ruleTimings[rule.Name] += d
+		}
+	}
+}
+
+// ApplicableRules returns the configured rules which would be evaluated for the node in the
+// current audit mode without executing them, e.g. the rules not allowed in offline audit are
+// excluded. The rules evaluated for the SELECT of "CREATE TABLE ... AS SELECT" are included.
+// It helps to find out why a rule is not triggered.
+func (i *MysqlDriverImpl) ApplicableRules(node ast.Node) []*driverV2.Rule {
+	rules, _ := i.filterRules(node)
+	selectNode, ok := getCreateTableSelectNode(node)
+	if !ok {
+		return rules
+	}
+	applicable := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		applicable[rule.Name] = struct{}{}
+	}
+	selectRules, _ := i.filterRules(selectNode)
+	for _, rule := range selectRules {
+		applicable[rule.Name] = struct{}{}
+	}
+	// 按规则配置的顺序返回
+	result := make([]*driverV2.Rule, 0, len(applicable))
+	for _, rule := range i.rules {
+		if _, ok := applicable[rule.Name]; ok {
+			result = append(result, rule)
+		}
+	}
+	return result
+}
+
+// filterRules returns the rules and handlers which are applicable to the node
+// in the current audit mode and match the rule tag filter of the config.
+func (i *MysqlDriverImpl) filterRules(node ast.Node) ([]*driverV2.Rule, []*rulepkg.RuleHandler) {
+	rules := make([]*driverV2.Rule, 0, len(i.rules))
+	handlers := make([]*rulepkg.RuleHandler, 0, len(i.rules))
+	for _, rule := range i.rules {
+		handler, ok := rulepkg.GetRuleHandlerFromAllRules(rule.Name)
+		if !ok || handler.Func == nil {
+			continue
+		}
+		if i.IsOfflineAudit() && !handler.IsAllowOfflineRule(node) {
+			continue
+		}
+		if !driverV2.MatchRuleTags(handler.Rule.CategoryTags, i.cnf.includeRuleTags, i.cnf.excludeRuleTags) {
+			continue
+		}
+		if i.cnf.isExecutedSQL {
+			if handler.OnlyAuditNotExecutedSQL {
+				continue
+			}
+			if handler.IsDisableExecutedSQLRule(node) {
+				continue
+			}
+		}
+
+		rules = append(rules, rule)
+		handlers = append(handlers, handler)
+	}
+	return rules, handlers
+}
+
+// getCreateTableSelectNode returns the query of "CREATE TABLE ... AS SELECT" as an
+// independent statement, ok is false if the node is not a CTAS statement.
+func getCreateTableSelectNode(node ast.Node) (ast.Node, bool) {
+	stmt, ok := node.(*ast.CreateTableStmt)
+	if !ok || stmt.Select == nil {
+		return nil, false
+	}
+	var buf strings.Builder
+	if err := stmt.Select.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &buf)); err != nil {
+		return nil, false
+	}
+	selectNode, err := util.ParseOneSql(buf.String())
+	if err != nil {
+		return nil, false
+	}
+	selectNode.SetStartLine(stmt.StartLine())
+	return selectNode, true
+}
+
+// getProcedureStmt returns the parsed "CREATE PROCEDURE" statement, ok is false if the node
+// is not a procedure statement or there is no statement to audit in its body.
+func getProcedureStmt(node ast.Node) (*util.ProcedureStmt, bool) {
+	stmt, ok := node.(*ast.UnparsedStmt)
+	if !ok {
+		return nil, false
+	}
+	procedure, ok := util.ParseProcedureStmt(stmt.Text())
+	if !ok || len(procedure.BodyStmts) == 0 {
+		return nil, false
+	}
+	return procedure, true
+}
+
+// auditProcedureBody audits the statements in the procedure body one by one, the results of
+// the same rule are aggregated to one result of the highest level, and the messages are tagged
+// with the procedure name. The statements in body are not executed when the procedure is
+// created, so the context is not updated by them.
+func (i *MysqlDriverImpl) auditProcedureBody(procedure *util.ProcedureStmt) {
+	outer := i.result
+	defer func() { i.result = outer }()
+
+	// 同一规则在多条语句中的审核结果合并为一条，消息去重后按出现顺序拼接
+	aggregated := driverV2.NewAuditResults()
+	results := map[string]*driverV2.AuditResult{}
+	messages := map[string]map[language.Tag][]string{}
+	for _, stmt := range procedure.BodyStmts {
+		i.result = driverV2.NewAuditResults()
+		rules, handlers := i.filterRules(stmt)
+		i.auditRules(stmt, rules, handlers)
+		for _, result := range i.result.Results {
+			ar, ok := results[result.RuleName]
+			if !ok {
+				ar = &driverV2.AuditResult{
+					Level:               result.Level,
+					RuleName:            result.RuleName,
+					I18nAuditResultInfo: map[language.Tag]driverV2.AuditResultInfo{},
+				}
+				results[result.RuleName] = ar
+				messages[result.RuleName] = map[language.Tag][]string{}
+				aggregated.Results = append(aggregated.Results, ar)
+			}
+			if result.Level.More(ar.Level) {
+				ar.Level = result.Level
+			}
+			for langTag, info := range result.I18nAuditResultInfo {
+				if !containsString(messages[result.RuleName][langTag], info.Message) {
+					messages[result.RuleName][langTag] = append(messages[result.RuleName][langTag], info.Message)
+				}
+			}
+		}
+	}
+
+	name := procedure.Name
+	if procedure.Schema != "" {
+		name = fmt.Sprintf("%s.%s", procedure.Schema, procedure.Name)
+	}
+	tags := plocale.Bundle.LocalizeAll(plocale.ProcedureBodyAuditResult)
+	for _, ar := range aggregated.Results {
+		for langTag, msgs := range messages[ar.RuleName] {
+			tag, ok := tags[langTag]
+			if !ok {
+				tag = tags[i18nPkg.DefaultLang]
+			}
+			ar.I18nAuditResultInfo[langTag] = driverV2.AuditResultInfo{
+				Message: fmt.Sprintf(tag, name, strings.Join(msgs, "; ")),
+			}
+		}
+	}
+	outer.Merge(aggregated)
+}
+
+func containsString(array []string, s string) bool {
+	for _, v := range array {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// reparseNode parses the text of the statement again, the new node can be modified
+// without affecting the original one. ok is false if it can not be parsed to one statement.
+func reparseNode(node ast.Node) (ast.Node, bool) {
+	stmt, ok := node.(ast.StmtNode)
+	if !ok {
+		return nil, false
+	}
+	stmts, err := util.ParseSql(stmt.Text())
+	if err != nil || len(stmts) != 1 {
+		return nil, false
+	}
+	stmts[0].SetStartLine(stmt.StartLine())
+	return stmts[0], true
+}
+
+// auditRule audits the node by one rule, the time spent is returned if the rules are profiled.
+func (i *MysqlDriverImpl) auditRule(ctx *session.Context, res *driverV2.AuditResults, node ast.Node, rule *driverV2.Rule, handler *rulepkg.RuleHandler) time.Duration {
+	input := &rulepkg.RuleHandlerInput{
+		Ctx:  ctx,
+		Rule: *rule,
+		Res:  res,
+		Node: node,
+	}
+	input.AffectedRowsEstimator = i.getAffectRowsEstimator()
+
+	var start time.Time
+	if i.ruleTimings != nil {
+		start = time.Now()
+	}
+	err := handler.Func(input)
+	var d time.Duration
+	if i.ruleTimings != nil {
+		d = time.Since(start)
+	}
+	if err != nil {
+		res.AddResultWithError(rule.Level, rule.Name, err.Error(), true, plocale.Bundle.LocalizeAll(handler.Message))
+		i.Logger().Errorf("rule_desc_name=%v rule_desc=%v err:%v", rule.Name, rule.I18nRuleInfo[i18nPkg.DefaultLang].Desc, err.Error())
+	}
+	return d
+}
+
 func (i *MysqlDriverImpl) GenRollbackSQL(ctx context.Context, sql string) (string, i18nPkg.I18nStr, error) {
-	return "", nil, nil
+	if i.HasInvalidSql {
+		return "", nil, nil
+	}
+
+	nodes, err := i.ParseSql(sql)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(nodes) == 0 {
+		return "", nil, nil
+	}
+
+	rollback, reason, err := i.GenerateRollbackSql(nodes[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	i.Ctx.UpdateContext(nodes[0])
+
+	return rollback, reason, nil
 }
 
 func (i *MysqlDriverImpl) Close(ctx context.Context) {
@@ -476,41 +1382,86 @@ func (i *MysqlDriverImpl) Ping(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return conn.Db.Ping()
+	if err := conn.Db.Ping(); err != nil {
+		return err
+	}
+	if i.readInst == nil {
+		return nil
+	}
+	readConn, err := i.getReadDbConn()
+	if err != nil {
+		return errors.Wrap(err, "connect read replica")
+	}
+	return errors.Wrap(readConn.Db.Ping(), "ping read replica")
+}
+
+// ServerInfo returns the capabilities of the instance which the SQL is executed on, e.g. the
+// version, sql_mode and whether the prerequisites of gh-ost are met. It is queried once and
+// cached on the context.
+func (i *MysqlDriverImpl) ServerInfo(ctx context.Context) (*session.ServerInfo, error) {
+	if i.IsOfflineAudit() {
+		return nil, fmt.Errorf("cannot get server info in offline audit")
+	}
+	if info := i.Ctx.GetServerInfo(); info != nil {
+		return info, nil
+	}
+	conn, err := i.getDbConn()
+	if err != nil {
+		return nil, err
+	}
+	info, err := session.LoadServerInfo(ctx, conn.Db)
+	if err != nil {
+		return nil, errors.Wrap(err, "load server info")
+	}
+	i.Ctx.SetServerInfo(info)
+	return info, nil
 }
 
 func (i *MysqlDriverImpl) Schemas(ctx context.Context) ([]string, error) {
 	if i.IsOfflineAudit() {
 		return nil, nil
 	}
-	conn, err := i.getDbConn()
+	conn, err := i.getReadDbConn()
 	if err != nil {
 		return nil, err
 	}
 	return conn.ShowDatabases(true)
 }
 
+// getAffectRowsEstimator returns the configured estimator, or the default estimator if it is not configured.
+func (i *MysqlDriverImpl) getAffectRowsEstimator() util.AffectedRowsEstimator {
+	if i.affectRowsEstimator == nil {
+		return util.DefaultAffectedRowsEstimator{Options: i.affectRowsOptions}
+	}
+	return i.affectRowsEstimator
+}
+
 func (i *MysqlDriverImpl) EstimateSQLAffectRows(ctx context.Context, sql string) (*driverV2.EstimatedAffectRows, error) {
 	if i.IsOfflineAudit() {
 		return nil, nil
 	}
 
-	conn, err := i.getDbConn()
+	conn, err := i.getReadDbConn()
 	if err != nil {
 		return nil, err
 	}
 
-	num, err := util.GetAffectedRowNum(ctx, sql, conn, i.Ctx.GetExecutionPlan)
-	if err != nil && errors.Is(err, util.ErrUnsupportedSqlType) {
+	num, method, err := i.getAffectRowsEstimator().EstimateAffectedRows(ctx, sql, conn, i.Ctx.GetExecutionPlan)
+	if err != nil && (errors.Is(err, util.ErrUnsupportedSqlType) || errors.Is(err, util.ErrSqlWithParamMarker)) {
 		return &driverV2.EstimatedAffectRows{ErrMessage: err.Error()}, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("get affected row num failed: %w", err)
 	}
 
-	return &driverV2.EstimatedAffectRows{
-		Count: num,
-	}, nil
+	affectRows := &driverV2.EstimatedAffectRows{
+		Count:  num,
+		Method: method,
+	}
+	if node, err := util.ParseOneSql(sql); err == nil && util.IsAffectedRowNumUpperBound(node) {
+		affectRows.I18nNote = plocale.Bundle.LocalizeAll(plocale.AffectRowsOnDuplicateUpperBound)
+	}
+	return affectRows, nil
 }
 
 type Config struct {
@@ -523,12 +1474,25 @@ type Config struct {
 	compositeIndexMaxColumn  int
 	indexSelectivityMinValue float64
 	isExecutedSQL            bool
+	profileRules             bool
+	ruleParallelism          int
+	includeRuleTags          []string
+	excludeRuleTags          []string
+	locale                   language.Tag
+	maxAuditStatements       int
 }
 
 func (i *MysqlDriverImpl) Context() *session.Context {
 	return i.Ctx
 }
 
+// ApplyDDL updates the context by the DDL statements executed outside the driver without
+// auditing them, it keeps the context in sync for the multi-step migration previews, see
+// session.Context.ApplyDDL.
+func (i *MysqlDriverImpl) ApplyDDL(sql string) error {
+	return i.Ctx.ApplyDDL(sql)
+}
+
 func (i *MysqlDriverImpl) ParseSql(sql string) ([]ast.Node, error) {
 	stmts, err := util.ParseSql(sql)
 	if err != nil {
@@ -554,7 +1518,7 @@ func (i *MysqlDriverImpl) getDbConn() (*executor.Executor, error) {
 	if i.isConnected {
 		return i.dbConn, nil
 	}
-	conn, err := executor.NewExecutor(i.log, i.inst, i.Ctx.CurrentSchema())
+	conn, err := executor.NewExecutor(i.log, i.inst, i.Ctx.CurrentSchema(), executor.WithPoolConfig(i.poolConfig), executor.WithQueryTimeout(i.queryTimeout))
 	if err == nil {
 		i.isConnected = true
 		i.dbConn = conn
@@ -562,6 +1526,23 @@ func (i *MysqlDriverImpl) getDbConn() (*executor.Executor, error) {
 	return conn, err
 }
 
+// getReadDbConn get db conn for the read-only queries of audit, it is connected to the read
+// replica if it is configured, otherwise it is the same as getDbConn.
+func (i *MysqlDriverImpl) getReadDbConn() (*executor.Executor, error) {
+	if i.readInst == nil {
+		return i.getDbConn()
+	}
+	if i.isReadConnected {
+		return i.readConn, nil
+	}
+	conn, err := newExecutorByDSN(i.log, i.readInst, i.Ctx.CurrentSchema())
+	if err == nil {
+		i.isReadConnected = true
+		i.readConn = conn
+	}
+	return conn, err
+}
+
 func (i *MysqlDriverImpl) GetConn() *executor.Executor {
 	return i.dbConn
 }
@@ -576,6 +1557,10 @@ func (i *MysqlDriverImpl) closeDbConn() {
 		i.dbConn.Db.Close()
 		i.isConnected = false
 	}
+	if i.isReadConnected {
+		i.readConn.Db.Close()
+		i.isReadConnected = false
+	}
 }
 
 // getTableName get table name from TableName ast.
@@ -587,10 +1572,14 @@ func (i *MysqlDriverImpl) getTableName(stmt *ast.TableName) string {
 	return fmt.Sprintf("%s.%s", schema, stmt.Name)
 }
 
-// getTableNameWithQuote get table name with quote.
+// getTableNameWithQuote is like util.GetTableNameWithQuote, but the current schema is used
+// if the schema is not specified.
 func (i *MysqlDriverImpl) getTableNameWithQuote(stmt *ast.TableName) string {
-	name := strings.Replace(i.getTableName(stmt), ".", "`.`", -1)
-	return fmt.Sprintf("`%s`", name)
+	schema := i.Ctx.GetSchemaName(stmt)
+	if schema == "" {
+		return util.QuoteIdentifier(stmt.Name.String())
+	}
+	return util.QuoteIdentifier(schema) + "." + util.QuoteIdentifier(stmt.Name.String())
 }
 
 // getPrimaryKey get table's primary key.
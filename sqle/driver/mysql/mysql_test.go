@@ -2,11 +2,28 @@ package mysql
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
 	"github.com/actiontech/sqle/sqle/driver/mysql/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/model"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 )
 
 func TestInspect_Parse(t *testing.T) {
@@ -159,6 +176,37 @@ func TestInspect_onlineddlWithGhost(t *testing.T) {
 	}
 }
 
+func TestInspect_ShouldUseGhost(t *testing.T) {
+	i := DefaultMysqlInspect()
+	i.cnf.DDLGhostMinSize = 16
+	i.Ctx.Schemas()["exist_db"].Tables["exist_tb_1"].Size = 4200
+
+	useGhost, size, err := i.ShouldUseGhost("alter table exist_db.exist_tb_1 add column col1 varchar(100);")
+	assert.NoError(t, err)
+	assert.True(t, useGhost)
+	assert.Equal(t, int64(4200), size)
+
+	i.Ctx.Schemas()["exist_db"].Tables["exist_tb_1"].Size = 15
+	useGhost, size, err = i.ShouldUseGhost("alter table exist_db.exist_tb_1 add column col1 varchar(100);")
+	assert.NoError(t, err)
+	assert.False(t, useGhost)
+	assert.Equal(t, int64(15), size)
+
+	useGhost, size, err = i.ShouldUseGhost("create index idx_1 on exist_db.exist_tb_1(v2);")
+	assert.NoError(t, err)
+	assert.False(t, useGhost)
+	assert.Equal(t, int64(0), size)
+
+	useGhost, _, err = i.ShouldUseGhost("-- no statement")
+	assert.NoError(t, err)
+	assert.False(t, useGhost)
+
+	i.cnf.DDLGhostMinSize = -1
+	useGhost, _, err = i.ShouldUseGhost("alter table exist_db.exist_tb_1 add column col1 varchar(100);")
+	assert.NoError(t, err)
+	assert.False(t, useGhost)
+}
+
 func TestInspect_assertSQLType(t *testing.T) {
 	args := []struct {
 		Name string
@@ -219,3 +267,949 @@ CREATEaa TABLE new_tbl AS SELECT * FROM orig_tbl;`,
 		})
 	}
 }
+
+func TestInspect_GetConnectionID(t *testing.T) {
+	_, err := DefaultMysqlInspectOffline().GetConnectionID(context.TODO())
+	assert.EqualError(t, err, "cannot get mysql conn_id in offline audit")
+
+	e, _, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	inspect := NewMockInspect(e)
+	inspect.isConnected = true
+	// the conn_id of mock executor is not queried
+	_, err = inspect.GetConnectionID(context.TODO())
+	assert.EqualError(t, err, "cannot find mysql conn_id, check logs")
+}
+
+func TestInspect_LevelOverrides(t *testing.T) {
+	rules := []*driverV2.Rule{
+		{Name: rulepkg.DDLCheckPKWithoutIfNotExists, Level: driverV2.RuleLevelWarn},
+		{Name: rulepkg.DDLCheckPKNotExist, Level: driverV2.RuleLevelWarn},
+		{Name: rulepkg.DDLCheckTableWithoutComment, Level: driverV2.RuleLevelWarn},
+	}
+	inspect, err := NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{
+		Rules: rules,
+		LevelOverrides: map[string]driverV2.RuleLevel{
+			rulepkg.DDLCheckPKWithoutIfNotExists: driverV2.RuleLevelError,
+			rulepkg.DDLCheckPKNotExist:           driverV2.RuleLevelNotice,
+			// the invalid level is ignored
+			rulepkg.DDLCheckTableWithoutComment: "fatal",
+		},
+	}, nil)
+	assert.NoError(t, err)
+	// the rules passed in are not modified
+	assert.Equal(t, driverV2.RuleLevelWarn, rules[0].Level)
+
+	results, err := inspect.audit(context.TODO(), "create table t1(id int)")
+	assert.NoError(t, err)
+	levels := map[string]driverV2.RuleLevel{}
+	for _, result := range results.Results {
+		levels[result.RuleName] = result.Level
+	}
+	assert.Equal(t, map[string]driverV2.RuleLevel{
+		rulepkg.DDLCheckPKWithoutIfNotExists: driverV2.RuleLevelError,
+		rulepkg.DDLCheckPKNotExist:           driverV2.RuleLevelNotice,
+		rulepkg.DDLCheckTableWithoutComment:  driverV2.RuleLevelWarn,
+	}, levels)
+}
+
+func TestInspect_RuleTimings(t *testing.T) {
+	rules := []*driverV2.Rule{
+		{Name: rulepkg.DDLCheckPKNotExist, Level: driverV2.RuleLevelWarn},
+		{Name: rulepkg.DMLCheckWhereIsInvalid, Level: driverV2.RuleLevelWarn},
+	}
+	inspect, err := NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{Rules: rules, ProfileRules: true}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, inspect.LastAuditRuleTimings())
+
+	_, err = inspect.Audit(context.TODO(), []string{"create table t1(id int)", "delete from t1", "delete from t1 where id = 1"})
+	assert.NoError(t, err)
+	timings := inspect.LastAuditRuleTimings()
+	assert.Len(t, timings, 2)
+	assert.Contains(t, timings, rulepkg.DDLCheckPKNotExist)
+	assert.Contains(t, timings, rulepkg.DMLCheckWhereIsInvalid)
+
+	// the timings are reset by the next audit
+	_, err = inspect.Audit(context.TODO(), []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{}, inspect.LastAuditRuleTimings())
+
+	// the timings are not recorded by default
+	inspect, err = NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{Rules: rules}, nil)
+	assert.NoError(t, err)
+	_, err = inspect.Audit(context.TODO(), []string{"create table t1(id int)"})
+	assert.NoError(t, err)
+	assert.Nil(t, inspect.LastAuditRuleTimings())
+}
+
+func TestInspect_MaxAuditStatements(t *testing.T) {
+	rules := []*driverV2.Rule{{Name: rulepkg.DDLCheckPKNotExist, Level: driverV2.RuleLevelWarn}}
+	sqls := []string{"create table t1(id int)", "create table t2(id int primary key)", "create table t3(id int)"}
+
+	inspect, err := NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{Rules: rules}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, driverV2.DefaultMaxAuditStatements, inspect.cnf.maxAuditStatements)
+
+	inspect, err = NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{Rules: rules, MaxAuditStatements: 2}, nil)
+	assert.NoError(t, err)
+	_, err = inspect.Audit(context.TODO(), sqls)
+	assert.ErrorIs(t, err, driverV2.ErrTooManyStatements)
+	assert.Contains(t, err.Error(), "3 statements exceed the limit 2")
+
+	results, err := inspect.Audit(context.TODO(), sqls[:2])
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	// the statements are not limited by AuditStream
+	var indexes []int
+	var hasResult []bool
+	err = inspect.AuditStream(context.TODO(), sqls, func(idx int, result *driverV2.AuditResults) {
+		indexes = append(indexes, idx)
+		hasResult = append(hasResult, result.HasResult())
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, []bool{true, false, true}, hasResult)
+
+	err = inspect.AuditStream(context.TODO(), []string{"create table t1(id int)", ""}, func(int, *driverV2.AuditResults) {
+		t.Fatal("no statement is audited if any of them is empty")
+	})
+	assert.Error(t, err)
+}
+
+// rulesWithFunc returns at most n offline rules which have handler function.
+func rulesWithFunc(n int) []*driverV2.Rule {
+	rules := []*driverV2.Rule{}
+	for _, handlers := range [][]rulepkg.RuleHandler{rulepkg.RuleHandlers, rulepkg.AIRuleHandlers} {
+		for idx := range handlers {
+			if len(rules) >= n {
+				return rules
+			}
+			if handlers[idx].Func != nil && handlers[idx].Rule.AllowOffline {
+				rules = append(rules, &handlers[idx].Rule)
+			}
+		}
+	}
+	return rules
+}
+
+func TestInspect_RuleParallelism(t *testing.T) {
+	sqls := []string{
+		"create table exist_db.t1(id int, v1 text, key idx_v1(v1))",
+		"alter table exist_db.exist_tb_1 add column v3 blob not null",
+		"select * from exist_db.exist_tb_1 where v1 like '%a' order by rand()",
+		"update exist_db.exist_tb_1 set v1 = 'a'",
+		"delete from exist_db.t1",
+		// the results of the pre check are kept before the results of the rules
+		"create table exist_db.exist_tb_1(id int, v1 text, key idx_v1(v1))",
+		"update not_exist_db.t1 set v1 = 'a'",
+	}
+	audit := func(parallelism int) []*driverV2.AuditResults {
+		inspect := DefaultMysqlInspect()
+		inspect.rules = rulesWithFunc(1000)
+		inspect.cnf.ruleParallelism = parallelism
+		results, err := inspect.Audit(context.TODO(), sqls)
+		assert.NoError(t, err)
+		return results
+	}
+	expected := audit(1)
+	actual := audit(8)
+	assert.Len(t, actual, len(expected))
+	for idx := range expected {
+		assert.True(t, expected[idx].HasResult())
+		// the results are the same as auditing one by one, including the order
+		assert.Equal(t, expected[idx].Results, actual[idx].Results, sqls[idx])
+	}
+}
+
+func BenchmarkAuditRules(b *testing.B) {
+	sql := "select * from exist_db.exist_tb_1 join exist_db.exist_tb_2 on exist_tb_1.id = exist_tb_2.id where exist_tb_1.v1 like '%a' order by rand()"
+	for _, parallelism := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("parallelism_%d", parallelism), func(b *testing.B) {
+			inspect := DefaultMysqlInspectOffline()
+			inspect.rules = rulesWithFunc(150)
+			inspect.cnf.ruleParallelism = parallelism
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if _, err := inspect.Audit(context.TODO(), []string{sql}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestInspect_ParseWithErrors(t *testing.T) {
+	nodes, errs := DefaultMysqlInspect().ParseWithErrors(context.TODO(), `
+select * from exist_db.exist_tb_1;
+insert into exist_db.exist_tb_1 values(1, '1', '1');
+select * from
+  where id = 1;
+delete from exist_db.exist_tb_1 where id = 1;
+`)
+	assert.Len(t, nodes, 4)
+	assert.Len(t, errs, 4)
+
+	assert.NoError(t, errs[0])
+	assert.Equal(t, uint64(2), nodes[0].StartLine)
+	assert.Equal(t, driverV2.SQLTypeDQL, nodes[0].Type)
+	assert.NotEmpty(t, nodes[0].Fingerprint)
+
+	assert.NoError(t, errs[1])
+	assert.Equal(t, uint64(3), nodes[1].StartLine)
+	assert.Equal(t, driverV2.SQLTypeDML, nodes[1].Type)
+
+	// the broken statement doesn't fail the others
+	assert.Error(t, errs[2])
+	assert.Contains(t, errs[2].Error(), "line 4")
+	assert.Equal(t, uint64(4), nodes[2].StartLine)
+	assert.Empty(t, nodes[2].Fingerprint)
+
+	assert.NoError(t, errs[3])
+	assert.Equal(t, uint64(6), nodes[3].StartLine)
+	assert.Equal(t, uint64(3), nodes[3].ExecBatchId)
+}
+
+func TestInspect_ParamMarker(t *testing.T) {
+	sqls := []string{
+		"insert into exist_db.exist_tb_1 (id, v1, v2) values (?, ?, ?)",
+		"update exist_db.exist_tb_1 set v1 = ? where id = ?",
+		"select id from exist_db.exist_tb_1 where v1 = ? limit ?",
+	}
+	nodes, err := DefaultMysqlInspect().Parse(context.TODO(), strings.Join(sqls, ";\n"))
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 3)
+
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	inspect := NewMockInspect(e)
+	inspect.isConnected = true
+	inspect.cnf.dmlExplainPreCheckEnable = true
+	implicitConversion := rulepkg.RuleHandlerMap[rulepkg.DMLCheckWhereExistImplicitConversion].Rule
+	explainAccessTypeAll := rulepkg.RuleHandlerMap[rulepkg.DMLCheckExplainAccessTypeAll].Rule
+	inspect.rules = []*driverV2.Rule{&implicitConversion, &explainAccessTypeAll}
+	results, err := inspect.Audit(context.TODO(), sqls)
+	assert.NoError(t, err)
+	for idx, result := range results {
+		// the types of the values are unknown, and the statements are not explained
+		assert.False(t, result.HasResult(), sqls[idx])
+	}
+
+	affectRows, err := inspect.EstimateSQLAffectRows(context.TODO(), sqls[0])
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), affectRows.Count)
+	affectRows, err = inspect.EstimateSQLAffectRows(context.TODO(), sqls[1])
+	assert.NoError(t, err)
+	assert.Equal(t, util.ErrSqlWithParamMarker.Error(), affectRows.ErrMessage)
+
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestInspect_EstimateSQLAffectRowsOnDuplicate(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	inspect := NewMockInspect(e)
+	inspect.isConnected = true
+
+	affectRows, err := inspect.EstimateSQLAffectRows(context.TODO(), "insert into exist_db.exist_tb_1 (id, v1) values (1, 'a'), (2, 'b') on duplicate key update v1 = values(v1)")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), affectRows.Count)
+	// the note is in all languages, so that it is kept by the plugin
+	assert.Equal(t, plocale.Bundle.LocalizeAll(plocale.AffectRowsOnDuplicateUpperBound), affectRows.I18nNote)
+	assert.Contains(t, affectRows.I18nNote.GetStrInLang(language.English), "upper bound")
+
+	affectRows, err = inspect.EstimateSQLAffectRows(context.TODO(), "replace into exist_db.exist_tb_1 (id, v1) values (1, 'a'), (2, 'b')")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), affectRows.Count)
+	assert.Empty(t, affectRows.I18nNote)
+
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestInspect_AffectedRowsRuleEstimator(t *testing.T) {
+	rule := rulepkg.RuleHandlerMap[rulepkg.DMLCheckAffectedRows].Rule
+	sql := "delete from exist_tb_1 where id > 1"
+	expectExplain := func(handler sqlmock.Sqlmock) {
+		handler.ExpectQuery(regexp.QuoteMeta("EXPLAIN SELECT COUNT(1) FROM `exist_tb_1` WHERE `id`>1")).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "select_type", "table", "partitions", "type", "possible_keys", "key", "key_len", "ref", "rows", "filtered", "Extra"}).
+				AddRow(1, "SIMPLE", "exist_tb_1", nil, "range", "PRIMARY", "PRIMARY", 4, nil, 20000, 100.00, "Using where"))
+		handler.ExpectQuery(regexp.QuoteMeta(showWarnings)).
+			WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}))
+	}
+
+	t.Run("default estimator counts the rows", func(t *testing.T) {
+		e, handler, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		expectExplain(handler)
+		handler.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(1) FROM `exist_tb_1` WHERE `id`>1")).
+			WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow("10"))
+		runSingleRuleInspectCase(rule, t, "", NewMockInspect(e), sql, newTestResult())
+		assert.NoError(t, handler.ExpectationsWereMet())
+	})
+
+	t.Run("configured estimator is used by the rule", func(t *testing.T) {
+		e, handler, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		expectExplain(handler)
+		inspect := NewMockInspect(e)
+		inspect.affectRowsEstimator, err = util.NewAffectedRowsEstimator(util.AffectedRowsEstimatorExplain, inspect.affectRowsOptions)
+		assert.NoError(t, err)
+		runSingleRuleInspectCase(rule, t, "", inspect, sql, newTestResult().addResult(rulepkg.DMLCheckAffectedRows, 20000, 10000))
+		assert.NoError(t, handler.ExpectationsWereMet())
+	})
+}
+
+func TestDeduplicateResults(t *testing.T) {
+	inspect := DefaultMysqlInspect()
+	selectAll := rulepkg.RuleHandlerMap[rulepkg.DMLDisableSelectAllColumn].Rule
+	inspect.rules = []*driverV2.Rule{&selectAll}
+	results, err := inspect.Audit(context.TODO(), []string{
+		"alter table exist_db.exist_tb_1 add column v1 int",
+		"select * from exist_db.exist_tb_1",
+		"alter table exist_db.exist_tb_1 add column v2 int, add column id int",
+		"select * from exist_db.exist_tb_2",
+		"alter table exist_db.exist_tb_1 add column v3 int",
+	})
+	assert.NoError(t, err)
+
+	deduplicated := DeduplicateResults(results)
+	assert.Len(t, deduplicated, 2)
+	// "字段 v1 已存在" and "字段 v2,id 已存在"
+	assert.Equal(t, driverV2.RuleLevelError, deduplicated[0].Result.Level)
+	assert.Equal(t, "", deduplicated[0].Result.RuleName)
+	assert.Equal(t, 2, deduplicated[0].Count)
+	assert.Equal(t, []int{0, 2}, deduplicated[0].StatementIndexes)
+	assert.Equal(t, rulepkg.DMLDisableSelectAllColumn, deduplicated[1].Result.RuleName)
+	assert.Equal(t, 2, deduplicated[1].Count)
+	assert.Equal(t, []int{1, 3}, deduplicated[1].StatementIndexes)
+	// the results passed in are not modified
+	assert.Len(t, results, 5)
+	assert.Len(t, results[2].Results, 1)
+
+	assert.Empty(t, DeduplicateResults(nil))
+	assert.Equal(t, fingerprintResultMessage("索引 `idx_1` 字段 'a',\"b\"重复"), fingerprintResultMessage("索引 idx_2 字段 c重复"))
+	assert.NotEqual(t, fingerprintResultMessage("表 t1 不存在"), fingerprintResultMessage("库 t1 不存在"))
+}
+
+func TestAnalyzeBatchPatterns(t *testing.T) {
+	inspect := DefaultMysqlInspectOffline()
+	nodes, err := inspect.Parse(context.TODO(), `
+select * from exist_db.exist_tb_1 where id = 1;
+update exist_db.exist_tb_1 set v1 = 'a' where id = 1;
+select * from exist_db.exist_tb_1 where id = 2;
+select v1 from exist_db.exist_tb_2 where id = 1;
+select * from exist_db.exist_tb_1 where id = 3;
+update exist_db.exist_tb_1 set v1 = 'b' where id = 2;
+update exist_db.exist_tb_1 set v1 = 'c' where id = 3;
+select v1 from exist_db.exist_tb_2 where id = 2;
+`)
+	assert.NoError(t, err)
+
+	patterns := AnalyzeBatchPatterns(nodes, 1)
+	assert.Len(t, patterns, 2)
+	assert.Equal(t, "SELECT * FROM `EXIST_DB`.`EXIST_TB_1` WHERE `id`=?", patterns[0].Fingerprint)
+	assert.Equal(t, 3, patterns[0].Count)
+	assert.Equal(t, []int{0, 2, 4}, patterns[0].StatementIndexes)
+	assert.Contains(t, patterns[0].Suggestion[language.English], "appears 3 times")
+	assert.Equal(t, 2, patterns[1].Count)
+	assert.Equal(t, []int{3, 7}, patterns[1].StatementIndexes)
+
+	// the repeated UPDATE statements are not reported
+	patterns = AnalyzeBatchPatterns(nodes, 2)
+	assert.Len(t, patterns, 1)
+	assert.Equal(t, 3, patterns[0].Count)
+
+	assert.Empty(t, AnalyzeBatchPatterns(nodes, DefaultSelectMaxRepeat))
+	assert.Empty(t, AnalyzeBatchPatterns(nil, 0))
+}
+
+func TestAuditResultsJSON(t *testing.T) {
+	inspect := DefaultMysqlInspect()
+	selectAll := rulepkg.RuleHandlerMap[rulepkg.DMLDisableSelectAllColumn].Rule
+	inspect.rules = []*driverV2.Rule{&selectAll}
+	scriptResults, err := inspect.AuditScript(context.TODO(), `
+select * from exist_db.exist_tb_1;
+select id from exist_db.exist_tb_1;
+`)
+	assert.NoError(t, err)
+	nodes := []driverV2.Node{}
+	results := []*driverV2.AuditResults{}
+	for _, r := range scriptResults {
+		nodes = append(nodes, r.Node)
+		results = append(results, r.Result)
+	}
+
+	data, err := driverV2.MarshalNodesAuditResultsJSON(nodes, results, language.English)
+	assert.NoError(t, err)
+	payload := driverV2.NodesAuditResultsJSON{}
+	assert.NoError(t, json.Unmarshal(data, &payload))
+	assert.Equal(t, driverV2.AuditResultsJSONVersion, payload.Version)
+	assert.Equal(t, "en", payload.Lang)
+	assert.Len(t, payload.Statements, 2)
+	assert.Equal(t, uint64(2), payload.Statements[0].StartLine)
+	assert.Equal(t, nodes[0].Fingerprint, payload.Statements[0].Fingerprint)
+	assert.Equal(t, driverV2.RuleLevelNotice, payload.Statements[0].Level)
+	assert.Equal(t, []driverV2.AuditResultJSON{{
+		RuleName: rulepkg.DMLDisableSelectAllColumn,
+		Level:    driverV2.RuleLevelNotice,
+		Message:  results[0].Results[0].I18nAuditResultInfo[language.English].Message,
+	}}, payload.Statements[0].Results)
+	assert.NotEqual(t, results[0].Results[0].I18nAuditResultInfo[i18nPkg.DefaultLang].Message, payload.Statements[0].Results[0].Message)
+	assert.Equal(t, uint64(3), payload.Statements[1].StartLine)
+	assert.Equal(t, driverV2.RuleLevelNull, payload.Statements[1].Level)
+	assert.NotNil(t, payload.Statements[1].Results)
+	assert.Empty(t, payload.Statements[1].Results)
+
+	// the message falls back to DefaultLang if the lang not exists
+	data, err = results[0].MarshalJSONByLangTag(language.French)
+	assert.NoError(t, err)
+	single := driverV2.AuditResultsJSON{}
+	assert.NoError(t, json.Unmarshal(data, &single))
+	assert.Equal(t, driverV2.AuditResultsJSONVersion, single.Version)
+	assert.Equal(t, "fr", single.Lang)
+	assert.Equal(t, driverV2.RuleLevelNotice, single.Level)
+	assert.Equal(t, results[0].Results[0].I18nAuditResultInfo[i18nPkg.DefaultLang].Message, single.Results[0].Message)
+
+	_, err = driverV2.MarshalNodesAuditResultsJSON(nodes, results[:1], language.English)
+	assert.Error(t, err)
+}
+
+func TestInspect_AuditLocale(t *testing.T) {
+	newInspect := func() *MysqlDriverImpl {
+		inspect := DefaultMysqlInspect()
+		selectAll := rulepkg.RuleHandlerMap[rulepkg.DMLDisableSelectAllColumn].Rule
+		inspect.rules = []*driverV2.Rule{&selectAll}
+		return inspect
+	}
+	sql := "select * from exist_db.exist_tb_1"
+
+	// not resolved by default
+	results, err := newInspect().Audit(context.TODO(), []string{sql})
+	assert.NoError(t, err)
+	all := results[0].Results[0].I18nAuditResultInfo
+	assert.Len(t, all, 2)
+	assert.NotEqual(t, all[language.English].Message, all[i18nPkg.DefaultLang].Message)
+	assert.Nil(t, results[0].Results[0].ResolvedAuditResultInfo)
+	assert.Equal(t, all[i18nPkg.DefaultLang], results[0].Results[0].GetResolvedAuditResultInfo())
+
+	// the locale in context, the messages in all languages are kept
+	results, err = newInspect().Audit(driverV2.WithAuditLocale(context.TODO(), language.English), []string{sql})
+	assert.NoError(t, err)
+	assert.Equal(t, all, results[0].Results[0].I18nAuditResultInfo)
+	assert.Equal(t, all[language.English], results[0].Results[0].GetResolvedAuditResultInfo())
+	assert.Equal(t, fmt.Sprintf("[%s]%s", driverV2.RuleLevelNotice, all[language.English].Message), results[0].Message())
+
+	// the locale in config, which is overridden by the locale in context
+	inspect := newInspect()
+	inspect.cnf.locale = language.English
+	results, err = inspect.Audit(context.TODO(), []string{sql})
+	assert.NoError(t, err)
+	assert.Equal(t, all[language.English], results[0].Results[0].GetResolvedAuditResultInfo())
+	results, err = inspect.Audit(driverV2.WithAuditLocale(context.TODO(), i18nPkg.DefaultLang), []string{sql})
+	assert.NoError(t, err)
+	assert.Equal(t, all[i18nPkg.DefaultLang], results[0].Results[0].GetResolvedAuditResultInfo())
+
+	// the locale which has no messages falls back to DefaultLang
+	results, err = newInspect().Audit(driverV2.WithAuditLocale(context.TODO(), language.French), []string{sql})
+	assert.NoError(t, err)
+	assert.Equal(t, all[i18nPkg.DefaultLang], results[0].Results[0].GetResolvedAuditResultInfo())
+	assert.Equal(t, all, results[0].Results[0].I18nAuditResultInfo)
+}
+
+func TestInspect_AuditScript(t *testing.T) {
+	i := DefaultMysqlInspect()
+	results, err := i.AuditScript(context.TODO(), `
+create table exist_db.audit_script_tb(id int primary key);
+insert into exist_db.audit_script_tb values(1);
+select * from
+  where id = 1;
+insert into exist_db.not_exist_tb values(1);
+`)
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+
+	// the table created by the script is seen by the later statements
+	assert.Equal(t, uint64(2), results[0].Node.StartLine)
+	assert.NoError(t, results[0].ParseErr)
+	assert.False(t, results[0].Result.HasResult())
+	assert.Equal(t, uint64(3), results[1].Node.StartLine)
+	assert.Equal(t, driverV2.SQLTypeDML, results[1].Node.Type)
+	assert.False(t, results[1].Result.HasResult())
+
+	// the broken statement doesn't stop auditing the others
+	assert.Equal(t, uint64(4), results[2].Node.StartLine)
+	assert.Error(t, results[2].ParseErr)
+	assert.True(t, results[2].Result.HasResult())
+
+	assert.Equal(t, uint64(6), results[3].Node.StartLine)
+	assert.NoError(t, results[3].ParseErr)
+	assert.Contains(t, results[3].Result.Message(), "not_exist_tb")
+}
+
+func TestInspect_DryRunBatch(t *testing.T) {
+	i := DefaultMysqlInspect()
+	results, err := i.DryRunBatch(context.TODO(),
+		"create table exist_db.dry_run_tb(id int primary key)",
+		"insert into exist_db.dry_run_tb values(1)",
+		"insert into exist_db.not_exist_tb values(1)",
+		"select * from",
+		"select * from exist_db.exist_tb_1",
+	)
+	assert.NoError(t, err)
+	assert.Len(t, results, 5)
+
+	assert.Equal(t, driverV2.SQLTypeDDL, results[0].SQLType)
+	assert.False(t, results[0].WouldFail())
+
+	// the table created by the previous statement is visible
+	assert.Equal(t, driverV2.SQLTypeDML, results[1].SQLType)
+	assert.False(t, results[1].WouldFail())
+
+	assert.True(t, results[2].WouldFail())
+	assert.NoError(t, results[2].ParseErr)
+
+	// the statement with syntax error is kept as unparsed statement
+	assert.True(t, results[3].WouldFail())
+
+	assert.Equal(t, driverV2.SQLTypeDQL, results[4].SQLType)
+	assert.False(t, results[4].WouldFail())
+
+	// the context of the driver is not changed
+	_, exist := i.Ctx.GetTableInfo(&ast.TableName{Schema: model.NewCIStr("exist_db"), Name: model.NewCIStr("dry_run_tb")})
+	assert.False(t, exist)
+}
+
+func TestInspect_ExecCanceled(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i := NewMockInspect(e)
+	i.isConnected = true
+	i.dbConn = e
+
+	handler.ExpectExec(regexp.QuoteMeta("insert into exist_db.exist_tb_1 values(1, '1', '1')")).
+		WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = i.Exec(ctx, "insert into exist_db.exist_tb_1 values(1, '1', '1')")
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestInspect_ExecWithSessionVariables(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i := NewMockInspect(e)
+	i.isConnected = true
+	i.dbConn = e
+	vars := []executor.SessionVariable{{Name: "foreign_key_checks", Value: "0"}}
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT @@SESSION.foreign_key_checks")).
+		WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow("1"))
+	handler.ExpectExec(regexp.QuoteMeta("SET SESSION foreign_key_checks = 0")).WillReturnResult(sqlmock.NewResult(0, 0))
+	handler.ExpectExec(regexp.QuoteMeta("alter table exist_db.exist_tb_1 drop foreign key fk_1")).WillReturnResult(sqlmock.NewResult(0, 0))
+	handler.ExpectExec(regexp.QuoteMeta("SET SESSION foreign_key_checks = 1")).WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = i.ExecWithSessionVariables(context.TODO(), vars, "alter table exist_db.exist_tb_1 drop foreign key fk_1")
+	assert.NoError(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the variables are set once for the batch, and restored even if the execution failed
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT @@SESSION.foreign_key_checks")).
+		WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow("1"))
+	handler.ExpectExec(regexp.QuoteMeta("SET SESSION foreign_key_checks = 0")).WillReturnResult(sqlmock.NewResult(0, 0))
+	handler.ExpectExec(regexp.QuoteMeta("insert into exist_db.exist_tb_1 values(1, '1', '1')")).WillReturnResult(sqlmock.NewResult(1, 1))
+	handler.ExpectExec(regexp.QuoteMeta("insert into exist_db.exist_tb_1 values(2, '2', '2')")).WillReturnError(errors.New("duplicate entry"))
+	handler.ExpectExec(regexp.QuoteMeta("SET SESSION foreign_key_checks = 1")).WillReturnResult(sqlmock.NewResult(0, 0))
+	results, err := i.ExecBatchWithSessionVariables(context.TODO(), vars,
+		"insert into exist_db.exist_tb_1 values(1, '1', '1')",
+		"insert into exist_db.exist_tb_1 values(2, '2', '2')",
+		"insert into exist_db.exist_tb_1 values(3, '3', '3')",
+	)
+	assert.Error(t, err)
+	assert.Len(t, results, 2)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the query is not executed if the variables can not be set
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT @@SESSION.foreign_key_checks")).
+		WillReturnRows(sqlmock.NewRows([]string{"a"}).AddRow("1"))
+	handler.ExpectExec(regexp.QuoteMeta("SET SESSION foreign_key_checks = 0")).WillReturnError(errors.New("access denied"))
+	_, err = i.ExecWithSessionVariables(context.TODO(), vars, "insert into exist_db.exist_tb_1 values(1, '1', '1')")
+	assert.Error(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestInspect_ExecCaptureWarnings(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i := NewMockInspect(e)
+	i.isConnected = true
+	i.dbConn = e
+
+	// the warnings are not captured by default
+	handler.ExpectExec(regexp.QuoteMeta("alter table exist_db.exist_tb_1 modify v1 varchar(2)")).WillReturnResult(sqlmock.NewResult(0, 1))
+	result, err := i.Exec(context.TODO(), "alter table exist_db.exist_tb_1 modify v1 varchar(2)")
+	assert.NoError(t, err)
+	_, ok := result.(*ExecResult)
+	assert.False(t, ok)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	i.captureWarnings = true
+	handler.ExpectExec(regexp.QuoteMeta("alter table exist_db.exist_tb_1 modify v1 varchar(2)")).WillReturnResult(sqlmock.NewResult(0, 1))
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW WARNINGS")).
+		WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}).AddRow("Warning", "1265", "Data truncated for column 'v1' at row 1"))
+	handler.ExpectExec(regexp.QuoteMeta("insert into exist_db.exist_tb_1 values(1, '1', '1')")).WillReturnResult(sqlmock.NewResult(1, 1))
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW WARNINGS")).
+		WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}))
+	results, err := i.ExecBatch(context.TODO(),
+		"alter table exist_db.exist_tb_1 modify v1 varchar(2)",
+		"insert into exist_db.exist_tb_1 values(1, '1', '1')",
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+	assert.Len(t, results, 2)
+	execResult, ok := results[0].(*ExecResult)
+	assert.True(t, ok)
+	assert.Equal(t, []Warning{{Level: "Warning", Code: 1265, Message: "Data truncated for column 'v1' at row 1"}}, execResult.Warnings)
+	rowsAffected, err := execResult.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), rowsAffected)
+	execResult, ok = results[1].(*ExecResult)
+	assert.True(t, ok)
+	assert.Empty(t, execResult.Warnings)
+
+	// the query has been executed even if the warnings can not be captured
+	handler.ExpectExec(regexp.QuoteMeta("insert into exist_db.exist_tb_1 values(1, '1', '1')")).WillReturnResult(sqlmock.NewResult(1, 1))
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW WARNINGS")).WillReturnError(errors.New("connection lost"))
+	result, err = i.Exec(context.TODO(), "insert into exist_db.exist_tb_1 values(1, '1', '1')")
+	assert.NoError(t, err)
+	assert.Empty(t, result.(*ExecResult).Warnings)
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestInspect_ApplicableRules(t *testing.T) {
+	newRule := func(name string) *driverV2.Rule {
+		rule := rulepkg.RuleHandlerMap[name].Rule
+		return &rule
+	}
+	ruleNames := func(rules []*driverV2.Rule) []string {
+		names := []string{}
+		for _, rule := range rules {
+			names = append(names, rule.Name)
+		}
+		return names
+	}
+	rules := []*driverV2.Rule{
+		newRule(rulepkg.DDLCheckPKNotExist),
+		newRule(rulepkg.DMLCheckExplainAccessTypeAll),
+		newRule(rulepkg.DMLDisableSelectAllColumn),
+		newRule(rulepkg.ConfigDDLGhostMinSize),
+	}
+
+	parse := func(i *MysqlDriverImpl, sql string) ast.Node {
+		nodes, err := i.ParseSql(sql)
+		assert.NoError(t, err)
+		return nodes[0]
+	}
+
+	i := DefaultMysqlInspect()
+	i.rules = rules
+	assert.Equal(t, []string{rulepkg.DDLCheckPKNotExist, rulepkg.DMLCheckExplainAccessTypeAll, rulepkg.DMLDisableSelectAllColumn},
+		ruleNames(i.ApplicableRules(parse(i, "alter table exist_db.exist_tb_1 add column v3 int"))))
+
+	i = DefaultMysqlInspectOffline()
+	i.rules = rules
+	// the rule not allowed in offline audit and the rule not allowed for ALTER TABLE in offline audit are excluded
+	assert.Equal(t, []string{rulepkg.DMLDisableSelectAllColumn},
+		ruleNames(i.ApplicableRules(parse(i, "alter table exist_db.exist_tb_1 add column v3 int"))))
+	assert.Equal(t, []string{rulepkg.DDLCheckPKNotExist, rulepkg.DMLDisableSelectAllColumn},
+		ruleNames(i.ApplicableRules(parse(i, "create table t1 as select * from t2"))))
+}
+
+func TestInspect_AuditProcedureBody(t *testing.T) {
+	i := DefaultMysqlInspectOffline()
+	i.rules = []*driverV2.Rule{
+		{Name: rulepkg.DMLCheckWhereIsInvalid, Level: driverV2.RuleLevelError},
+		{Name: rulepkg.DMLDisableSelectAllColumn, Level: driverV2.RuleLevelWarn},
+		{Name: rulepkg.DDLCheckPKNotExist, Level: driverV2.RuleLevelWarn},
+	}
+
+	results, err := i.audit(context.TODO(), `CREATE PROCEDURE db1.p1(IN n INT)
+BEGIN
+	IF n > 0 THEN
+		DELETE FROM t1;
+	ELSE
+		UPDATE t1 SET a = 1;
+	END IF;
+	SELECT * FROM t2 WHERE id = n;
+	DELETE FROM t3 WHERE id = n;
+END`)
+	assert.NoError(t, err)
+	messages := map[string]string{}
+	levels := map[string]driverV2.RuleLevel{}
+	for _, result := range results.Results {
+		// the procedure statement is not supported by the parser
+		if result.RuleName == "" {
+			continue
+		}
+		messages[result.RuleName] = result.GetAuditResultInfoByLangTag(language.English).Message
+		levels[result.RuleName] = result.Level
+	}
+	// the results of the same rule in different statements are aggregated
+	assert.Equal(t, map[string]string{
+		rulepkg.DMLCheckWhereIsInvalid:    "statement in procedure db1.p1: Prohibit SQL without WHERE condition or WHERE condition that is always TRUE",
+		rulepkg.DMLDisableSelectAllColumn: "statement in procedure db1.p1: Do not use SELECT *",
+	}, messages)
+	assert.Equal(t, map[string]driverV2.RuleLevel{
+		rulepkg.DMLCheckWhereIsInvalid:    driverV2.RuleLevelError,
+		rulepkg.DMLDisableSelectAllColumn: driverV2.RuleLevelWarn,
+	}, levels)
+
+	results, err = i.audit(context.TODO(), "CREATE PROCEDURE p2() BEGIN DELETE FROM t1 WHERE id = 1; END")
+	assert.NoError(t, err)
+	for _, result := range results.Results {
+		assert.Equal(t, "", result.RuleName)
+	}
+}
+
+func TestInspect_ReadReplica(t *testing.T) {
+	primary := &driverV2.DSN{Host: "primary", DatabaseName: "exist_db"}
+	replica := &driverV2.DSN{Host: "replica", DatabaseName: "exist_db"}
+
+	read, write := (&driverV2.Config{DSN: primary, ReadDSN: replica}).ReadWriteDSN()
+	assert.Equal(t, replica, read)
+	assert.Equal(t, primary, write)
+	// either DSN is used for both if only one is set
+	read, write = (&driverV2.Config{DSN: primary}).ReadWriteDSN()
+	assert.Equal(t, primary, read)
+	assert.Equal(t, primary, write)
+	read, write = (&driverV2.Config{ReadDSN: replica}).ReadWriteDSN()
+	assert.Equal(t, replica, read)
+	assert.Equal(t, replica, write)
+
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	re, readHandler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i, err := NewInspectWithExecutors(log.NewEntry(), &driverV2.Config{DSN: primary, ReadDSN: replica}, e, re)
+	assert.NoError(t, err)
+	assert.Equal(t, primary, i.GetDSN())
+	assert.Equal(t, re, i.Context().GetExecutor())
+
+	// the metadata queries are sent to the replica
+	readHandler.ExpectQuery("show databases").
+		WillReturnRows(sqlmock.NewRows([]string{"Database"}).AddRow("exist_db"))
+	schemas, err := i.Schemas(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"exist_db"}, schemas)
+
+	// the SQL is executed on the primary
+	handler.ExpectExec(regexp.QuoteMeta("insert into exist_db.exist_tb_1 values(1, '1', '1')")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_, err = i.Exec(context.TODO(), "insert into exist_db.exist_tb_1 values(1, '1', '1')")
+	assert.NoError(t, err)
+
+	// the rows for rollback are read from the primary
+	node, err := util.ParseOneSql("delete from exist_db.exist_tb_1 where id = 1")
+	assert.NoError(t, err)
+	deleteStmt := node.(*ast.DeleteStmt)
+	i.cnf.DMLRollbackMaxRows = 1000
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` WHERE `id` = 1 LIMIT 1001")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}).AddRow("1", "1", "1"))
+	table := &ast.TableName{Schema: model.NewCIStr("exist_db"), Name: model.NewCIStr("exist_tb_1")}
+	records, _, err := i.getRecordsForRollback("*", table, "", deleteStmt.Where, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	assert.NoError(t, i.Ping(context.TODO()))
+	assert.NoError(t, handler.ExpectationsWereMet())
+	assert.NoError(t, readHandler.ExpectationsWereMet())
+
+	i.Close(context.TODO())
+	assert.False(t, i.isConnected)
+	assert.False(t, i.isReadConnected)
+
+	// the primary is used for both without replica
+	i, err = NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{DSN: primary}, e)
+	assert.NoError(t, err)
+	assert.Equal(t, e, i.Context().GetExecutor())
+	assert.Nil(t, i.readInst)
+}
+
+func TestInspect_RuleTags(t *testing.T) {
+	newRule := func(name string) *driverV2.Rule {
+		rule := rulepkg.AIRuleHandlerMap[name].Rule
+		return &rule
+	}
+	rules := []*driverV2.Rule{
+		// security
+		newRule(ai.SQLE00010),
+		// maintenance, performance
+		newRule(ai.SQLE00236),
+		// performance
+		newRule(ai.SQLE00238),
+	}
+	applicableRules := func(include, exclude []string) []string {
+		i, err := NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{
+			Rules:           rules,
+			IncludeRuleTags: include,
+			ExcludeRuleTags: exclude,
+		}, nil)
+		assert.NoError(t, err)
+		nodes, err := i.ParseSql("create table t1(id int)")
+		assert.NoError(t, err)
+		names := []string{}
+		for _, rule := range i.ApplicableRules(nodes[0]) {
+			names = append(names, rule.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{ai.SQLE00010, ai.SQLE00236, ai.SQLE00238}, applicableRules(nil, nil))
+	assert.Equal(t, []string{ai.SQLE00010}, applicableRules([]string{"security"}, nil))
+	// the tags are matched case-insensitively
+	assert.Equal(t, []string{ai.SQLE00010}, applicableRules(nil, []string{"PERFORMANCE"}))
+	assert.Equal(t, []string{ai.SQLE00238}, applicableRules([]string{"performance"}, []string{"maintenance"}))
+	assert.Equal(t, []string{}, applicableRules([]string{"unknown"}, nil))
+}
+
+func TestInspect_ServerInfo(t *testing.T) {
+	offline, err := NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{}, nil)
+	assert.NoError(t, err)
+	_, err = offline.ServerInfo(context.TODO())
+	assert.Error(t, err)
+
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i, err := NewInspectWithExecutor(log.NewEntry(), &driverV2.Config{DSN: &driverV2.DSN{DatabaseName: "exist_db"}}, e)
+	assert.NoError(t, err)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version', 'version_comment', 'sql_mode', 'lower_case_table_names', 'performance_schema', 'log_bin', 'binlog_format', 'binlog_row_image')")).
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("binlog_format", "STATEMENT").
+			AddRow("binlog_row_image", "FULL").
+			AddRow("log_bin", "ON").
+			AddRow("lower_case_table_names", "1").
+			AddRow("performance_schema", "OFF").
+			AddRow("sql_mode", "STRICT_TRANS_TABLES").
+			AddRow("version", "8.0.30-22").
+			AddRow("version_comment", "Percona Server (GPL), Release 22"))
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW GRANTS FOR CURRENT_USER()")).
+		WillReturnRows(sqlmock.NewRows([]string{"Grants for u@%"}).
+			AddRow("GRANT SELECT, PROCESS, REPLICATION CLIENT ON *.* TO `u`@`%`").
+			AddRow("GRANT ALTER, CREATE, DELETE, DROP, INDEX, INSERT, LOCK TABLES, TRIGGER, UPDATE ON `exist_db`.* TO `u`@`%`").
+			AddRow("GRANT SELECT (`id`, `name`), UPDATE (`name`) ON `other_db`.`t1` TO `u`@`%`"))
+	info, err := i.ServerInfo(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "8.0.30-22", info.Version)
+	assert.Equal(t, session.ServerFlavorPercona, info.Flavor)
+	assert.Equal(t, "STRICT_TRANS_TABLES", info.SQLMode)
+	assert.Equal(t, "1", info.LowerCaseTableNames)
+	assert.False(t, info.PerformanceSchema)
+	assert.True(t, info.LogBin)
+	assert.Equal(t, []string{
+		"binlog_format is STATEMENT, gh-ost requires ROW",
+		"missing global privileges: REPLICATION SLAVE",
+	}, info.GhostIssues("exist_db"))
+	assert.Empty(t, info.PtOscIssues("exist_db"))
+	assert.Equal(t, []string{"missing privileges on schema other_db: ALTER, CREATE, DELETE, DROP, INSERT, TRIGGER, UPDATE"}, info.PtOscIssues("other_db"))
+
+	// the server info is cached on the context
+	cached, err := i.ServerInfo(context.TODO())
+	assert.NoError(t, err)
+	assert.Same(t, info, cached)
+	assert.Same(t, info, i.Context().Clone().GetServerInfo())
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	mariadb := &session.ServerInfo{
+		Version:      "10.6.12-MariaDB-log",
+		LogBin:       true,
+		BinlogFormat: "ROW",
+		Grants:       []string{"GRANT ALL PRIVILEGES ON *.* TO `root`@`localhost` WITH GRANT OPTION"},
+	}
+	assert.Empty(t, mariadb.GhostIssues("exist_db"))
+	assert.Empty(t, mariadb.PtOscIssues("exist_db"))
+}
+
+func TestInspect_OnlineDDLPrerequisites(t *testing.T) {
+	query := "alter table exist_db.exist_tb_1 add column v3 varchar(255);"
+
+	// pt-online-schema-change is checked during audit
+	i := DefaultMysqlInspect()
+	i.Ctx.Schemas()["exist_db"].Tables["exist_tb_1"].Size = 4200
+	i.Ctx.SetServerInfo(&session.ServerInfo{Grants: []string{"GRANT SELECT, INSERT, UPDATE, DELETE, CREATE, DROP, ALTER ON `exist_db`.* TO `root`@`%`"}})
+	res, err := i.audit(context.TODO(), query)
+	assert.NoError(t, err)
+	assert.Equal(t, driverV2.RuleLevelError, res.Level())
+	assert.Contains(t, res.Message(), "the prerequisites of pt-online-schema-change are not met: "+
+		"missing privileges on schema exist_db: TRIGGER; missing global privileges: PROCESS")
+
+	i = DefaultMysqlInspect()
+	i.Ctx.Schemas()["exist_db"].Tables["exist_tb_1"].Size = 4200
+	i.Ctx.SetServerInfo(&session.ServerInfo{Grants: []string{"GRANT ALL PRIVILEGES ON *.* TO `root`@`%`"}})
+	res, err = i.audit(context.TODO(), query)
+	assert.NoError(t, err)
+	assert.NotContains(t, res.Message(), "prerequisites")
+
+	// gh-ost is checked before dry-run during audit, pt-online-schema-change is not checked
+	// since gh-ost takes precedence
+	i = DefaultMysqlInspect()
+	ghostRule := rulepkg.RuleHandlerMap[rulepkg.ConfigDDLGhostMinSize].Rule
+	i.rules = []*driverV2.Rule{&ghostRule}
+	i.cnf.DDLGhostMinSize = 16
+	i.Ctx.Schemas()["exist_db"].Tables["exist_tb_1"].Size = 4200
+	i.Ctx.SetServerInfo(&session.ServerInfo{
+		LogBin:       true,
+		BinlogFormat: "STATEMENT",
+		Grants:       []string{"GRANT ALL PRIVILEGES ON `exist_db`.* TO `root`@`%`"},
+	})
+	res, err = i.audit(context.TODO(), query)
+	assert.NoError(t, err)
+	assert.Equal(t, driverV2.RuleLevelError, res.Level())
+	assert.Contains(t, res.Message(), "the prerequisites of gh-ost are not met: "+
+		"binlog_format is STATEMENT, gh-ost requires ROW; missing global privileges: REPLICATION SLAVE, REPLICATION CLIENT")
+	assert.NotContains(t, res.Message(), "pt-online-schema-change are not met")
+}
+
+func TestInspect_ApplyDDL(t *testing.T) {
+	i := DefaultMysqlInspect()
+	table := &ast.TableName{Schema: model.NewCIStr("exist_db"), Name: model.NewCIStr("exist_tb_1")}
+	newTable := &ast.TableName{Schema: model.NewCIStr("exist_db"), Name: model.NewCIStr("t_new")}
+	hasColumn := func(name string) bool {
+		stmt, exist, err := i.Context().GetCreateTableStmt(table)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+		for _, col := range stmt.Cols {
+			if col.Name.Name.L == name {
+				return true
+			}
+		}
+		return false
+	}
+	alterNewTable := "ALTER TABLE exist_db.t_new ADD COLUMN name VARCHAR(32);"
+	res, err := i.audit(context.TODO(), alterNewTable)
+	assert.NoError(t, err)
+	assert.Equal(t, driverV2.RuleLevelError, res.Level())
+
+	assert.NoError(t, i.ApplyDDL("ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT; CREATE TABLE exist_db.t_new (id INT);"))
+	assert.True(t, hasColumn("v3"))
+	exist, err := i.Context().IsTableExist(newTable)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	// the statements audited later see the changes
+	res, err = i.audit(context.TODO(), alterNewTable)
+	assert.NoError(t, err)
+	assert.NotEqual(t, driverV2.RuleLevelError, res.Level())
+
+	assert.NoError(t, i.ApplyDDL("USE exist_db; DROP TABLE t_new;"))
+	exist, err = i.Context().IsTableExist(newTable)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	// nothing is applied if any statement is not DDL
+	err = i.ApplyDDL("ALTER TABLE exist_db.exist_tb_1 DROP COLUMN v3; INSERT INTO exist_db.exist_tb_1 (id) VALUES (1);")
+	assert.EqualError(t, err, `sql "INSERT INTO exist_db.exist_tb_1 (id) VALUES (1);" is not a DDL statement`)
+	assert.True(t, hasColumn("v3"))
+
+	assert.Error(t, i.ApplyDDL("ALTER TABLE"))
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
 
@@ -22,8 +25,10 @@ import (
 )
 
 type Executor struct {
-	l  base.Logger
+	l  *logAdaptor
 	mc *base.MigrationContext
+	// migrate runs the migration, it is the gh-ost migrator if nil.
+	migrate func() error
 }
 
 func NewExecutor(logger *logrus.Entry, inst *driverV2.DSN, schema string, query string) (*Executor, error) {
@@ -95,8 +100,32 @@ func (e *Executor) Execute(ctx context.Context, dryRun bool) error {
 		e.mc.Noop = true
 	}
 
-	m := logic.NewMigrator(e.mc)
-	err := m.Migrate()
+	// the migration can only be stopped by the panic flag file, see stop
+	if e.mc.PanicFlagFile == "" {
+		dir, err := os.MkdirTemp("", "gh-ost")
+		if err != nil {
+			return errors.Wrap(err, "create panic flag file dir")
+		}
+		defer os.RemoveAll(dir)
+		e.mc.PanicFlagFile = filepath.Join(dir, "gh-ost.panic")
+		defer func() { e.mc.PanicFlagFile = "" }()
+	}
+
+	if fn := progressFuncFromContext(ctx); fn != nil {
+		done := make(chan struct{})
+		defer close(done)
+		go reportProgress(e.mc, fn, done)
+	}
+
+	migrate := e.migrate
+	if migrate == nil {
+		migrate = logic.NewMigrator(e.mc).Migrate
+	}
+	migrated := make(chan error, 1)
+	go func() {
+		migrated <- migrate()
+	}()
+	err := e.wait(ctx, migrated)
 	if err != nil {
 		return errors.Wrapf(err, "migrate table, dry-run(%v)", dryRun)
 	}
@@ -104,6 +133,70 @@ func (e *Executor) Execute(ctx context.Context, dryRun bool) error {
 	return nil
 }
 
+// wait waits for the migration finished, aborted by gh-ost or canceled by ctx.
+func (e *Executor) wait(ctx context.Context, migrated <-chan error) error {
+	select {
+	case err := <-migrated:
+		return err
+	case err := <-e.l.aborted:
+		e.stop(migrated)
+		return errors.Wrap(err, "gh-ost aborted")
+	case <-ctx.Done():
+		e.l.inner.Warnf("migration canceled, %v", ctx.Err())
+		e.stop(migrated)
+		return errors.Wrap(ctx.Err(), "gh-ost canceled")
+	}
+}
+
+// stopTimeout is how long stop waits for the migration to return.
+var stopTimeout = 30 * time.Second
+
+// stop stops the running migration and waits for it to return. gh-ost runs in
+// SQLE process and can't exit like the gh-ost command, so the migration is
+// throttled by user command and the panic flag file is touched to make gh-ost
+// panic abort, the ghost table and changelog table are left without cleanup as
+// gh-ost does on panic abort. The panic flag file is removed after that, so it
+// doesn't abort the later migrations.
+//
+// gh-ost only listens to the first panic abort, the later ones are drained
+// here to keep its goroutines from blocking on sending them. The migration
+// blocked in gh-ost may not return, stop gives up after stopTimeout.
+func (e *Executor) stop(migrated <-chan error) {
+	atomic.StoreInt64(&e.mc.ThrottleCommandedByUser, 1)
+	if err := touchFile(e.mc.PanicFlagFile); err != nil {
+		e.l.inner.Errorf("touch panic flag file %s failed, %v", e.mc.PanicFlagFile, err)
+	}
+	defer func() {
+		if err := os.Remove(e.mc.PanicFlagFile); err != nil && !os.IsNotExist(err) {
+			e.l.inner.Errorf("remove panic flag file %s failed, %v", e.mc.PanicFlagFile, err)
+		}
+	}()
+
+	timeout := time.NewTimer(stopTimeout)
+	defer timeout.Stop()
+	for {
+		select {
+		case err := <-migrated:
+			if err != nil {
+				e.l.inner.Warnf("migration stopped, %v", err)
+			}
+			return
+		case <-e.mc.PanicAbort:
+		case <-timeout.C:
+			e.l.inner.Errorf("migration is not stopped in %v", stopTimeout)
+			return
+		}
+	}
+}
+
+func touchFile(path string) error {
+	f, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
 const cfgPath = "./etc/gh-ost.ini"
 
 // config refer to https://github.com/github/gh-ost/blob/master/go/cmd/gh-ost/main.go
@@ -1,9 +1,18 @@
 package onlineddl
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/github/gh-ost/go/base"
 	_ "github.com/pingcap/tidb/types/parser_driver"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_parseAlterTableOptions(t *testing.T) {
@@ -65,3 +74,111 @@ func Test_parseAlterTableOptions(t *testing.T) {
 		})
 	}
 }
+
+// panicAbortMigrate imitates the migration of gh-ost, it runs until the panic flag file is found,
+// and sends the panic abort on every check as the throttler of gh-ost does.
+func panicAbortMigrate(mc *base.MigrationContext, exited *int32) func() error {
+	return func() error {
+		defer atomic.StoreInt32(exited, 1)
+		for {
+			if base.FileExists(mc.PanicFlagFile) {
+				mc.PanicAbort <- fmt.Errorf("Found panic-file %s. Aborting without cleanup", mc.PanicFlagFile)
+				mc.PanicAbort <- fmt.Errorf("Found panic-file %s. Aborting without cleanup", mc.PanicFlagFile)
+				return fmt.Errorf("panic abort")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func TestExecutor_waitCanceled(t *testing.T) {
+	mc := base.NewMigrationContext()
+	mc.PanicFlagFile = filepath.Join(t.TempDir(), "gh-ost.panic")
+	e := &Executor{
+		l:  newLogAdaptor(logrus.WithField("unittest", "unittest")),
+		mc: mc,
+	}
+	var exited int32
+	migrated := make(chan error, 1)
+	go func() {
+		migrated <- panicAbortMigrate(mc, &exited)()
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	err := e.wait(ctx, migrated)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&mc.ThrottleCommandedByUser))
+	// the migration is stopped by the panic flag file, which is removed after that
+	assert.Equal(t, int32(1), atomic.LoadInt32(&exited))
+	assert.False(t, base.FileExists(mc.PanicFlagFile))
+}
+
+func TestExecutor_waitStopTimeout(t *testing.T) {
+	defer func(timeout time.Duration) { stopTimeout = timeout }(stopTimeout)
+	stopTimeout = 50 * time.Millisecond
+
+	mc := base.NewMigrationContext()
+	mc.PanicFlagFile = filepath.Join(t.TempDir(), "gh-ost.panic")
+	e := &Executor{
+		l:  newLogAdaptor(logrus.WithField("unittest", "unittest")),
+		mc: mc,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// the migration never returns
+	err := e.wait(ctx, make(chan error))
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.False(t, base.FileExists(mc.PanicFlagFile))
+}
+
+func TestExecutor_ExecuteCanceled(t *testing.T) {
+	mc := base.NewMigrationContext()
+	var exited int32
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &Executor{
+		l:  newLogAdaptor(logrus.WithField("unittest", "unittest")),
+		mc: mc,
+		migrate: func() error {
+			// the panic flag file is created for the migration if it is not configured
+			assert.NotEmpty(t, mc.PanicFlagFile)
+			cancel()
+			return panicAbortMigrate(mc, &exited)()
+		},
+	}
+
+	err := e.Execute(ctx, false)
+	assert.True(t, errors.Is(err, context.Canceled))
+	// Execute returns after the migration goroutine exits
+	assert.Equal(t, int32(1), atomic.LoadInt32(&exited))
+	assert.Empty(t, mc.PanicFlagFile)
+}
+
+func TestExecutor_waitAborted(t *testing.T) {
+	mc := base.NewMigrationContext()
+	mc.PanicFlagFile = filepath.Join(t.TempDir(), "gh-ost.panic")
+	e := &Executor{
+		l:  newLogAdaptor(logrus.WithField("unittest", "unittest")),
+		mc: mc,
+	}
+
+	// gh-ost calls fatal log on panic abort, it should not exit the process
+	_ = e.l.Fatale(fmt.Errorf("critical-load met"))
+	var exited int32
+	migrated := make(chan error, 1)
+	go func() {
+		migrated <- panicAbortMigrate(mc, &exited)()
+	}()
+	err := e.wait(context.Background(), migrated)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "critical-load met")
+	assert.Equal(t, int64(1), atomic.LoadInt64(&mc.ThrottleCommandedByUser))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&exited))
+
+	migrated <- nil
+	assert.NoError(t, e.wait(context.Background(), migrated))
+}
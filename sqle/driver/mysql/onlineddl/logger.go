@@ -1,17 +1,30 @@
 package onlineddl
 
 import (
+	"fmt"
+
 	"github.com/openark/golib/log"
 	"github.com/sirupsen/logrus"
 )
 
 type logAdaptor struct {
 	inner *logrus.Entry
+	// aborted receives the error of fatal log. gh-ost calls fatal log on panic abort
+	// and expects the process exiting, but it runs in SQLE process.
+	aborted chan error
 }
 
 func newLogAdaptor(l *logrus.Entry) *logAdaptor {
 	return &logAdaptor{
-		inner: l,
+		inner:   l,
+		aborted: make(chan error, 1),
+	}
+}
+
+func (l *logAdaptor) abort(err error) {
+	select {
+	case l.aborted <- err:
+	default:
 	}
 }
 
@@ -57,17 +70,22 @@ func (l *logAdaptor) Errore(err error) error {
 }
 
 func (l *logAdaptor) Fatal(args ...interface{}) error {
-	l.inner.Fatal(args...)
+	err := fmt.Errorf("%s", fmt.Sprint(args...))
+	l.inner.Error(err)
+	l.abort(err)
 	return nil
 }
 
 func (l *logAdaptor) Fatalf(format string, args ...interface{}) error {
-	l.inner.Fatalf(format, args...)
+	err := fmt.Errorf(format, args...)
+	l.inner.Error(err)
+	l.abort(err)
 	return nil
 }
 
 func (l *logAdaptor) Fatale(err error) error {
-	l.inner.Fatalln(err)
+	l.inner.Errorln(err)
+	l.abort(err)
 	return nil
 }
 
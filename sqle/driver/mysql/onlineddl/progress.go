@@ -0,0 +1,158 @@
+package onlineddl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/github/gh-ost/go/base"
+)
+
+// ETAUnknown means gh-ost can not estimate the remaining time yet.
+const ETAUnknown = time.Duration(base.ETAUnknown)
+
+// Progress is the progress of a gh-ost migration.
+type Progress struct {
+	RowsCopied   int64
+	RowsEstimate int64
+	// CopyProgress is the percentage of copied rows, from 0 to 100.
+	CopyProgress float64
+	// ETA is the estimated remaining time, it is ETAUnknown if it can't be estimated.
+	ETA   time.Duration
+	State string
+}
+
+// ProgressFunc receives the progress of a running migration.
+type ProgressFunc func(Progress)
+
+const progressInterval = time.Second
+
+type progressFuncKey struct{}
+
+// WithProgressFunc returns a context carrying fn, Executor.Execute reports
+// the progress of migration to fn every second. The migration is only logged
+// if no ProgressFunc is carried.
+func WithProgressFunc(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressFuncKey{}, fn)
+}
+
+func progressFuncFromContext(ctx context.Context) ProgressFunc {
+	fn, _ := ctx.Value(progressFuncKey{}).(ProgressFunc)
+	return fn
+}
+
+// statusLineRegexp matches the status line printed by gh-ost, e.g.
+// Copy: 0/2915 0.0%; Applied: 0; Backlog: 0/100; Time: 0s(total), 0s(copy); streamer: mysql-bin.000001:4026; Lag: 0.00s, HeartbeatLag: 0.00s, State: migrating; ETA: N/A
+var statusLineRegexp = regexp.MustCompile(`^Copy: (\d+)/(\d+) ([\d.]+)%;.* State: (.*); ETA: (.*)$`)
+
+// ParseProgress parses the status line printed by gh-ost, ok is false if the line is not a status line.
+func ParseProgress(line string) (progress Progress, ok bool) {
+	matches := statusLineRegexp.FindStringSubmatch(line)
+	if matches == nil {
+		return Progress{}, false
+	}
+	var err error
+	if progress.RowsCopied, err = strconv.ParseInt(matches[1], 10, 64); err != nil {
+		return Progress{}, false
+	}
+	if progress.RowsEstimate, err = strconv.ParseInt(matches[2], 10, 64); err != nil {
+		return Progress{}, false
+	}
+	if progress.CopyProgress, err = strconv.ParseFloat(matches[3], 64); err != nil {
+		return Progress{}, false
+	}
+	progress.State = matches[4]
+	if progress.ETA, err = parseETA(matches[5]); err != nil {
+		return Progress{}, false
+	}
+	return progress, true
+}
+
+// parseETA parses the ETA printed by gh-ost, it is "due", "N/A" or a prettified duration.
+func parseETA(eta string) (time.Duration, error) {
+	switch eta {
+	case "due":
+		return 0, nil
+	case "N/A":
+		return ETAUnknown, nil
+	}
+	d, err := time.ParseDuration(eta)
+	if err != nil {
+		return 0, fmt.Errorf("invalid eta %s: %v", eta, err)
+	}
+	return d, nil
+}
+
+// progressFromContext reads the progress from the migration context, which is updated by
+// gh-ost running in process. ok is false if the migration context has no progress yet, e.g.
+// gh-ost is counting the rows of the table.
+func progressFromContext(mc *base.MigrationContext) (progress Progress, ok bool) {
+	progress = Progress{
+		RowsCopied:   mc.GetTotalRowsCopied(),
+		RowsEstimate: atomic.LoadInt64(&mc.RowsEstimate) + atomic.LoadInt64(&mc.RowsDeltaEstimate),
+		CopyProgress: mc.GetProgressPct(),
+		ETA:          mc.GetETADuration(),
+		State:        "migrating",
+	}
+	if isThrottled, reason, _ := mc.IsThrottled(); isThrottled {
+		progress.State = fmt.Sprintf("throttled, %s", reason)
+	}
+	return progress, progress.RowsEstimate > 0 || progress.RowsCopied > 0
+}
+
+// statusTimeout limits the time to read the status line from the serve socket of gh-ost.
+const statusTimeout = time.Second
+
+// progressFromStatus reads the status line by the "sup" command of the serve socket of gh-ost,
+// which is the same as the line gh-ost prints to stdout. ok is false if gh-ost does not serve
+// the socket or the status line is not printed.
+func progressFromStatus(socketFile string) (progress Progress, ok bool) {
+	if socketFile == "" {
+		return Progress{}, false
+	}
+	conn, err := net.DialTimeout("unix", socketFile, statusTimeout)
+	if err != nil {
+		return Progress{}, false
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(statusTimeout)); err != nil {
+		return Progress{}, false
+	}
+	if _, err := fmt.Fprintln(conn, "sup"); err != nil {
+		return Progress{}, false
+	}
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		if progress, ok := ParseProgress(scanner.Text()); ok {
+			return progress, true
+		}
+	}
+	return Progress{}, false
+}
+
+// reportProgress reports the progress to fn until done is closed. The status line of gh-ost
+// is parsed if the migration context has no progress, the progress of the migration context
+// is reported if the status line is not available either.
+func reportProgress(mc *base.MigrationContext, fn ProgressFunc, done <-chan struct{}) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			progress, ok := progressFromContext(mc)
+			if !ok {
+				if status, ok := progressFromStatus(mc.ServeSocketFile); ok {
+					progress = status
+				}
+			}
+			fn(progress)
+		}
+	}
+}
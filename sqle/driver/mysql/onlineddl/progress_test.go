@@ -0,0 +1,153 @@
+package onlineddl
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/github/gh-ost/go/base"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProgress(t *testing.T) {
+	tests := []struct {
+		line   string
+		want   Progress
+		wantOk bool
+	}{
+		{
+			line: "Copy: 0/2915 0.0%; Applied: 0; Backlog: 0/100; Time: 0s(total), 0s(copy); streamer: mysql-bin.000001:4026; Lag: 0.00s, HeartbeatLag: 0.00s, State: migrating; ETA: N/A",
+			want: Progress{
+				RowsCopied:   0,
+				RowsEstimate: 2915,
+				CopyProgress: 0,
+				ETA:          ETAUnknown,
+				State:        "migrating",
+			},
+			wantOk: true,
+		},
+		{
+			line: "Copy: 1500000/4000000 37.5%; Applied: 120; Backlog: 0/1000; Time: 2m30s(total), 2m29s(copy); streamer: mysql-bin.000003:185739; Lag: 0.02s, HeartbeatLag: 0.05s, State: migrating; ETA: 4m8s",
+			want: Progress{
+				RowsCopied:   1500000,
+				RowsEstimate: 4000000,
+				CopyProgress: 37.5,
+				ETA:          4*time.Minute + 8*time.Second,
+				State:        "migrating",
+			},
+			wantOk: true,
+		},
+		{
+			line: "Copy: 2000/4000 50.0%; Applied: 0; Backlog: 0/1000; Time: 1h(total), 59m(copy); streamer: mysql-bin.000003:185739; Lag: 0.02s, HeartbeatLag: 0.05s, State: throttled, commanded by user; ETA: 1h",
+			want: Progress{
+				RowsCopied:   2000,
+				RowsEstimate: 4000,
+				CopyProgress: 50,
+				ETA:          time.Hour,
+				State:        "throttled, commanded by user",
+			},
+			wantOk: true,
+		},
+		{
+			line: "Copy: 4000/4000 100.0%; Applied: 0; Backlog: 0/1000; Time: 10s(total), 9s(copy); streamer: mysql-bin.000003:185739; Lag: 0.02s, HeartbeatLag: 0.05s, State: migrating; ETA: due",
+			want: Progress{
+				RowsCopied:   4000,
+				RowsEstimate: 4000,
+				CopyProgress: 100,
+				ETA:          0,
+				State:        "migrating",
+			},
+			wantOk: true,
+		},
+		{
+			line:   "# Migrating `db1`.`t1`; Ghost table is `db1`.`_t1_gho`",
+			wantOk: false,
+		},
+		{
+			line:   "Copy: 1/2 50.0%; State: migrating; ETA: soon",
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		got, ok := ParseProgress(tt.line)
+		assert.Equal(t, tt.wantOk, ok, tt.line)
+		assert.Equal(t, tt.want, got, tt.line)
+	}
+}
+
+func TestReportProgress(t *testing.T) {
+	mc := base.NewMigrationContext()
+	mc.RowsEstimate = 100
+	mc.TotalRowsCopied = 25
+	mc.SetProgressPct(25)
+
+	progresses := make(chan Progress, 10)
+	fn := progressFuncFromContext(WithProgressFunc(context.Background(), func(p Progress) {
+		progresses <- p
+	}))
+	assert.NotNil(t, fn)
+	assert.Nil(t, progressFuncFromContext(context.Background()))
+
+	done := make(chan struct{})
+	go reportProgress(mc, fn, done)
+	p := <-progresses
+	close(done)
+
+	assert.Equal(t, int64(25), p.RowsCopied)
+	assert.Equal(t, int64(100), p.RowsEstimate)
+	assert.Equal(t, float64(25), p.CopyProgress)
+	assert.Equal(t, ETAUnknown, p.ETA)
+	assert.Equal(t, "migrating", p.State)
+}
+
+// serveStatus serves the serve socket of gh-ost, which responds the status line to "sup".
+func serveStatus(t *testing.T, statusLine string) string {
+	socketFile := filepath.Join(t.TempDir(), "gh-ost.sock")
+	l, err := net.Listen("unix", socketFile)
+	assert.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			command, _, _ := bufio.NewReader(conn).ReadLine()
+			if string(command) == "sup" {
+				conn.Write([]byte(statusLine + "\n"))
+			}
+			conn.Close()
+		}
+	}()
+	return socketFile
+}
+
+func TestReportProgressFromStatus(t *testing.T) {
+	statusLine := "Copy: 0/0 0.0%; Applied: 0; Backlog: 0/1000; Time: 3s(total), 0s(copy); streamer: mysql-bin.000003:185739; Lag: 0.00s, HeartbeatLag: 0.00s, State: counting rows; ETA: N/A"
+
+	// the migration context has no progress while gh-ost is counting the rows
+	mc := base.NewMigrationContext()
+	mc.ServeSocketFile = serveStatus(t, statusLine)
+	_, ok := progressFromContext(mc)
+	assert.False(t, ok)
+
+	progresses := make(chan Progress, 10)
+	done := make(chan struct{})
+	go reportProgress(mc, func(p Progress) { progresses <- p }, done)
+	p := <-progresses
+	close(done)
+	assert.Equal(t, Progress{ETA: ETAUnknown, State: "counting rows"}, p)
+
+	// the progress of the migration context is reported if gh-ost does not serve the socket
+	_, ok = progressFromStatus(filepath.Join(t.TempDir(), "not_exist.sock"))
+	assert.False(t, ok)
+	mc.ServeSocketFile = ""
+	done = make(chan struct{})
+	go reportProgress(mc, func(p Progress) { progresses <- p }, done)
+	p = <-progresses
+	close(done)
+	assert.Equal(t, "migrating", p.State)
+}
@@ -4,13 +4,16 @@ import "github.com/nicksnyder/go-i18n/v2/i18n"
 
 // advisor
 var (
-	ThreeStarIndexAdviceFormat   = &i18n.Message{ID: "ThreeStarIndexAdviceFormat", Other: "索引建议 | 根据三星索引设计规范，建议对表%s添加%s索引：【%s】"}
-	PrefixIndexAdviceFormat      = &i18n.Message{ID: "PrefixIndexAdviceFormat", Other: "索引建议 | SQL使用了前模糊匹配，数据量大时，可建立翻转函数索引"}
-	ExtremalIndexAdviceFormat    = &i18n.Message{ID: "ExtremalIndexAdviceFormat", Other: "索引建议 | SQL使用了最值函数，可以利用索引有序的性质快速找到最值，建议对表%s添加单列索引，参考列：%s"}
-	FunctionIndexAdviceFormatV80 = &i18n.Message{ID: "FunctionIndexAdviceFormatV80", Other: "索引建议 | SQL使用了函数作为查询条件，在MySQL8.0.13以上的版本，可以创建函数索引，建议对表%s添加函数索引，参考列：%s"}
-	FunctionIndexAdviceFormatV57 = &i18n.Message{ID: "FunctionIndexAdviceFormatV57", Other: "索引建议 | SQL使用了函数作为查询条件，在MySQL5.7以上的版本，可以在虚拟列上创建索引，建议对表%s添加虚拟列索引，参考列：%s"}
-	FunctionIndexAdviceFormatAll = &i18n.Message{ID: "FunctionIndexAdviceFormatAll", Other: "索引建议 | SQL使用了函数作为查询条件，在MySQL5.7以上的版本，可以在虚拟列上创建索引，在MySQL8.0.13以上的版本，可以创建函数索引，建议根据MySQL版本对表%s添加合适的索引，参考列：%s"}
-	JoinIndexAdviceFormat        = &i18n.Message{ID: "JoinIndexAdviceFormat", Other: "索引建议 | SQL中字段%s为被驱动表%s上的关联字段，建议对表%s添加单列索引，参考列：%s"}
+	ThreeStarIndexAdviceFormat               = &i18n.Message{ID: "ThreeStarIndexAdviceFormat", Other: "索引建议 | 根据三星索引设计规范，建议对表%s添加%s索引：【%s】"}
+	ThreeStarCoverIndexAdviceFormat          = &i18n.Message{ID: "ThreeStarCoverIndexAdviceFormat", Other: "索引建议 | SQL查询的字段都包含在索引中时可以避免回表，建议对表%s添加覆盖索引：【%s】"}
+	ThreeStarCoverIndexExceedMaxColumnFormat = &i18n.Message{ID: "ThreeStarCoverIndexExceedMaxColumnFormat", Other: "索引建议 | 表%s的覆盖索引需要包含%d列：【%s】，超过了复合索引列数上限%d，不建议添加覆盖索引"}
+	ExtendIndexAdviceFormat                  = &i18n.Message{ID: "ExtendIndexAdviceFormat", Other: "索引建议 | 表%s已有索引%s，建议在该索引上追加列：【%s】，而不是新建索引"}
+	PrefixIndexAdviceFormat                  = &i18n.Message{ID: "PrefixIndexAdviceFormat", Other: "索引建议 | SQL使用了前模糊匹配，数据量大时，可建立翻转函数索引"}
+	ExtremalIndexAdviceFormat                = &i18n.Message{ID: "ExtremalIndexAdviceFormat", Other: "索引建议 | SQL使用了最值函数，可以利用索引有序的性质快速找到最值，建议对表%s添加单列索引，参考列：%s"}
+	FunctionIndexAdviceFormatV80             = &i18n.Message{ID: "FunctionIndexAdviceFormatV80", Other: "索引建议 | SQL使用了函数作为查询条件，在MySQL8.0.13以上的版本，可以创建函数索引，建议对表%s添加函数索引，参考列：%s"}
+	FunctionIndexAdviceFormatV57             = &i18n.Message{ID: "FunctionIndexAdviceFormatV57", Other: "索引建议 | SQL使用了函数作为查询条件，在MySQL5.7以上的版本，可以在虚拟列上创建索引，建议对表%s添加虚拟列索引，参考列：%s"}
+	FunctionIndexAdviceFormatAll             = &i18n.Message{ID: "FunctionIndexAdviceFormatAll", Other: "索引建议 | SQL使用了函数作为查询条件，在MySQL5.7以上的版本，可以在虚拟列上创建索引，在MySQL8.0.13以上的版本，可以创建函数索引，建议根据MySQL版本对表%s添加合适的索引，参考列：%s"}
+	JoinIndexAdviceFormat                    = &i18n.Message{ID: "JoinIndexAdviceFormat", Other: "索引建议 | SQL中字段%s为被驱动表%s上的关联字段，建议对表%s添加单列索引，参考列：%s"}
 
 	AdvisorIndexTypeComposite = &i18n.Message{ID: "AdvisorIndexTypeComposite", Other: "复合"}
 	AdvisorIndexTypeSingle    = &i18n.Message{ID: "AdvisorIndexTypeSingle", Other: "单列"}
@@ -32,6 +35,9 @@ var (
 	AnalysisDescCardinality      = &i18n.Message{ID: "AnalysisDescCardinality", Other: "基数"}
 	AnalysisDescIndexType        = &i18n.Message{ID: "AnalysisDescIndexType", Other: "索引类型"}
 	AnalysisDescComment          = &i18n.Message{ID: "AnalysisDescComment", Other: "备注"}
+	AnalysisDescTableRows        = &i18n.Message{ID: "AnalysisDescTableRows", Other: "表行数"}
+	AnalysisDescSelectivity      = &i18n.Message{ID: "AnalysisDescSelectivity", Other: "选择性(%)"}
+	AnalysisDescLowSelectivity   = &i18n.Message{ID: "AnalysisDescLowSelectivity", Other: "选择性过低"}
 	AnalysisChartXTime           = &i18n.Message{ID: "AnalysisChartXTime", Other: "时间"}
 	AnalysisChartYCost           = &i18n.Message{ID: "AnalysisChartYTime", Other: "花费"}
 )
@@ -60,8 +66,10 @@ var (
 	CheckInvalidErrorFormat = &i18n.Message{ID: "CheckInvalidErrorFormat", Other: "预检查失败: %v"}
 	CheckInvalidError       = &i18n.Message{ID: "CheckInvalidError", Other: "预检查失败"}
 
-	UnsupportedSyntaxError = &i18n.Message{ID: "UnsupportedSyntaxError", Other: "语法错误或者解析器不支持，请人工确认SQL正确性"}
-	AnonymousMark          = &i18n.Message{ID: "AnonymousMark", Other: "(匿名)"}
+	UnsupportedSyntaxError     = &i18n.Message{ID: "UnsupportedSyntaxError", Other: "语法错误或者解析器不支持，请人工确认SQL正确性"}
+	MariaDBSyntaxSkipped       = &i18n.Message{ID: "MariaDBSyntaxSkipped", Other: "解析器不支持MariaDB语法 %v，已跳过审核，请人工确认SQL正确性"}
+	CheckConstraintNotEnforced = &i18n.Message{ID: "CheckConstraintNotEnforced", Other: "目标版本 %v 会解析但不会强制执行CHECK约束（MySQL 8.0.16、MariaDB 10.2.1及以上版本才会生效），请在应用中校验数据"}
+	AnonymousMark              = &i18n.Message{ID: "AnonymousMark", Other: "(匿名)"}
 
 	AuditResultMsgWhiteList   = &i18n.Message{ID: "AuditResultMsgWhiteList", Other: "白名单"}
 	AuditResultMsgExcludedSQL = &i18n.Message{ID: "AuditResultMsgExcludedSQL", Other: "审核SQL例外"}
@@ -72,6 +80,9 @@ var (
 	ParseDDLError     = &i18n.Message{ID: "ParseDDLError", Other: "解析建表语句失败，部分在线审核规则可能失效，请人工确认"}
 	GhostDryRunError  = &i18n.Message{ID: "GhostDryRunError", Other: "表空间大小超过%vMB, 将使用gh-ost进行上线, 但是dry-run抛出如下错误: %v"}
 	GhostDryRunNotice = &i18n.Message{ID: "GhostDryRunNotice", Other: "表空间大小超过%vMB, 将使用gh-ost进行上线"}
+
+	ProcedureBodyAuditResult = &i18n.Message{ID: "ProcedureBodyAuditResult", Other: "存储过程 %s 中的语句: %s"}
+	RepeatedSelectSuggestion = &i18n.Message{ID: "RepeatedSelectSuggestion", Other: "相同模式的 SELECT 语句在本批次中出现了 %d 次，可能是应用在循环中逐条查询(N+1 查询)，建议改为 JOIN 或使用 IN 批量查询"}
 )
 
 // pt_otc
@@ -80,6 +91,7 @@ var (
 	PTOSCAvoidUniqueIndex                   = &i18n.Message{ID: "PTOSCAvoidUniqueIndex", Other: "[osc]添加唯一键使用 pt-online-schema-change，可能会导致数据丢失，在数据迁移到新表时使用了insert ignore"}
 	PTOSCAvoidRenameTable                   = &i18n.Message{ID: "PTOSCAvoidRenameTable", Other: "[osc]pt-online-schema-change 不支持使用rename table 来重命名表"}
 	PTOSCAvoidNoDefaultValueOnNotNullColumn = &i18n.Message{ID: "PTOSCAvoidNoDefaultValueOnNotNullColumn", Other: "[osc]非空字段必须设置默认值，不然 pt-online-schema-change 会执行失败"}
+	PTOSCPrerequisitesError                 = &i18n.Message{ID: "PTOSCPrerequisitesError", Other: "[osc]将使用 pt-online-schema-change 进行上线, 但是前置检查失败: %v"}
 )
 
 // rollback
@@ -93,6 +105,12 @@ var (
 	NotSupportParamMarkerStatementRollback    = &i18n.Message{ID: "NotSupportParamMarkerStatementRollback", Other: "不支持回滚包含指纹的语句"}
 	NotSupportHasVariableRollback             = &i18n.Message{ID: "NotSupportHasVariableRollback", Other: "不支持回滚包含变量的 DML 语句"}
 	NotSupportExceedMaxRowsRollback           = &i18n.Message{ID: "NotSupportExceedMaxRowsRollback", Other: "预计影响行数超过配置的最大值，不生成回滚语句"}
+	NotSupportNoOriginalTableRollback         = &i18n.Message{ID: "NotSupportNoOriginalTableRollback", Other: "无法获取原始表结构（离线审核），不生成回滚语句"}
+)
+
+// affected rows
+var (
+	AffectRowsOnDuplicateUpperBound = &i18n.Message{ID: "AffectRowsOnDuplicateUpperBound", Other: "INSERT ... ON DUPLICATE KEY UPDATE 的影响行数是上限，执行前无法得知插入或更新的行数"}
 )
 
 // rule Category
@@ -160,8 +178,8 @@ var (
 	ConfigDMLRollbackMaxRowsDesc                                 = &i18n.Message{ID: "ConfigDMLRollbackMaxRowsDesc", Other: "在 DML 语句中预计影响行数超过指定值则不回滚"}
 	ConfigDMLRollbackMaxRowsAnnotation                           = &i18n.Message{ID: "ConfigDMLRollbackMaxRowsAnnotation", Other: "大事务回滚，容易影响数据库性能，使得业务发生波动；具体规则阈值可以根据业务需求调整，默认值：1000"}
 	ConfigDMLRollbackMaxRowsParams1                              = &i18n.Message{ID: "ConfigDMLRollbackMaxRowsParams1", Other: "最大影响行数"}
-	ConfigDDLOSCMinSizeDesc                                      = &i18n.Message{ID: "ConfigDDLOSCMinSizeDesc", Other: "改表时，表空间超过指定大小(MB)审核时输出osc改写建议"}
-	ConfigDDLOSCMinSizeAnnotation                                = &i18n.Message{ID: "ConfigDDLOSCMinSizeAnnotation", Other: "开启该规则后会对大表的DDL语句给出 pt-osc工具的改写建议【需要参考命令进行手工执行，后续会支持自动执行】；直接对大表进行DDL变更时可能会导致长时间锁表问题，影响业务可持续性。具体对大表定义的阈值可以根据业务需求调整，默认值：1024"}
+	ConfigDDLOSCMinSizeDesc                                      = &i18n.Message{ID: "ConfigDDLOSCMinSizeDesc", Other: "改表时，表空间超过指定大小(MB)审核时输出osc改写建议，并使用pt-osc上线"}
+	ConfigDDLOSCMinSizeAnnotation                                = &i18n.Message{ID: "ConfigDDLOSCMinSizeAnnotation", Other: "开启该规则后会对大表的DDL语句给出 pt-osc工具的改写建议，并在上线时使用 pt-osc 工具执行（同时开启gh-ost上线规则且均满足阈值时优先使用gh-ost）；直接对大表进行DDL变更时可能会导致长时间锁表问题，影响业务可持续性。具体对大表定义的阈值可以根据业务需求调整，默认值：1024"}
 	ConfigDDLOSCMinSizeParams1                                   = &i18n.Message{ID: "ConfigDDLOSCMinSizeParams1", Other: "表空间大小（MB）"}
 	DDLCheckTableSizeDesc                                        = &i18n.Message{ID: "DDLCheckTableSizeDesc", Other: "不建议对数据量过大的表执行DDL操作"}
 	DDLCheckTableSizeAnnotation                                  = &i18n.Message{ID: "DDLCheckTableSizeAnnotation", Other: "大表执行DDL，耗时较久且负载较高，长时间占用锁资源，会影响数据库性能；具体规则阈值可以根据业务需求调整，默认值：1024"}
@@ -561,12 +579,13 @@ var (
 	DMLCheckExplainFullIndexScanAnnotation                       = &i18n.Message{ID: "DMLCheckExplainFullIndexScanAnnotation", Other: "在数据量大的情况下索引全扫描严重影响SQL性能。"}
 	DMLCheckExplainFullIndexScanMessage                          = &i18n.Message{ID: "DMLCheckExplainFullIndexScanMessage", Other: "不建议对表进行全索引扫描"}
 	DMLCheckLimitOffsetNumDesc                                   = &i18n.Message{ID: "DMLCheckLimitOffsetNumDesc", Other: "不建议LIMIT的偏移OFFSET大于阈值"}
-	DMLCheckLimitOffsetNumAnnotation                             = &i18n.Message{ID: "DMLCheckLimitOffsetNumAnnotation", Other: "因为OFFSET指定了结果集的起始位置，如果起始位置过大，那么 MySQL 需要处理更多的数据才能返回结果集，这可能会导致查询性能下降。"}
+	DMLCheckLimitOffsetNumAnnotation                             = &i18n.Message{ID: "DMLCheckLimitOffsetNumAnnotation", Other: "因为OFFSET指定了结果集的起始位置，如果起始位置过大，那么 MySQL 需要处理更多的数据才能返回结果集，这可能会导致查询性能下降。建议使用基于索引字段的游标分页（seek/keyset分页）代替，例如记录上一页最后一行的主键值，下一页使用 WHERE id > ? ORDER BY id LIMIT n 查询。"}
 	DMLCheckLimitOffsetNumMessage                                = &i18n.Message{ID: "DMLCheckLimitOffsetNumMessage", Other: "不建议LIMIT的偏移OFFSET大于阈值，OFFSET=%v（阈值为%v）"}
 	DMLCheckLimitOffsetNumParams1                                = &i18n.Message{ID: "DMLCheckLimitOffsetNumParams1", Other: "offset 大小"}
 	DMLCheckUpdateOrDeleteHasWhereDesc                           = &i18n.Message{ID: "DMLCheckUpdateOrDeleteHasWhereDesc", Other: "建议UPDATE/DELETE操作使用WHERE条件"}
-	DMLCheckUpdateOrDeleteHasWhereAnnotation                     = &i18n.Message{ID: "DMLCheckUpdateOrDeleteHasWhereAnnotation", Other: "因为这些语句的目的是修改数据库中的数据，需要使用 WHERE 条件来过滤需要更新或删除的记录，以确保数据的正确性。另外，使用 WHERE 条件还可以提高查询性能。"}
+	DMLCheckUpdateOrDeleteHasWhereAnnotation                     = &i18n.Message{ID: "DMLCheckUpdateOrDeleteHasWhereAnnotation", Other: "因为这些语句的目的是修改数据库中的数据，需要使用 WHERE 条件来过滤需要更新或删除的记录，以确保数据的正确性。另外，使用 WHERE 条件还可以提高查询性能。没有 WHERE 条件的 UPDATE/DELETE 会修改整张表的数据（多表 UPDATE/DELETE 会修改所有关联到的记录）。可以通过规则参数允许带有 LIMIT 子句的单表 UPDATE/DELETE，此时每次最多修改 LIMIT 条记录，常用于分批清理数据。"}
 	DMLCheckUpdateOrDeleteHasWhereMessage                        = &i18n.Message{ID: "DMLCheckUpdateOrDeleteHasWhereMessage", Other: "建议UPDATE/DELETE操作使用WHERE条件"}
+	DMLCheckUpdateOrDeleteHasWhereParams1                        = &i18n.Message{ID: "DMLCheckUpdateOrDeleteHasWhereParams1", Other: "允许带有LIMIT子句的UPDATE/DELETE不使用WHERE条件"}
 	DMLCheckSortColumnLengthDesc                                 = &i18n.Message{ID: "DMLCheckSortColumnLengthDesc", Other: "禁止对长字段排序"}
 	DMLCheckSortColumnLengthAnnotation                           = &i18n.Message{ID: "DMLCheckSortColumnLengthAnnotation", Other: "对例如VARCHAR(2000)这样的长字段进行ORDER BY、DISTINCT、GROUP BY、UNION之类的操作，会引发排序，有性能隐患"}
 	DMLCheckSortColumnLengthMessage                              = &i18n.Message{ID: "DMLCheckSortColumnLengthMessage", Other: "长度超过阈值的字段不建议用于ORDER BY、DISTINCT、GROUP BY、UNION，这些字段有：%v"}
@@ -784,427 +803,559 @@ var (
 
 // ai rules
 var (
-	Rule00001Desc       = &i18n.Message{ID: "Rule00001Desc", Other: "禁止SQL语句不带WHERE条件或者WHERE条件为永真"}
-	Rule00001Annotation = &i18n.Message{ID: "Rule00001Annotation", Other: "使用有效的WHERE条件能够避免全表扫描，提高SQL执行效率；而恒为TRUE的WHERE条件，如where 1=1、where true=true等，在执行时会进行全表扫描产生额外开销。"}
-	Rule00001Message    = &i18n.Message{ID: "Rule00001Message", Other: "禁止SQL语句不带WHERE条件或者WHERE条件为永真"}
-	Rule00002Desc       = &i18n.Message{ID: "Rule00002Desc", Other: "SQL绑定的变量个数不建议超过阈值"}
-	Rule00002Annotation = &i18n.Message{ID: "Rule00002Annotation", Other: "过度使用绑定变量会增加查询的复杂度，从而降低查询性能。同时还会增加维护成本。SQLE设置MySQL绑定变量个数最大阈值：100"}
-	Rule00002Message    = &i18n.Message{ID: "Rule00002Message", Other: "SQL绑定的变量个数不建议超过阈值"}
-	Rule00002Params1    = &i18n.Message{ID: "Rule00002Params1", Other: "绑定变量阈值"}
-	Rule00003Desc       = &i18n.Message{ID: "Rule00003Desc", Other: "建议为组成索引的字段添加非空约束，并配置合理的default值"}
-	Rule00003Annotation = &i18n.Message{ID: "Rule00003Annotation", Other: "在MySQL中，NULL值表示的含义为missing unknown value，在不同的场景下MySQL存在不同的处理方式；当字段内容存在NULL值时，处理结果可能存在异常"}
-	Rule00003Message    = &i18n.Message{ID: "Rule00003Message", Other: "建议为组成索引的字段添加非空约束，并配置合理的default值"}
-	Rule00004Desc       = &i18n.Message{ID: "Rule00004Desc", Other: "建议表的自增字段起始值为0"}
-	Rule00004Annotation = &i18n.Message{ID: "Rule00004Annotation", Other: "创建表时AUTO_INCREMENT设置为0则自增从1开始，可以避免数据空洞。例如在导出表结构DDL时，表结构内AUTO_INCREMENT通常为当前的自增值，如果建表时没有把AUTO_INCREMENT设置为0，那么通过该DDL进行建表操作会导致自增值从一个无意义数字开始。"}
-	Rule00004Message    = &i18n.Message{ID: "Rule00004Message", Other: "建议表的自增字段起始值为0"}
-	Rule00005Desc       = &i18n.Message{ID: "Rule00005Desc", Other: "避免复合索引中包含过多字段"}
-	Rule00005Annotation = &i18n.Message{ID: "Rule00005Annotation", Other: "在设计复合索引过程中，每增加一个索引字段，都会使索引的大小线性增加，从而占用更多的磁盘空间，且增加索引维护的开销。尤其是在数据频繁变动的环境中，这会显著增加数据库的维护压力。"}
-	Rule00005Message    = &i18n.Message{ID: "Rule00005Message", Other: "避免复合索引中包含过多字段"}
-	Rule00005Params1    = &i18n.Message{ID: "Rule00005Params1", Other: "复合索引内字段个数"}
-	Rule00007Desc       = &i18n.Message{ID: "Rule00007Desc", Other: "建表时，自增字段只能设置一个"}
-	Rule00007Annotation = &i18n.Message{ID: "Rule00007Annotation", Other: "多个自增字段会造成表写入性能影响、可读性差、数据库设计不规范等缺点。"}
-	Rule00007Message    = &i18n.Message{ID: "Rule00007Message", Other: "建表时，自增字段只能设置一个"}
-	Rule00008Desc       = &i18n.Message{ID: "Rule00008Desc", Other: "表里必须存在主键"}
-	Rule00008Annotation = &i18n.Message{ID: "Rule00008Annotation", Other: "表必须存在主键。如果表没有明确指定主键，可能会导致一些问题，如数据一致性难以保证、查询性能下降、数据完整性问题、数据管理和维护困难以及数据库优化受限等。"}
-	Rule00008Message    = &i18n.Message{ID: "Rule00008Message", Other: "表里必须存在主键"}
-	Rule00009Desc       = &i18n.Message{ID: "Rule00009Desc", Other: "避免对条件字段使用函数操作"}
-	Rule00009Annotation = &i18n.Message{ID: "Rule00009Annotation", Other: "对条件字段做函数操作，可能会破坏索引值的有序性，导致优化器选择放弃走索引，使查询性能大幅度降低"}
-	Rule00009Message    = &i18n.Message{ID: "Rule00009Message", Other: "避免对条件字段使用函数操作"}
-	Rule00010Desc       = &i18n.Message{ID: "Rule00010Desc", Other: "禁止进行删除主键的操作"}
-	Rule00010Annotation = &i18n.Message{ID: "Rule00010Annotation", Other: "删除主键将影响表数据结构和性能，属于高开销操作。另外，如果业务中使用了主键，删除主键可能导致业务逻辑阻塞、异常等问题。"}
-	Rule00010Message    = &i18n.Message{ID: "Rule00010Message", Other: "禁止进行删除主键的操作"}
-	Rule00011Desc       = &i18n.Message{ID: "Rule00011Desc", Other: "存在多条对同一个表的修改语句，建议合并成一个ALTER语句"}
-	Rule00011Annotation = &i18n.Message{ID: "Rule00011Annotation", Other: "避免对同一个表使用多条单独的ALTER语句，以减少数据库的锁定时间和执行开销，提高SQL语句的可读性和维护性。"}
-	Rule00011Message    = &i18n.Message{ID: "Rule00011Message", Other: "存在多条对同一个表的修改语句，建议合并成一个ALTER语句"}
-	Rule00012Desc       = &i18n.Message{ID: "Rule00012Desc", Other: "建议使用BIGINT类型表示小数"}
-	Rule00012Annotation = &i18n.Message{ID: "Rule00012Annotation", Other: "在MySQL中，对于金额等需要高精度计算的小数，建议使用BIGINT类型表示，以避免浮点数精度问题。例如，可以用分来表示金额，1元在数据库中用整型表示为100。"}
-	Rule00012Message    = &i18n.Message{ID: "Rule00012Message", Other: "建议使用BIGINT类型表示小数"}
-	Rule00013Desc       = &i18n.Message{ID: "Rule00013Desc", Other: "建议使用 DECIMAL 类型表示精确数值"}
-	Rule00013Annotation = &i18n.Message{ID: "Rule00013Annotation", Other: "在数据库中，精确数值的表示对于财务数据、统计数据等需要高精度计算的场景至关重要。使用非精确浮点类型如 FLOAT 或 DOUBLE 可能导致精度丢失、计算误差，从而影响数据的准确性与可靠性。"}
-	Rule00013Message    = &i18n.Message{ID: "Rule00013Message", Other: "建议使用 DECIMAL 类型表示精确数值. 不符合规定的字段: %v"}
-	Rule00014Desc       = &i18n.Message{ID: "Rule00014Desc", Other: "不建议使用自定义函数"}
-	Rule00014Annotation = &i18n.Message{ID: "Rule00014Annotation", Other: "自定义函数和存储过程维护较困难，且依赖性高，可能导致SQL无法跨库使用。此外，它们在使用时存在一些限制，如无法使用事务相关语句、无法直接产生输出的语句，以及无法在函数体内使用USE语句指定数据库。"}
-	Rule00014Message    = &i18n.Message{ID: "Rule00014Message", Other: "不建议使用自定义函数"}
-	Rule00015Desc       = &i18n.Message{ID: "Rule00015Desc", Other: "避免库内出现多种数据库排序规则"}
-	Rule00015Annotation = &i18n.Message{ID: "Rule00015Annotation", Other: "建议库内使用一致的数据库排序规则，以确保查询性能和索引有效性，避免因排序规则不一致导致的全表扫描和数据一致性问题。"}
-	Rule00015Message    = &i18n.Message{ID: "Rule00015Message", Other: "避免库内出现多种数据库排序规则"}
-	Rule00016Desc       = &i18n.Message{ID: "Rule00016Desc", Other: "存储大数据类型（如长文本、图片等）的字段只能设置为NULL"}
-	Rule00016Annotation = &i18n.Message{ID: "Rule00016Annotation", Other: "在MySQL中，存储大数据类型的内容常用BLOB和TEXT、GEOMETRY以及JSON类型，但它们无法指定默认值；写入数据时，如未对该字段指定值会导致写入失败。"}
-	Rule00016Message    = &i18n.Message{ID: "Rule00016Message", Other: "存储大数据类型（如长文本、图片等）的字段只能设置为NULL"}
-	Rule00017Desc       = &i18n.Message{ID: "Rule00017Desc", Other: "不建议使用BLOB或TEXT类型"}
-	Rule00017Annotation = &i18n.Message{ID: "Rule00017Annotation", Other: "BLOB或TEXT类型消耗大量的磁盘空间、网络IO带宽，同时在该表上的DML操作都会变得很慢"}
-	Rule00017Message    = &i18n.Message{ID: "Rule00017Message", Other: "不建议使用BLOB或TEXT类型. 不符合规定的字段: %v"}
-	Rule00018Desc       = &i18n.Message{ID: "Rule00018Desc", Other: "CHAR长度大于20时，建议使用VARCHAR类型"}
-	Rule00018Annotation = &i18n.Message{ID: "Rule00018Annotation", Other: "VARCHAR是变长字段，存储空间小，可节省存储空间，同时相对较小的字段检索效率显然也要高些"}
-	Rule00018Message    = &i18n.Message{ID: "Rule00018Message", Other: "CHAR长度大于20时，建议使用VARCHAR类型"}
-	Rule00018Params1    = &i18n.Message{ID: "Rule00018Params1", Other: "CHAR最大长度"}
-	Rule00019Desc       = &i18n.Message{ID: "Rule00019Desc", Other: "不建议使用复合类型（SET和ENUM类型）数据"}
-	Rule00019Annotation = &i18n.Message{ID: "Rule00019Annotation", Other: "SET类型，ENUM类型不是SQL标准，移植性较差；后期如修改或增加枚举值需重建整张表，代价较大；且无法通过字面值进行排序；在插入数据时，必须带上引号，否则将写入枚举值的顺序值，造成不可预期的问题"}
-	Rule00019Message    = &i18n.Message{ID: "Rule00019Message", Other: "不建议使用复合类型（SET和ENUM类型）数据"}
-	Rule00020Desc       = &i18n.Message{ID: "Rule00020Desc", Other: "避免表中包含有太多的列"}
-	Rule00020Annotation = &i18n.Message{ID: "Rule00020Annotation", Other: "数据库表中字段过多会导致数据操作效率降低、数据完整性检查成本增加，以及索引维护与更新效率之间的权衡成本。对于追求事务响应和处理速度的OLTP系统，应尽量避免宽表设计，采用规范化数据模型以提升性能。"}
-	Rule00020Message    = &i18n.Message{ID: "Rule00020Message", Other: "避免表中包含有太多的列"}
-	Rule00020Params1    = &i18n.Message{ID: "Rule00020Params1", Other: "表内列数上限"}
-	Rule00021Desc       = &i18n.Message{ID: "Rule00021Desc", Other: "禁止表字段缺少NOT NULL约束"}
-	Rule00021Annotation = &i18n.Message{ID: "Rule00021Annotation", Other: "若数据库表字段缺少NOT NULL约束，则字段存储值可能是NULL，后期判断时，需要加上IS NULL判断，增加SQL编写的复杂度。"}
-	Rule00021Message    = &i18n.Message{ID: "Rule00021Message", Other: "禁止表字段缺少NOT NULL约束"}
-	Rule00022Desc       = &i18n.Message{ID: "Rule00022Desc", Other: "避免使用数据倾斜度高的索引字段"}
-	Rule00022Annotation = &i18n.Message{ID: "Rule00022Annotation", Other: "为了提高查询效率，建议在执行SQL时优先使用倾斜度低的索引字段。倾斜度低的索引可以更快地定位数据，减少不必要的数据扫描，从而加速查询响应时间。规则检查将会计算候选索引字段的倾斜度，如果索引的倾斜度高于设定的阈值，则建议调整索引策略。"}
-	Rule00022Message    = &i18n.Message{ID: "Rule00022Message", Other: "索引列 %v 超过倾斜度阈值 %v, 避免使用数据倾斜度高的索引字段"}
-	Rule00022Params1    = &i18n.Message{ID: "Rule00022Params1", Other: "倾斜度"}
-	Rule00023Desc       = &i18n.Message{ID: "Rule00023Desc", Other: "主键包含的列数不建议超过阈值"}
-	Rule00023Annotation = &i18n.Message{ID: "Rule00023Annotation", Other: "主建中的列过多，会导致辅助索引占用更多的空间，同时增加索引维护的开销；具体规则阈值可根据业务需求调整，默认值：2"}
-	Rule00023Message    = &i18n.Message{ID: "Rule00023Message", Other: "主键包含的列数不建议超过阈值. 阈值: %v"}
-	Rule00023Params1    = &i18n.Message{ID: "Rule00023Params1", Other: "最大列数"}
-	Rule00025Desc       = &i18n.Message{ID: "Rule00025Desc", Other: "建议时间类型的列添加默认值"}
-	Rule00025Annotation = &i18n.Message{ID: "Rule00025Annotation", Other: "时间类型的字段添加默认值，可避免在数据写入未指定该字段值时，字段内容出现全为0的日期格式或者NULL值，与实际业务预期不符。"}
-	Rule00025Message    = &i18n.Message{ID: "Rule00025Message", Other: "建议时间类型的列添加默认值. 不符合规定的字段: %v"}
-	Rule00026Desc       = &i18n.Message{ID: "Rule00026Desc", Other: "整数字段建议指定最大显示宽度"}
-	Rule00026Annotation = &i18n.Message{ID: "Rule00026Annotation", Other: "在表结构定义中，整数字段定义指定了最大显示宽度，可以体现业务对该字段的数据存储预期；同时保持了字段定义的一致性，减少在数据库之间迁移时需要修改字段长度的工作量。"}
-	Rule00026Message    = &i18n.Message{ID: "Rule00026Message", Other: "整数字段建议指定最大显示宽度"}
-	Rule00027Desc       = &i18n.Message{ID: "Rule00027Desc", Other: "列建议添加注释"}
-	Rule00027Annotation = &i18n.Message{ID: "Rule00027Annotation", Other: "列添加注释能够使列的意义更明确，方便日后的维护"}
-	Rule00027Message    = &i18n.Message{ID: "Rule00027Message", Other: "列建议添加注释. 不符合规定的字段: %v"}
-	Rule00029Desc       = &i18n.Message{ID: "Rule00029Desc", Other: "禁止使用存储过程"}
-	Rule00029Annotation = &i18n.Message{ID: "Rule00029Annotation", Other: "存储过程在一定程度上能使程序难以调试和拓展，各种数据库端的存储过程语法相差很大，给将来的数据移植带来很大的困难，且会极大的出现BUG的几率"}
-	Rule00029Message    = &i18n.Message{ID: "Rule00029Message", Other: "禁止使用存储过程"}
-	Rule00030Desc       = &i18n.Message{ID: "Rule00030Desc", Other: "禁止使用触发器"}
-	Rule00030Annotation = &i18n.Message{ID: "Rule00030Annotation", Other: "触发器难以开发和维护，不能高效移植，且在复杂的逻辑以及高并发下，容易出现死锁影响业务。"}
-	Rule00030Message    = &i18n.Message{ID: "Rule00030Message", Other: "禁止使用触发器"}
-	Rule00031Desc       = &i18n.Message{ID: "Rule00031Desc", Other: "禁止使用视图"}
-	Rule00031Annotation = &i18n.Message{ID: "Rule00031Annotation", Other: "视图的查询性能较差，同时基表结构变更，需要对视图进行维护。如果视图可读性差，且包含复杂的逻辑，会增加维护的成本。"}
-	Rule00031Message    = &i18n.Message{ID: "Rule00031Message", Other: "禁止使用视图"}
-	Rule00032Desc       = &i18n.Message{ID: "Rule00032Desc", Other: "数据库名称必须使用固定后缀结尾"}
-	Rule00032Annotation = &i18n.Message{ID: "Rule00032Annotation", Other: "通过配置该规则可以规范指定业务的数据库命名规则，具体命名规范可以自定义设置。"}
-	Rule00032Message    = &i18n.Message{ID: "Rule00032Message", Other: "数据库名称必须使用固定后缀结尾:%s"}
-	Rule00032Params1    = &i18n.Message{ID: "Rule00032Params1", Other: "固定后缀"}
-	Rule00033Desc       = &i18n.Message{ID: "Rule00033Desc", Other: "建表DDL必须包括更新时间字段，并应设置该字段为自动根据其他字段内容的变更进行更新。"}
-	Rule00033Annotation = &i18n.Message{ID: "Rule00033Annotation", Other: "使用更新时间字段，有利于问题查找跟踪和检索数据，同时避免后期对数据生命周期管理不便，可保证时间的准确性"}
-	Rule00033Message    = &i18n.Message{ID: "Rule00033Message", Other: "建表DDL必须包括更新时间字段，并应设置该字段为自动根据其他字段内容的变更进行更新。更新时间字段名: %v"}
-	Rule00033Params1    = &i18n.Message{ID: "Rule00033Params1", Other: "更新时间字段名"}
-	Rule00034Desc       = &i18n.Message{ID: "Rule00034Desc", Other: "字段约束为NOT NULL时必须带默认值"}
-	Rule00034Annotation = &i18n.Message{ID: "Rule00034Annotation", Other: "如存在NOT NULL且不带默认值的字段，对字段进行写入时不包含该字段，会导致插入报错"}
-	Rule00034Message    = &i18n.Message{ID: "Rule00034Message", Other: "字段约束为NOT NULL时必须带默认值"}
-	Rule00035Desc       = &i18n.Message{ID: "Rule00035Desc", Other: "DDL语句中不建议使用中文全角引号"}
-	Rule00035Annotation = &i18n.Message{ID: "Rule00035Annotation", Other: "建议开启此规则，可避免MySQL会将中文全角引号识别为命名的一部分，执行结果与业务预期不符"}
-	Rule00035Message    = &i18n.Message{ID: "Rule00035Message", Other: "DDL语句中不建议使用中文全角引号"}
-	Rule00037Desc       = &i18n.Message{ID: "Rule00037Desc", Other: "避免一张表内二级索引的个数过多"}
-	Rule00037Annotation = &i18n.Message{ID: "Rule00037Annotation", Other: "在表上建立的每个索引都会增加存储开销，索引对于插入、删除、更新操作也会增加维护索引处理上的开销（TPS），且太多与不充分、不正确的索引对性能都毫无益处。"}
-	Rule00037Message    = &i18n.Message{ID: "Rule00037Message", Other: "避免一张表内二级索引的个数过多"}
-	Rule00037Params1    = &i18n.Message{ID: "Rule00037Params1", Other: "二级索引个数"}
-	Rule00039Desc       = &i18n.Message{ID: "Rule00039Desc", Other: "建议使用数据区分度高的索引字段"}
-	Rule00039Annotation = &i18n.Message{ID: "Rule00039Annotation", Other: "为了提高查询效率，建议在执行SQL时优先使用区分度高的索引字段。区分度高的索引可以更快地定位数据，减少不必要的数据扫描，从而加速查询响应时间。规则检查将会计算候选索引字段的区分度，如果索引的区分度低于设定的阈值，则建议调整索引策略。"}
-	Rule00039Message    = &i18n.Message{ID: "Rule00039Message", Other: "索引列 %v 未超过区分度阈值 %v, 建议使用数据区分度高的索引字段"}
-	Rule00039Params1    = &i18n.Message{ID: "Rule00039Params1", Other: "区分度"}
-	Rule00040Desc       = &i18n.Message{ID: "Rule00040Desc", Other: "普通索引必须使用固定前缀"}
-	Rule00040Annotation = &i18n.Message{ID: "Rule00040Annotation", Other: "通过配置该规则可以规范指定业务的普通索引命名规则，具体命名规范可以自定义设置。"}
-	Rule00040Message    = &i18n.Message{ID: "Rule00040Message", Other: "普通索引必须使用固定前缀"}
-	Rule00040Params1    = &i18n.Message{ID: "Rule00040Params1", Other: "固定前缀"}
-	Rule00041Desc       = &i18n.Message{ID: "Rule00041Desc", Other: "唯一索引必须使用固定前缀"}
-	Rule00041Annotation = &i18n.Message{ID: "Rule00041Annotation", Other: "通过配置该规则可以规范指定业务的唯一索引命名规则，具体命名规范可以自定义设置。"}
-	Rule00041Message    = &i18n.Message{ID: "Rule00041Message", Other: "唯一索引必须使用固定前缀"}
-	Rule00041Params1    = &i18n.Message{ID: "Rule00041Params1", Other: "固定前缀"}
-	Rule00042Desc       = &i18n.Message{ID: "Rule00042Desc", Other: "临时表必须使用固定前缀"}
-	Rule00042Annotation = &i18n.Message{ID: "Rule00042Annotation", Other: "统一命名规范，有利于后期维护以及业务开发"}
-	Rule00042Message    = &i18n.Message{ID: "Rule00042Message", Other: "临时表必须使用固定前缀"}
-	Rule00042Params1    = &i18n.Message{ID: "Rule00042Params1", Other: "固定前缀"}
-	Rule00043Desc       = &i18n.Message{ID: "Rule00043Desc", Other: "避免表内同一字段上存在过多索引"}
-	Rule00043Annotation = &i18n.Message{ID: "Rule00043Annotation", Other: "一个表内同一字段上存在过多索引，一般情况下这些索引都是没有存在价值的；相反，还会降低数据增加删除时的性能，特别是对频繁更新的表来说，负面影响更大；具体规则阈值可以根据业务需求调整，默认值：2"}
-	Rule00043Message    = &i18n.Message{ID: "Rule00043Message", Other: "避免表内同一字段上存在过多索引. 字段 %v 上的索引数量不建议超过%v个"}
-	Rule00043Params1    = &i18n.Message{ID: "Rule00043Params1", Other: "单字段的索引数最大值"}
-	Rule00045Desc       = &i18n.Message{ID: "Rule00045Desc", Other: "避免在分页查询中使用过大偏移量"}
-	Rule00045Annotation = &i18n.Message{ID: "Rule00045Annotation", Other: "在数据库中，分页查询通常使用 LIMIT 和 OFFSET 语句进行。当数据量较大时，使用大的偏移量（OFFSET）进行分页查询可能会导致性能下降，因为数据库需要跳过大量的行来获得所需的结果集。"}
-	Rule00045Message    = &i18n.Message{ID: "Rule00045Message", Other: "避免在分页查询中使用过大偏移量, 最大偏移量:%v"}
-	Rule00045Params1    = &i18n.Message{ID: "Rule00045Params1", Other: "最大偏移量"}
-	Rule00046Desc       = &i18n.Message{ID: "Rule00046Desc", Other: "数据库对象命名不建议大小写字母混合"}
-	Rule00046Annotation = &i18n.Message{ID: "Rule00046Annotation", Other: "数据库对象命名规范，不推荐采用大小写混用的形式建议词语之间使用下划线连接，提高代码可读性"}
-	Rule00046Message    = &i18n.Message{ID: "Rule00046Message", Other: "数据库对象命名不建议大小写字母混合"}
-	Rule00047Desc       = &i18n.Message{ID: "Rule00047Desc", Other: "数据库对象名称的字符个数不建议超过阈值"}
-	Rule00047Annotation = &i18n.Message{ID: "Rule00047Annotation", Other: "通过配置该规则可以规范指定业务的对象命名长度，具体长度可以自定义设置。"}
-	Rule00047Message    = &i18n.Message{ID: "Rule00047Message", Other: "数据库对象名称的字符个数不建议超过阈值:%v"}
-	Rule00047Params1    = &i18n.Message{ID: "Rule00047Params1", Other: "字符个数"}
-	Rule00048Desc       = &i18n.Message{ID: "Rule00048Desc", Other: "数据库对象命名只能使用英文、下划线或数字，首字母必须是英文"}
-	Rule00048Annotation = &i18n.Message{ID: "Rule00048Annotation", Other: "遵循良好的命名约定和避免特殊字符的使用，可以提高代码的可读性、可维护性，并减少潜在的兼容性和语法问题。"}
-	Rule00048Message    = &i18n.Message{ID: "Rule00048Message", Other: "数据库对象命名只能使用英文、下划线或数字，首字母必须是英文"}
-	Rule00049Desc       = &i18n.Message{ID: "Rule00049Desc", Other: "数据库对象命名禁止使用保留字"}
-	Rule00049Annotation = &i18n.Message{ID: "Rule00049Annotation", Other: "通过配置该规则可以规范指定业务的数据对象命名规则，避免发生冲突，以及混淆"}
-	Rule00049Message    = &i18n.Message{ID: "Rule00049Message", Other: "数据库对象命名禁止使用保留字"}
-	Rule00051Desc       = &i18n.Message{ID: "Rule00051Desc", Other: "禁止主键使用自增"}
-	Rule00051Annotation = &i18n.Message{ID: "Rule00051Annotation", Other: "后期维护相对不便，过于依赖数据库自增机制达到全局唯一，不易拆分，容易造成主键冲突"}
-	Rule00051Message    = &i18n.Message{ID: "Rule00051Message", Other: "禁止主键使用自增"}
-	Rule00052Desc       = &i18n.Message{ID: "Rule00052Desc", Other: "建议主键使用自增"}
-	Rule00052Annotation = &i18n.Message{ID: "Rule00052Annotation", Other: "自增主键通常为数字类型，其数据写入速度快，占用的存储空间小。自增主键保证了数据的有序性，减少了页分裂的频率，并简化了应用层的数据写入逻辑。"}
-	Rule00052Message    = &i18n.Message{ID: "Rule00052Message", Other: "建议主键使用自增"}
-	Rule00053Desc       = &i18n.Message{ID: "Rule00053Desc", Other: "不建议使用SELECT *"}
-	Rule00053Annotation = &i18n.Message{ID: "Rule00053Annotation", Other: "当表结构变更时，使用*通配符选择所有列将导致查询行为会发生更改，与业务期望不符；同时SELECT * 中的无用字段会带来不必要的磁盘I/O，以及网络开销，且无法覆盖索引进而回表，大幅度降低查询效率。"}
-	Rule00053Message    = &i18n.Message{ID: "Rule00053Message", Other: "不建议使用SELECT *"}
-	Rule00054Desc       = &i18n.Message{ID: "Rule00054Desc", Other: "建议主键字段使用BIGINT时采用无符号的BIGINT"}
-	Rule00054Annotation = &i18n.Message{ID: "Rule00054Annotation", Other: "在设计主键时若选择BIGINT时，使用无符号类型，相对于有符号类型，可以使数据库的索引性能更加优化，因为它减少了负值处理的开销，并能在某些情况下提高查询速度。特别是在系统设计初期可能无法完全预见到未来数据量的情况下，无符号数值类型（BIGINT UNSIGNED）可以有效避免因数据增长导致的溢出问题。"}
-	Rule00054Message    = &i18n.Message{ID: "Rule00054Message", Other: "建议主键字段使用BIGINT时采用无符号的BIGINT"}
-	Rule00055Desc       = &i18n.Message{ID: "Rule00055Desc", Other: "不建议创建冗余索引"}
-	Rule00055Annotation = &i18n.Message{ID: "Rule00055Annotation", Other: "MySQL需要单独维护重复的索引，冗余索引增加维护成本，影响更新性能"}
-	Rule00055Message    = &i18n.Message{ID: "Rule00055Message", Other: "已存在索引 %v , 索引 %v 为冗余索引"}
-	Rule00056Desc       = &i18n.Message{ID: "Rule00056Desc", Other: "表建议使用指定的字符集"}
-	Rule00056Annotation = &i18n.Message{ID: "Rule00056Annotation", Other: "数据库内使用非标准的字符集，可能导致字符无法编码或者编码不全引起的乱码，最终出现应用写入数据失败或者查询结果显示乱码，影响数据库服务可用性。"}
-	Rule00056Message    = &i18n.Message{ID: "Rule00056Message", Other: "表建议使用指定的字符集: %v"}
-	Rule00056Params1    = &i18n.Message{ID: "Rule00056Params1", Other: "标准字符集"}
-	Rule00057Desc       = &i18n.Message{ID: "Rule00057Desc", Other: "必须使用INNODB数据库引擎"}
-	Rule00057Annotation = &i18n.Message{ID: "Rule00057Annotation", Other: "INNODB 支持事务，支持行级锁，更好的恢复性，高并发下性能更好。"}
-	Rule00057Message    = &i18n.Message{ID: "Rule00057Message", Other: "必须使用INNODB数据库引擎"}
-	Rule00058Desc       = &i18n.Message{ID: "Rule00058Desc", Other: "避免使用分区表相关功能"}
-	Rule00058Annotation = &i18n.Message{ID: "Rule00058Annotation", Other: "分区表在使用过程中存在诸多缺点，比如分区裁剪的不确定性、不支持全局分区索引、锁定粒度放大、分区前期规划较为繁杂等问题。如存在分区诉求，通常使用物理分表，即可避免分区表带来的缺点。"}
-	Rule00058Message    = &i18n.Message{ID: "Rule00058Message", Other: "避免使用分区表相关功能"}
-	Rule00059Desc       = &i18n.Message{ID: "Rule00059Desc", Other: "禁止修改大表的字段类型"}
-	Rule00059Annotation = &i18n.Message{ID: "Rule00059Annotation", Other: "对于大型数据表，修改字段类型的DDL操作将导致显著的性能下降和可用性影响。此类操作通常需要复制整个表来更改数据类型，期间表将无法进行写操作，并且可能导致长时间的锁等待，对线上业务造成过长时间的影响。"}
-	Rule00059Message    = &i18n.Message{ID: "Rule00059Message", Other: "禁止修改大表的字段类型，表大小阈值: %v GB"}
-	Rule00059Params1    = &i18n.Message{ID: "Rule00059Params1", Other: "表大小(GB)"}
-	Rule00060Desc       = &i18n.Message{ID: "Rule00060Desc", Other: "表建议添加注释"}
-	Rule00060Annotation = &i18n.Message{ID: "Rule00060Annotation", Other: "表添加注释能够使表的意义更明确，方便日后的维护"}
-	Rule00060Message    = &i18n.Message{ID: "Rule00060Message", Other: "表建议添加注释"}
-	Rule00061Desc       = &i18n.Message{ID: "Rule00061Desc", Other: "建议新建表句子中包含表存在判断操作"}
-	Rule00061Annotation = &i18n.Message{ID: "Rule00061Annotation", Other: "新建表如果已经存在，不加 IF NOT EXISTS 会报错。新建表只在表不存在的前提下进行，避免SQL 实际执行报错。"}
-	Rule00061Message    = &i18n.Message{ID: "Rule00061Message", Other: "建议新建表句子中包含表存在判断操作"}
-	Rule00062Desc       = &i18n.Message{ID: "Rule00062Desc", Other: "建议事务隔离级别设置成RC"}
-	Rule00062Annotation = &i18n.Message{ID: "Rule00062Annotation", Other: "RC 虽然没有解决幻读的问题，但是没有间隙锁，从而每次在做更新操作时影响的行数比默认RR要小很多；默认的RR隔离级别虽然解决了幻读问题，但是增加了间隙锁，导致加锁的范围扩大，性能比RC要低，增加死锁的概率；在大多数情况下，出现幻读的几率较小，所以建议使用RC。"}
-	Rule00062Message    = &i18n.Message{ID: "Rule00062Message", Other: "建议事务隔离级别设置成RC"}
-	Rule00063Desc       = &i18n.Message{ID: "Rule00063Desc", Other: "唯一索引名必须遵循指定格式"}
-	Rule00063Annotation = &i18n.Message{ID: "Rule00063Annotation", Other: "通过配置该规则可以规范指定业务的唯一索引命名规则，如索引字段存在多个，则可以拼接字段名，不要超过索引名长度即可。"}
-	Rule00063Message    = &i18n.Message{ID: "Rule00063Message", Other: "唯一索引名必须遵循指定格式"}
-	Rule00063Params1    = &i18n.Message{ID: "Rule00063Params1", Other: "索引命名格式"}
-	Rule00064Desc       = &i18n.Message{ID: "Rule00064Desc", Other: "不建议索引字段是VARCHAR类型时其长度大于阈值"}
-	Rule00064Annotation = &i18n.Message{ID: "Rule00064Annotation", Other: "建立索引时没有限制索引的大小，索引长度会根据该字段实际存储的值来计算，VARCHAR 定义的长度越长，导致业务写入的内容越多，则建立的索引其存储大小将会越大。"}
-	Rule00064Message    = &i18n.Message{ID: "Rule00064Message", Other: "不建议索引字段是VARCHAR类型时其长度大于阈值. 不符合规则的字段: %v"}
-	Rule00064Params1    = &i18n.Message{ID: "Rule00064Params1", Other: "VARCHAR最大长度"}
-	Rule00065Desc       = &i18n.Message{ID: "Rule00065Desc", Other: "禁止修改表时指定或调整字段在表结构中的顺序"}
-	Rule00065Annotation = &i18n.Message{ID: "Rule00065Annotation", Other: "FIRST 和 AFTER 关键词在 ALTER TABLE 语句中用于调整字段的顺序，这种操作会改变表字段的物理顺序，可能导致依赖默认列顺序的业务SQL出现错误，影响数据的一致性和业务的稳定性。"}
-	Rule00065Message    = &i18n.Message{ID: "Rule00065Message", Other: "禁止修改表时指定或调整字段在表结构中的顺序"}
-	Rule00066Desc       = &i18n.Message{ID: "Rule00066Desc", Other: "禁止除索引外的DROP 操作"}
-	Rule00066Annotation = &i18n.Message{ID: "Rule00066Annotation", Other: "DROP 操作是数据定义语言（DDL）的一部分，一旦执行，将导致无法恢复的数据或结构丢失。在不恰当的情况下执行DROP操作可能导致数据丢失、系统功能缺失甚至业务中断。"}
-	Rule00066Message    = &i18n.Message{ID: "Rule00066Message", Other: "禁止除索引外的DROP 操作"}
-	Rule00067Desc       = &i18n.Message{ID: "Rule00067Desc", Other: "表不建议使用外键"}
-	Rule00067Annotation = &i18n.Message{ID: "Rule00067Annotation", Other: "外键在大量写入场景下性能较差，强烈禁止使用"}
-	Rule00067Message    = &i18n.Message{ID: "Rule00067Message", Other: "表不建议使用外键"}
-	Rule00068Desc       = &i18n.Message{ID: "Rule00068Desc", Other: "禁止使用TIMESTAMP字段"}
-	Rule00068Annotation = &i18n.Message{ID: "Rule00068Annotation", Other: "TIMESTAMP类型字段受制于2038年问题，其时间范围仅限于1970-01-01 00:00:01 UTC至2038-01-19 03:14:07 UTC。超过这个时间范围，TIMESTAMP将无法存储更晚的时间点，导致应用报错。此外，TIMESTAMP字段在存储时会根据数据库服务器的时区进行转换，这可能导致跨时区应用中的时间不一致问题。"}
-	Rule00068Message    = &i18n.Message{ID: "Rule00068Message", Other: "禁止使用TIMESTAMP字段"}
-	Rule00071Desc       = &i18n.Message{ID: "Rule00071Desc", Other: "禁止进行删除列的操作"}
-	Rule00071Annotation = &i18n.Message{ID: "Rule00071Annotation", Other: "在业务系统中，表的列往往与多个业务流程紧密关联。删除表中的列可能导致与该列相关的数据访问、数据处理和数据表示逻辑出现故障，因为相关的SQL语句、应用程序代码或报告工具可能还在尝试访问已删除的列。"}
-	Rule00071Message    = &i18n.Message{ID: "Rule00071Message", Other: "禁止进行删除列的操作"}
-	Rule00072Desc       = &i18n.Message{ID: "Rule00072Desc", Other: "禁止删除外键"}
-	Rule00072Annotation = &i18n.Message{ID: "Rule00072Annotation", Other: "外键的存在是为了维持数据之间的引用完整性，确保数据的一致性。删除外键，可能导致依赖于这些外键约束的业务逻辑出现故障或数据完整性问题。"}
-	Rule00072Message    = &i18n.Message{ID: "Rule00072Message", Other: "禁止删除外键"}
-	Rule00073Desc       = &i18n.Message{ID: "Rule00073Desc", Other: "不建议修改表的默认字符集"}
-	Rule00073Annotation = &i18n.Message{ID: "Rule00073Annotation", Other: "修改表的默认字符集，只会影响后续新增的字段，不会修改表已有字段的字符集，最终可能会出现表与字段的字符集不一致，引发数据查询出现乱码以及索引失效情况。"}
-	Rule00073Message    = &i18n.Message{ID: "Rule00073Message", Other: "不建议修改表的默认字符集"}
-	Rule00074Desc       = &i18n.Message{ID: "Rule00074Desc", Other: "禁止对表名字或段名进行重命名"}
-	Rule00074Annotation = &i18n.Message{ID: "Rule00074Annotation", Other: "对表名或列名进行重命名操作会直接影响线上业务的稳定性和连续性。这种操作可能引起与表或列相关的SQL语句执行失败，导致业务中断。"}
-	Rule00074Message    = &i18n.Message{ID: "Rule00074Message", Other: "禁止对表名字或段名进行重命名"}
-	Rule00075Desc       = &i18n.Message{ID: "Rule00075Desc", Other: "建议列与表使用同一个字符集"}
-	Rule00075Annotation = &i18n.Message{ID: "Rule00075Annotation", Other: "统一字符集可以避免由于字符集转换产生的乱码，不同的字符集进行比较前需要进行转换会造成索引失效"}
-	Rule00075Message    = &i18n.Message{ID: "Rule00075Message", Other: "建议列与表使用同一个字符集. 不符合规则的字段: %v"}
-	Rule00076Desc       = &i18n.Message{ID: "Rule00076Desc", Other: "UPDATE/DELETE操作影响行数不建议超过阈值"}
-	Rule00076Annotation = &i18n.Message{ID: "Rule00076Annotation", Other: "在数据库中，进行修改或删除等数据变更操作时，一次性操作的数据量过大，会消耗大量的系统资源，产生长事务，会导致查询性能下降，影响其他事务或查询的执行。"}
-	Rule00076Message    = &i18n.Message{ID: "Rule00076Message", Other: "UPDATE/DELETE操作影响行数不建议超过阈值"}
-	Rule00076Params1    = &i18n.Message{ID: "Rule00076Params1", Other: "影响行数上限"}
-	Rule00078Desc       = &i18n.Message{ID: "Rule00078Desc", Other: "禁止使用聚合函数"}
-	Rule00078Annotation = &i18n.Message{ID: "Rule00078Annotation", Other: "禁止使用SQL聚合函数是为了确保查询的简单性、高性能和数据一致性。"}
-	Rule00078Message    = &i18n.Message{ID: "Rule00078Message", Other: "禁止使用聚合函数"}
-	Rule00079Desc       = &i18n.Message{ID: "Rule00079Desc", Other: "别名不建议与表或列的名字相同"}
-	Rule00079Annotation = &i18n.Message{ID: "Rule00079Annotation", Other: "表或列的别名与其真实名称相同, 这样的别名会使得查询更难去分辨"}
-	Rule00079Message    = &i18n.Message{ID: "Rule00079Message", Other: "别名不建议与表或列的名字相同"}
-	Rule00080Desc       = &i18n.Message{ID: "Rule00080Desc", Other: "建议单条SQL写入数据的行数不超过阈值"}
-	Rule00080Annotation = &i18n.Message{ID: "Rule00080Annotation", Other: "为了避免单个SQL语句在批量写入时对数据库性能造成过大压力，限制每条SQL语句一次性插入的数据行数不得超过指定行。这有助于提高事务的可管理性，减少锁冲突，优化日志处理，以及提升错误恢复速度。"}
-	Rule00080Message    = &i18n.Message{ID: "Rule00080Message", Other: "建议单条SQL写入数据的行数不超过阈值"}
-	Rule00080Params1    = &i18n.Message{ID: "Rule00080Params1", Other: "单条SQL写入行数上限"}
-	Rule00082Desc       = &i18n.Message{ID: "Rule00082Desc", Other: "禁止使用文件排序"}
-	Rule00082Annotation = &i18n.Message{ID: "Rule00082Annotation", Other: "大数据量的情况下，文件排序意味着SQL性能较低，会增加OS的开销，影响数据库性能。"}
-	Rule00082Message    = &i18n.Message{ID: "Rule00082Message", Other: "禁止使用文件排序"}
-	Rule00083Desc       = &i18n.Message{ID: "Rule00083Desc", Other: "不建议对表进行索引跳跃扫描"}
-	Rule00083Annotation = &i18n.Message{ID: "Rule00083Annotation", Other: "索引扫描是跳跃扫描，未遵循最左匹配原则，可能降低索引的使用效率，影响查询性能，尽量避免使用。"}
-	Rule00083Message    = &i18n.Message{ID: "Rule00083Message", Other: "不建议对表进行索引跳跃扫描"}
-	Rule00084Desc       = &i18n.Message{ID: "Rule00084Desc", Other: "不建议使用临时表"}
-	Rule00084Annotation = &i18n.Message{ID: "Rule00084Annotation", Other: "大数据量的情况下，临时表意味着SQL性能较低，会增加OS的开销，影响数据库性能"}
-	Rule00084Message    = &i18n.Message{ID: "Rule00084Message", Other: "不建议使用临时表"}
-	Rule00085Desc       = &i18n.Message{ID: "Rule00085Desc", Other: "不建议对表进行全索引扫描"}
-	Rule00085Annotation = &i18n.Message{ID: "Rule00085Annotation", Other: "在数据量大的情况下索引全扫描严重影响SQL性能；一次性大数据量获取的场景较少，业务层需要考虑当前SQL的合理性，是否缺少过滤条件以及限制条数。"}
-	Rule00085Message    = &i18n.Message{ID: "Rule00085Message", Other: "不建议对表进行全索引扫描"}
-	Rule00086Desc       = &i18n.Message{ID: "Rule00086Desc", Other: "禁止使用子字符串匹配或后缀匹配搜索"}
-	Rule00086Annotation = &i18n.Message{ID: "Rule00086Annotation", Other: "使用子字符串匹配搜索或后缀匹配搜索将导致查询无法使用索引，导致全表扫描"}
-	Rule00086Message    = &i18n.Message{ID: "Rule00086Message", Other: "禁止使用子字符串匹配或后缀匹配搜索"}
-	Rule00087Desc       = &i18n.Message{ID: "Rule00087Desc", Other: "避免WHERE条件内IN语句中的参数值个数过多"}
-	Rule00087Annotation = &i18n.Message{ID: "Rule00087Annotation", Other: "当IN值过多时，有可能会出现无法使用索引，导致查询走全表扫描、性能变差、资源消耗过多等问题。"}
-	Rule00087Message    = &i18n.Message{ID: "Rule00087Message", Other: "避免WHERE条件内IN语句中的参数值个数过多"}
-	Rule00087Params1    = &i18n.Message{ID: "Rule00087Params1", Other: "IN的参数值个数"}
-	Rule00088Desc       = &i18n.Message{ID: "Rule00088Desc", Other: "INSERT 语句必须指定COLUMN"}
-	Rule00088Annotation = &i18n.Message{ID: "Rule00088Annotation", Other: "当表结构发生变更，INSERT请求不明确指定列名，会发生插入数据不匹配的情况；建议开启此规则，避免插入结果与业务预期不符"}
-	Rule00088Message    = &i18n.Message{ID: "Rule00088Message", Other: "INSERT 语句必须指定COLUMN"}
-	Rule00089Desc       = &i18n.Message{ID: "Rule00089Desc", Other: "禁止INSERT ... SELECT"}
-	Rule00089Annotation = &i18n.Message{ID: "Rule00089Annotation", Other: "使用 INSERT ... SELECT 在默认事务隔离级别下，可能会导致对查询的表施加表级锁"}
-	Rule00089Message    = &i18n.Message{ID: "Rule00089Message", Other: "禁止INSERT ... SELECT"}
-	Rule00090Desc       = &i18n.Message{ID: "Rule00090Desc", Other: "建议使用UNION ALL替代UNION"}
-	Rule00090Annotation = &i18n.Message{ID: "Rule00090Annotation", Other: "union会对结果集进行去重，union all只是简单的将两个结果合并后就返回，从效率上看，union all 要比union快很多；如果合并的两个结果集中允许包含重复数据的话，建议开启此规则，使用union all替代union"}
-	Rule00090Message    = &i18n.Message{ID: "Rule00090Message", Other: "建议使用UNION ALL替代UNION"}
-	Rule00091Desc       = &i18n.Message{ID: "Rule00091Desc", Other: "建议表连接时有连接条件"}
-	Rule00091Annotation = &i18n.Message{ID: "Rule00091Annotation", Other: "为了确保连接操作的正确性和可靠性，应该始终指定连接条件，定义正确的关联关系。缺少连接条件，可能导致连接操作失败，最终数据库会使用笛卡尔积的方式进行处理，产生不正确的连接结果，并导致性能问题，消耗大量的CPU和内存资源。"}
-	Rule00091Message    = &i18n.Message{ID: "Rule00091Message", Other: "建议表连接时有连接条件"}
-	Rule00092Desc       = &i18n.Message{ID: "Rule00092Desc", Other: "建议DELETE/UPDATE语句使用LIMIT子句控制影响行数"}
-	Rule00092Annotation = &i18n.Message{ID: "Rule00092Annotation", Other: "在进行DELETE和UPDATE操作时，通过添加LIMIT子句可以明确限制操作影响的数据行数。这样做有助于减少由于执行错误而导致的数据损失风险，并可以有效地控制长事务的执行时间，降低对数据库性能的影响。"}
-	Rule00092Message    = &i18n.Message{ID: "Rule00092Message", Other: "建议DELETE/UPDATE语句使用LIMIT子句控制影响行数"}
-	Rule00094Desc       = &i18n.Message{ID: "Rule00094Desc", Other: "避免使用不必要的内置函数"}
-	Rule00094Annotation = &i18n.Message{ID: "Rule00094Annotation", Other: "通过配置该规则可以指定业务中需要禁止使用的内置函数，使用内置函数可能会导致SQL无法走索引或者产生一些非预期的结果。实际需要禁用的函数可通过规则设置。"}
-	Rule00094Message    = &i18n.Message{ID: "Rule00094Message", Other: "避免使用不必要的内置函数：%v"}
-	Rule00094Params1    = &i18n.Message{ID: "Rule00094Params1", Other: "函数名"}
-	Rule00095Desc       = &i18n.Message{ID: "Rule00095Desc", Other: "建议使用'<>'代替'!='"}
-	Rule00095Annotation = &i18n.Message{ID: "Rule00095Annotation", Other: "'<>' 是ANSI SQL标准中定义的不等于运算符。如果使用了!=运算符，数据库优化器会自动转换为SQL标准不等于运算符，增加了优化器的转换开销；另外，目前并非所有的SQL数据库系统都支持 !=，使用标准的运算符可以确保SQL在各数据库之间具有更高的兼容性。"}
-	Rule00095Message    = &i18n.Message{ID: "Rule00095Message", Other: "建议使用'<>'代替'!='"}
-	Rule00096Desc       = &i18n.Message{ID: "Rule00096Desc", Other: "不建议参与连接操作的表数量过多"}
-	Rule00096Annotation = &i18n.Message{ID: "Rule00096Annotation", Other: "表关联越多，意味着各种驱动关系组合就越多，比较各种结果集的执行成本的代价也就越高，进而SQL查询性能会大幅度下降。"}
-	Rule00096Message    = &i18n.Message{ID: "Rule00096Message", Other: "不建议参与连接操作的表数量过多"}
-	Rule00096Params1    = &i18n.Message{ID: "Rule00096Params1", Other: "参与表连接的表个数"}
-	Rule00097Desc       = &i18n.Message{ID: "Rule00097Desc", Other: "禁止对长字段排序"}
-	Rule00097Annotation = &i18n.Message{ID: "Rule00097Annotation", Other: "在MySQL数据库中，对长字段（如VARCHAR(2000)、TEXT、BLOB等）进行排序操作（包括但不限于ORDER BY、DISTINCT、GROUP BY、UNION等）是不推荐的实践。这类操作会导致排序缓冲区（sort_buffer_size）溢出，引发性能下降和资源浪费。此外，由于长字段排序可能导致临时表（使用Temptable引擎）溢出到磁盘，这不仅会严重影响查询性能，还可能导致系统稳定性和响应能力的降低。"}
-	Rule00097Message    = &i18n.Message{ID: "Rule00097Message", Other: "禁止对长字段排序"}
-	Rule00097Params1    = &i18n.Message{ID: "Rule00097Params1", Other: "排序字段的最大长度"}
-	Rule00098Desc       = &i18n.Message{ID: "Rule00098Desc", Other: "避免对同一张表进行多次连接或查询。"}
-	Rule00098Annotation = &i18n.Message{ID: "Rule00098Annotation", Other: "在设计SQL语句时，应避免对同一张表进行多次连接或查询。这种做法可能导致查询性能显著下降，因为它会增加数据库的I/O操作，CPU处理以及内存使用，从而影响整体查询效率。"}
-	Rule00098Message    = &i18n.Message{ID: "Rule00098Message", Other: "避免对同一张表进行多次连接或查询。违反规则的表名: %s"}
-	Rule00098Params1    = &i18n.Message{ID: "Rule00098Params1", Other: "max_table_join_count"}
-	Rule00099Desc       = &i18n.Message{ID: "Rule00099Desc", Other: "不建议在SELECT语句中存在FOR UPDATE操作"}
-	Rule00099Annotation = &i18n.Message{ID: "Rule00099Annotation", Other: "SELECT FOR UPDATE 会对查询结果集中每行数据都添加排他锁，其他线程对该记录的更新与删除操作都会阻塞，在高并发下，容易造成数据库大量锁等待，影响数据库查询性能"}
-	Rule00099Message    = &i18n.Message{ID: "Rule00099Message", Other: "不建议在SELECT语句中存在FOR UPDATE操作"}
-	Rule00100Desc       = &i18n.Message{ID: "Rule00100Desc", Other: "避免SELECT语句一次性返回的结果过多"}
-	Rule00100Annotation = &i18n.Message{ID: "Rule00100Annotation", Other: "如果查询的扫描行数很大，会导致IO、网络资源消耗过大，并且可能会导致优化器选择错误的执行计划而不走索引。"}
-	Rule00100Message    = &i18n.Message{ID: "Rule00100Message", Other: "避免SELECT语句一次性返回的结果过多"}
-	Rule00100Params1    = &i18n.Message{ID: "Rule00100Params1", Other: "结果集返回行数"}
-	Rule00101Desc       = &i18n.Message{ID: "Rule00101Desc", Other: "不建议针对大表执行SELECT 语句时存在ORDER BY操作"}
-	Rule00101Annotation = &i18n.Message{ID: "Rule00101Annotation", Other: "在大表的情况下，数据查询中的ORDER BY 操作对查询性能影响较大，建议将排序部分放到业务处理或者限定查询数据的范围。"}
-	Rule00101Message    = &i18n.Message{ID: "Rule00101Message", Other: "不建议针对大表执行SELECT 语句时存在ORDER BY操作"}
-	Rule00102Desc       = &i18n.Message{ID: "Rule00102Desc", Other: "禁止UPDATE/DELETE语句使用ORDER BY操作 "}
-	Rule00102Annotation = &i18n.Message{ID: "Rule00102Annotation", Other: "使用ORDER BY子句的UPDATE或DELETE语句会导致不必要的性能开销，影响数据库响应时间，并可能导致锁竞争，从而影响到系统的整体性能和稳定性。"}
-	Rule00102Message    = &i18n.Message{ID: "Rule00102Message", Other: "禁止UPDATE/DELETE语句使用ORDER BY操作 "}
-	Rule00107Desc       = &i18n.Message{ID: "Rule00107Desc", Other: "建议将过长的SQL分解成几个简单的SQL"}
-	Rule00107Annotation = &i18n.Message{ID: "Rule00107Annotation", Other: "过长的SQL可读性较差，难以维护，且容易引发性能问题。"}
-	Rule00107Message    = &i18n.Message{ID: "Rule00107Message", Other: "建议将过长的SQL分解成几个简单的SQL"}
-	Rule00107Params1    = &i18n.Message{ID: "Rule00107Params1", Other: "句子长度限制"}
-	Rule00108Desc       = &i18n.Message{ID: "Rule00108Desc", Other: "避免子查询嵌套层数过多"}
-	Rule00108Annotation = &i18n.Message{ID: "Rule00108Annotation", Other: "子查询嵌套层数超过阈值，有些情况下，子查询并不能使用到索引。同时对于返回结果集比较大的子查询，会产生大量的临时表，消耗过多的CPU和IO资源，产生大量的慢查询"}
-	Rule00108Message    = &i18n.Message{ID: "Rule00108Message", Other: "避免子查询嵌套层数过多"}
-	Rule00108Params1    = &i18n.Message{ID: "Rule00108Params1", Other: "子查询嵌套层数"}
-	Rule00109Desc       = &i18n.Message{ID: "Rule00109Desc", Other: "禁止在子查询中使用LIMIT"}
-	Rule00109Annotation = &i18n.Message{ID: "Rule00109Annotation", Other: "不支持在子查询中进行'LIMIT & IN/ALL/ANY/SOME'，数据库会执行报错。"}
-	Rule00109Message    = &i18n.Message{ID: "Rule00109Message", Other: "禁止在子查询中使用LIMIT"}
-	Rule00110Desc       = &i18n.Message{ID: "Rule00110Desc", Other: "建议为SQL查询条件建立索引"}
-	Rule00110Annotation = &i18n.Message{ID: "Rule00110Annotation", Other: "为SQL查询条件建立索引可以显著提高查询性能，减少I/O操作，并提高查询效率。特别是在处理大数据量的表时，索引可以大幅度缩短查询时间，优化数据库性能。"}
-	Rule00110Message    = &i18n.Message{ID: "Rule00110Message", Other: "建议为SQL查询条件建立索引. 不符合条件的字段有: %v"}
-	Rule00111Desc       = &i18n.Message{ID: "Rule00111Desc", Other: "避免对条件字段使用表达式操作"}
-	Rule00111Annotation = &i18n.Message{ID: "Rule00111Annotation", Other: "对条件字段做表达式操作，可能会破坏索引值的有序性，导致优化器选择放弃走索引，使查询性能大幅度降低"}
-	Rule00111Message    = &i18n.Message{ID: "Rule00111Message", Other: "避免对条件字段使用表达式操作"}
-	Rule00112Desc       = &i18n.Message{ID: "Rule00112Desc", Other: "禁止WHERE子句中条件字段与值的数据类型不一致"}
-	Rule00112Annotation = &i18n.Message{ID: "Rule00112Annotation", Other: "WHERE子句中条件字段与值数据类型不一致会引发隐式数据类型转换，导致优化器选择错误的执行计划，在高并发、大数据量的情况下，不走索引会使得数据库的查询性能严重下降"}
-	Rule00112Message    = &i18n.Message{ID: "Rule00112Message", Other: "禁止WHERE子句中条件字段与值的数据类型不一致"}
-	Rule00113Desc       = &i18n.Message{ID: "Rule00113Desc", Other: "不建议对条件字段使用负向查询"}
-	Rule00113Annotation = &i18n.Message{ID: "Rule00113Annotation", Other: "SQL查询条件中存在NOT、NOT IN、NOT LIKE、NOT EXISTS、不等于等负向查询条件，将导致优化器选择错误的执行计划，导致出现慢SQL。"}
-	Rule00113Message    = &i18n.Message{ID: "Rule00113Message", Other: "不建议对条件字段使用负向查询"}
-	Rule00115Desc       = &i18n.Message{ID: "Rule00115Desc", Other: "避免使用标量子查询"}
-	Rule00115Annotation = &i18n.Message{ID: "Rule00115Annotation", Other: "标量子查询存在多次访问同一张表的问题，执行开销大效率低，可使用JOIN操作或者关联子查询结果集的方式替代标量子查询。"}
-	Rule00115Message    = &i18n.Message{ID: "Rule00115Message", Other: "避免使用标量子查询"}
-	Rule00118Desc       = &i18n.Message{ID: "Rule00118Desc", Other: "建议在执行DROP/TRUNCATE等操作前进行备份"}
-	Rule00118Annotation = &i18n.Message{ID: "Rule00118Annotation", Other: "DROP/TRUNCATE是DDL，操作立即生效，不会写入日志，所以无法回滚，在执行高危操作之前对数据进行备份是很有必要的"}
-	Rule00118Message    = &i18n.Message{ID: "Rule00118Message", Other: "建议在执行DROP/TRUNCATE等操作前进行备份"}
-	Rule00119Desc       = &i18n.Message{ID: "Rule00119Desc", Other: "建议为GROUP BY语句添加ORDER BY条件"}
-	Rule00119Annotation = &i18n.Message{ID: "Rule00119Annotation", Other: "GROUP BY 语句不加ORDER BY，结果将无序或者非预期的排序。建议加上ORDER BY 条件，按照一定的顺序来展示查询结果。"}
-	Rule00119Message    = &i18n.Message{ID: "Rule00119Message", Other: "建议为GROUP BY语句添加ORDER BY条件"}
-	Rule00120Desc       = &i18n.Message{ID: "Rule00120Desc", Other: "避免使用 IN (NULL) 或者 NOT IN (NULL)"}
-	Rule00120Annotation = &i18n.Message{ID: "Rule00120Annotation", Other: "使用 `IN(NULL)` 或 `NOT IN(NULL)` 会导致查询条件永远为假，从而使得查询无法返回任何结果。这不仅影响查询逻辑和结果的准确性，还可能导致性能问题和不必要的资源消耗。"}
-	Rule00120Message    = &i18n.Message{ID: "Rule00120Message", Other: "避免使用 IN (NULL) 或者 NOT IN (NULL)"}
-	Rule00121Desc       = &i18n.Message{ID: "Rule00121Desc", Other: "建议在限定记录数的查询语句中使用ORDER BY"}
-	Rule00121Annotation = &i18n.Message{ID: "Rule00121Annotation", Other: "在限定记录的查询语句中，如果没有ORDER BY子句，每次查询的结果可能会受数据更新影响而出现非确定性的结，最终与业务需求不符。"}
-	Rule00121Message    = &i18n.Message{ID: "Rule00121Message", Other: "建议在限定记录数的查询语句中使用ORDER BY"}
-	Rule00122Desc       = &i18n.Message{ID: "Rule00122Desc", Other: "避免对值全为NULL的列直接使用 SUM或COUNT函数"}
-	Rule00122Annotation = &i18n.Message{ID: "Rule00122Annotation", Other: "当某一列的值全是NULL时，COUNT(COL)的返回结果为0，但SUM(COL)的返回结果为NULL，因此使用SUM()时需注意NPE问题（指数据返回NULL）；如业务需避免NPE问题，建议开启此规则"}
-	Rule00122Message    = &i18n.Message{ID: "Rule00122Message", Other: "避免对值全为NULL的列直接使用 SUM或COUNT函数. 违反规则的列名: %s"}
-	Rule00123Desc       = &i18n.Message{ID: "Rule00123Desc", Other: "禁止使用TRUNCATE操作"}
-	Rule00123Annotation = &i18n.Message{ID: "Rule00123Annotation", Other: "TRUNCATE是DDL，可以快速清理全表数据，回收磁盘空间，执行后数据默认隐式提交，无法回滚，在没有备份的场景下，谨慎使用TRUNCATE"}
-	Rule00123Message    = &i18n.Message{ID: "Rule00123Message", Other: "禁止使用TRUNCATE操作"}
-	Rule00124Desc       = &i18n.Message{ID: "Rule00124Desc", Other: "删除全表时建议使用 TRUNCATE 替代 DELETE"}
-	Rule00124Annotation = &i18n.Message{ID: "Rule00124Annotation", Other: "TRUNCATE TABLE 比 DELETE 速度快，且使用的系统和事务日志资源少，同时TRUNCATE后表所占用的空间会被释放，而DELETE后需要手工执行OPTIMIZE才能释放表空间"}
-	Rule00124Message    = &i18n.Message{ID: "Rule00124Message", Other: "删除全表时建议使用 TRUNCATE 替代 DELETE"}
-	Rule00126Desc       = &i18n.Message{ID: "Rule00126Desc", Other: "不建议对字段编号进行 GROUP BY"}
-	Rule00126Annotation = &i18n.Message{ID: "Rule00126Annotation", Other: "GROUP BY 1 表示按第一列进行GROUP BY；在GROUP BY子句中使用字段编号，而不是表达式或列名称，当查询列顺序改变时，会导致查询逻辑出现问题"}
-	Rule00126Message    = &i18n.Message{ID: "Rule00126Message", Other: "不建议对字段编号进行 GROUP BY"}
-	Rule00127Desc       = &i18n.Message{ID: "Rule00127Desc", Other: "不建议在ORDER BY中使用表达式或函数"}
-	Rule00127Annotation = &i18n.Message{ID: "Rule00127Annotation", Other: "在ORDER BY子句中使用表达式或函数会导致无法有效利用索引，从而可能涉及到全表扫描和使用临时表进行数据排序。这样的操作在处理大数据量时会显著降低查询性能。"}
-	Rule00127Message    = &i18n.Message{ID: "Rule00127Message", Other: "不建议在ORDER BY中使用表达式或函数"}
-	Rule00128Desc       = &i18n.Message{ID: "Rule00128Desc", Other: "不建议使用 HAVING 子句"}
-	Rule00128Annotation = &i18n.Message{ID: "Rule00128Annotation", Other: "对于索引字段，放在HAVING子句中时不会走索引；建议将HAVING子句改写为WHERE中的查询条件，可以在查询处理期间使用索引，提高SQL的执行效率"}
-	Rule00128Message    = &i18n.Message{ID: "Rule00128Message", Other: "不建议使用 HAVING 子句"}
-	Rule00131Desc       = &i18n.Message{ID: "Rule00131Desc", Other: "避免使用 ORDER BY RAND() 进行随机排序"}
-	Rule00131Annotation = &i18n.Message{ID: "Rule00131Annotation", Other: "使用 ORDER BY RAND() 会导致数据库生成临时表并进行完整的表扫描和排序，这在处理大数据量时会显著增加查询时间和服务器负载。建议采用更高效的随机数据检索方法，如利用主键或其他索引实现快速随机访问。"}
-	Rule00131Message    = &i18n.Message{ID: "Rule00131Message", Other: "避免使用 ORDER BY RAND() 进行随机排序"}
-	Rule00132Desc       = &i18n.Message{ID: "Rule00132Desc", Other: "不推荐使用子查询"}
-	Rule00132Annotation = &i18n.Message{ID: "Rule00132Annotation", Other: "有些情况下，子查询并不能使用到索引，同时对于返回结果集比较大的子查询，会产生大量的临时表，消耗过多的CPU和IO资源，产生大量的慢查询"}
-	Rule00132Message    = &i18n.Message{ID: "Rule00132Message", Other: "不推荐使用子查询"}
-	Rule00134Desc       = &i18n.Message{ID: "Rule00134Desc", Other: "避免对主键值进行修改"}
-	Rule00134Annotation = &i18n.Message{ID: "Rule00134Annotation", Other: "主键在大多数数据库系统中用于定义数据的唯一性，并且常常与数据的物理存储结构密切相关。更新主键会导致底层存储结构（如聚簇索引）的重大重新组织，引发性能下降。此外，主键的更改可能影响数据一致性，尤其在涉及复杂事务处理和高并发操作的场景中。"}
-	Rule00134Message    = &i18n.Message{ID: "Rule00134Message", Other: "避免对主键值进行修改"}
-	Rule00139Desc       = &i18n.Message{ID: "Rule00139Desc", Other: "不建议使用全表扫描"}
-	Rule00139Annotation = &i18n.Message{ID: "Rule00139Annotation", Other: "全表扫描是数据库执行查询时读取表中每一行来查找匹配记录的过程。对于大型表来说，出现全表扫描的SQL会导致显著的性能下降和资源消耗，影响业务稳定运行。"}
-	Rule00139Message    = &i18n.Message{ID: "Rule00139Message", Other: "不建议使用全表扫描. 表大小阈值: %v GB"}
-	Rule00139Params1    = &i18n.Message{ID: "Rule00139Params1", Other: "表大小(GB)"}
-	Rule00140Desc       = &i18n.Message{ID: "Rule00140Desc", Other: "建议对表、视图等对象进行操作时指定库名"}
-	Rule00140Annotation = &i18n.Message{ID: "Rule00140Annotation", Other: "对表、视图等对象进行创建、修改、查询、更新、删除等DDL、DML操作时，如未指定schema或者库名，会导致在不确定的数据库下执行，与实际业务预期不符合，而且会导致SQL语句执行错误。"}
-	Rule00140Message    = &i18n.Message{ID: "Rule00140Message", Other: "建议对表、视图等对象进行操作时指定库名"}
-	Rule00141Desc       = &i18n.Message{ID: "Rule00141Desc", Other: "表关联嵌套循环的层次过多"}
-	Rule00141Annotation = &i18n.Message{ID: "Rule00141Annotation", Other: "嵌套越深，需要扫描的行数、生成的结果集就越大，SQL的执行效率越低。"}
-	Rule00141Message    = &i18n.Message{ID: "Rule00141Message", Other: "表关联嵌套循环的层次过多"}
-	Rule00141Params1    = &i18n.Message{ID: "Rule00141Params1", Other: "表关联嵌套循环层数"}
-	Rule00143Desc       = &i18n.Message{ID: "Rule00143Desc", Other: "多表关联时，不建议在WHERE条件中对不同表的字段使用OR条件"}
-	Rule00143Annotation = &i18n.Message{ID: "Rule00143Annotation", Other: "多表关联时，在WHERE条件中对不同表的字段使用OR条件可能会导致SQL无法使用正确的索引"}
-	Rule00143Message    = &i18n.Message{ID: "Rule00143Message", Other: "多表关联时，不建议在WHERE条件中对不同表的字段使用OR条件"}
-	Rule00151Desc       = &i18n.Message{ID: "Rule00151Desc", Other: "避免CREATE TABLE/ALTER TABLE 使用禁止的表空间"}
-	Rule00151Annotation = &i18n.Message{ID: "Rule00151Annotation", Other: "不允许在系统表空间上创建用户对象，避免不必要的安全风险，方便维护"}
-	Rule00151Message    = &i18n.Message{ID: "Rule00151Message", Other: "避免CREATE TABLE/ALTER TABLE 使用禁止的表空间"}
-	Rule00153Desc       = &i18n.Message{ID: "Rule00153Desc", Other: "创建表建议添加索引"}
-	Rule00153Annotation = &i18n.Message{ID: "Rule00153Annotation", Other: "规划和设计表时，索引应根据业务需求和数据分布合理创建，无索引通常是不合理的情况"}
-	Rule00153Message    = &i18n.Message{ID: "Rule00153Message", Other: "创建表建议添加索引"}
-	Rule00161Desc       = &i18n.Message{ID: "Rule00161Desc", Other: "建议序列或自增字段的步长为1"}
-	Rule00161Annotation = &i18n.Message{ID: "Rule00161Annotation", Other: "序列或自增字段的步长为1时，有助于保证主键和其他自增字段的连续性，避免不必要的数据间隔和数字资源的浪费。不仅简化了数据库的管理和维护，而且也提高了系统的可预测性和稳定性。特别是在处理大量数据插入或高并发场景时，连续的主键值还能减少潜在的冲突和错误。"}
-	Rule00161Message    = &i18n.Message{ID: "Rule00161Message", Other: "建议序列或自增字段的步长为1"}
-	Rule00170Desc       = &i18n.Message{ID: "Rule00170Desc", Other: "避免缩短字段长度"}
-	Rule00170Annotation = &i18n.Message{ID: "Rule00170Annotation", Other: "修改字段长度值低于现有字段长度值，如果该字段现有数据超出设定后的长度，会造成语句执行报错"}
-	Rule00170Message    = &i18n.Message{ID: "Rule00170Message", Other: "避免缩短字段长度. 字段 %s 的新长度 %d 小于当前最大长度 %d"}
-	Rule00174Desc       = &i18n.Message{ID: "Rule00174Desc", Other: "禁止GRANT 授予过高权限"}
-	Rule00174Annotation = &i18n.Message{ID: "Rule00174Annotation", Other: "授予过高权限，可能会带来严重的安全风险。"}
-	Rule00174Message    = &i18n.Message{ID: "Rule00174Message", Other: "禁止GRANT 授予过高权限"}
-	Rule00174Params1    = &i18n.Message{ID: "Rule00174Params1", Other: "高权限范围"}
-	Rule00175Desc       = &i18n.Message{ID: "Rule00175Desc", Other: "避免不必要的索引扫描合并"}
-	Rule00175Annotation = &i18n.Message{ID: "Rule00175Annotation", Other: "索引合并说明一个查询同时使用了多个索引，增加了更多IO操作，特别是在数据量大的情况下执行效率比复合索引明显更多。此外，索引合并操作可能消耗更多CPU和内存资源，以及较长的查询响应时间。"}
-	Rule00175Message    = &i18n.Message{ID: "Rule00175Message", Other: "避免不必要的索引扫描合并"}
-	Rule00176Desc       = &i18n.Message{ID: "Rule00176Desc", Other: "不建议SQL中包含hint指令"}
-	Rule00176Annotation = &i18n.Message{ID: "Rule00176Annotation", Other: "使用hint可能会导致数据库走错误的执行计划，从而影响执行效率，消耗系统资源。"}
-	Rule00176Message    = &i18n.Message{ID: "Rule00176Message", Other: "不建议SQL中包含hint指令"}
-	Rule00177Desc       = &i18n.Message{ID: "Rule00177Desc", Other: "建议Order By字段个数不超过指定阈值"}
-	Rule00177Annotation = &i18n.Message{ID: "Rule00177Annotation", Other: "使用过多的Order By字段会增加排序操作的复杂性，并可能导致性能下降。排序时，MySQL需要对结果集中的每一行进行多字段比较，这可能会耗费更多的CPU和内存资源。如果排序数据集大小超过了可用内存，则可能会导致创建临时表并在磁盘上进行排序，从而增加I/O开销。"}
-	Rule00177Message    = &i18n.Message{ID: "Rule00177Message", Other: "建议Order By字段个数不超过指定阈值. 阈值: %v"}
-	Rule00177Params1    = &i18n.Message{ID: "Rule00177Params1", Other: "order by字段个数最大值"}
-	Rule00178Desc       = &i18n.Message{ID: "Rule00178Desc", Other: "SQL语句存在全表排序操作"}
-	Rule00178Annotation = &i18n.Message{ID: "Rule00178Annotation", Other: "SQL语句存在全表排序操作，无过滤条件，也就是WHERE 必须显式指定过滤条件"}
-	Rule00178Message    = &i18n.Message{ID: "Rule00178Message", Other: "SQL语句存在全表排序操作"}
-	Rule00179Desc       = &i18n.Message{ID: "Rule00179Desc", Other: "避免隐式数据类型转换的SQL查询"}
-	Rule00179Annotation = &i18n.Message{ID: "Rule00179Annotation", Other: "确保WHERE子句中用于索引列的条件字段与索引列的数据类型一致。不一致的数据类型会导致执行计划存在隐式类型转换操作。这种转换不仅增加CPU负担，还可能使得原本高效的索引无法使用，导致查询性能显著下降。"}
-	Rule00179Message    = &i18n.Message{ID: "Rule00179Message", Other: "避免隐式数据类型转换的SQL查询"}
-	Rule00180Desc       = &i18n.Message{ID: "Rule00180Desc", Other: "避免执行计划中 filter 次数过多"}
-	Rule00180Annotation = &i18n.Message{ID: "Rule00180Annotation", Other: "执行计划中的filter 步骤表示查询在检索数据之后需要进行额外的行过滤。过滤通常发生在已经通过索引或其他方法获取的行集上。如果这个步骤处理的行数很多，那么它可能会成为查询性能的瓶颈。"}
-	Rule00180Message    = &i18n.Message{ID: "Rule00180Message", Other: "避免执行计划中 filter 次数过多"}
-	Rule00180Params1    = &i18n.Message{ID: "Rule00180Params1", Other: "filter 个数阈值"}
-	Rule00218Desc       = &i18n.Message{ID: "Rule00218Desc", Other: "联合索引最左侧的字段必须出现在查询条件内"}
-	Rule00218Annotation = &i18n.Message{ID: "Rule00218Annotation", Other: "当查询条件包含联合索引的最左侧字段时，查询语句才能更好的利用索引的特性：有序性、过滤性等"}
-	Rule00218Message    = &i18n.Message{ID: "Rule00218Message", Other: "联合索引最左侧的字段必须出现在查询条件内. 不符合规范的字段: %v"}
-	Rule00219Desc       = &i18n.Message{ID: "Rule00219Desc", Other: "建表DDL必须包括创建时间字段，并应确保该字段能记录表记录的创建时间。"}
-	Rule00219Annotation = &i18n.Message{ID: "Rule00219Annotation", Other: "使用创建时间字段，有利于问题查找跟踪和检索数据，同时避免后期对数据生命周期管理不便 ，可保证时间的准确性"}
-	Rule00219Message    = &i18n.Message{ID: "Rule00219Message", Other: "建表DDL必须包括创建时间字段，并应确保该字段能记录表记录的创建时间。"}
-	Rule00219Params1    = &i18n.Message{ID: "Rule00219Params1", Other: "创建时间字段名"}
-	Rule00220Desc       = &i18n.Message{ID: "Rule00220Desc", Other: "避免不带where条件的count(*)或者count(1)"}
-	Rule00220Annotation = &i18n.Message{ID: "Rule00220Annotation", Other: "不带 where 条件的 count(*) 或者 count(1) 都是对表进行暴力扫描，极其耗费系统资源"}
-	Rule00220Message    = &i18n.Message{ID: "Rule00220Message", Other: "避免不带where条件的count(*)或者count(1)"}
+	Rule00001Desc               = &i18n.Message{ID: "Rule00001Desc", Other: "禁止SQL语句不带WHERE条件或者WHERE条件为永真"}
+	Rule00001Annotation         = &i18n.Message{ID: "Rule00001Annotation", Other: "使用有效的WHERE条件能够避免全表扫描，提高SQL执行效率；而恒为TRUE的WHERE条件，如where 1=1、where true=true等，在执行时会进行全表扫描产生额外开销。"}
+	Rule00001Message            = &i18n.Message{ID: "Rule00001Message", Other: "禁止SQL语句不带WHERE条件或者WHERE条件为永真"}
+	Rule00002Desc               = &i18n.Message{ID: "Rule00002Desc", Other: "SQL绑定的变量个数不建议超过阈值"}
+	Rule00002Annotation         = &i18n.Message{ID: "Rule00002Annotation", Other: "过度使用绑定变量会增加查询的复杂度，从而降低查询性能。同时还会增加维护成本。SQLE设置MySQL绑定变量个数最大阈值：100"}
+	Rule00002Message            = &i18n.Message{ID: "Rule00002Message", Other: "SQL绑定的变量个数不建议超过阈值"}
+	Rule00002Params1            = &i18n.Message{ID: "Rule00002Params1", Other: "绑定变量阈值"}
+	Rule00003Desc               = &i18n.Message{ID: "Rule00003Desc", Other: "建议为组成索引的字段添加非空约束，并配置合理的default值"}
+	Rule00003Annotation         = &i18n.Message{ID: "Rule00003Annotation", Other: "在MySQL中，NULL值表示的含义为missing unknown value，在不同的场景下MySQL存在不同的处理方式；当字段内容存在NULL值时，处理结果可能存在异常"}
+	Rule00003Message            = &i18n.Message{ID: "Rule00003Message", Other: "建议为组成索引的字段添加非空约束，并配置合理的default值"}
+	Rule00004Desc               = &i18n.Message{ID: "Rule00004Desc", Other: "建议表的自增字段起始值为0"}
+	Rule00004Annotation         = &i18n.Message{ID: "Rule00004Annotation", Other: "创建表时AUTO_INCREMENT设置为0则自增从1开始，可以避免数据空洞。例如在导出表结构DDL时，表结构内AUTO_INCREMENT通常为当前的自增值，如果建表时没有把AUTO_INCREMENT设置为0，那么通过该DDL进行建表操作会导致自增值从一个无意义数字开始。"}
+	Rule00004Message            = &i18n.Message{ID: "Rule00004Message", Other: "建议表的自增字段起始值为0"}
+	Rule00005Desc               = &i18n.Message{ID: "Rule00005Desc", Other: "避免复合索引中包含过多字段"}
+	Rule00005Annotation         = &i18n.Message{ID: "Rule00005Annotation", Other: "在设计复合索引过程中，每增加一个索引字段，都会使索引的大小线性增加，从而占用更多的磁盘空间，且增加索引维护的开销。尤其是在数据频繁变动的环境中，这会显著增加数据库的维护压力。索引长度按字段类型的最大字节数累加，前缀索引按前缀长度计算，超过 InnoDB 的索引长度上限(3072字节)时建表会失败；无法获取字段定义时(如离线审核 ALTER TABLE)不检查索引长度。"}
+	Rule00005Message            = &i18n.Message{ID: "Rule00005Message", Other: "避免复合索引中包含过多字段或索引长度过长，索引(字段个数, 索引长度字节数): %v"}
+	Rule00005Params1            = &i18n.Message{ID: "Rule00005Params1", Other: "复合索引内字段个数"}
+	Rule00005Params2            = &i18n.Message{ID: "Rule00005Params2", Other: "索引长度上限(字节)"}
+	Rule00007Desc               = &i18n.Message{ID: "Rule00007Desc", Other: "建表时，自增字段只能设置一个"}
+	Rule00007Annotation         = &i18n.Message{ID: "Rule00007Annotation", Other: "多个自增字段会造成表写入性能影响、可读性差、数据库设计不规范等缺点。"}
+	Rule00007Message            = &i18n.Message{ID: "Rule00007Message", Other: "建表时，自增字段只能设置一个"}
+	Rule00008Desc               = &i18n.Message{ID: "Rule00008Desc", Other: "表里必须存在主键"}
+	Rule00008Annotation         = &i18n.Message{ID: "Rule00008Annotation", Other: "表必须存在主键。如果表没有明确指定主键，可能会导致一些问题，如数据一致性难以保证、查询性能下降、数据完整性问题、数据管理和维护困难以及数据库优化受限等。"}
+	Rule00008Message            = &i18n.Message{ID: "Rule00008Message", Other: "表里必须存在主键"}
+	Rule00009Desc               = &i18n.Message{ID: "Rule00009Desc", Other: "避免对条件字段使用函数操作"}
+	Rule00009Annotation         = &i18n.Message{ID: "Rule00009Annotation", Other: "对条件字段做函数操作，可能会破坏索引值的有序性，导致优化器选择放弃走索引，使查询性能大幅度降低"}
+	Rule00009Message            = &i18n.Message{ID: "Rule00009Message", Other: "避免对条件字段使用函数操作"}
+	Rule00010Desc               = &i18n.Message{ID: "Rule00010Desc", Other: "禁止进行删除主键的操作"}
+	Rule00010Annotation         = &i18n.Message{ID: "Rule00010Annotation", Other: "删除主键将影响表数据结构和性能，属于高开销操作。另外，如果业务中使用了主键，删除主键可能导致业务逻辑阻塞、异常等问题。"}
+	Rule00010Message            = &i18n.Message{ID: "Rule00010Message", Other: "禁止进行删除主键的操作"}
+	Rule00011Desc               = &i18n.Message{ID: "Rule00011Desc", Other: "存在多条对同一个表的修改语句，建议合并成一个ALTER语句"}
+	Rule00011Annotation         = &i18n.Message{ID: "Rule00011Annotation", Other: "避免对同一个表使用多条单独的ALTER语句，以减少数据库的锁定时间和执行开销，提高SQL语句的可读性和维护性。"}
+	Rule00011Message            = &i18n.Message{ID: "Rule00011Message", Other: "存在多条对同一个表的修改语句，建议合并成一个ALTER语句"}
+	Rule00012Desc               = &i18n.Message{ID: "Rule00012Desc", Other: "建议使用BIGINT类型表示小数"}
+	Rule00012Annotation         = &i18n.Message{ID: "Rule00012Annotation", Other: "在MySQL中，对于金额等需要高精度计算的小数，建议使用BIGINT类型表示，以避免浮点数精度问题。例如，可以用分来表示金额，1元在数据库中用整型表示为100。"}
+	Rule00012Message            = &i18n.Message{ID: "Rule00012Message", Other: "建议使用BIGINT类型表示小数"}
+	Rule00013Desc               = &i18n.Message{ID: "Rule00013Desc", Other: "建议使用 DECIMAL 类型表示精确数值"}
+	Rule00013Annotation         = &i18n.Message{ID: "Rule00013Annotation", Other: "在数据库中，精确数值的表示对于财务数据、统计数据等需要高精度计算的场景至关重要。使用非精确浮点类型如 FLOAT 或 DOUBLE 可能导致精度丢失、计算误差，从而影响数据的准确性与可靠性。"}
+	Rule00013Message            = &i18n.Message{ID: "Rule00013Message", Other: "建议使用 DECIMAL 类型表示精确数值. 不符合规定的字段: %v"}
+	Rule00014Desc               = &i18n.Message{ID: "Rule00014Desc", Other: "不建议使用自定义函数"}
+	Rule00014Annotation         = &i18n.Message{ID: "Rule00014Annotation", Other: "自定义函数和存储过程维护较困难，且依赖性高，可能导致SQL无法跨库使用。此外，它们在使用时存在一些限制，如无法使用事务相关语句、无法直接产生输出的语句，以及无法在函数体内使用USE语句指定数据库。"}
+	Rule00014Message            = &i18n.Message{ID: "Rule00014Message", Other: "不建议使用自定义函数"}
+	Rule00015Desc               = &i18n.Message{ID: "Rule00015Desc", Other: "避免库内出现多种数据库排序规则"}
+	Rule00015Annotation         = &i18n.Message{ID: "Rule00015Annotation", Other: "建议库内使用一致的数据库排序规则，以确保查询性能和索引有效性，避免因排序规则不一致导致的全表扫描和数据一致性问题。"}
+	Rule00015Message            = &i18n.Message{ID: "Rule00015Message", Other: "避免库内出现多种数据库排序规则"}
+	Rule00016Desc               = &i18n.Message{ID: "Rule00016Desc", Other: "存储大数据类型（如长文本、图片等）的字段只能设置为NULL"}
+	Rule00016Annotation         = &i18n.Message{ID: "Rule00016Annotation", Other: "在MySQL中，存储大数据类型的内容常用BLOB和TEXT、GEOMETRY以及JSON类型，但它们无法指定默认值；写入数据时，如未对该字段指定值会导致写入失败。"}
+	Rule00016Message            = &i18n.Message{ID: "Rule00016Message", Other: "存储大数据类型（如长文本、图片等）的字段只能设置为NULL"}
+	Rule00017Desc               = &i18n.Message{ID: "Rule00017Desc", Other: "不建议使用BLOB或TEXT类型"}
+	Rule00017Annotation         = &i18n.Message{ID: "Rule00017Annotation", Other: "BLOB或TEXT类型消耗大量的磁盘空间、网络IO带宽，同时在该表上的DML操作都会变得很慢"}
+	Rule00017Message            = &i18n.Message{ID: "Rule00017Message", Other: "不建议使用BLOB或TEXT类型. 不符合规定的字段: %v"}
+	Rule00018Desc               = &i18n.Message{ID: "Rule00018Desc", Other: "CHAR长度大于20时，建议使用VARCHAR类型"}
+	Rule00018Annotation         = &i18n.Message{ID: "Rule00018Annotation", Other: "VARCHAR是变长字段，存储空间小，可节省存储空间，同时相对较小的字段检索效率显然也要高些"}
+	Rule00018Message            = &i18n.Message{ID: "Rule00018Message", Other: "CHAR长度大于20时，建议使用VARCHAR类型"}
+	Rule00018Params1            = &i18n.Message{ID: "Rule00018Params1", Other: "CHAR最大长度"}
+	Rule00019Desc               = &i18n.Message{ID: "Rule00019Desc", Other: "不建议使用复合类型（SET和ENUM类型）数据"}
+	Rule00019Annotation         = &i18n.Message{ID: "Rule00019Annotation", Other: "SET类型，ENUM类型不是SQL标准，移植性较差；后期如修改或增加枚举值需重建整张表，代价较大；且无法通过字面值进行排序；在插入数据时，必须带上引号，否则将写入枚举值的顺序值，造成不可预期的问题。建议使用关联的字典表（通过外键或应用约束取值），或使用 TINYINT 并在字段注释中说明每个值的含义；确需使用的字段可以加入规则参数的白名单"}
+	Rule00019Message            = &i18n.Message{ID: "Rule00019Message", Other: "不建议使用复合类型（SET和ENUM类型）数据，字段: %v"}
+	Rule00019Params1            = &i18n.Message{ID: "Rule00019Params1", Other: "允许使用SET和ENUM类型的字段(多个用逗号分隔，格式为字段名或表名.字段名)"}
+	Rule00020Desc               = &i18n.Message{ID: "Rule00020Desc", Other: "避免表中包含有太多的列"}
+	Rule00020Annotation         = &i18n.Message{ID: "Rule00020Annotation", Other: "数据库表中字段过多会导致数据操作效率降低、数据完整性检查成本增加，以及索引维护与更新效率之间的权衡成本。对于追求事务响应和处理速度的OLTP系统，应尽量避免宽表设计，采用规范化数据模型以提升性能。此外，宽表容易触及 MySQL 的硬性限制：单表最多 4096 列（InnoDB 表最多 1017 列），且一行中除 TEXT/BLOB 外的字段总长度不能超过 65535 字节。"}
+	Rule00020Message            = &i18n.Message{ID: "Rule00020Message", Other: "避免表中包含有太多的列，当前列数: %v，上限: %v"}
+	Rule00020Params1            = &i18n.Message{ID: "Rule00020Params1", Other: "表内列数上限"}
+	Rule00021Desc               = &i18n.Message{ID: "Rule00021Desc", Other: "禁止表字段缺少NOT NULL约束"}
+	Rule00021Annotation         = &i18n.Message{ID: "Rule00021Annotation", Other: "若数据库表字段缺少NOT NULL约束，则字段存储值可能是NULL，后期判断时，需要加上IS NULL判断，增加SQL编写的复杂度。"}
+	Rule00021Message            = &i18n.Message{ID: "Rule00021Message", Other: "禁止表字段缺少NOT NULL约束"}
+	Rule00022Desc               = &i18n.Message{ID: "Rule00022Desc", Other: "避免使用数据倾斜度高的索引字段"}
+	Rule00022Annotation         = &i18n.Message{ID: "Rule00022Annotation", Other: "为了提高查询效率，建议在执行SQL时优先使用倾斜度低的索引字段。倾斜度低的索引可以更快地定位数据，减少不必要的数据扫描，从而加速查询响应时间。规则检查将会计算候选索引字段的倾斜度，如果索引的倾斜度高于设定的阈值，则建议调整索引策略。"}
+	Rule00022Message            = &i18n.Message{ID: "Rule00022Message", Other: "索引列 %v 超过倾斜度阈值 %v, 避免使用数据倾斜度高的索引字段"}
+	Rule00022Params1            = &i18n.Message{ID: "Rule00022Params1", Other: "倾斜度"}
+	Rule00023Desc               = &i18n.Message{ID: "Rule00023Desc", Other: "主键包含的列数不建议超过阈值"}
+	Rule00023Annotation         = &i18n.Message{ID: "Rule00023Annotation", Other: "主建中的列过多，会导致辅助索引占用更多的空间，同时增加索引维护的开销；具体规则阈值可根据业务需求调整，默认值：2"}
+	Rule00023Message            = &i18n.Message{ID: "Rule00023Message", Other: "主键包含的列数不建议超过阈值. 阈值: %v"}
+	Rule00023Params1            = &i18n.Message{ID: "Rule00023Params1", Other: "最大列数"}
+	Rule00025Desc               = &i18n.Message{ID: "Rule00025Desc", Other: "建议时间类型的列添加默认值"}
+	Rule00025Annotation         = &i18n.Message{ID: "Rule00025Annotation", Other: "时间类型的字段添加默认值，可避免在数据写入未指定该字段值时，字段内容出现全为0的日期格式或者NULL值，与实际业务预期不符。"}
+	Rule00025Message            = &i18n.Message{ID: "Rule00025Message", Other: "建议时间类型的列添加默认值. 不符合规定的字段: %v"}
+	Rule00026Desc               = &i18n.Message{ID: "Rule00026Desc", Other: "整数字段建议指定最大显示宽度"}
+	Rule00026Annotation         = &i18n.Message{ID: "Rule00026Annotation", Other: "在表结构定义中，整数字段定义指定了最大显示宽度，可以体现业务对该字段的数据存储预期；同时保持了字段定义的一致性，减少在数据库之间迁移时需要修改字段长度的工作量。"}
+	Rule00026Message            = &i18n.Message{ID: "Rule00026Message", Other: "整数字段建议指定最大显示宽度"}
+	Rule00027Desc               = &i18n.Message{ID: "Rule00027Desc", Other: "列建议添加注释"}
+	Rule00027Annotation         = &i18n.Message{ID: "Rule00027Annotation", Other: "列添加注释能够使列的意义更明确，方便日后的维护"}
+	Rule00027Message            = &i18n.Message{ID: "Rule00027Message", Other: "列建议添加注释. 不符合规定的字段: %v"}
+	Rule00027Params1            = &i18n.Message{ID: "Rule00027Params1", Other: "注释最小长度"}
+	Rule00029Desc               = &i18n.Message{ID: "Rule00029Desc", Other: "禁止使用存储过程"}
+	Rule00029Annotation         = &i18n.Message{ID: "Rule00029Annotation", Other: "存储过程在一定程度上能使程序难以调试和拓展，各种数据库端的存储过程语法相差很大，给将来的数据移植带来很大的困难，且会极大的出现BUG的几率"}
+	Rule00029Message            = &i18n.Message{ID: "Rule00029Message", Other: "禁止使用存储过程"}
+	Rule00030Desc               = &i18n.Message{ID: "Rule00030Desc", Other: "禁止使用触发器"}
+	Rule00030Annotation         = &i18n.Message{ID: "Rule00030Annotation", Other: "触发器难以开发和维护，不能高效移植，且在复杂的逻辑以及高并发下，容易出现死锁影响业务。"}
+	Rule00030Message            = &i18n.Message{ID: "Rule00030Message", Other: "禁止使用触发器"}
+	Rule00031Desc               = &i18n.Message{ID: "Rule00031Desc", Other: "禁止使用视图"}
+	Rule00031Annotation         = &i18n.Message{ID: "Rule00031Annotation", Other: "视图的查询性能较差，同时基表结构变更，需要对视图进行维护。如果视图可读性差，且包含复杂的逻辑，会增加维护的成本。"}
+	Rule00031Message            = &i18n.Message{ID: "Rule00031Message", Other: "禁止使用视图"}
+	Rule00032Desc               = &i18n.Message{ID: "Rule00032Desc", Other: "数据库名称必须使用固定后缀结尾"}
+	Rule00032Annotation         = &i18n.Message{ID: "Rule00032Annotation", Other: "通过配置该规则可以规范指定业务的数据库命名规则，具体命名规范可以自定义设置。"}
+	Rule00032Message            = &i18n.Message{ID: "Rule00032Message", Other: "数据库名称必须使用固定后缀结尾:%s"}
+	Rule00032Params1            = &i18n.Message{ID: "Rule00032Params1", Other: "固定后缀"}
+	Rule00033Desc               = &i18n.Message{ID: "Rule00033Desc", Other: "建表DDL必须包括更新时间字段，并应设置该字段为自动根据其他字段内容的变更进行更新。"}
+	Rule00033Annotation         = &i18n.Message{ID: "Rule00033Annotation", Other: "使用更新时间字段，有利于问题查找跟踪和检索数据，同时避免后期对数据生命周期管理不便，可保证时间的准确性"}
+	Rule00033Message            = &i18n.Message{ID: "Rule00033Message", Other: "建表DDL必须包括更新时间字段，并应设置该字段为自动根据其他字段内容的变更进行更新。更新时间字段名: %v"}
+	Rule00033Params1            = &i18n.Message{ID: "Rule00033Params1", Other: "更新时间字段名"}
+	Rule00034Desc               = &i18n.Message{ID: "Rule00034Desc", Other: "字段约束为NOT NULL时必须带默认值"}
+	Rule00034Annotation         = &i18n.Message{ID: "Rule00034Annotation", Other: "如存在NOT NULL且不带默认值的字段，对字段进行写入时不包含该字段，会导致插入报错"}
+	Rule00034Message            = &i18n.Message{ID: "Rule00034Message", Other: "字段约束为NOT NULL时必须带默认值"}
+	Rule00035Desc               = &i18n.Message{ID: "Rule00035Desc", Other: "DDL语句中不建议使用中文全角引号"}
+	Rule00035Annotation         = &i18n.Message{ID: "Rule00035Annotation", Other: "建议开启此规则，可避免MySQL会将中文全角引号识别为命名的一部分，执行结果与业务预期不符"}
+	Rule00035Message            = &i18n.Message{ID: "Rule00035Message", Other: "DDL语句中不建议使用中文全角引号"}
+	Rule00037Desc               = &i18n.Message{ID: "Rule00037Desc", Other: "避免一张表内二级索引的个数过多"}
+	Rule00037Annotation         = &i18n.Message{ID: "Rule00037Annotation", Other: "在表上建立的每个索引都会增加存储开销，索引对于插入、删除、更新操作也会增加维护索引处理上的开销（TPS），且太多与不充分、不正确的索引对性能都毫无益处。"}
+	Rule00037Message            = &i18n.Message{ID: "Rule00037Message", Other: "避免一张表内二级索引的个数过多"}
+	Rule00037Params1            = &i18n.Message{ID: "Rule00037Params1", Other: "二级索引个数"}
+	Rule00039Desc               = &i18n.Message{ID: "Rule00039Desc", Other: "建议使用数据区分度高的索引字段"}
+	Rule00039Annotation         = &i18n.Message{ID: "Rule00039Annotation", Other: "为了提高查询效率，建议在执行SQL时优先使用区分度高的索引字段。区分度高的索引可以更快地定位数据，减少不必要的数据扫描，从而加速查询响应时间。规则检查将会计算候选索引字段的区分度，如果索引的区分度低于设定的阈值，则建议调整索引策略。"}
+	Rule00039Message            = &i18n.Message{ID: "Rule00039Message", Other: "索引列 %v 未超过区分度阈值 %v, 建议使用数据区分度高的索引字段"}
+	Rule00039Params1            = &i18n.Message{ID: "Rule00039Params1", Other: "区分度"}
+	Rule00040Desc               = &i18n.Message{ID: "Rule00040Desc", Other: "普通索引必须使用固定前缀"}
+	Rule00040Annotation         = &i18n.Message{ID: "Rule00040Annotation", Other: "通过配置该规则可以规范指定业务的普通索引命名规则，具体命名规范可以自定义设置。"}
+	Rule00040Message            = &i18n.Message{ID: "Rule00040Message", Other: "普通索引必须使用固定前缀"}
+	Rule00040Params1            = &i18n.Message{ID: "Rule00040Params1", Other: "固定前缀"}
+	Rule00041Desc               = &i18n.Message{ID: "Rule00041Desc", Other: "唯一索引必须使用固定前缀"}
+	Rule00041Annotation         = &i18n.Message{ID: "Rule00041Annotation", Other: "通过配置该规则可以规范指定业务的唯一索引命名规则，具体命名规范可以自定义设置。"}
+	Rule00041Message            = &i18n.Message{ID: "Rule00041Message", Other: "唯一索引必须使用固定前缀，索引名: %v，建议修改为: %v"}
+	Rule00041Params1            = &i18n.Message{ID: "Rule00041Params1", Other: "固定前缀"}
+	Rule00042Desc               = &i18n.Message{ID: "Rule00042Desc", Other: "临时表必须使用固定前缀"}
+	Rule00042Annotation         = &i18n.Message{ID: "Rule00042Annotation", Other: "统一命名规范，有利于后期维护以及业务开发"}
+	Rule00042Message            = &i18n.Message{ID: "Rule00042Message", Other: "临时表必须使用固定前缀"}
+	Rule00042Params1            = &i18n.Message{ID: "Rule00042Params1", Other: "固定前缀"}
+	Rule00043Desc               = &i18n.Message{ID: "Rule00043Desc", Other: "避免表内同一字段上存在过多索引"}
+	Rule00043Annotation         = &i18n.Message{ID: "Rule00043Annotation", Other: "一个表内同一字段上存在过多索引，一般情况下这些索引都是没有存在价值的；相反，还会降低数据增加删除时的性能，特别是对频繁更新的表来说，负面影响更大；具体规则阈值可以根据业务需求调整，默认值：2"}
+	Rule00043Message            = &i18n.Message{ID: "Rule00043Message", Other: "避免表内同一字段上存在过多索引. 字段 %v 上的索引数量不建议超过%v个"}
+	Rule00043Params1            = &i18n.Message{ID: "Rule00043Params1", Other: "单字段的索引数最大值"}
+	Rule00045Desc               = &i18n.Message{ID: "Rule00045Desc", Other: "避免在分页查询中使用过大偏移量"}
+	Rule00045Annotation         = &i18n.Message{ID: "Rule00045Annotation", Other: "在数据库中，分页查询通常使用 LIMIT 和 OFFSET 语句进行。当数据量较大时，使用大的偏移量（OFFSET）进行分页查询可能会导致性能下降，因为数据库需要跳过大量的行来获得所需的结果集。"}
+	Rule00045Message            = &i18n.Message{ID: "Rule00045Message", Other: "避免在分页查询中使用过大偏移量, 最大偏移量:%v"}
+	Rule00045Params1            = &i18n.Message{ID: "Rule00045Params1", Other: "最大偏移量"}
+	Rule00046Desc               = &i18n.Message{ID: "Rule00046Desc", Other: "数据库对象命名不建议大小写字母混合"}
+	Rule00046Annotation         = &i18n.Message{ID: "Rule00046Annotation", Other: "数据库对象命名规范，不推荐采用大小写混用的形式建议词语之间使用下划线连接，提高代码可读性"}
+	Rule00046Message            = &i18n.Message{ID: "Rule00046Message", Other: "数据库对象命名不建议大小写字母混合"}
+	Rule00047Desc               = &i18n.Message{ID: "Rule00047Desc", Other: "数据库对象名称的字符个数不建议超过阈值"}
+	Rule00047Annotation         = &i18n.Message{ID: "Rule00047Annotation", Other: "通过配置该规则可以规范指定业务的对象命名长度，具体长度可以自定义设置。"}
+	Rule00047Message            = &i18n.Message{ID: "Rule00047Message", Other: "数据库对象名称的字符个数不建议超过阈值:%v"}
+	Rule00047Params1            = &i18n.Message{ID: "Rule00047Params1", Other: "字符个数"}
+	Rule00048Desc               = &i18n.Message{ID: "Rule00048Desc", Other: "数据库对象命名只能使用英文、下划线或数字，首字母必须是英文"}
+	Rule00048Annotation         = &i18n.Message{ID: "Rule00048Annotation", Other: "遵循良好的命名约定和避免特殊字符的使用，可以提高代码的可读性、可维护性，并减少潜在的兼容性和语法问题。"}
+	Rule00048Message            = &i18n.Message{ID: "Rule00048Message", Other: "数据库对象命名只能使用英文、下划线或数字，首字母必须是英文"}
+	Rule00049Desc               = &i18n.Message{ID: "Rule00049Desc", Other: "数据库对象命名禁止使用保留字"}
+	Rule00049Annotation         = &i18n.Message{ID: "Rule00049Annotation", Other: "通过配置该规则可以规范指定业务的数据对象命名规则，避免发生冲突，以及混淆"}
+	Rule00049Message            = &i18n.Message{ID: "Rule00049Message", Other: "数据库对象命名禁止使用保留字"}
+	Rule00051Desc               = &i18n.Message{ID: "Rule00051Desc", Other: "禁止主键使用自增"}
+	Rule00051Annotation         = &i18n.Message{ID: "Rule00051Annotation", Other: "后期维护相对不便，过于依赖数据库自增机制达到全局唯一，不易拆分，容易造成主键冲突"}
+	Rule00051Message            = &i18n.Message{ID: "Rule00051Message", Other: "禁止主键使用自增"}
+	Rule00052Desc               = &i18n.Message{ID: "Rule00052Desc", Other: "建议主键使用自增"}
+	Rule00052Annotation         = &i18n.Message{ID: "Rule00052Annotation", Other: "自增主键通常为数字类型，其数据写入速度快，占用的存储空间小。自增主键保证了数据的有序性，减少了页分裂的频率，并简化了应用层的数据写入逻辑。"}
+	Rule00052Message            = &i18n.Message{ID: "Rule00052Message", Other: "建议主键使用自增"}
+	Rule00053Desc               = &i18n.Message{ID: "Rule00053Desc", Other: "不建议使用SELECT *"}
+	Rule00053Annotation         = &i18n.Message{ID: "Rule00053Annotation", Other: "当表结构变更时，使用*通配符选择所有列将导致查询行为会发生更改，与业务期望不符；同时SELECT * 中的无用字段会带来不必要的磁盘I/O，以及网络开销，且无法覆盖索引进而回表，大幅度降低查询效率。"}
+	Rule00053Message            = &i18n.Message{ID: "Rule00053Message", Other: "不建议使用SELECT *"}
+	Rule00053Params1            = &i18n.Message{ID: "Rule00053Params1", Other: "是否允许在EXISTS子查询中使用SELECT *"}
+	Rule00054Desc               = &i18n.Message{ID: "Rule00054Desc", Other: "建议主键字段使用BIGINT时采用无符号的BIGINT"}
+	Rule00054Annotation         = &i18n.Message{ID: "Rule00054Annotation", Other: "在设计主键时若选择BIGINT时，使用无符号类型，相对于有符号类型，可以使数据库的索引性能更加优化，因为它减少了负值处理的开销，并能在某些情况下提高查询速度。特别是在系统设计初期可能无法完全预见到未来数据量的情况下，无符号数值类型（BIGINT UNSIGNED）可以有效避免因数据增长导致的溢出问题。"}
+	Rule00054Message            = &i18n.Message{ID: "Rule00054Message", Other: "建议主键字段使用BIGINT时采用无符号的BIGINT"}
+	Rule00055Desc               = &i18n.Message{ID: "Rule00055Desc", Other: "不建议创建冗余索引"}
+	Rule00055Annotation         = &i18n.Message{ID: "Rule00055Annotation", Other: "MySQL需要单独维护重复的索引，冗余索引增加维护成本，影响更新性能"}
+	Rule00055Message            = &i18n.Message{ID: "Rule00055Message", Other: "已存在索引 %v , 索引 %v 为冗余索引"}
+	Rule00056Desc               = &i18n.Message{ID: "Rule00056Desc", Other: "表建议使用指定的字符集"}
+	Rule00056Annotation         = &i18n.Message{ID: "Rule00056Annotation", Other: "数据库内使用非标准的字符集，可能导致字符无法编码或者编码不全引起的乱码，最终出现应用写入数据失败或者查询结果显示乱码，影响数据库服务可用性。"}
+	Rule00056Message            = &i18n.Message{ID: "Rule00056Message", Other: "表建议使用指定的字符集: %v"}
+	Rule00056Params1            = &i18n.Message{ID: "Rule00056Params1", Other: "标准字符集"}
+	Rule00057Desc               = &i18n.Message{ID: "Rule00057Desc", Other: "必须使用INNODB数据库引擎"}
+	Rule00057Annotation         = &i18n.Message{ID: "Rule00057Annotation", Other: "INNODB 支持事务，支持行级锁，更好的恢复性，高并发下性能更好。"}
+	Rule00057Message            = &i18n.Message{ID: "Rule00057Message", Other: "必须使用INNODB数据库引擎"}
+	Rule00058Desc               = &i18n.Message{ID: "Rule00058Desc", Other: "避免使用分区表相关功能"}
+	Rule00058Annotation         = &i18n.Message{ID: "Rule00058Annotation", Other: "分区表在使用过程中存在诸多缺点，比如分区裁剪的不确定性、不支持全局分区索引、锁定粒度放大、分区前期规划较为繁杂等问题。如存在分区诉求，通常使用物理分表，即可避免分区表带来的缺点。"}
+	Rule00058Message            = &i18n.Message{ID: "Rule00058Message", Other: "避免使用分区表相关功能"}
+	Rule00059Desc               = &i18n.Message{ID: "Rule00059Desc", Other: "禁止修改大表的字段类型"}
+	Rule00059Annotation         = &i18n.Message{ID: "Rule00059Annotation", Other: "对于大型数据表，修改字段类型的DDL操作将导致显著的性能下降和可用性影响。此类操作通常需要复制整个表来更改数据类型，期间表将无法进行写操作，并且可能导致长时间的锁等待，对线上业务造成过长时间的影响。"}
+	Rule00059Message            = &i18n.Message{ID: "Rule00059Message", Other: "禁止修改大表的字段类型，表大小阈值: %v GB"}
+	Rule00059Params1            = &i18n.Message{ID: "Rule00059Params1", Other: "表大小(GB)"}
+	Rule00060Desc               = &i18n.Message{ID: "Rule00060Desc", Other: "表建议添加注释"}
+	Rule00060Annotation         = &i18n.Message{ID: "Rule00060Annotation", Other: "表添加注释能够使表的意义更明确，方便日后的维护"}
+	Rule00060Message            = &i18n.Message{ID: "Rule00060Message", Other: "表建议添加注释"}
+	Rule00061Desc               = &i18n.Message{ID: "Rule00061Desc", Other: "建议新建表句子中包含表存在判断操作"}
+	Rule00061Annotation         = &i18n.Message{ID: "Rule00061Annotation", Other: "新建表如果已经存在，不加 IF NOT EXISTS 会报错。新建表只在表不存在的前提下进行，避免SQL 实际执行报错。"}
+	Rule00061Message            = &i18n.Message{ID: "Rule00061Message", Other: "建议新建表句子中包含表存在判断操作"}
+	Rule00062Desc               = &i18n.Message{ID: "Rule00062Desc", Other: "建议事务隔离级别设置成RC"}
+	Rule00062Annotation         = &i18n.Message{ID: "Rule00062Annotation", Other: "RC 虽然没有解决幻读的问题，但是没有间隙锁，从而每次在做更新操作时影响的行数比默认RR要小很多；默认的RR隔离级别虽然解决了幻读问题，但是增加了间隙锁，导致加锁的范围扩大，性能比RC要低，增加死锁的概率；在大多数情况下，出现幻读的几率较小，所以建议使用RC。"}
+	Rule00062Message            = &i18n.Message{ID: "Rule00062Message", Other: "建议事务隔离级别设置成RC"}
+	Rule00063Desc               = &i18n.Message{ID: "Rule00063Desc", Other: "唯一索引名必须遵循指定格式"}
+	Rule00063Annotation         = &i18n.Message{ID: "Rule00063Annotation", Other: "通过配置该规则可以规范指定业务的唯一索引命名规则，如索引字段存在多个，则可以拼接字段名，不要超过索引名长度即可。"}
+	Rule00063Message            = &i18n.Message{ID: "Rule00063Message", Other: "唯一索引名必须遵循指定格式，索引名: %v，建议修改为: %v"}
+	Rule00063Params1            = &i18n.Message{ID: "Rule00063Params1", Other: "索引命名格式"}
+	Rule00064Desc               = &i18n.Message{ID: "Rule00064Desc", Other: "不建议索引字段是VARCHAR类型时其长度大于阈值"}
+	Rule00064Annotation         = &i18n.Message{ID: "Rule00064Annotation", Other: "建立索引时没有限制索引的大小，索引长度会根据该字段实际存储的值来计算，VARCHAR 定义的长度越长，导致业务写入的内容越多，则建立的索引其存储大小将会越大。"}
+	Rule00064Message            = &i18n.Message{ID: "Rule00064Message", Other: "不建议索引字段是VARCHAR类型时其长度大于阈值. 不符合规则的字段: %v"}
+	Rule00064Params1            = &i18n.Message{ID: "Rule00064Params1", Other: "VARCHAR最大长度"}
+	Rule00065Desc               = &i18n.Message{ID: "Rule00065Desc", Other: "禁止修改表时指定或调整字段在表结构中的顺序"}
+	Rule00065Annotation         = &i18n.Message{ID: "Rule00065Annotation", Other: "FIRST 和 AFTER 关键词在 ALTER TABLE 语句中用于调整字段的顺序，这种操作会改变表字段的物理顺序，可能导致依赖默认列顺序的业务SQL出现错误，影响数据的一致性和业务的稳定性。"}
+	Rule00065Message            = &i18n.Message{ID: "Rule00065Message", Other: "禁止修改表时指定或调整字段在表结构中的顺序"}
+	Rule00066Desc               = &i18n.Message{ID: "Rule00066Desc", Other: "禁止除索引外的DROP 操作"}
+	Rule00066Annotation         = &i18n.Message{ID: "Rule00066Annotation", Other: "DROP 操作是数据定义语言（DDL）的一部分，一旦执行，将导致无法恢复的数据或结构丢失。在不恰当的情况下执行DROP操作可能导致数据丢失、系统功能缺失甚至业务中断。"}
+	Rule00066Message            = &i18n.Message{ID: "Rule00066Message", Other: "禁止除索引外的DROP 操作"}
+	Rule00067Desc               = &i18n.Message{ID: "Rule00067Desc", Other: "表不建议使用外键"}
+	Rule00067Annotation         = &i18n.Message{ID: "Rule00067Annotation", Other: "外键在大量写入场景下性能较差，强烈禁止使用"}
+	Rule00067Message            = &i18n.Message{ID: "Rule00067Message", Other: "表不建议使用外键"}
+	Rule00068Desc               = &i18n.Message{ID: "Rule00068Desc", Other: "禁止使用TIMESTAMP字段"}
+	Rule00068Annotation         = &i18n.Message{ID: "Rule00068Annotation", Other: "TIMESTAMP类型字段受制于2038年问题，其时间范围仅限于1970-01-01 00:00:01 UTC至2038-01-19 03:14:07 UTC。超过这个时间范围，TIMESTAMP将无法存储更晚的时间点，导致应用报错。此外，TIMESTAMP字段在存储时会根据数据库服务器的时区进行转换，这可能导致跨时区应用中的时间不一致问题。"}
+	Rule00068Message            = &i18n.Message{ID: "Rule00068Message", Other: "禁止使用TIMESTAMP字段"}
+	Rule00071Desc               = &i18n.Message{ID: "Rule00071Desc", Other: "禁止进行删除列的操作"}
+	Rule00071Annotation         = &i18n.Message{ID: "Rule00071Annotation", Other: "在业务系统中，表的列往往与多个业务流程紧密关联。删除表中的列可能导致与该列相关的数据访问、数据处理和数据表示逻辑出现故障，因为相关的SQL语句、应用程序代码或报告工具可能还在尝试访问已删除的列。"}
+	Rule00071Message            = &i18n.Message{ID: "Rule00071Message", Other: "禁止进行删除列的操作"}
+	Rule00072Desc               = &i18n.Message{ID: "Rule00072Desc", Other: "禁止删除外键"}
+	Rule00072Annotation         = &i18n.Message{ID: "Rule00072Annotation", Other: "外键的存在是为了维持数据之间的引用完整性，确保数据的一致性。删除外键，可能导致依赖于这些外键约束的业务逻辑出现故障或数据完整性问题。"}
+	Rule00072Message            = &i18n.Message{ID: "Rule00072Message", Other: "禁止删除外键"}
+	Rule00073Desc               = &i18n.Message{ID: "Rule00073Desc", Other: "不建议修改表的默认字符集"}
+	Rule00073Annotation         = &i18n.Message{ID: "Rule00073Annotation", Other: "修改表的默认字符集，只会影响后续新增的字段，不会修改表已有字段的字符集，最终可能会出现表与字段的字符集不一致，引发数据查询出现乱码以及索引失效情况。"}
+	Rule00073Message            = &i18n.Message{ID: "Rule00073Message", Other: "不建议修改表的默认字符集"}
+	Rule00074Desc               = &i18n.Message{ID: "Rule00074Desc", Other: "禁止对表名字或段名进行重命名"}
+	Rule00074Annotation         = &i18n.Message{ID: "Rule00074Annotation", Other: "对表名或列名进行重命名操作会直接影响线上业务的稳定性和连续性。这种操作可能引起与表或列相关的SQL语句执行失败，导致业务中断。"}
+	Rule00074Message            = &i18n.Message{ID: "Rule00074Message", Other: "禁止对表名字或段名进行重命名"}
+	Rule00075Desc               = &i18n.Message{ID: "Rule00075Desc", Other: "建议列与表使用同一个字符集"}
+	Rule00075Annotation         = &i18n.Message{ID: "Rule00075Annotation", Other: "统一字符集可以避免由于字符集转换产生的乱码，不同的字符集进行比较前需要进行转换会造成索引失效"}
+	Rule00075Message            = &i18n.Message{ID: "Rule00075Message", Other: "建议列与表使用同一个字符集. 不符合规则的字段: %v"}
+	Rule00076Desc               = &i18n.Message{ID: "Rule00076Desc", Other: "UPDATE/DELETE操作影响行数不建议超过阈值"}
+	Rule00076Annotation         = &i18n.Message{ID: "Rule00076Annotation", Other: "在数据库中，进行修改或删除等数据变更操作时，一次性操作的数据量过大，会消耗大量的系统资源，产生长事务，会导致查询性能下降，影响其他事务或查询的执行。"}
+	Rule00076Message            = &i18n.Message{ID: "Rule00076Message", Other: "UPDATE/DELETE操作影响行数不建议超过阈值"}
+	Rule00076Params1            = &i18n.Message{ID: "Rule00076Params1", Other: "影响行数上限"}
+	Rule00078Desc               = &i18n.Message{ID: "Rule00078Desc", Other: "禁止使用聚合函数"}
+	Rule00078Annotation         = &i18n.Message{ID: "Rule00078Annotation", Other: "禁止使用SQL聚合函数是为了确保查询的简单性、高性能和数据一致性。"}
+	Rule00078Message            = &i18n.Message{ID: "Rule00078Message", Other: "禁止使用聚合函数"}
+	Rule00079Desc               = &i18n.Message{ID: "Rule00079Desc", Other: "别名不建议与表或列的名字相同"}
+	Rule00079Annotation         = &i18n.Message{ID: "Rule00079Annotation", Other: "表或列的别名与其真实名称相同, 这样的别名会使得查询更难去分辨"}
+	Rule00079Message            = &i18n.Message{ID: "Rule00079Message", Other: "别名不建议与表或列的名字相同"}
+	Rule00080Desc               = &i18n.Message{ID: "Rule00080Desc", Other: "建议单条SQL写入数据的行数不超过阈值"}
+	Rule00080Annotation         = &i18n.Message{ID: "Rule00080Annotation", Other: "为了避免单个SQL语句在批量写入时对数据库性能造成过大压力，限制每条SQL语句一次性插入的数据行数不得超过指定行。这有助于提高事务的可管理性，减少锁冲突，优化日志处理，以及提升错误恢复速度。"}
+	Rule00080Message            = &i18n.Message{ID: "Rule00080Message", Other: "建议单条SQL写入数据的行数不超过阈值"}
+	Rule00080Params1            = &i18n.Message{ID: "Rule00080Params1", Other: "单条SQL写入行数上限"}
+	Rule00082Desc               = &i18n.Message{ID: "Rule00082Desc", Other: "禁止使用文件排序"}
+	Rule00082Annotation         = &i18n.Message{ID: "Rule00082Annotation", Other: "大数据量的情况下，文件排序意味着SQL性能较低，会增加OS的开销，影响数据库性能。"}
+	Rule00082Message            = &i18n.Message{ID: "Rule00082Message", Other: "禁止使用文件排序"}
+	Rule00083Desc               = &i18n.Message{ID: "Rule00083Desc", Other: "不建议对表进行索引跳跃扫描"}
+	Rule00083Annotation         = &i18n.Message{ID: "Rule00083Annotation", Other: "索引扫描是跳跃扫描，未遵循最左匹配原则，可能降低索引的使用效率，影响查询性能，尽量避免使用。"}
+	Rule00083Message            = &i18n.Message{ID: "Rule00083Message", Other: "不建议对表进行索引跳跃扫描"}
+	Rule00084Desc               = &i18n.Message{ID: "Rule00084Desc", Other: "不建议使用临时表"}
+	Rule00084Annotation         = &i18n.Message{ID: "Rule00084Annotation", Other: "大数据量的情况下，临时表意味着SQL性能较低，会增加OS的开销，影响数据库性能"}
+	Rule00084Message            = &i18n.Message{ID: "Rule00084Message", Other: "不建议使用临时表"}
+	Rule00085Desc               = &i18n.Message{ID: "Rule00085Desc", Other: "不建议对表进行全索引扫描"}
+	Rule00085Annotation         = &i18n.Message{ID: "Rule00085Annotation", Other: "在数据量大的情况下索引全扫描严重影响SQL性能；一次性大数据量获取的场景较少，业务层需要考虑当前SQL的合理性，是否缺少过滤条件以及限制条数。"}
+	Rule00085Message            = &i18n.Message{ID: "Rule00085Message", Other: "不建议对表进行全索引扫描"}
+	Rule00086Desc               = &i18n.Message{ID: "Rule00086Desc", Other: "禁止使用子字符串匹配或后缀匹配搜索"}
+	Rule00086Annotation         = &i18n.Message{ID: "Rule00086Annotation", Other: "使用子字符串匹配搜索或后缀匹配搜索将导致查询无法使用索引，导致全表扫描"}
+	Rule00086Message            = &i18n.Message{ID: "Rule00086Message", Other: "禁止使用子字符串匹配或后缀匹配搜索"}
+	Rule00087Desc               = &i18n.Message{ID: "Rule00087Desc", Other: "避免WHERE条件内IN语句中的参数值个数过多"}
+	Rule00087Annotation         = &i18n.Message{ID: "Rule00087Annotation", Other: "当IN值过多时，有可能会出现无法使用索引，导致查询走全表扫描、性能变差、资源消耗过多等问题。"}
+	Rule00087Message            = &i18n.Message{ID: "Rule00087Message", Other: "避免WHERE条件内IN语句中的参数值个数过多"}
+	Rule00087Params1            = &i18n.Message{ID: "Rule00087Params1", Other: "IN的参数值个数"}
+	Rule00088Desc               = &i18n.Message{ID: "Rule00088Desc", Other: "INSERT 语句必须指定COLUMN"}
+	Rule00088Annotation         = &i18n.Message{ID: "Rule00088Annotation", Other: "当表结构发生变更，INSERT请求不明确指定列名，会发生插入数据不匹配的情况；建议开启此规则，避免插入结果与业务预期不符"}
+	Rule00088Message            = &i18n.Message{ID: "Rule00088Message", Other: "INSERT 语句必须指定COLUMN"}
+	Rule00089Desc               = &i18n.Message{ID: "Rule00089Desc", Other: "禁止INSERT ... SELECT"}
+	Rule00089Annotation         = &i18n.Message{ID: "Rule00089Annotation", Other: "使用 INSERT ... SELECT 在默认事务隔离级别下，可能会导致对查询的表施加表级锁"}
+	Rule00089Message            = &i18n.Message{ID: "Rule00089Message", Other: "禁止INSERT ... SELECT"}
+	Rule00090Desc               = &i18n.Message{ID: "Rule00090Desc", Other: "建议使用UNION ALL替代UNION"}
+	Rule00090Annotation         = &i18n.Message{ID: "Rule00090Annotation", Other: "union会对结果集进行去重，union all只是简单的将两个结果合并后就返回，从效率上看，union all 要比union快很多；如果合并的两个结果集中允许包含重复数据的话，建议开启此规则，使用union all替代union"}
+	Rule00090Message            = &i18n.Message{ID: "Rule00090Message", Other: "建议使用UNION ALL替代UNION"}
+	Rule00091Desc               = &i18n.Message{ID: "Rule00091Desc", Other: "建议表连接时有连接条件"}
+	Rule00091Annotation         = &i18n.Message{ID: "Rule00091Annotation", Other: "为了确保连接操作的正确性和可靠性，应该始终指定连接条件，定义正确的关联关系。缺少连接条件，可能导致连接操作失败，最终数据库会使用笛卡尔积的方式进行处理，产生不正确的连接结果，并导致性能问题，消耗大量的CPU和内存资源。使用 CROSS JOIN 关键字显式声明的笛卡尔积默认不做检查，可通过规则参数禁止。"}
+	Rule00091Message            = &i18n.Message{ID: "Rule00091Message", Other: "建议表连接时有连接条件"}
+	Rule00091Params1            = &i18n.Message{ID: "Rule00091Params1", Other: "允许显式的CROSS JOIN"}
+	Rule00092Desc               = &i18n.Message{ID: "Rule00092Desc", Other: "建议DELETE/UPDATE语句使用LIMIT子句控制影响行数"}
+	Rule00092Annotation         = &i18n.Message{ID: "Rule00092Annotation", Other: "在进行DELETE和UPDATE操作时，通过添加LIMIT子句可以明确限制操作影响的数据行数。这样做有助于减少由于执行错误而导致的数据损失风险，并可以有效地控制长事务的执行时间，降低对数据库性能的影响。"}
+	Rule00092Message            = &i18n.Message{ID: "Rule00092Message", Other: "建议DELETE/UPDATE语句使用LIMIT子句控制影响行数"}
+	Rule00094Desc               = &i18n.Message{ID: "Rule00094Desc", Other: "避免使用不必要的内置函数"}
+	Rule00094Annotation         = &i18n.Message{ID: "Rule00094Annotation", Other: "通过配置该规则可以指定业务中需要禁止使用的内置函数，使用内置函数可能会导致SQL无法走索引或者产生一些非预期的结果。实际需要禁用的函数可通过规则设置。"}
+	Rule00094Message            = &i18n.Message{ID: "Rule00094Message", Other: "避免使用不必要的内置函数：%v"}
+	Rule00094Params1            = &i18n.Message{ID: "Rule00094Params1", Other: "函数名"}
+	Rule00095Desc               = &i18n.Message{ID: "Rule00095Desc", Other: "建议使用'<>'代替'!='"}
+	Rule00095Annotation         = &i18n.Message{ID: "Rule00095Annotation", Other: "'<>' 是ANSI SQL标准中定义的不等于运算符。如果使用了!=运算符，数据库优化器会自动转换为SQL标准不等于运算符，增加了优化器的转换开销；另外，目前并非所有的SQL数据库系统都支持 !=，使用标准的运算符可以确保SQL在各数据库之间具有更高的兼容性。"}
+	Rule00095Message            = &i18n.Message{ID: "Rule00095Message", Other: "建议使用'<>'代替'!='"}
+	Rule00096Desc               = &i18n.Message{ID: "Rule00096Desc", Other: "不建议参与连接操作的表数量过多"}
+	Rule00096Annotation         = &i18n.Message{ID: "Rule00096Annotation", Other: "表关联越多，意味着各种驱动关系组合就越多，比较各种结果集的执行成本的代价也就越高，进而SQL查询性能会大幅度下降。"}
+	Rule00096Message            = &i18n.Message{ID: "Rule00096Message", Other: "不建议参与连接操作的表数量过多"}
+	Rule00096Params1            = &i18n.Message{ID: "Rule00096Params1", Other: "参与表连接的表个数"}
+	Rule00097Desc               = &i18n.Message{ID: "Rule00097Desc", Other: "禁止对长字段排序"}
+	Rule00097Annotation         = &i18n.Message{ID: "Rule00097Annotation", Other: "在MySQL数据库中，对长字段（如VARCHAR(2000)、TEXT、BLOB等）进行排序操作（包括但不限于ORDER BY、DISTINCT、GROUP BY、UNION等）是不推荐的实践。这类操作会导致排序缓冲区（sort_buffer_size）溢出，引发性能下降和资源浪费。此外，由于长字段排序可能导致临时表（使用Temptable引擎）溢出到磁盘，这不仅会严重影响查询性能，还可能导致系统稳定性和响应能力的降低。"}
+	Rule00097Message            = &i18n.Message{ID: "Rule00097Message", Other: "禁止对长字段排序"}
+	Rule00097Params1            = &i18n.Message{ID: "Rule00097Params1", Other: "排序字段的最大长度"}
+	Rule00098Desc               = &i18n.Message{ID: "Rule00098Desc", Other: "避免对同一张表进行多次连接或查询。"}
+	Rule00098Annotation         = &i18n.Message{ID: "Rule00098Annotation", Other: "在设计SQL语句时，应避免对同一张表进行多次连接或查询。这种做法可能导致查询性能显著下降，因为它会增加数据库的I/O操作，CPU处理以及内存使用，从而影响整体查询效率。"}
+	Rule00098Message            = &i18n.Message{ID: "Rule00098Message", Other: "避免对同一张表进行多次连接或查询。违反规则的表名: %s"}
+	Rule00098Params1            = &i18n.Message{ID: "Rule00098Params1", Other: "max_table_join_count"}
+	Rule00099Desc               = &i18n.Message{ID: "Rule00099Desc", Other: "不建议在SELECT语句中存在FOR UPDATE操作"}
+	Rule00099Annotation         = &i18n.Message{ID: "Rule00099Annotation", Other: "SELECT FOR UPDATE 会对查询结果集中每行数据都添加排他锁，其他线程对该记录的更新与删除操作都会阻塞，在高并发下，容易造成数据库大量锁等待，影响数据库查询性能"}
+	Rule00099Message            = &i18n.Message{ID: "Rule00099Message", Other: "不建议在SELECT语句中存在FOR UPDATE操作"}
+	Rule00100Desc               = &i18n.Message{ID: "Rule00100Desc", Other: "避免SELECT语句一次性返回的结果过多"}
+	Rule00100Annotation         = &i18n.Message{ID: "Rule00100Annotation", Other: "如果查询的扫描行数很大，会导致IO、网络资源消耗过大，并且可能会导致优化器选择错误的执行计划而不走索引。"}
+	Rule00100Message            = &i18n.Message{ID: "Rule00100Message", Other: "避免SELECT语句一次性返回的结果过多"}
+	Rule00100Params1            = &i18n.Message{ID: "Rule00100Params1", Other: "结果集返回行数"}
+	Rule00101Desc               = &i18n.Message{ID: "Rule00101Desc", Other: "不建议针对大表执行SELECT 语句时存在ORDER BY操作"}
+	Rule00101Annotation         = &i18n.Message{ID: "Rule00101Annotation", Other: "在大表的情况下，数据查询中的ORDER BY 操作对查询性能影响较大，建议将排序部分放到业务处理或者限定查询数据的范围。"}
+	Rule00101Message            = &i18n.Message{ID: "Rule00101Message", Other: "不建议针对大表执行SELECT 语句时存在ORDER BY操作"}
+	Rule00102Desc               = &i18n.Message{ID: "Rule00102Desc", Other: "禁止UPDATE/DELETE语句使用ORDER BY操作 "}
+	Rule00102Annotation         = &i18n.Message{ID: "Rule00102Annotation", Other: "使用ORDER BY子句的UPDATE或DELETE语句会导致不必要的性能开销，影响数据库响应时间，并可能导致锁竞争，从而影响到系统的整体性能和稳定性。"}
+	Rule00102Message            = &i18n.Message{ID: "Rule00102Message", Other: "禁止UPDATE/DELETE语句使用ORDER BY操作 "}
+	Rule00107Desc               = &i18n.Message{ID: "Rule00107Desc", Other: "建议将过长的SQL分解成几个简单的SQL"}
+	Rule00107Annotation         = &i18n.Message{ID: "Rule00107Annotation", Other: "过长的SQL可读性较差，难以维护，且容易引发性能问题。"}
+	Rule00107Message            = &i18n.Message{ID: "Rule00107Message", Other: "建议将过长的SQL分解成几个简单的SQL"}
+	Rule00107Params1            = &i18n.Message{ID: "Rule00107Params1", Other: "句子长度限制"}
+	Rule00108Desc               = &i18n.Message{ID: "Rule00108Desc", Other: "避免子查询嵌套层数过多"}
+	Rule00108Annotation         = &i18n.Message{ID: "Rule00108Annotation", Other: "子查询嵌套层数超过阈值，有些情况下，子查询并不能使用到索引。同时对于返回结果集比较大的子查询，会产生大量的临时表，消耗过多的CPU和IO资源，产生大量的慢查询"}
+	Rule00108Message            = &i18n.Message{ID: "Rule00108Message", Other: "避免子查询嵌套层数过多"}
+	Rule00108Params1            = &i18n.Message{ID: "Rule00108Params1", Other: "子查询嵌套层数"}
+	Rule00109Desc               = &i18n.Message{ID: "Rule00109Desc", Other: "禁止在子查询中使用LIMIT"}
+	Rule00109Annotation         = &i18n.Message{ID: "Rule00109Annotation", Other: "不支持在子查询中进行'LIMIT & IN/ALL/ANY/SOME'，数据库会执行报错。"}
+	Rule00109Message            = &i18n.Message{ID: "Rule00109Message", Other: "禁止在子查询中使用LIMIT"}
+	Rule00110Desc               = &i18n.Message{ID: "Rule00110Desc", Other: "建议为SQL查询条件建立索引"}
+	Rule00110Annotation         = &i18n.Message{ID: "Rule00110Annotation", Other: "为SQL查询条件建立索引可以显著提高查询性能，减少I/O操作，并提高查询效率。特别是在处理大数据量的表时，索引可以大幅度缩短查询时间，优化数据库性能。"}
+	Rule00110Message            = &i18n.Message{ID: "Rule00110Message", Other: "建议为SQL查询条件建立索引. 不符合条件的字段有: %v"}
+	Rule00111Desc               = &i18n.Message{ID: "Rule00111Desc", Other: "避免对条件字段使用表达式操作"}
+	Rule00111Annotation         = &i18n.Message{ID: "Rule00111Annotation", Other: "对条件字段做表达式操作，可能会破坏索引值的有序性，导致优化器选择放弃走索引，使查询性能大幅度降低"}
+	Rule00111Message            = &i18n.Message{ID: "Rule00111Message", Other: "避免对条件字段使用表达式操作"}
+	Rule00112Desc               = &i18n.Message{ID: "Rule00112Desc", Other: "禁止WHERE子句中条件字段与值的数据类型不一致"}
+	Rule00112Annotation         = &i18n.Message{ID: "Rule00112Annotation", Other: "WHERE子句中条件字段与值数据类型不一致会引发隐式数据类型转换，导致优化器选择错误的执行计划，在高并发、大数据量的情况下，不走索引会使得数据库的查询性能严重下降"}
+	Rule00112Message            = &i18n.Message{ID: "Rule00112Message", Other: "禁止WHERE子句中条件字段与值的数据类型不一致"}
+	Rule00113Desc               = &i18n.Message{ID: "Rule00113Desc", Other: "不建议对条件字段使用负向查询"}
+	Rule00113Annotation         = &i18n.Message{ID: "Rule00113Annotation", Other: "SQL查询条件中存在NOT、NOT IN、NOT LIKE、NOT EXISTS、不等于等负向查询条件，将导致优化器选择错误的执行计划，导致出现慢SQL。"}
+	Rule00113Message            = &i18n.Message{ID: "Rule00113Message", Other: "不建议对条件字段使用负向查询"}
+	Rule00115Desc               = &i18n.Message{ID: "Rule00115Desc", Other: "避免使用标量子查询"}
+	Rule00115Annotation         = &i18n.Message{ID: "Rule00115Annotation", Other: "标量子查询存在多次访问同一张表的问题，执行开销大效率低，可使用JOIN操作或者关联子查询结果集的方式替代标量子查询。"}
+	Rule00115Message            = &i18n.Message{ID: "Rule00115Message", Other: "避免使用标量子查询"}
+	Rule00118Desc               = &i18n.Message{ID: "Rule00118Desc", Other: "建议在执行DROP/TRUNCATE等操作前进行备份"}
+	Rule00118Annotation         = &i18n.Message{ID: "Rule00118Annotation", Other: "DROP/TRUNCATE是DDL，操作立即生效，不会写入日志，所以无法回滚，在执行高危操作之前对数据进行备份是很有必要的"}
+	Rule00118Message            = &i18n.Message{ID: "Rule00118Message", Other: "建议在执行DROP/TRUNCATE等操作前进行备份"}
+	Rule00119Desc               = &i18n.Message{ID: "Rule00119Desc", Other: "建议为GROUP BY语句添加ORDER BY条件"}
+	Rule00119Annotation         = &i18n.Message{ID: "Rule00119Annotation", Other: "GROUP BY 语句不加ORDER BY，结果将无序或者非预期的排序。建议加上ORDER BY 条件，按照一定的顺序来展示查询结果。"}
+	Rule00119Message            = &i18n.Message{ID: "Rule00119Message", Other: "建议为GROUP BY语句添加ORDER BY条件"}
+	Rule00120Desc               = &i18n.Message{ID: "Rule00120Desc", Other: "避免使用 IN (NULL) 或者 NOT IN (NULL)"}
+	Rule00120Annotation         = &i18n.Message{ID: "Rule00120Annotation", Other: "使用 `IN(NULL)` 或 `NOT IN(NULL)` 会导致查询条件永远为假，从而使得查询无法返回任何结果。这不仅影响查询逻辑和结果的准确性，还可能导致性能问题和不必要的资源消耗。"}
+	Rule00120Message            = &i18n.Message{ID: "Rule00120Message", Other: "避免使用 IN (NULL) 或者 NOT IN (NULL)"}
+	Rule00121Desc               = &i18n.Message{ID: "Rule00121Desc", Other: "建议在限定记录数的查询语句中使用ORDER BY"}
+	Rule00121Annotation         = &i18n.Message{ID: "Rule00121Annotation", Other: "在限定记录的查询语句中，如果没有ORDER BY子句，每次查询的结果可能会受数据更新影响而出现非确定性的结，最终与业务需求不符。"}
+	Rule00121Message            = &i18n.Message{ID: "Rule00121Message", Other: "建议在限定记录数的查询语句中使用ORDER BY"}
+	Rule00122Desc               = &i18n.Message{ID: "Rule00122Desc", Other: "避免对值全为NULL的列直接使用 SUM或COUNT函数"}
+	Rule00122Annotation         = &i18n.Message{ID: "Rule00122Annotation", Other: "当某一列的值全是NULL时，COUNT(COL)的返回结果为0，但SUM(COL)的返回结果为NULL，因此使用SUM()时需注意NPE问题（指数据返回NULL）；如业务需避免NPE问题，建议开启此规则"}
+	Rule00122Message            = &i18n.Message{ID: "Rule00122Message", Other: "避免对值全为NULL的列直接使用 SUM或COUNT函数. 违反规则的列名: %s"}
+	Rule00123Desc               = &i18n.Message{ID: "Rule00123Desc", Other: "禁止使用TRUNCATE操作"}
+	Rule00123Annotation         = &i18n.Message{ID: "Rule00123Annotation", Other: "TRUNCATE是DDL，可以快速清理全表数据，回收磁盘空间，执行后数据默认隐式提交，无法回滚，在没有备份的场景下，谨慎使用TRUNCATE"}
+	Rule00123Message            = &i18n.Message{ID: "Rule00123Message", Other: "禁止使用TRUNCATE操作"}
+	Rule00124Desc               = &i18n.Message{ID: "Rule00124Desc", Other: "删除全表时建议使用 TRUNCATE 替代 DELETE"}
+	Rule00124Annotation         = &i18n.Message{ID: "Rule00124Annotation", Other: "TRUNCATE TABLE 比 DELETE 速度快，且使用的系统和事务日志资源少，同时TRUNCATE后表所占用的空间会被释放，而DELETE后需要手工执行OPTIMIZE才能释放表空间"}
+	Rule00124Message            = &i18n.Message{ID: "Rule00124Message", Other: "删除全表时建议使用 TRUNCATE 替代 DELETE"}
+	Rule00126Desc               = &i18n.Message{ID: "Rule00126Desc", Other: "不建议对字段编号进行 GROUP BY"}
+	Rule00126Annotation         = &i18n.Message{ID: "Rule00126Annotation", Other: "GROUP BY 1 表示按第一列进行GROUP BY；在GROUP BY子句中使用字段编号，而不是表达式或列名称，当查询列顺序改变时，会导致查询逻辑出现问题"}
+	Rule00126Message            = &i18n.Message{ID: "Rule00126Message", Other: "不建议对字段编号进行 GROUP BY"}
+	Rule00127Desc               = &i18n.Message{ID: "Rule00127Desc", Other: "不建议在ORDER BY中使用表达式或函数"}
+	Rule00127Annotation         = &i18n.Message{ID: "Rule00127Annotation", Other: "在ORDER BY子句中使用表达式或函数会导致无法有效利用索引，从而可能涉及到全表扫描和使用临时表进行数据排序。这样的操作在处理大数据量时会显著降低查询性能。"}
+	Rule00127Message            = &i18n.Message{ID: "Rule00127Message", Other: "不建议在ORDER BY中使用表达式或函数"}
+	Rule00128Desc               = &i18n.Message{ID: "Rule00128Desc", Other: "不建议使用 HAVING 子句"}
+	Rule00128Annotation         = &i18n.Message{ID: "Rule00128Annotation", Other: "对于索引字段，放在HAVING子句中时不会走索引；建议将HAVING子句改写为WHERE中的查询条件，可以在查询处理期间使用索引，提高SQL的执行效率"}
+	Rule00128Message            = &i18n.Message{ID: "Rule00128Message", Other: "不建议使用 HAVING 子句"}
+	Rule00131Desc               = &i18n.Message{ID: "Rule00131Desc", Other: "避免使用 ORDER BY RAND() 进行随机排序"}
+	Rule00131Annotation         = &i18n.Message{ID: "Rule00131Annotation", Other: "使用 ORDER BY RAND() 会导致数据库生成临时表并进行完整的表扫描和排序，这在处理大数据量时会显著增加查询时间和服务器负载。建议采用更高效的随机数据检索方法，如利用主键或其他索引实现快速随机访问。"}
+	Rule00131Message            = &i18n.Message{ID: "Rule00131Message", Other: "避免使用 ORDER BY RAND() 进行随机排序"}
+	Rule00132Desc               = &i18n.Message{ID: "Rule00132Desc", Other: "不推荐使用子查询"}
+	Rule00132Annotation         = &i18n.Message{ID: "Rule00132Annotation", Other: "有些情况下，子查询并不能使用到索引，同时对于返回结果集比较大的子查询，会产生大量的临时表，消耗过多的CPU和IO资源，产生大量的慢查询"}
+	Rule00132Message            = &i18n.Message{ID: "Rule00132Message", Other: "不推荐使用子查询"}
+	Rule00134Desc               = &i18n.Message{ID: "Rule00134Desc", Other: "避免对主键值进行修改"}
+	Rule00134Annotation         = &i18n.Message{ID: "Rule00134Annotation", Other: "主键在大多数数据库系统中用于定义数据的唯一性，并且常常与数据的物理存储结构密切相关。更新主键会导致底层存储结构（如聚簇索引）的重大重新组织，引发性能下降。此外，主键的更改可能影响数据一致性，尤其在涉及复杂事务处理和高并发操作的场景中。"}
+	Rule00134Message            = &i18n.Message{ID: "Rule00134Message", Other: "避免对主键值进行修改"}
+	Rule00139Desc               = &i18n.Message{ID: "Rule00139Desc", Other: "不建议使用全表扫描"}
+	Rule00139Annotation         = &i18n.Message{ID: "Rule00139Annotation", Other: "全表扫描是数据库执行查询时读取表中每一行来查找匹配记录的过程。对于大型表来说，出现全表扫描的SQL会导致显著的性能下降和资源消耗，影响业务稳定运行。"}
+	Rule00139Message            = &i18n.Message{ID: "Rule00139Message", Other: "不建议使用全表扫描. 表大小阈值: %v GB"}
+	Rule00139Params1            = &i18n.Message{ID: "Rule00139Params1", Other: "表大小(GB)"}
+	Rule00140Desc               = &i18n.Message{ID: "Rule00140Desc", Other: "建议对表、视图等对象进行操作时指定库名"}
+	Rule00140Annotation         = &i18n.Message{ID: "Rule00140Annotation", Other: "对表、视图等对象进行创建、修改、查询、更新、删除等DDL、DML操作时，如未指定schema或者库名，会导致在不确定的数据库下执行，与实际业务预期不符合，而且会导致SQL语句执行错误。"}
+	Rule00140Message            = &i18n.Message{ID: "Rule00140Message", Other: "建议对表、视图等对象进行操作时指定库名"}
+	Rule00141Desc               = &i18n.Message{ID: "Rule00141Desc", Other: "表关联嵌套循环的层次过多"}
+	Rule00141Annotation         = &i18n.Message{ID: "Rule00141Annotation", Other: "嵌套越深，需要扫描的行数、生成的结果集就越大，SQL的执行效率越低。"}
+	Rule00141Message            = &i18n.Message{ID: "Rule00141Message", Other: "表关联嵌套循环的层次过多"}
+	Rule00141Params1            = &i18n.Message{ID: "Rule00141Params1", Other: "表关联嵌套循环层数"}
+	Rule00143Desc               = &i18n.Message{ID: "Rule00143Desc", Other: "多表关联时，不建议在WHERE条件中对不同表的字段使用OR条件"}
+	Rule00143Annotation         = &i18n.Message{ID: "Rule00143Annotation", Other: "多表关联时，在WHERE条件中对不同表的字段使用OR条件可能会导致SQL无法使用正确的索引"}
+	Rule00143Message            = &i18n.Message{ID: "Rule00143Message", Other: "多表关联时，不建议在WHERE条件中对不同表的字段使用OR条件"}
+	Rule00151Desc               = &i18n.Message{ID: "Rule00151Desc", Other: "避免CREATE TABLE/ALTER TABLE 使用禁止的表空间"}
+	Rule00151Annotation         = &i18n.Message{ID: "Rule00151Annotation", Other: "不允许在系统表空间上创建用户对象，避免不必要的安全风险，方便维护"}
+	Rule00151Message            = &i18n.Message{ID: "Rule00151Message", Other: "避免CREATE TABLE/ALTER TABLE 使用禁止的表空间"}
+	Rule00153Desc               = &i18n.Message{ID: "Rule00153Desc", Other: "创建表建议添加索引"}
+	Rule00153Annotation         = &i18n.Message{ID: "Rule00153Annotation", Other: "规划和设计表时，索引应根据业务需求和数据分布合理创建，无索引通常是不合理的情况"}
+	Rule00153Message            = &i18n.Message{ID: "Rule00153Message", Other: "创建表建议添加索引"}
+	Rule00161Desc               = &i18n.Message{ID: "Rule00161Desc", Other: "建议序列或自增字段的步长为1"}
+	Rule00161Annotation         = &i18n.Message{ID: "Rule00161Annotation", Other: "序列或自增字段的步长为1时，有助于保证主键和其他自增字段的连续性，避免不必要的数据间隔和数字资源的浪费。不仅简化了数据库的管理和维护，而且也提高了系统的可预测性和稳定性。特别是在处理大量数据插入或高并发场景时，连续的主键值还能减少潜在的冲突和错误。"}
+	Rule00161Message            = &i18n.Message{ID: "Rule00161Message", Other: "建议序列或自增字段的步长为1"}
+	Rule00170Desc               = &i18n.Message{ID: "Rule00170Desc", Other: "避免缩短字段长度"}
+	Rule00170Annotation         = &i18n.Message{ID: "Rule00170Annotation", Other: "修改字段长度值低于现有字段长度值，如果该字段现有数据超出设定后的长度，会造成语句执行报错"}
+	Rule00170Message            = &i18n.Message{ID: "Rule00170Message", Other: "避免缩短字段长度. 字段 %s 的新长度 %d 小于当前最大长度 %d"}
+	Rule00174Desc               = &i18n.Message{ID: "Rule00174Desc", Other: "禁止GRANT 授予过高权限"}
+	Rule00174Annotation         = &i18n.Message{ID: "Rule00174Annotation", Other: "授予过高权限，可能会带来严重的安全风险。"}
+	Rule00174Message            = &i18n.Message{ID: "Rule00174Message", Other: "禁止GRANT 授予过高权限"}
+	Rule00174Params1            = &i18n.Message{ID: "Rule00174Params1", Other: "高权限范围"}
+	Rule00175Desc               = &i18n.Message{ID: "Rule00175Desc", Other: "避免不必要的索引扫描合并"}
+	Rule00175Annotation         = &i18n.Message{ID: "Rule00175Annotation", Other: "索引合并说明一个查询同时使用了多个索引，增加了更多IO操作，特别是在数据量大的情况下执行效率比复合索引明显更多。此外，索引合并操作可能消耗更多CPU和内存资源，以及较长的查询响应时间。"}
+	Rule00175Message            = &i18n.Message{ID: "Rule00175Message", Other: "避免不必要的索引扫描合并"}
+	Rule00176Desc               = &i18n.Message{ID: "Rule00176Desc", Other: "不建议SQL中包含hint指令"}
+	Rule00176Annotation         = &i18n.Message{ID: "Rule00176Annotation", Other: "使用hint可能会导致数据库走错误的执行计划，从而影响执行效率，消耗系统资源。"}
+	Rule00176Message            = &i18n.Message{ID: "Rule00176Message", Other: "不建议SQL中包含hint指令"}
+	Rule00177Desc               = &i18n.Message{ID: "Rule00177Desc", Other: "建议Order By字段个数不超过指定阈值"}
+	Rule00177Annotation         = &i18n.Message{ID: "Rule00177Annotation", Other: "使用过多的Order By字段会增加排序操作的复杂性，并可能导致性能下降。排序时，MySQL需要对结果集中的每一行进行多字段比较，这可能会耗费更多的CPU和内存资源。如果排序数据集大小超过了可用内存，则可能会导致创建临时表并在磁盘上进行排序，从而增加I/O开销。"}
+	Rule00177Message            = &i18n.Message{ID: "Rule00177Message", Other: "建议Order By字段个数不超过指定阈值. 阈值: %v"}
+	Rule00177Params1            = &i18n.Message{ID: "Rule00177Params1", Other: "order by字段个数最大值"}
+	Rule00178Desc               = &i18n.Message{ID: "Rule00178Desc", Other: "SQL语句存在全表排序操作"}
+	Rule00178Annotation         = &i18n.Message{ID: "Rule00178Annotation", Other: "SQL语句存在全表排序操作，无过滤条件，也就是WHERE 必须显式指定过滤条件"}
+	Rule00178Message            = &i18n.Message{ID: "Rule00178Message", Other: "SQL语句存在全表排序操作"}
+	Rule00179Desc               = &i18n.Message{ID: "Rule00179Desc", Other: "避免隐式数据类型转换的SQL查询"}
+	Rule00179Annotation         = &i18n.Message{ID: "Rule00179Annotation", Other: "确保WHERE子句中用于索引列的条件字段与索引列的数据类型一致。不一致的数据类型会导致执行计划存在隐式类型转换操作。这种转换不仅增加CPU负担，还可能使得原本高效的索引无法使用，导致查询性能显著下降。"}
+	Rule00179Message            = &i18n.Message{ID: "Rule00179Message", Other: "避免隐式数据类型转换的SQL查询"}
+	Rule00180Desc               = &i18n.Message{ID: "Rule00180Desc", Other: "避免执行计划中 filter 次数过多"}
+	Rule00180Annotation         = &i18n.Message{ID: "Rule00180Annotation", Other: "执行计划中的filter 步骤表示查询在检索数据之后需要进行额外的行过滤。过滤通常发生在已经通过索引或其他方法获取的行集上。如果这个步骤处理的行数很多，那么它可能会成为查询性能的瓶颈。"}
+	Rule00180Message            = &i18n.Message{ID: "Rule00180Message", Other: "避免执行计划中 filter 次数过多"}
+	Rule00180Params1            = &i18n.Message{ID: "Rule00180Params1", Other: "filter 个数阈值"}
+	Rule00218Desc               = &i18n.Message{ID: "Rule00218Desc", Other: "联合索引最左侧的字段必须出现在查询条件内"}
+	Rule00218Annotation         = &i18n.Message{ID: "Rule00218Annotation", Other: "当查询条件包含联合索引的最左侧字段时，查询语句才能更好的利用索引的特性：有序性、过滤性等"}
+	Rule00218Message            = &i18n.Message{ID: "Rule00218Message", Other: "联合索引最左侧的字段必须出现在查询条件内. 不符合规范的字段: %v"}
+	Rule00219Desc               = &i18n.Message{ID: "Rule00219Desc", Other: "建表DDL必须包括创建时间字段，并应确保该字段能记录表记录的创建时间。"}
+	Rule00219Annotation         = &i18n.Message{ID: "Rule00219Annotation", Other: "使用创建时间字段，有利于问题查找跟踪和检索数据，同时避免后期对数据生命周期管理不便 ，可保证时间的准确性"}
+	Rule00219Message            = &i18n.Message{ID: "Rule00219Message", Other: "建表DDL必须包括创建时间字段，并应确保该字段能记录表记录的创建时间。"}
+	Rule00219Params1            = &i18n.Message{ID: "Rule00219Params1", Other: "创建时间字段名"}
+	Rule00220Desc               = &i18n.Message{ID: "Rule00220Desc", Other: "避免不带where条件的count(*)或者count(1)"}
+	Rule00220Annotation         = &i18n.Message{ID: "Rule00220Annotation", Other: "不带 where 条件的 count(*) 或者 count(1) 都是对表进行暴力扫描，极其耗费系统资源"}
+	Rule00220Message            = &i18n.Message{ID: "Rule00220Message", Other: "避免不带where条件的count(*)或者count(1)"}
+	Rule00221Desc               = &i18n.Message{ID: "Rule00221Desc", Other: "建表需显式指定字符集和排序规则"}
+	Rule00221Annotation         = &i18n.Message{ID: "Rule00221Annotation", Other: "未显式指定字符集和排序规则时，表会继承库或实例的默认值，不同环境的默认值可能不同，导致表的字符集和排序规则不一致，进而引发乱码、索引失效或关联查询时的隐式转换。字段单独指定与表不同的字符集和排序规则同样会导致上述问题。"}
+	Rule00221Message            = &i18n.Message{ID: "Rule00221Message", Other: "建表需显式指定字符集和排序规则，且须为以下组合之一: %v，字段的字符集和排序规则须与表一致"}
+	Rule00221Params1            = &i18n.Message{ID: "Rule00221Params1", Other: "允许的字符集和排序规则组合，格式为 字符集:排序规则，多个组合用英文逗号分隔"}
+	Rule00222Desc               = &i18n.Message{ID: "Rule00222Desc", Other: "避免WHERE或JOIN条件中字符串字段与数值比较"}
+	Rule00222Annotation         = &i18n.Message{ID: "Rule00222Annotation", Other: "字符串类型的字段与数值常量比较（或数值类型的字段与字符串常量比较）时，MySQL会将两侧转换为浮点数后比较，字符串字段上的索引将无法使用，导致全表扫描；同时转换规则可能导致非预期的匹配结果。建议常量的类型与字段类型保持一致，例如 WHERE phone = '13800000000'。"}
+	Rule00222Message            = &i18n.Message{ID: "Rule00222Message", Other: "WHERE或JOIN条件中字段与常量类型不一致，存在隐式类型转换: %v"}
+	Rule00223Desc               = &i18n.Message{ID: "Rule00223Desc", Other: "在 MySQL 中，外键字段必须被索引覆盖"}
+	Rule00223Annotation         = &i18n.Message{ID: "Rule00223Annotation", Other: "外键字段需要是某个索引的最左前缀。MySQL 在检查外键约束以及对父表执行更新、删除时需要通过外键字段查找子表数据，若外键字段没有索引将导致全表扫描和大范围加锁；同时 InnoDB 在外键字段缺少索引时会隐式创建索引，导致表上的索引不可控。建议在定义外键时显式创建以外键字段为前缀的索引。"}
+	Rule00223Message            = &i18n.Message{ID: "Rule00223Message", Other: "在 MySQL 中，外键字段必须被索引覆盖，未被索引覆盖的外键字段: %v"}
+	Rule00224Desc               = &i18n.Message{ID: "Rule00224Desc", Other: "在 MySQL 中，数据库的sql_mode需包含必需的模式"}
+	Rule00224Annotation         = &i18n.Message{ID: "Rule00224Annotation", Other: "sql_mode缺少STRICT_TRANS_TABLES时，写入超长或类型不匹配的数据会被静默截断或转换，仅产生警告；缺少ONLY_FULL_GROUP_BY时，GROUP BY查询可能返回不确定的结果。建议在数据库中开启必需的sql_mode，以避免数据被静默修改。"}
+	Rule00224Message            = &i18n.Message{ID: "Rule00224Message", Other: "在 MySQL 中，数据库的sql_mode需包含必需的模式，缺少的模式: %v"}
+	Rule00224Params1            = &i18n.Message{ID: "Rule00224Params1", Other: "必需的sql_mode，多个模式用逗号分隔"}
+	Rule00225Desc               = &i18n.Message{ID: "Rule00225Desc", Other: "在 MySQL 中，主键和唯一键的字段必须显式定义NOT NULL约束"}
+	Rule00225Annotation         = &i18n.Message{ID: "Rule00225Annotation", Other: "主键和作为自然键的唯一键用于唯一标识一行记录。MySQL 会将未声明 NOT NULL 的主键字段隐式转换为 NOT NULL，使表结构与 DDL 不一致；而唯一键允许存在多个 NULL 值，可空字段将使唯一约束失效。建议为主键和唯一键的每个字段显式定义 NOT NULL 约束。"}
+	Rule00225Message            = &i18n.Message{ID: "Rule00225Message", Other: "在 MySQL 中，主键和唯一键的字段必须显式定义NOT NULL约束，不符合要求的字段: %v"}
+	Rule00226Desc               = &i18n.Message{ID: "Rule00226Desc", Other: "在 MySQL 中，预期数据量大的表建议使用分区表"}
+	Rule00226Annotation         = &i18n.Message{ID: "Rule00226Annotation", Other: "日志、流水等预期数据量大的表随时间持续增长，不分区时单表过大会导致查询、备份和历史数据清理的成本越来越高。建议对这类表按时间字段使用 RANGE 分区，查询可以通过分区裁剪只扫描相关分区，历史数据可以通过 DROP PARTITION 快速清理。临时表不做检查。"}
+	Rule00226Message            = &i18n.Message{ID: "Rule00226Message", Other: "在 MySQL 中，预期数据量大的表建议使用分区表，表: %v"}
+	Rule00226Params1            = &i18n.Message{ID: "Rule00226Params1", Other: "大表表名的正则表达式"}
+	Rule00227Desc               = &i18n.Message{ID: "Rule00227Desc", Other: "在 MySQL 中，TEXT/BLOB/JSON类型的列建立索引时必须指定前缀长度"}
+	Rule00227Annotation         = &i18n.Message{ID: "Rule00227Annotation", Other: "MySQL 不支持对 TEXT、BLOB 类型的列的完整值建立索引，必须指定前缀长度，否则语句执行失败；JSON 类型的列也不能直接建立索引，可以通过生成列或函数索引对其中的值建立索引。即使指定了前缀长度，过长的前缀也会使索引占用大量空间，降低写入和查询的性能，建议将前缀长度控制在规则参数以内。全文索引和空间索引不做检查。"}
+	Rule00227Message            = &i18n.Message{ID: "Rule00227Message", Other: "在 MySQL 中，TEXT/BLOB/JSON类型的列建立索引时必须指定前缀长度，且前缀长度不能超过规则参数，索引(列): %v"}
+	Rule00227Params1            = &i18n.Message{ID: "Rule00227Params1", Other: "前缀长度的最大值(0表示不限制)"}
+	Rule00228Desc               = &i18n.Message{ID: "Rule00228Desc", Other: "在 MySQL 中，为表新增NOT NULL字段时必须指定默认值"}
+	Rule00228Annotation         = &i18n.Message{ID: "Rule00228Annotation", Other: "为已有数据的表新增没有默认值的NOT NULL字段，在严格模式下可能执行失败，或者需要为已有的行填充隐式默认值而重建表；大表重建耗时长、占用大量IO，期间可能阻塞业务。新增可为空的字段或指定默认值的字段可以使用即时算法。表大小大于等于规则参数时按规则等级提示，否则（包括离线审核）按低于规则等级一级的等级提示。自增列和生成列不检查。"}
+	Rule00228Message            = &i18n.Message{ID: "Rule00228Message", Other: "在 MySQL 中，为表新增NOT NULL字段时必须指定默认值，字段: %v"}
+	Rule00228Params1            = &i18n.Message{ID: "Rule00228Params1", Other: "表大小阈值(MB)，超过阈值时按规则等级提示"}
+	Rule00229Desc               = &i18n.Message{ID: "Rule00229Desc", Other: "在 MySQL 中，表的存储引擎必须在允许的范围内"}
+	Rule00229Annotation         = &i18n.Message{ID: "Rule00229Annotation", Other: "混用 InnoDB 和 MyISAM 等存储引擎会导致事务行为不一致：非事务引擎的表不会随事务回滚，崩溃后也可能丢失数据。建议统一使用规则参数中允许的存储引擎。建表语句未指定存储引擎时使用服务器的默认存储引擎，它不一定是 InnoDB，可以通过第二个规则参数开启检查，离线审核时无法得知默认存储引擎，开启检查后会直接提示。"}
+	Rule00229Message            = &i18n.Message{ID: "Rule00229Message", Other: "在 MySQL 中，表的存储引擎必须在允许的范围内，存储引擎: %v"}
+	Rule00229Params1            = &i18n.Message{ID: "Rule00229Params1", Other: "允许的存储引擎(多个引擎用逗号分隔)"}
+	Rule00229Params2            = &i18n.Message{ID: "Rule00229Params2", Other: "建表语句未指定存储引擎时是否检查默认存储引擎"}
+	Rule00230Desc               = &i18n.Message{ID: "Rule00230Desc", Other: "在 MySQL 中，加锁读必须使用索引列的等值或范围条件"}
+	Rule00230Annotation         = &i18n.Message{ID: "Rule00230Annotation", Other: "InnoDB 的加锁读(SELECT ... FOR UPDATE/LOCK IN SHARE MODE)会对扫描过的每一条索引记录加 next-key 锁(记录锁加间隙锁)，而不仅仅是最终返回的记录。如果 WHERE 条件无法通过索引定位，查询会扫描聚簇索引，锁住整张表的记录和间隙，阻塞其他事务的更新和插入，高并发下容易引起大量锁等待甚至死锁。建议在加锁读的 WHERE 条件中使用索引第一列的等值或范围条件，缩小加锁范围。离线审核时无法获取索引信息，仅检查没有 WHERE 条件的加锁读。"}
+	Rule00230Message            = &i18n.Message{ID: "Rule00230Message", Other: "在 MySQL 中，加锁读必须使用索引列的等值或范围条件，加锁方式: %v，表: %v"}
+	Rule00231Desc               = &i18n.Message{ID: "Rule00231Desc", Other: "在 MySQL 中，删除分区会同时删除分区中的数据，执行前需要确认"}
+	Rule00231Annotation         = &i18n.Message{ID: "Rule00231Annotation", Other: "ALTER TABLE ... DROP PARTITION 会直接删除分区及其中的全部数据，且不会记录逐行的 binlog，无法通过回滚或闪回工具恢复。它常用于按时间归档的分区表清理历史数据，但分区名写错或分区范围理解有误都会造成数据丢失。执行前请确认被删除分区中的数据已经备份或不再需要；如果只需要清空数据而保留分区，可以使用 TRUNCATE PARTITION。"}
+	Rule00231Message            = &i18n.Message{ID: "Rule00231Message", Other: "在 MySQL 中，删除分区会同时删除分区中的数据，执行前需要确认，表: %v，分区: %v"}
+	Rule00232Desc               = &i18n.Message{ID: "Rule00232Desc", Other: "在 MySQL 中，建表时主键字段应为第一列，审计字段应为最后的列"}
+	Rule00232Annotation         = &i18n.Message{ID: "Rule00232Annotation", Other: "统一的字段顺序便于阅读表结构和维护：主键字段放在第一列，创建时间、更新时间等审计字段按约定的顺序放在最后。规则参数可以指定主键字段名和审计字段名；表中没有这些字段时不检查，如需强制要求表包含审计字段，可以开启第三个规则参数。"}
+	Rule00232Message            = &i18n.Message{ID: "Rule00232Message", Other: "在 MySQL 中，建表时主键字段应为第一列，审计字段应为最后的列，表: %v，期望的字段顺序: %v"}
+	Rule00232Params1            = &i18n.Message{ID: "Rule00232Params1", Other: "主键字段名"}
+	Rule00232Params2            = &i18n.Message{ID: "Rule00232Params2", Other: "审计字段名(多个字段用逗号分隔，按期望的顺序)"}
+	Rule00232Params3            = &i18n.Message{ID: "Rule00232Params3", Other: "是否要求表必须包含审计字段"}
+	Rule00233Desc               = &i18n.Message{ID: "Rule00233Desc", Other: "在 MySQL 中，避免使用 NOT IN (子查询)，建议改写为 NOT EXISTS"}
+	Rule00233Annotation         = &i18n.Message{ID: "Rule00233Annotation", Other: "当子查询的结果中包含 NULL 时，expr NOT IN (子查询) 的结果为 NULL 而不是 TRUE，查询不会返回任何数据，这通常不是预期的结果；NOT IN (子查询) 也难以被优化为反连接，容易导致子查询被反复执行。NOT EXISTS 不受 NULL 的影响，在列不为 NULL 时与 NOT IN 的结果一致。规则会给出改写后的SQL：外层的列会使用外层表名或别名限定，关联子查询中原有的关联条件保持不变；子查询为 UNION，或包含 GROUP BY、HAVING、LIMIT、聚合函数，或外层有多张表且列没有限定表名时无法自动改写，提示为 \"-\"。"}
+	Rule00233Message            = &i18n.Message{ID: "Rule00233Message", Other: "在 MySQL 中，避免使用 NOT IN (子查询)，建议改写为 NOT EXISTS，改写后的SQL: %v"}
+	Rule00234Desc               = &i18n.Message{ID: "Rule00234Desc", Other: "在 MySQL 中，TIMESTAMP 字段应显式指定 DEFAULT 和 NULL/NOT NULL"}
+	Rule00234Annotation         = &i18n.Message{ID: "Rule00234Annotation", Other: "TIMESTAMP 字段的隐式默认值取决于系统变量 explicit_defaults_for_timestamp：该变量关闭时，未指定 DEFAULT 和 NULL 的 TIMESTAMP 字段会被隐式地设为 NOT NULL，第一个这样的字段还会被自动初始化和自动更新为当前时间，容易导致数据被意外修改。建议显式指定 DEFAULT 和 NULL/NOT NULL。在线审核时若 explicit_defaults_for_timestamp 为 ON，按低于规则等级一级的等级提示，否则（包括离线审核）按规则等级提示。开启规则参数后，要求使用 DATETIME 代替 TIMESTAMP，所有 TIMESTAMP 字段都会被提示。"}
+	Rule00234Message            = &i18n.Message{ID: "Rule00234Message", Other: "在 MySQL 中，TIMESTAMP 字段应显式指定 DEFAULT 和 NULL/NOT NULL，或使用 DATETIME 代替，字段: %v"}
+	Rule00234Params1            = &i18n.Message{ID: "Rule00234Params1", Other: "是否要求使用 DATETIME 代替 TIMESTAMP"}
+	Rule00235Desc               = &i18n.Message{ID: "Rule00235Desc", Other: "在 MySQL 中，金额字段应使用 DECIMAL 类型并保留足够的小数位"}
+	Rule00235Annotation         = &i18n.Message{ID: "Rule00235Annotation", Other: "FLOAT 和 DOUBLE 是近似数值类型，存储和计算金额时会产生舍入误差，金额字段应使用精确数值类型 DECIMAL(p,s)，并保留足够的小数位。列名（不区分大小写）匹配第一个规则参数的列被视为金额字段，DECIMAL 的小数位数（未指定时为0）应不小于第二个规则参数。"}
+	Rule00235Message            = &i18n.Message{ID: "Rule00235Message", Other: "在 MySQL 中，金额字段应使用 DECIMAL 类型并保留足够的小数位，字段: %v"}
+	Rule00235Params1            = &i18n.Message{ID: "Rule00235Params1", Other: "金额字段名的正则表达式"}
+	Rule00235Params2            = &i18n.Message{ID: "Rule00235Params2", Other: "DECIMAL 的最小小数位数"}
+	Rule00236Desc               = &i18n.Message{ID: "Rule00236Desc", Other: "在 MySQL 中，表的行格式应为允许的行格式"}
+	Rule00236Annotation         = &i18n.Message{ID: "Rule00236Annotation", Other: "InnoDB 的 COMPACT 和 REDUNDANT 行格式将变长字段的前768字节存储在行内，索引键前缀最多为767字节，使用 utf8mb4 字符集（每个字符最多4字节）时，VARCHAR(191) 以上的字段无法创建完整的索引，大的 VARCHAR/TEXT 字段也更容易导致行过大。DYNAMIC 和 COMPRESSED 行格式支持最多3072字节的索引键前缀，并将长字段完全存储在溢出页中。建议使用第一个规则参数中允许的行格式，ROW_FORMAT=DEFAULT 视为未指定；开启第二个规则参数后，建表时未指定行格式也会被提示。"}
+	Rule00236Message            = &i18n.Message{ID: "Rule00236Message", Other: "在 MySQL 中，表的行格式应为允许的行格式，表: %v，行格式: %v"}
+	Rule00236Params1            = &i18n.Message{ID: "Rule00236Params1", Other: "允许的行格式(多个用逗号分隔)"}
+	Rule00236Params2            = &i18n.Message{ID: "Rule00236Params2", Other: "建表时是否要求显式指定行格式"}
+	Rule00237Desc               = &i18n.Message{ID: "Rule00237Desc", Other: "在 MySQL 中，整数类型的单列主键应设置为自增"}
+	Rule00237Annotation         = &i18n.Message{ID: "Rule00237Annotation", Other: "使用自增的代理主键时，新行总是追加到聚簇索引的末尾，避免页分裂和碎片，主键也更短、更稳定，二级索引占用的空间更小。整数类型的单列主键通常是代理键，如果不设置为自增，需要由应用生成唯一值，容易产生冲突和乱序写入。主键为自然键（如业务编码）的表可以通过规则参数按表名或主键字段名豁免。"}
+	Rule00237Message            = &i18n.Message{ID: "Rule00237Message", Other: "在 MySQL 中，整数类型的单列主键应设置为自增，字段: %v"}
+	Rule00237Params1            = &i18n.Message{ID: "Rule00237Params1", Other: "豁免的表名或主键字段名(正则表达式，主键为自然键的表)"}
+	Rule00238Desc               = &i18n.Message{ID: "Rule00238Desc", Other: "在 MySQL 中，应根据数据长度是否固定选择 CHAR 或 VARCHAR 类型"}
+	Rule00238Annotation         = &i18n.Message{ID: "Rule00238Annotation", Other: "CHAR 是定长类型，不足长度的值会用空格补齐，用于存储长度变化较大的数据时会浪费存储空间，使用多字节字符集时浪费更明显，较长的 CHAR 字段建议使用 VARCHAR。VARCHAR 需要额外的1~2字节记录长度，对于长度固定且很短的值（如状态码、性别），使用 CHAR 更节省空间，开启第二个规则参数后，长度不超过2的 VARCHAR 字段会被提示。"}
+	Rule00238Message            = &i18n.Message{ID: "Rule00238Message", Other: "在 MySQL 中，应根据数据长度是否固定选择 CHAR 或 VARCHAR 类型，字段及建议的类型: %v"}
+	Rule00238Params1            = &i18n.Message{ID: "Rule00238Params1", Other: "CHAR最大长度"}
+	Rule00238Params2            = &i18n.Message{ID: "Rule00238Params2", Other: "是否检查长度不超过2的VARCHAR字段"}
+	Rule00239Desc               = &i18n.Message{ID: "Rule00239Desc", Other: "在 MySQL 中，外键应显式命名"}
+	Rule00239Annotation         = &i18n.Message{ID: "Rule00239Annotation", Other: "未显式命名的外键由 MySQL 自动生成名称（如 t1_ibfk_1），生成的名称依赖建表顺序，在开发、测试、生产等不同环境中可能不一致，导致 DROP FOREIGN KEY 等变更脚本无法在各环境中复用，也不利于结构比对；显式命名外键后可在各环境中统一管理。可通过规则参数要求外键名使用固定前缀，参数为空时只检查外键是否命名。"}
+	Rule00239Message            = &i18n.Message{ID: "Rule00239Message", Other: "在 MySQL 中，外键应显式命名，未命名或不符合前缀要求的外键: %v"}
+	Rule00239Params1            = &i18n.Message{ID: "Rule00239Params1", Other: "外键名固定前缀(为空时不检查前缀)"}
+	Rule00240Desc               = &i18n.Message{ID: "Rule00240Desc", Other: "在 MySQL 中，避免使用已废弃或已移除的函数"}
+	Rule00240Annotation         = &i18n.Message{ID: "Rule00240Annotation", Other: "PASSWORD()、ENCODE()、DECODE() 等函数已在 MySQL 8.0 中移除，使用这些函数的 SQL 在升级后会执行失败。审核时会根据目标版本判断函数是否已被移除，目标版本低于函数被移除的版本时不提示；目标版本未知时提示所有已废弃的函数。MariaDB 仍支持这些函数，不检查。可通过规则参数配置函数名及其被移除的版本。"}
+	Rule00240Message            = &i18n.Message{ID: "Rule00240Message", Other: "在 MySQL 中，避免使用已废弃或已移除的函数，函数及其被移除的版本: %v"}
+	Rule00240Params1            = &i18n.Message{ID: "Rule00240Params1", Other: "函数名及移除的版本(格式为 函数名:版本，多个以英文逗号分隔)"}
+	Rule00241Desc               = &i18n.Message{ID: "Rule00241Desc", Other: "在 MySQL 中，时间字段的小数秒精度应保持一致"}
+	Rule00241Annotation         = &i18n.Message{ID: "Rule00241Annotation", Other: "同一张表中部分字段为 DATETIME、部分字段为 DATETIME(6) 时，字段之间的比较结果和应用程序对时间的处理容易出现偏差，例如精度较低的字段会对写入的值进行四舍五入。建议表中 DATETIME、TIMESTAMP、TIME 字段统一使用规则参数指定的小数秒精度，参数为-1时只检查表中时间字段的精度是否一致。同时使用 DATETIME 和 TIMESTAMP 类型时，由于二者的取值范围和时区处理不同，会单独给出提示。"}
+	Rule00241Message            = &i18n.Message{ID: "Rule00241Message", Other: "在 MySQL 中，时间字段的小数秒精度应保持一致，不符合要求的字段及其精度: %v"}
+	Rule00241Params1            = &i18n.Message{ID: "Rule00241Params1", Other: "小数秒精度(为-1时只检查一致性)"}
+	Rule00241MixedTemporalTypes = &i18n.Message{ID: "Rule00241MixedTemporalTypes", Other: "表中同时使用了 DATETIME 和 TIMESTAMP 类型，二者的取值范围和时区处理不同，请确认是否符合预期，DATETIME 字段: %v，TIMESTAMP 字段: %v"}
+	Rule00242Desc               = &i18n.Message{ID: "Rule00242Desc", Other: "在 MySQL 中，预期数据量可以使用 INT 存储时，主键和自增字段不建议使用 BIGINT"}
+	Rule00242Annotation         = &i18n.Message{ID: "Rule00242Annotation", Other: "BIGINT 占用8个字节，INT 占用4个字节。InnoDB 的每一行都会在聚簇索引中保存主键，每个二级索引的记录也会保存主键的值，因此主键每减少4个字节，每行数据可节省4个字节，每个二级索引的每条记录也可节省4个字节，引用该主键的外键字段同样受益，索引更小意味着缓冲池可以缓存更多的数据。INT 最大可存储2147483647行，INT UNSIGNED 最大可存储4294967295行，预期的最大行数在此范围内时，建议使用 INT 或 INT UNSIGNED。对于业务上确实需要 BIGINT 的表（如日志表、流水表），可以通过规则参数豁免。"}
+	Rule00242Message            = &i18n.Message{ID: "Rule00242Message", Other: "在 MySQL 中，预期数据量可以使用 INT 存储时，主键和自增字段不建议使用 BIGINT，字段及建议的类型: %v"}
+	Rule00242Params1            = &i18n.Message{ID: "Rule00242Params1", Other: "预期的最大行数"}
+	Rule00242Params2            = &i18n.Message{ID: "Rule00242Params2", Other: "豁免的表名(多个以英文逗号分隔)"}
+	Rule00243Desc               = &i18n.Message{ID: "Rule00243Desc", Other: "在 MySQL 中，字段默认值不建议使用不确定的函数"}
+	Rule00243Annotation         = &i18n.Message{ID: "Rule00243Annotation", Other: "MySQL 8.0.13 开始支持使用表达式作为字段的默认值，如 DEFAULT (UUID())。默认值中使用 UUID()、RAND() 等不确定的函数时，每次插入得到的值都不同，使用这类默认值的语句在基于语句的复制（binlog_format=STATEMENT）下是不安全的，主从数据可能不一致；对已有数据的表新增此类字段时，每行会得到不同的值，且会导致 ALTER TABLE 无法使用 INSTANT 算法；UUID() 等随机值作为索引字段时，插入的位置随机，会导致频繁的页分裂。DATETIME、TIMESTAMP 字段直接使用 CURRENT_TIMESTAMP 作为默认值是常见用法，不受此规则限制。建议由应用程序生成这类值，或者将不确定的函数从规则参数中移除。"}
+	Rule00243Message            = &i18n.Message{ID: "Rule00243Message", Other: "在 MySQL 中，字段默认值不建议使用不确定的函数，字段及默认值: %v"}
+	Rule00243Params1            = &i18n.Message{ID: "Rule00243Params1", Other: "不确定的函数(多个以英文逗号分隔)"}
+	Rule00244Desc               = &i18n.Message{ID: "Rule00244Desc", Other: "在 MySQL 中，查询的执行计划中不建议出现文件排序和临时表"}
+	Rule00244Annotation         = &i18n.Message{ID: "Rule00244Annotation", Other: "执行计划的 Extra 列出现 Using filesort 表示无法利用索引的顺序完成排序，需要额外的排序操作，结果集超过 sort_buffer_size 时会使用磁盘文件排序；出现 Using temporary 表示需要创建内部临时表保存中间结果，常见于 GROUP BY、DISTINCT、UNION 以及排序和分组的字段不一致等场景，临时表超过内存限制时会转为磁盘临时表。二者在数据量较大时都会明显增加查询的耗时和 IO 开销。建议为 ORDER BY、GROUP BY、DISTINCT 涉及的字段创建合适的索引（联合索引中与 WHERE 条件中等值匹配的字段在前，排序或分组的字段在后），使排序和分组可以利用索引的顺序完成。"}
+	Rule00244Message            = &i18n.Message{ID: "Rule00244Message", Other: "在 MySQL 中，查询的执行计划中不建议出现文件排序和临时表，建议为排序、分组的字段创建合适的索引，表及执行计划: %v"}
+	Rule00245Desc               = &i18n.Message{ID: "Rule00245Desc", Other: "在 MySQL 中，VARCHAR 字段的长度不建议超过阈值"}
+	Rule00245Annotation         = &i18n.Message{ID: "Rule00245Annotation", Other: "VARCHAR(21844) 这类接近上限的定义通常是误用，MySQL 中一行数据除 BLOB、TEXT 外所有字段占用的最大字节数不能超过 65535 字节，过长的 VARCHAR 字段容易导致建表或新增字段失败；同时排序、分组使用的内存临时表按字段定义的最大长度分配空间，过长的定义会浪费内存。对于确实较长的数据，建议使用 TEXT 类型。另外，建表语句中 CHAR、VARCHAR 等字段的估算行大小接近 65535 字节时会单独提示，估算时按字符集每个字符的最大字节数计算。"}
+	Rule00245Message            = &i18n.Message{ID: "Rule00245Message", Other: "在 MySQL 中，VARCHAR 字段的长度不建议超过阈值，较长的数据建议使用 TEXT 类型，超过阈值的字段: %v"}
+	Rule00245Params1            = &i18n.Message{ID: "Rule00245Params1", Other: "VARCHAR 的最大长度"}
+	Rule00245Params2            = &i18n.Message{ID: "Rule00245Params2", Other: "行大小达到 65535 字节的百分比时提示"}
+	Rule00245RowSizeNearLimit   = &i18n.Message{ID: "Rule00245RowSizeNearLimit", Other: "CHAR、VARCHAR 等字段估算的行大小为 %v 字节，接近 65535 字节的行大小上限，可能导致建表失败，建议将较长的字段改为 TEXT 类型"}
+	Rule00246Desc               = &i18n.Message{ID: "Rule00246Desc", Other: "在 MySQL 中，建议使用 utf8mb4 字符集代替 utf8(utf8mb3)"}
+	Rule00246Annotation         = &i18n.Message{ID: "Rule00246Annotation", Other: "MySQL 中的 utf8 是 utf8mb3 的别名，每个字符最多只占用 3 个字节，无法存储 emoji 表情、部分生僻汉字等需要 4 个字节的字符。写入这类字符时，严格模式下语句会报错，非严格模式下字符会被截断或替换为问号，导致数据丢失且难以恢复。utf8mb3 在 MySQL 8.0 中已被废弃，建议表和字段统一使用 utf8mb4 字符集及其排序规则，ALTER TABLE ... CONVERT TO CHARACTER SET 同样建议转换为 utf8mb4。"}
+	Rule00246Message            = &i18n.Message{ID: "Rule00246Message", Other: "在 MySQL 中，建议使用 utf8mb4 字符集代替 utf8(utf8mb3)，使用 utf8 的定义: %v"}
+	Rule00247Desc               = &i18n.Message{ID: "Rule00247Desc", Other: "在 MySQL 中，JOIN 的关联字段应该有索引"}
+	Rule00247Annotation         = &i18n.Message{ID: "Rule00247Annotation", Other: "多表关联时，被驱动表（RIGHT JOIN 为左侧的表，其他 JOIN 为右侧的表）的关联字段如果没有可用的索引，驱动表的每一行都需要对被驱动表做一次全表扫描（或者依赖 Join Buffer 做块嵌套循环），数据量较大时会导致查询的耗时和 IO 开销急剧增加。建议为 ON 或 USING 条件中被驱动表的关联字段创建索引，或者将其作为联合索引的第一个字段。"}
+	Rule00247Message            = &i18n.Message{ID: "Rule00247Message", Other: "在 MySQL 中，JOIN 的关联字段应该有索引，缺少索引的表及字段: %v"}
+	Rule00248Desc               = &i18n.Message{ID: "Rule00248Desc", Other: "在 MySQL 中，联合索引中等值条件的字段应该在范围条件和排序的字段之前"}
+	Rule00248Annotation         = &i18n.Message{ID: "Rule00248Annotation", Other: "联合索引遵循最左前缀原则，索引中的某个字段使用了范围条件（>、<、BETWEEN、LIKE 等）或者用于排序后，其后的字段无法继续利用索引进行等值查找，也无法利用索引的顺序完成排序。当查询的等值条件字段在联合索引中位于范围条件或排序字段之后时，索引只能使用到范围条件的字段，需要扫描更多的索引记录并回表过滤。建议调整联合索引的字段顺序，将等值条件的字段放在前面，范围条件和排序的字段放在后面；调整前请确认其他查询对该索引的使用不受影响，或者新建字段顺序合理的索引。"}
+	Rule00248Message            = &i18n.Message{ID: "Rule00248Message", Other: "在 MySQL 中，联合索引中等值条件的字段应该在范围条件和排序的字段之前，建议调整字段顺序的索引: %v"}
+	Rule00249Desc               = &i18n.Message{ID: "Rule00249Desc", Other: "在 MySQL 中，不建议在触发器中修改其他表的数据"}
+	Rule00249Annotation         = &i18n.Message{ID: "Rule00249Annotation", Other: "触发器中对其他表的 INSERT、UPDATE、DELETE 会在业务 SQL 之外隐式地修改数据，形成难以察觉的表间耦合：排查问题和变更表结构时容易遗漏，级联的触发器会放大锁的范围和事务的耗时，基于语句的复制以及主从库触发器不一致时还会导致数据不一致。建议将这类逻辑放在应用程序或存储过程中显式执行；确实需要在触发器中记录审计日志时，可以将审计日志表配置到规则参数中。"}
+	Rule00249Message            = &i18n.Message{ID: "Rule00249Message", Other: "在 MySQL 中，不建议在触发器中修改其他表的数据，触发器修改的表: %v"}
+	Rule00249Params1            = &i18n.Message{ID: "Rule00249Params1", Other: "允许修改的表(如审计日志表)，多个表名用逗号分隔"}
+	Rule00250Desc               = &i18n.Message{ID: "Rule00250Desc", Other: "在 MySQL 中，INSERT ... SELECT 的插入字段数应该与查询字段数一致"}
+	Rule00250Annotation         = &i18n.Message{ID: "Rule00250Annotation", Other: "INSERT ... SELECT 语句中，插入的字段数（指定的字段列表，或者未指定字段列表时目标表的全部字段）与 SELECT 查询的字段数不一致时，语句执行时会报错 Column count doesn't match value count，导致上线失败。使用 SELECT * 时，源表或目标表结构的变化也会导致字段数不一致。建议显式指定插入的字段列表及查询的字段，并确认二者的数量和顺序一致。"}
+	Rule00250Message            = &i18n.Message{ID: "Rule00250Message", Other: "在 MySQL 中，INSERT ... SELECT 的插入字段数应该与查询字段数一致，插入字段数: %v, 查询字段数: %v"}
+	Rule00251Desc               = &i18n.Message{ID: "Rule00251Desc", Other: "在 MySQL 中，标识符长度不应超过规则参数"}
+	Rule00251Annotation         = &i18n.Message{ID: "Rule00251Annotation", Other: "MySQL 中表名、列名、索引名和约束名的最大长度为 64 个字符，超过时语句执行失败；复制过滤、ORM 等工具对标识符长度的限制可能更低，过长的名称也不便于阅读和维护，建议将标识符长度控制在规则参数以内。MySQL 中列名、索引名和约束名不区分大小写，同一张表中仅大小写不同的名称会导致语句执行失败，表名是否区分大小写取决于 lower_case_table_names，在不同的环境之间迁移时也容易产生冲突，可以通过规则参数开启对同一张表中仅大小写不同的名称的检查。"}
+	Rule00251Message            = &i18n.Message{ID: "Rule00251Message", Other: "在 MySQL 中，标识符长度不应超过%v个字符，且同一张表中的名称不应仅大小写不同，违反规则的标识符: %v"}
+	Rule00251Params1            = &i18n.Message{ID: "Rule00251Params1", Other: "标识符的最大长度(字符)"}
+	Rule00251Params2            = &i18n.Message{ID: "Rule00251Params2", Other: "是否检查同一张表中仅大小写不同的名称"}
+	Rule00252Desc               = &i18n.Message{ID: "Rule00252Desc", Other: "在 MySQL 中，删除列之前应该先删除或修改引用该列的索引和外键"}
+	Rule00252Annotation         = &i18n.Message{ID: "Rule00252Annotation", Other: "删除被索引或外键引用的列时，如果该列是外键的字段，语句执行失败；如果该列是索引的唯一字段，索引会被同时删除；如果该列是复合索引的字段，该列会从索引中移除，可能导致索引失去唯一性约束而执行失败，或者改变索引的定义而影响查询性能。这些隐式的变更不易察觉，建议先显式删除或修改引用该列的主键、唯一键、索引和外键，再删除该列。该规则需要获取表结构，离线审核时不检查。"}
+	Rule00252Message            = &i18n.Message{ID: "Rule00252Message", Other: "在 MySQL 中，删除列之前应该先删除或修改引用该列的索引和外键，列(索引或外键): %v"}
 )
@@ -2,9 +2,12 @@ package mysql
 
 import (
 	"bytes"
+	"context"
+	_driver "database/sql/driver"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"strings"
 	"sync"
 	"text/template"
@@ -13,6 +16,8 @@ import (
 	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
 	"github.com/actiontech/sqle/sqle/driver/mysql/util"
 	"github.com/pingcap/parser/ast"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 var ptTemplate = `pt-online-schema-change D={{.Schema}},t={{.Table}} --alter='{{.Alter}}' --host={{.Host}} --user={{.User}} --port={{.Port}} --ask-pass --print --execute`
@@ -52,21 +57,39 @@ func (i *MysqlDriverImpl) generateOSCCommandLine(node ast.Node) (i18nPkg.I18nStr
 		return nil, err
 	}
 
+	changes, reason, err := i.generatePtOSCAlter(stmt)
+	if err != nil || reason != nil {
+		return reason, err
+	}
+	if changes == "" {
+		return nil, nil
+	}
+
+	commandLine, err := renderPtTemplate(i.ptTemplateValues(stmt, changes))
+	if err != nil {
+		return nil, err
+	}
+	return i18nPkg.ConvertStr2I18nAsDefaultLang("[osc]" + commandLine), nil
+}
+
+// generatePtOSCAlter generate the value of "--alter" option, the reason is not nil
+// if the statement can not be executed by pt-online-schema-change.
+func (i *MysqlDriverImpl) generatePtOSCAlter(stmt *ast.AlterTableStmt) (string, i18nPkg.I18nStr, error) {
 	createTableStmt, exist, err := i.Ctx.GetCreateTableStmt(stmt.Table)
 	if !exist || err != nil {
-		return nil, err
+		return "", nil, err
 	}
 
 	// In almost all cases a PRIMARY KEY or UNIQUE INDEX needs to be present in the table.
 	// This is necessary because the tool creates a DELETE trigger to keep the new table
 	// updated while the process is running.
 	if !util.HasPrimaryKey(createTableStmt) && !util.HasUniqIndex(createTableStmt) {
-		return plocale.Bundle.LocalizeAll(plocale.PTOSCNoUniqueIndexOrPrimaryKey), nil
+		return "", plocale.Bundle.LocalizeAll(plocale.PTOSCNoUniqueIndexOrPrimaryKey), nil
 	}
 
 	// The RENAME clause cannot be used to rename the table.
 	if len(util.GetAlterTableSpecByTp(stmt.Specs, ast.AlterTableRenameTable)) > 0 {
-		return plocale.Bundle.LocalizeAll(plocale.PTOSCAvoidRenameTable), nil
+		return "", plocale.Bundle.LocalizeAll(plocale.PTOSCAvoidRenameTable), nil
 	}
 
 	// If you add a column without a default value and make it NOT NULL, the tool will fail,
@@ -75,7 +98,7 @@ func (i *MysqlDriverImpl) generateOSCCommandLine(node ast.Node) (i18nPkg.I18nStr
 		for _, col := range spec.NewColumns {
 			if util.HasOneInOptions(col.Options, ast.ColumnOptionNotNull) {
 				if !util.HasOneInOptions(col.Options, ast.ColumnOptionDefaultValue) {
-					return plocale.Bundle.LocalizeAll(plocale.PTOSCAvoidNoDefaultValueOnNotNullColumn), nil
+					return "", plocale.Bundle.LocalizeAll(plocale.PTOSCAvoidNoDefaultValueOnNotNullColumn), nil
 				}
 			}
 		}
@@ -87,7 +110,7 @@ func (i *MysqlDriverImpl) generateOSCCommandLine(node ast.Node) (i18nPkg.I18nStr
 	for _, spec := range util.GetAlterTableSpecByTp(stmt.Specs, ast.AlterTableAddConstraint) {
 		switch spec.Constraint.Tp {
 		case ast.ConstraintUniq:
-			return plocale.Bundle.LocalizeAll(plocale.PTOSCAvoidUniqueIndex), nil
+			return "", plocale.Bundle.LocalizeAll(plocale.PTOSCAvoidUniqueIndex), nil
 		}
 	}
 
@@ -109,26 +132,271 @@ func (i *MysqlDriverImpl) generateOSCCommandLine(node ast.Node) (i18nPkg.I18nStr
 			changes = append(changes, change)
 		}
 	}
+	return strings.Join(changes, ","), nil, nil
+}
 
-	if len(changes) <= 0 {
-		return nil, nil
+func (i *MysqlDriverImpl) ptTemplateValues(stmt *ast.AlterTableStmt, alter string) map[string]string {
+	return map[string]string{
+		"Alter":  alter,
+		"Host":   i.inst.Host,
+		"Port":   i.inst.Port,
+		"User":   i.inst.User,
+		"Schema": i.Ctx.GetSchemaName(stmt.Table),
+		"Table":  stmt.Table.Name.String(),
 	}
+}
 
+func renderPtTemplate(values map[string]string) (string, error) {
 	ptTemplateMutex.Lock()
 	text := ptTemplate
 	ptTemplateMutex.Unlock()
 	tp, err := template.New("tp").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	buff := bytes.NewBufferString("")
+	if err := tp.Execute(buff, values); err != nil {
+		return "", err
+	}
+	return buff.String(), nil
+}
+
+// generatePtOSCArgs builds the arguments of pt-online-schema-change from the template.
+// The template is rendered with placeholders and split into arguments before the
+// placeholders are replaced, so the values are never interpreted by a shell.
+// The options controlling password and execution mode in the template are replaced
+// by SQLE.
+func (i *MysqlDriverImpl) generatePtOSCArgs(stmt *ast.AlterTableStmt, alter string) ([]string, error) {
+	values := i.ptTemplateValues(stmt, alter)
+	placeholders := make(map[string]string, len(values))
+	for key := range values {
+		placeholders[key] = fmt.Sprintf("\x00%s\x00", key)
+	}
+	commandLine, err := renderPtTemplate(placeholders)
 	if err != nil {
 		return nil, err
 	}
-	buff := bytes.NewBufferString("[osc]")
-	err = tp.Execute(buff, map[string]interface{}{
-		"Alter":  strings.Join(changes, ","),
-		"Host":   i.inst.Host,
-		"Port":   i.inst.Port,
-		"User":   i.inst.User,
-		"Schema": i.Ctx.GetSchemaName(stmt.Table),
-		"Table":  stmt.Table.Name.String(),
+	words, err := splitCommandLine(commandLine)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, len(words))
+	for _, word := range words {
+		switch word {
+		case "--ask-pass", "--execute", "--dry-run":
+			continue
+		}
+		for key, placeholder := range placeholders {
+			word = strings.ReplaceAll(word, placeholder, values[key])
+		}
+		args = append(args, word)
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("pt-online-schema-change template is empty")
+	}
+	return args, nil
+}
+
+// splitCommandLine splits the command line into words like a POSIX shell,
+// it supports single quotes, double quotes and backslash escapes.
+func splitCommandLine(commandLine string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range commandLine {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == '\\':
+			escaped = true
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in command line: %s", commandLine)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// ShouldUsePtOSC returns whether the query will be executed by pt-online-schema-change
+// and the size(MB) of the altered table. pt-online-schema-change is used when the query
+// is an ALTER TABLE statement and the table size is not less than DDLOSCMinSize.
+func (i *MysqlDriverImpl) ShouldUsePtOSC(query string) (bool, int64, error) {
+	if i.cnf.DDLOSCMinSize < 0 {
+		return false, 0, nil
+	}
+
+	node, err := i.ParseSql(query)
+	if err != nil {
+		return false, 0, errors.Wrap(err, "parse SQL")
+	}
+	if len(node) == 0 {
+		return false, 0, nil
+	}
+
+	stmt, ok := node[0].(*ast.AlterTableStmt)
+	if !ok {
+		return false, 0, nil
+	}
+
+	tableSize, err := i.Ctx.GetTableSize(stmt.Table)
+	if err != nil {
+		return false, 0, errors.Wrap(err, "get table size")
+	}
+
+	return int64(tableSize) >= i.cnf.DDLOSCMinSize, int64(tableSize), nil
+}
+
+func (i *MysqlDriverImpl) executeByPtOSC(ctx context.Context, query string, isDryRun bool) (_driver.Result, error) {
+	node, err := i.ParseSql(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse SQL")
+	}
+	if len(node) == 0 {
+		return nil, errors.New("no SQL to execute by pt-online-schema-change")
+	}
+
+	stmt, ok := node[0].(*ast.AlterTableStmt)
+	if !ok {
+		return nil, errors.New("type assertion failed, unable to convert to expected type")
+	}
+
+	alter, reason, err := i.generatePtOSCAlter(stmt)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate pt-online-schema-change alter")
+	}
+	if reason != nil {
+		return nil, errors.New(reason.GetStrInLang(i18nPkg.DefaultLang))
+	}
+	if alter == "" {
+		return nil, errors.New("no alter specification for pt-online-schema-change")
+	}
+	if isDryRun {
+		if err := i.checkOnlineDDLPrerequisites(ctx, onlineDDLToolPtOSC, i.Ctx.GetSchemaName(stmt.Table)); err != nil {
+			return nil, err
+		}
+	}
+
+	args, err := i.generatePtOSCArgs(stmt, alter)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate pt-online-schema-change args")
+	}
+
+	// pass the password by a temporary option file instead of the command line
+	defaultsFile, err := os.CreateTemp("", "sqle-pt-osc-*.cnf")
+	if err != nil {
+		return nil, errors.Wrap(err, "create option file for pt-online-schema-change")
+	}
+	defer os.Remove(defaultsFile.Name())
+	_, err = fmt.Fprintf(defaultsFile, "[client]\npassword=\"%s\"\n",
+		strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(i.inst.Password))
+	if closeErr := defaultsFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "write option file for pt-online-schema-change")
+	}
+
+	actionStr := "run"
+	mode := "--execute"
+	if isDryRun {
+		actionStr = "dry-run"
+		mode = "--dry-run"
+	}
+	args = append(args, fmt.Sprintf("--defaults-file=%s", defaultsFile.Name()), mode)
+
+	l := i.log.WithFields(logrus.Fields{
+		"onlineddl": "pt-online-schema-change",
+		"host":      i.inst.Host,
+		"port":      i.inst.Port,
+		"alter":     query,
 	})
-	return i18nPkg.ConvertStr2I18nAsDefaultLang(buff.String()), err
+	output := &logWriter{l: l}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	l.Infof("%s pt-online-schema-change", actionStr)
+	err = cmd.Run()
+	output.flush()
+	if err != nil && ctx.Err() != nil {
+		l.Warnf("%s pt-online-schema-change canceled, %v", actionStr, ctx.Err())
+		return nil, errors.Wrapf(ctx.Err(), "%s pt-online-schema-change canceled", actionStr)
+	}
+	if err != nil {
+		l.Errorf("%s pt-online-schema-change error:%v", actionStr, err)
+		return nil, errors.Wrapf(err, "%s pt-online-schema-change, last output: %s", actionStr, output.lastLine)
+	}
+	l.Infof("%s OK!", actionStr)
+	return _driver.ResultNoRows, nil
+}
+
+// logWriter writes the output of the command to logger line by line.
+type logWriter struct {
+	l        *logrus.Entry
+	buf      bytes.Buffer
+	lastLine string
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		idx := bytes.IndexAny(w.buf.Bytes(), "\r\n")
+		if idx < 0 {
+			break
+		}
+		line := string(w.buf.Next(idx + 1))
+		w.logLine(line)
+	}
+	return len(p), nil
+}
+
+func (w *logWriter) flush() {
+	if w.buf.Len() > 0 {
+		w.logLine(w.buf.String())
+		w.buf.Reset()
+	}
+}
+
+func (w *logWriter) logLine(line string) {
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		return
+	}
+	w.lastLine = line
+	w.l.Info(line)
 }
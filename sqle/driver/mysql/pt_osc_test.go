@@ -1,12 +1,18 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
 	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
 	"github.com/actiontech/sqle/sqle/driver/mysql/util"
+	"github.com/pingcap/parser/ast"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
 )
@@ -62,3 +68,141 @@ func runOSCCase(t *testing.T, desc string, sql, expect string) {
 	}
 	assert.Equal(t, expect, actual[i18nPkg.DefaultLang], desc)
 }
+
+func TestSplitCommandLine(t *testing.T) {
+	words, err := splitCommandLine(`pt-online-schema-change D=db,t=tb --alter='ADD COLUMN v3 varchar(255) DEFAULT "a b"' --host="127.0.0.1" a\ b`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"pt-online-schema-change",
+		"D=db,t=tb",
+		`--alter=ADD COLUMN v3 varchar(255) DEFAULT "a b"`,
+		"--host=127.0.0.1",
+		"a b",
+	}, words)
+
+	_, err = splitCommandLine(`pt-online-schema-change --alter='ADD COLUMN`)
+	assert.Error(t, err)
+}
+
+func TestGeneratePtOSCArgs(t *testing.T) {
+	i := DefaultMysqlInspect()
+	stmt, err := util.ParseOneSql("alter table exist_tb_1 add column v3 varchar(255) default 'it''s';")
+	assert.NoError(t, err)
+	alterStmt := stmt.(*ast.AlterTableStmt)
+	alter, reason, err := i.generatePtOSCAlter(alterStmt)
+	assert.NoError(t, err)
+	assert.Nil(t, reason)
+
+	// the quote in the alter is kept in one argument
+	args, err := i.generatePtOSCArgs(alterStmt, alter)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"pt-online-schema-change",
+		"D=exist_db,t=exist_tb_1",
+		"--alter=" + alter,
+		"--host=127.0.0.1",
+		"--user=root",
+		"--port=3306",
+		"--print",
+	}, args)
+}
+
+func TestExecuteByPtOSC(t *testing.T) {
+	dir := t.TempDir()
+	outputPath := filepath.Join(dir, "args")
+	script := filepath.Join(dir, "fake-pt-osc.sh")
+	assert.NoError(t, os.WriteFile(script, []byte(fmt.Sprintf("#!/bin/sh\necho \"$@\" >> %s\necho 'Copying rows: 50%%'\n", outputPath)), 0700))
+	templateFile := filepath.Join(dir, "pt-osc.template")
+	assert.NoError(t, os.WriteFile(templateFile, []byte(script+` D={{.Schema}},t={{.Table}} --alter='{{.Alter}}' --ask-pass --execute`), 0600))
+
+	ptTemplateMutex.Lock()
+	originTemplate := ptTemplate
+	ptTemplateMutex.Unlock()
+	defer func() {
+		ptTemplateMutex.Lock()
+		ptTemplate = originTemplate
+		ptTemplateMutex.Unlock()
+	}()
+	assert.NoError(t, LoadPtTemplateFromFile(templateFile))
+
+	i := DefaultMysqlInspect()
+	i.Ctx.SetServerInfo(&session.ServerInfo{Grants: []string{"GRANT ALL PRIVILEGES ON *.* TO `root`@`%`"}})
+	_, err := i.executeByPtOSC(context.TODO(), "alter table exist_tb_1 add column v3 varchar(255);", true)
+	assert.NoError(t, err)
+	_, err = i.executeByPtOSC(context.TODO(), "alter table exist_tb_1 add column v3 varchar(255);", false)
+	assert.NoError(t, err)
+
+	output, err := os.ReadFile(outputPath)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	assert.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "D=exist_db,t=exist_tb_1 --alter=ADD COLUMN `v3` varchar(255) --defaults-file="))
+	assert.True(t, strings.HasSuffix(lines[0], "--dry-run"))
+	assert.True(t, strings.HasSuffix(lines[1], "--execute"))
+	assert.NotContains(t, lines[1], "--ask-pass")
+
+	_, err = i.executeByPtOSC(context.TODO(), "alter table exist_tb_13 add column v4 varchar(255);", true)
+	assert.Error(t, err)
+	_, err = i.executeByPtOSC(context.TODO(), "-- no statement", true)
+	assert.Error(t, err)
+
+	// the dry-run fails before running the tool if the privileges are missing
+	i.Ctx.SetServerInfo(&session.ServerInfo{Grants: []string{"GRANT SELECT, INSERT, UPDATE, DELETE ON `exist_db`.* TO `root`@`%`"}})
+	_, err = i.executeByPtOSC(context.TODO(), "alter table exist_tb_1 add column v3 varchar(255);", true)
+	assert.EqualError(t, err, "the prerequisites of pt-online-schema-change are not met: "+
+		"missing privileges on schema exist_db: ALTER, CREATE, DROP, TRIGGER; missing global privileges: PROCESS")
+	output, err = os.ReadFile(outputPath)
+	assert.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(output)), "\n"), 2)
+}
+
+func TestSelectOnlineDDLTool(t *testing.T) {
+	query := "alter table exist_db.exist_tb_1 add column v3 varchar(255);"
+	i := DefaultMysqlInspect()
+	i.Ctx.Schemas()["exist_db"].Tables["exist_tb_1"].Size = 100
+
+	i.cnf.DDLGhostMinSize, i.cnf.DDLOSCMinSize = -1, -1
+	tool, err := i.selectOnlineDDLTool(query)
+	assert.NoError(t, err)
+	assert.Equal(t, onlineDDLToolNone, tool)
+
+	i.cnf.DDLGhostMinSize, i.cnf.DDLOSCMinSize = -1, 16
+	tool, err = i.selectOnlineDDLTool(query)
+	assert.NoError(t, err)
+	assert.Equal(t, onlineDDLToolPtOSC, tool)
+
+	// gh-ost takes precedence when both are matched
+	i.cnf.DDLGhostMinSize, i.cnf.DDLOSCMinSize = 16, 16
+	tool, err = i.selectOnlineDDLTool(query)
+	assert.NoError(t, err)
+	assert.Equal(t, onlineDDLToolGhost, tool)
+
+	i.cnf.DDLGhostMinSize, i.cnf.DDLOSCMinSize = 1024, 16
+	tool, err = i.selectOnlineDDLTool(query)
+	assert.NoError(t, err)
+	assert.Equal(t, onlineDDLToolPtOSC, tool)
+
+	tool, err = i.selectOnlineDDLTool("select * from exist_db.exist_tb_1")
+	assert.NoError(t, err)
+	assert.Equal(t, onlineDDLToolNone, tool)
+}
+
+func TestShouldUsePtOSC(t *testing.T) {
+	i := DefaultMysqlInspect()
+	i.cnf.DDLOSCMinSize = 16
+	i.Ctx.Schemas()["exist_db"].Tables["exist_tb_1"].Size = 16
+
+	usePtOSC, size, err := i.ShouldUsePtOSC("alter table exist_db.exist_tb_1 add column col1 varchar(100);")
+	assert.NoError(t, err)
+	assert.True(t, usePtOSC)
+	assert.Equal(t, int64(16), size)
+
+	usePtOSC, _, err = i.ShouldUsePtOSC("-- no statement")
+	assert.NoError(t, err)
+	assert.False(t, usePtOSC)
+
+	i.cnf.DDLOSCMinSize = -1
+	usePtOSC, _, err = i.ShouldUsePtOSC("alter table exist_db.exist_tb_1 add column col1 varchar(100);")
+	assert.NoError(t, err)
+	assert.False(t, usePtOSC)
+}
@@ -0,0 +1,655 @@
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	"github.com/actiontech/sqle/sqle/driver/mysql/util"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+)
+
+// GenerateRollbackSql generate rollback SQL for the node, unableRollbackReason
+// is not nil when the node can not be rolled back.
+func (i *MysqlDriverImpl) GenerateRollbackSql(node ast.Node) (string, i18nPkg.I18nStr, error) {
+	switch node.(type) {
+	case ast.DDLNode:
+		return i.GenerateDDLStmtRollbackSql(node)
+	case ast.DMLNode:
+		return i.GenerateDMLStmtRollbackSql(node)
+	}
+	return "", nil, nil
+}
+
+func (i *MysqlDriverImpl) GenerateDDLStmtRollbackSql(node ast.Node) (rollbackSql string, unableRollbackReason i18nPkg.I18nStr, err error) {
+	switch stmt := node.(type) {
+	case *ast.AlterTableStmt:
+		rollbackSql, unableRollbackReason, err = i.generateAlterTableRollbackSql(stmt)
+	case *ast.CreateTableStmt:
+		rollbackSql, unableRollbackReason, err = i.generateCreateTableRollbackSql(stmt)
+	case *ast.DropTableStmt:
+		rollbackSql, unableRollbackReason, err = i.generateDropTableRollbackSql(stmt)
+	case *ast.CreateIndexStmt:
+		rollbackSql, unableRollbackReason, err = i.generateCreateIndexRollbackSql(stmt)
+	case *ast.DropIndexStmt:
+		rollbackSql, unableRollbackReason, err = i.generateDropIndexRollbackSql(stmt)
+	default:
+		unableRollbackReason = plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback)
+	}
+	return
+}
+
+func (i *MysqlDriverImpl) GenerateDMLStmtRollbackSql(node ast.Node) (rollbackSql string, unableRollbackReason i18nPkg.I18nStr, err error) {
+	// MysqlDriverImpl may skip initialized cnf when Audited SQLs in whitelist.
+	if i.cnf == nil || i.cnf.DMLRollbackMaxRows < 0 {
+		return "", nil, nil
+	}
+
+	paramMarkerChecker := util.ParamMarkerChecker{}
+	node.Accept(&paramMarkerChecker)
+	if paramMarkerChecker.HasParamMarker {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportParamMarkerStatementRollback), nil
+	}
+
+	hasVarChecker := util.HasVarChecker{}
+	node.Accept(&hasVarChecker)
+	if hasVarChecker.HasVar {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportHasVariableRollback), nil
+	}
+
+	switch stmt := node.(type) {
+	case *ast.InsertStmt:
+		rollbackSql, unableRollbackReason, err = i.generateInsertRollbackSqls(stmt)
+	case *ast.DeleteStmt:
+		rollbackSql, unableRollbackReason, err = i.generateDeleteRollbackSql(stmt)
+	case *ast.UpdateStmt:
+		rollbackSql, unableRollbackReason, err = i.generateUpdateRollbackSql(stmt)
+	default:
+		unableRollbackReason = plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback)
+	}
+	return
+}
+
+// getOriginalCreateTableStmt get the table definition before the DDL executed,
+// unableRollbackReason is not nil when the definition can not be fetched.
+func (i *MysqlDriverImpl) getOriginalCreateTableStmt(table *ast.TableName) (stmt *ast.CreateTableStmt, exist bool, unableRollbackReason i18nPkg.I18nStr, err error) {
+	stmt, exist, err = i.Ctx.GetCreateTableStmt(table)
+	if err != nil {
+		return nil, exist, nil, err
+	}
+	// the table definition can not be fetched without instance.
+	if stmt == nil && i.IsOfflineAudit() {
+		return nil, exist, plocale.Bundle.LocalizeAll(plocale.NotSupportNoOriginalTableRollback), nil
+	}
+	return stmt, exist, nil, nil
+}
+
+// generateCreateTableRollbackSql generate drop table SQL for create table.
+func (i *MysqlDriverImpl) generateCreateTableRollbackSql(stmt *ast.CreateTableStmt) (string, i18nPkg.I18nStr, error) {
+	// if table exist, create table will be failed or be skipped by "if not exists". don't rollback
+	tableExist, err := i.Ctx.IsTableExist(stmt.Table)
+	if err != nil {
+		return "", nil, err
+	}
+	if tableExist {
+		return "", nil, nil
+	}
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s;", i.getTableNameWithQuote(stmt.Table)), nil, nil
+}
+
+// generateDropTableRollbackSql generate create table SQL for drop table.
+func (i *MysqlDriverImpl) generateDropTableRollbackSql(stmt *ast.DropTableStmt) (string, i18nPkg.I18nStr, error) {
+	if stmt.IsView {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback), nil
+	}
+	rollbackSqls := []string{}
+	for _, table := range stmt.Tables {
+		createTableStmt, exist, reason, err := i.getOriginalCreateTableStmt(table)
+		if err != nil || reason != nil {
+			return "", reason, err
+		}
+		// if table not exist, can not rollback it.
+		if !exist || createTableStmt == nil {
+			continue
+		}
+		var buf strings.Builder
+		if err := createTableStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &buf)); err != nil {
+			return "", nil, err
+		}
+		rollbackSqls = append(rollbackSqls, buf.String()+";")
+	}
+	return strings.Join(rollbackSqls, "\n"), nil, nil
+}
+
+// generateCreateIndexRollbackSql generate drop index SQL for create index.
+func (i *MysqlDriverImpl) generateCreateIndexRollbackSql(stmt *ast.CreateIndexStmt) (string, i18nPkg.I18nStr, error) {
+	return fmt.Sprintf("DROP INDEX %s ON %s;", util.QuoteIdentifier(stmt.IndexName), i.getTableNameWithQuote(stmt.Table)), nil, nil
+}
+
+// generateDropIndexRollbackSql generate create index SQL for drop index.
+func (i *MysqlDriverImpl) generateDropIndexRollbackSql(stmt *ast.DropIndexStmt) (string, i18nPkg.I18nStr, error) {
+	createTableStmt, exist, reason, err := i.getOriginalCreateTableStmt(stmt.Table)
+	if err != nil || reason != nil || !exist || createTableStmt == nil {
+		return "", reason, err
+	}
+	constraint := getIndexConstraintByName(createTableStmt, stmt.IndexName)
+	if constraint == nil {
+		return "", nil, nil
+	}
+	rollbackStmt := &ast.AlterTableStmt{
+		Table: util.NewTableName(i.Ctx.GetSchemaName(stmt.Table), stmt.Table.Name.String()),
+		Specs: []*ast.AlterTableSpec{{Tp: ast.AlterTableAddConstraint, Constraint: constraint}},
+	}
+	return util.AlterTableStmtFormat(rollbackStmt), nil, nil
+}
+
+// generateAlterTableRollbackSql generate alter table SQL for alter table. The inverse
+// specs are emitted in reverse sequence of the specs in origin SQL.
+func (i *MysqlDriverImpl) generateAlterTableRollbackSql(stmt *ast.AlterTableStmt) (string, i18nPkg.I18nStr, error) {
+	schemaName := i.Ctx.GetSchemaName(stmt.Table)
+	tableName := stmt.Table.Name.String()
+
+	createTableStmt, exist, reason, err := i.getOriginalCreateTableStmt(stmt.Table)
+	if err != nil || reason != nil || !exist || createTableStmt == nil {
+		return "", reason, err
+	}
+
+	rollbackStmt := &ast.AlterTableStmt{
+		Table: util.NewTableName(schemaName, tableName),
+		Specs: []*ast.AlterTableSpec{},
+	}
+	for idx := len(stmt.Specs) - 1; idx >= 0; idx-- {
+		spec := stmt.Specs[idx]
+		if spec.Tp == ast.AlterTableRenameTable {
+			// the rollback SQL is executed on the renamed table.
+			rollbackStmt.Table = util.NewTableName(i.Ctx.GetSchemaName(spec.NewTable), spec.NewTable.Name.String())
+			rollbackStmt.Specs = append(rollbackStmt.Specs, &ast.AlterTableSpec{
+				Tp:       ast.AlterTableRenameTable,
+				NewTable: util.NewTableName(schemaName, tableName),
+			})
+			continue
+		}
+		specs, ok := generateAlterTableSpecRollback(createTableStmt, spec)
+		if !ok {
+			return "", plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback), nil
+		}
+		rollbackStmt.Specs = append(rollbackStmt.Specs, specs...)
+	}
+	return util.AlterTableStmtFormat(rollbackStmt), nil, nil
+}
+
+// generateAlterTableSpecRollback generate the inverse specs of the alter table spec,
+// it returns false if the spec is not supported to rollback.
+func generateAlterTableSpecRollback(table *ast.CreateTableStmt, spec *ast.AlterTableSpec) ([]*ast.AlterTableSpec, bool) {
+	specs := []*ast.AlterTableSpec{}
+	switch spec.Tp {
+	case ast.AlterTableAddColumns:
+		for idx := len(spec.NewColumns) - 1; idx >= 0; idx-- {
+			specs = append(specs, &ast.AlterTableSpec{
+				Tp:            ast.AlterTableDropColumn,
+				OldColumnName: &ast.ColumnName{Name: spec.NewColumns[idx].Name.Name},
+			})
+		}
+	case ast.AlterTableDropColumn:
+		col := getColumnDefByName(table, spec.OldColumnName.Name.L)
+		if col == nil {
+			return nil, false
+		}
+		specs = append(specs, &ast.AlterTableSpec{
+			Tp:         ast.AlterTableAddColumns,
+			NewColumns: []*ast.ColumnDef{col},
+		})
+	case ast.AlterTableModifyColumn:
+		col := getColumnDefByName(table, spec.NewColumns[0].Name.Name.L)
+		if col == nil {
+			return nil, false
+		}
+		specs = append(specs, &ast.AlterTableSpec{
+			Tp:         ast.AlterTableModifyColumn,
+			NewColumns: []*ast.ColumnDef{col},
+		})
+	case ast.AlterTableChangeColumn:
+		col := getColumnDefByName(table, spec.OldColumnName.Name.L)
+		if col == nil {
+			return nil, false
+		}
+		specs = append(specs, &ast.AlterTableSpec{
+			Tp:            ast.AlterTableChangeColumn,
+			OldColumnName: spec.NewColumns[0].Name,
+			NewColumns:    []*ast.ColumnDef{col},
+		})
+	case ast.AlterTableAlterColumn:
+		col := getColumnDefByName(table, spec.NewColumns[0].Name.Name.L)
+		if col == nil {
+			return nil, false
+		}
+		newCol := &ast.ColumnDef{Name: col.Name}
+		for _, op := range col.Options {
+			if op.Tp == ast.ColumnOptionDefaultValue {
+				newCol.Options = []*ast.ColumnOption{op}
+			}
+		}
+		specs = append(specs, &ast.AlterTableSpec{
+			Tp:         ast.AlterTableAlterColumn,
+			NewColumns: []*ast.ColumnDef{newCol},
+		})
+	case ast.AlterTableAddConstraint:
+		switch spec.Constraint.Tp {
+		case ast.ConstraintPrimaryKey:
+			specs = append(specs, &ast.AlterTableSpec{Tp: ast.AlterTableDropPrimaryKey})
+		case ast.ConstraintForeignKey:
+			if spec.Constraint.Name == "" {
+				return nil, false
+			}
+			specs = append(specs, &ast.AlterTableSpec{Tp: ast.AlterTableDropForeignKey, Name: spec.Constraint.Name})
+		case ast.ConstraintIndex, ast.ConstraintKey, ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey, ast.ConstraintFulltext:
+			name := spec.Constraint.Name
+			// MySQL names the index by the first column when the name is omitted.
+			if name == "" && len(spec.Constraint.Keys) > 0 && spec.Constraint.Keys[0].Column != nil {
+				name = spec.Constraint.Keys[0].Column.Name.O
+			}
+			if name == "" {
+				return nil, false
+			}
+			specs = append(specs, &ast.AlterTableSpec{Tp: ast.AlterTableDropIndex, Name: name})
+		default:
+			return nil, false
+		}
+	case ast.AlterTableDropIndex:
+		constraint := getIndexConstraintByName(table, spec.Name)
+		if constraint == nil {
+			return nil, false
+		}
+		specs = append(specs, &ast.AlterTableSpec{Tp: ast.AlterTableAddConstraint, Constraint: constraint})
+	case ast.AlterTableDropPrimaryKey:
+		for _, constraint := range table.Constraints {
+			if constraint.Tp == ast.ConstraintPrimaryKey {
+				specs = append(specs, &ast.AlterTableSpec{Tp: ast.AlterTableAddConstraint, Constraint: constraint})
+			}
+		}
+		if len(specs) == 0 {
+			return nil, false
+		}
+	case ast.AlterTableDropForeignKey:
+		for _, constraint := range table.Constraints {
+			if constraint.Tp == ast.ConstraintForeignKey && strings.EqualFold(constraint.Name, spec.Name) {
+				specs = append(specs, &ast.AlterTableSpec{Tp: ast.AlterTableAddConstraint, Constraint: constraint})
+			}
+		}
+		if len(specs) == 0 {
+			return nil, false
+		}
+	case ast.AlterTableRenameIndex:
+		specs = append(specs, &ast.AlterTableSpec{
+			Tp:      ast.AlterTableRenameIndex,
+			FromKey: spec.ToKey,
+			ToKey:   spec.FromKey,
+		})
+	default:
+		return nil, false
+	}
+	return specs, true
+}
+
+func getColumnDefByName(table *ast.CreateTableStmt, colName string) *ast.ColumnDef {
+	for _, col := range table.Cols {
+		if col.Name.Name.L == strings.ToLower(colName) {
+			return col
+		}
+	}
+	return nil
+}
+
+func getIndexConstraintByName(table *ast.CreateTableStmt, indexName string) *ast.Constraint {
+	for _, constraint := range table.Constraints {
+		switch constraint.Tp {
+		case ast.ConstraintIndex, ast.ConstraintKey, ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey, ast.ConstraintFulltext:
+			if strings.EqualFold(constraint.Name, indexName) {
+				return constraint
+			}
+		}
+	}
+	return nil
+}
+
+// generateInsertRollbackSqls generate delete SQL for insert.
+func (i *MysqlDriverImpl) generateInsertRollbackSqls(stmt *ast.InsertStmt) (string, i18nPkg.I18nStr, error) {
+	tables := util.GetTables(stmt.Table.TableRefs)
+	// table just has one in insert stmt.
+	if len(tables) != 1 {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportMultiTableStatementRollback), nil
+	}
+	if stmt.OnDuplicate != nil {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportOnDuplicatStatementRollback), nil
+	}
+	if stmt.IsReplace || stmt.Select != nil {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback), nil
+	}
+	table := tables[0]
+	createTableStmt, exist, err := i.Ctx.GetCreateTableStmt(table)
+	if err != nil {
+		return "", nil, err
+	}
+	// if table not exist, insert will failed.
+	if !exist {
+		return "", nil, nil
+	}
+	pkColumnsName, hasPk, err := i.getPrimaryKey(createTableStmt)
+	if err != nil {
+		return "", nil, err
+	}
+	if !hasPk {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportNoPrimaryKeyTableRollback), nil
+	}
+
+	rollbackSqls := []string{}
+	// match "insert into table_name value (v1,...)"
+	if stmt.Lists != nil {
+		if int64(len(stmt.Lists)) > i.cnf.DMLRollbackMaxRows {
+			return "", plocale.Bundle.LocalizeAll(plocale.NotSupportExceedMaxRowsRollback), nil
+		}
+		columnsName := []string{}
+		if stmt.Columns != nil {
+			for _, col := range stmt.Columns {
+				columnsName = append(columnsName, col.Name.L)
+			}
+		} else {
+			for _, col := range createTableStmt.Cols {
+				columnsName = append(columnsName, col.Name.Name.L)
+			}
+		}
+		for _, value := range stmt.Lists {
+			// mysql will throw error: 1136 (21S01): Column count doesn't match value count
+			if len(columnsName) != len(value) {
+				return "", nil, nil
+			}
+			where := []string{}
+			for n, name := range columnsName {
+				if _, isPk := pkColumnsName[name]; isPk {
+					where = append(where, fmt.Sprintf("%s = %s", util.QuoteIdentifier(name), util.ExprFormat(value[n])))
+				}
+			}
+			if len(where) != len(pkColumnsName) {
+				return "", plocale.Bundle.LocalizeAll(plocale.NotSupportInsertWithoutPrimaryKeyRollback), nil
+			}
+			rollbackSqls = append(rollbackSqls, fmt.Sprintf("DELETE FROM %s WHERE %s;",
+				i.getTableNameWithQuote(table), strings.Join(where, " AND ")))
+		}
+		return strings.Join(rollbackSqls, "\n"), nil, nil
+	}
+
+	// match "insert into table_name set col_name = value1, ..."
+	if stmt.Setlist != nil {
+		if 1 > i.cnf.DMLRollbackMaxRows {
+			return "", plocale.Bundle.LocalizeAll(plocale.NotSupportExceedMaxRowsRollback), nil
+		}
+		where := []string{}
+		for _, setExpr := range stmt.Setlist {
+			name := setExpr.Column.Name.L
+			if _, isPk := pkColumnsName[name]; isPk {
+				where = append(where, fmt.Sprintf("%s = %s", util.QuoteIdentifier(name), util.ExprFormat(setExpr.Expr)))
+			}
+		}
+		if len(where) != len(pkColumnsName) {
+			return "", plocale.Bundle.LocalizeAll(plocale.NotSupportInsertWithoutPrimaryKeyRollback), nil
+		}
+		rollbackSqls = append(rollbackSqls, fmt.Sprintf("DELETE FROM %s WHERE %s;",
+			i.getTableNameWithQuote(table), strings.Join(where, " AND ")))
+	}
+	return strings.Join(rollbackSqls, "\n"), nil, nil
+}
+
+// generateDeleteRollbackSql generate insert SQL for delete.
+func (i *MysqlDriverImpl) generateDeleteRollbackSql(stmt *ast.DeleteStmt) (string, i18nPkg.I18nStr, error) {
+	// not support multi-table syntax
+	if stmt.IsMultiTable {
+		i.Logger().Infof("not support generate rollback sql with multi-delete statement")
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportMultiTableStatementRollback), nil
+	}
+	// sub query statement
+	if util.WhereStmtHasSubQuery(stmt.Where) {
+		i.Logger().Infof("not support generate rollback sql with sub query")
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportSubQueryStatementRollback), nil
+	}
+	tables := util.GetTables(stmt.TableRefs.TableRefs)
+	if len(tables) != 1 {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportMultiTableStatementRollback), nil
+	}
+	table := tables[0]
+	createTableStmt, exist, err := i.Ctx.GetCreateTableStmt(table)
+	if err != nil || !exist {
+		return "", nil, err
+	}
+	_, hasPk, err := i.getPrimaryKey(createTableStmt)
+	if err != nil {
+		return "", nil, err
+	}
+	if !hasPk {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportNoPrimaryKeyTableRollback), nil
+	}
+
+	records, exceed, err := i.getRecordsForRollback("*", table, "", stmt.Where, stmt.Order, stmt.Limit)
+	if err != nil {
+		return "", nil, err
+	}
+	if exceed {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportExceedMaxRowsRollback), nil
+	}
+
+	columnsName := []string{}
+	for _, col := range createTableStmt.Cols {
+		columnsName = append(columnsName, col.Name.Name.O)
+	}
+	values := []string{}
+	for _, record := range records {
+		if len(record) != len(columnsName) {
+			return "", nil, nil
+		}
+		vs := []string{}
+		for _, name := range columnsName {
+			vs = append(vs, rollbackValueFormat(record[name]))
+		}
+		values = append(values, fmt.Sprintf("(%s)", strings.Join(vs, ", ")))
+	}
+	rollbackSql := ""
+	if len(values) > 0 {
+		quotedColumns := make([]string, 0, len(columnsName))
+		for _, name := range columnsName {
+			quotedColumns = append(quotedColumns, util.QuoteIdentifier(name))
+		}
+		rollbackSql = fmt.Sprintf("INSERT INTO %s (%s) VALUES %s;",
+			i.getTableNameWithQuote(table), strings.Join(quotedColumns, ", "),
+			strings.Join(values, ", "))
+	}
+	return rollbackSql, nil, nil
+}
+
+// generateUpdateRollbackSql generate update SQL for update.
+func (i *MysqlDriverImpl) generateUpdateRollbackSql(stmt *ast.UpdateStmt) (string, i18nPkg.I18nStr, error) {
+	tableSources := util.GetTableSources(stmt.TableRefs.TableRefs)
+	// multi table syntax
+	if len(tableSources) != 1 {
+		i.Logger().Infof("not support generate rollback sql with multi-update statement")
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportMultiTableStatementRollback), nil
+	}
+	// sub query statement
+	if util.WhereStmtHasSubQuery(stmt.Where) {
+		i.Logger().Infof("not support generate rollback sql with sub query")
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportSubQueryStatementRollback), nil
+	}
+	var (
+		table      *ast.TableName
+		tableAlias string
+	)
+	tableSource := tableSources[0]
+	switch source := tableSource.Source.(type) {
+	case *ast.TableName:
+		table = source
+		tableAlias = tableSource.AsName.String()
+	case *ast.SelectStmt, *ast.UnionStmt:
+		i.Logger().Infof("not support generate rollback sql with update-select statement")
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportSubQueryStatementRollback), nil
+	default:
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback), nil
+	}
+	createTableStmt, exist, err := i.Ctx.GetCreateTableStmt(table)
+	if err != nil || !exist {
+		return "", nil, err
+	}
+	pkColumnsName, hasPk, err := i.getPrimaryKey(createTableStmt)
+	if err != nil {
+		return "", nil, err
+	}
+	if !hasPk {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportNoPrimaryKeyTableRollback), nil
+	}
+
+	// the new value of the primary key which is set by expression, e.g. "SET id = id + 1", is
+	// selected with the rows before update, so that the updated rows can be located.
+	fields := []string{"*"}
+	newPkFields := map[string]string{}
+	assignedColumns := map[string]struct{}{}
+	for _, l := range stmt.List {
+		name := l.Column.Name.L
+		if _, isPk := pkColumnsName[name]; isPk {
+			if _, isValue := l.Expr.(ast.ValueExpr); isValue {
+				delete(newPkFields, name)
+			} else {
+				columns := &util.ColumnNameVisitor{}
+				l.Expr.Accept(columns)
+				for _, column := range columns.ColumnNameList {
+					// the value assigned before is used by the expression, which can't be selected
+					if _, ok := assignedColumns[column.Name.Name.L]; ok {
+						return "", plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback), nil
+					}
+				}
+				field := fmt.Sprintf("sqle_new_%s", name)
+				newPkFields[name] = field
+				fields = append(fields, fmt.Sprintf("%s AS %s", util.ExprFormat(l.Expr), util.QuoteIdentifier(field)))
+			}
+		}
+		assignedColumns[name] = struct{}{}
+	}
+
+	records, exceed, err := i.getRecordsForRollback(strings.Join(fields, ", "), table, tableAlias, stmt.Where, stmt.Order, stmt.Limit)
+	if err != nil {
+		return "", nil, err
+	}
+	if exceed {
+		return "", plocale.Bundle.LocalizeAll(plocale.NotSupportExceedMaxRowsRollback), nil
+	}
+
+	rollbackSqls := []string{}
+	for _, record := range records {
+		if len(record) != len(createTableStmt.Cols)+len(fields)-1 {
+			return "", nil, nil
+		}
+		where := []string{}
+		value := []string{}
+		for _, col := range createTableStmt.Cols {
+			name := col.Name.Name.O
+			_, isPk := pkColumnsName[col.Name.Name.L]
+			v := rollbackValueFormat(record[name])
+
+			var newValue ast.ExprNode
+			for _, l := range stmt.List {
+				if col.Name.Name.L == l.Column.Name.L {
+					newValue = l.Expr
+				}
+			}
+			if newValue != nil {
+				value = append(value, fmt.Sprintf("%s = %s", util.QuoteIdentifier(name), v))
+			}
+			if isPk {
+				// the primary key is changed by update, so locate the row by new value.
+				if field, ok := newPkFields[col.Name.Name.L]; ok {
+					where = append(where, fmt.Sprintf("%s = %s", util.QuoteIdentifier(name), rollbackValueFormat(record[field])))
+				} else if newValue != nil {
+					where = append(where, fmt.Sprintf("%s = %s", util.QuoteIdentifier(name), util.ExprFormat(newValue)))
+				} else {
+					where = append(where, fmt.Sprintf("%s = %s", util.QuoteIdentifier(name), v))
+				}
+			}
+		}
+		if len(value) == 0 {
+			continue
+		}
+		rollbackSqls = append(rollbackSqls, fmt.Sprintf("UPDATE %s SET %s WHERE %s;",
+			i.getTableNameWithQuote(table),
+			strings.Join(value, ", "), strings.Join(where, " AND ")))
+	}
+	return strings.Join(rollbackSqls, "\n"), nil, nil
+}
+
+// getRecordsForRollback select the fields of the rows which will be updated or deleted. exceed
+// is true when the number of rows is greater than DMLRollbackMaxRows.
+func (i *MysqlDriverImpl) getRecordsForRollback(fields string, table *ast.TableName, tableAlias string, where ast.ExprNode,
+	order *ast.OrderByClause, limit *ast.Limit) (records []map[string]sql.NullString, exceed bool, err error) {
+	var max = i.cnf.DMLRollbackMaxRows
+	count, err := util.GetLimitCount(limit, max+1)
+	if err != nil {
+		return nil, false, err
+	}
+	// query one more row than the max rows to check whether the rows exceed.
+	if count > max {
+		count = max + 1
+	}
+
+	if i.Ctx.GetExecutor() == nil {
+		return nil, false, nil
+	}
+	// the rows are read from the primary which the statement is executed on, the read replica
+	// used by the context may lag behind it.
+	e, err := i.getDbConn()
+	if err != nil {
+		return nil, false, err
+	}
+	records, err = e.Db.Query(i.generateGetRecordsSql(fields, table, tableAlias, where, order, count))
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(records)) > max {
+		return nil, true, nil
+	}
+	return records, false, nil
+}
+
+// generateGetRecordsSql generate select SQL.
+func (i *MysqlDriverImpl) generateGetRecordsSql(expr string, table *ast.TableName, tableAlias string, where ast.ExprNode,
+	order *ast.OrderByClause, limit int64) string {
+	recordSql := fmt.Sprintf("SELECT %s FROM %s", expr, i.getTableNameWithQuote(table))
+	if tableAlias != "" {
+		recordSql = fmt.Sprintf("%s AS %s", recordSql, util.QuoteIdentifier(tableAlias))
+	}
+	if where != nil {
+		recordSql = fmt.Sprintf("%s WHERE %s", recordSql, util.ExprFormat(where))
+	}
+	if order != nil {
+		items := make([]string, 0, len(order.Items))
+		for _, item := range order.Items {
+			orderBy := util.ExprFormat(item.Expr)
+			if item.Desc {
+				orderBy = fmt.Sprintf("%s DESC", orderBy)
+			}
+			items = append(items, orderBy)
+		}
+		recordSql = fmt.Sprintf("%s ORDER BY %s", recordSql, strings.Join(items, ", "))
+	}
+	if limit > 0 {
+		recordSql = fmt.Sprintf("%s LIMIT %d", recordSql, limit)
+	}
+	return recordSql
+}
+
+// rollbackValueFormat format the column value queried from database as SQL literal.
+func rollbackValueFormat(v sql.NullString) string {
+	if !v.Valid {
+		return "NULL"
+	}
+	value := strings.ReplaceAll(v.String, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return fmt.Sprintf("'%s'", value)
+}
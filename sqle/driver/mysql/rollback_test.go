@@ -0,0 +1,264 @@
+package mysql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	"github.com/actiontech/sqle/sqle/driver/mysql/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func runRollbackCase(t *testing.T, desc string, i *MysqlDriverImpl, sql string, expectSql string, expectReason i18nPkg.I18nStr) {
+	stmt, err := util.ParseOneSql(sql)
+	if !assert.NoError(t, err, desc) {
+		return
+	}
+	rollbackSql, reason, err := i.GenerateRollbackSql(stmt)
+	if !assert.NoError(t, err, desc) {
+		return
+	}
+	assert.Equal(t, expectSql, rollbackSql, desc)
+	assert.Equal(t, expectReason, reason, desc)
+}
+
+func TestGenRollbackSQLWithoutStatement(t *testing.T) {
+	i := DefaultMysqlInspect()
+	rollbackSql, reason, err := i.GenRollbackSQL(context.TODO(), "-- no statement")
+	assert.NoError(t, err)
+	assert.Empty(t, rollbackSql)
+	assert.Nil(t, reason)
+}
+
+func TestInsertRollbackSql(t *testing.T) {
+	i := DefaultMysqlInspect()
+
+	runRollbackCase(t, "insert values", i,
+		"insert into exist_db.exist_tb_1 (id,v1,v2) values (1,'a','b');",
+		"DELETE FROM `exist_db`.`exist_tb_1` WHERE `id` = 1;", nil)
+
+	runRollbackCase(t, "insert values without columns", i,
+		"insert into exist_db.exist_tb_1 values (1,'a','b');",
+		"DELETE FROM `exist_db`.`exist_tb_1` WHERE `id` = 1;", nil)
+
+	runRollbackCase(t, "insert multi-row values", i,
+		"insert into exist_db.exist_tb_1 (id,v1,v2) values (1,'a','b'),(2,'c','d'),(3,'e','f');",
+		"DELETE FROM `exist_db`.`exist_tb_1` WHERE `id` = 1;\n"+
+			"DELETE FROM `exist_db`.`exist_tb_1` WHERE `id` = 2;\n"+
+			"DELETE FROM `exist_db`.`exist_tb_1` WHERE `id` = 3;", nil)
+
+	runRollbackCase(t, "insert set", i,
+		"insert into exist_db.exist_tb_1 set id=1,v1='a',v2='b';",
+		"DELETE FROM `exist_db`.`exist_tb_1` WHERE `id` = 1;", nil)
+
+	runRollbackCase(t, "insert without primary key", i,
+		"insert into exist_db.exist_tb_1 (v1,v2) values ('a','b');",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportInsertWithoutPrimaryKeyRollback))
+
+	runRollbackCase(t, "insert on duplicate", i,
+		"insert into exist_db.exist_tb_1 (id,v1,v2) values (1,'a','b') on duplicate key update v1='c';",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportOnDuplicatStatementRollback))
+
+	runRollbackCase(t, "insert into table without primary key", i,
+		"insert into exist_db.exist_tb_2 (id,v1,v2,user_id) values (1,'a','b',1);",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportNoPrimaryKeyTableRollback))
+
+	i.cnf.DMLRollbackMaxRows = 2
+	runRollbackCase(t, "insert multi-row values exceed max rows", i,
+		"insert into exist_db.exist_tb_1 (id,v1,v2) values (1,'a','b'),(2,'c','d'),(3,'e','f');",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportExceedMaxRowsRollback))
+
+	i.cnf.DMLRollbackMaxRows = -1
+	runRollbackCase(t, "rollback disabled", i,
+		"insert into exist_db.exist_tb_1 (id,v1,v2) values (1,'a','b');",
+		"", nil)
+}
+
+func TestDeleteRollbackSql(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i := NewMockInspect(e)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` WHERE `id` > 1 LIMIT 1001")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}).
+			AddRow("2", "a", nil).
+			AddRow("3", "it's", "b"))
+	runRollbackCase(t, "delete with where", i,
+		"delete from exist_db.exist_tb_1 where id > 1;",
+		"INSERT INTO `exist_db`.`exist_tb_1` (`id`, `v1`, `v2`) VALUES ('2', 'a', NULL), ('3', 'it\\'s', 'b');", nil)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` WHERE `id` = 100 LIMIT 1001")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}))
+	runRollbackCase(t, "delete nothing", i,
+		"delete from exist_db.exist_tb_1 where id = 100;",
+		"", nil)
+
+	i.cnf.DMLRollbackMaxRows = 1
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` LIMIT 2")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}).
+			AddRow("1", "a", "b").
+			AddRow("2", "c", "d"))
+	runRollbackCase(t, "delete exceed max rows", i,
+		"delete from exist_db.exist_tb_1;",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportExceedMaxRowsRollback))
+
+	runRollbackCase(t, "delete with sub query", i,
+		"delete from exist_db.exist_tb_1 where id in (select id from exist_db.exist_tb_2);",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportSubQueryStatementRollback))
+
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestUpdateRollbackSql(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	i := NewMockInspect(e)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` WHERE `id` = 1 LIMIT 1001")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}).
+			AddRow("1", "a", "b"))
+	runRollbackCase(t, "update with where", i,
+		"update exist_db.exist_tb_1 set v1='c' where id = 1;",
+		"UPDATE `exist_db`.`exist_tb_1` SET `v1` = 'a' WHERE `id` = '1';", nil)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` LIMIT 1001")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}).
+			AddRow("1", "a", "b").
+			AddRow("2", "c", nil))
+	runRollbackCase(t, "update without where", i,
+		"update exist_db.exist_tb_1 set v2='x';",
+		"UPDATE `exist_db`.`exist_tb_1` SET `v2` = 'b' WHERE `id` = '1';\n"+
+			"UPDATE `exist_db`.`exist_tb_1` SET `v2` = NULL WHERE `id` = '2';", nil)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` WHERE `id` = 1 LIMIT 1001")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}).
+			AddRow("1", "a", "b"))
+	runRollbackCase(t, "update primary key", i,
+		"update exist_db.exist_tb_1 set id=10 where id = 1;",
+		"UPDATE `exist_db`.`exist_tb_1` SET `id` = '1' WHERE `id` = 10;", nil)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT *, `a`.`id` + 1 AS `sqle_new_id` FROM `exist_db`.`exist_tb_1` AS `a` WHERE `id` > 1 LIMIT 1001")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2", "sqle_new_id"}).
+			AddRow("2", "a", "b", "3").
+			AddRow("3", "c", "d", "4"))
+	runRollbackCase(t, "update primary key by expression", i,
+		"update exist_db.exist_tb_1 as a set a.id=a.id+1, v1='x' where id > 1;",
+		"UPDATE `exist_db`.`exist_tb_1` SET `id` = '2', `v1` = 'a' WHERE `id` = '3';\n"+
+			"UPDATE `exist_db`.`exist_tb_1` SET `id` = '3', `v1` = 'c' WHERE `id` = '4';", nil)
+
+	runRollbackCase(t, "update primary key by the value assigned before", i,
+		"update exist_db.exist_tb_1 set v1='10', id=v1 where id = 1;",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback))
+
+	i.cnf.DMLRollbackMaxRows = 1
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exist_db`.`exist_tb_1` LIMIT 2")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v1", "v2"}).
+			AddRow("1", "a", "b").
+			AddRow("2", "c", "d"))
+	runRollbackCase(t, "update without where exceed max rows", i,
+		"update exist_db.exist_tb_1 set v2='x';",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportExceedMaxRowsRollback))
+
+	runRollbackCase(t, "update multi table", i,
+		"update exist_db.exist_tb_1 a, exist_db.exist_tb_2 b set a.v1=b.v1 where a.id=b.id;",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportMultiTableStatementRollback))
+
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestDDLRollbackSql(t *testing.T) {
+	i := DefaultMysqlInspect()
+
+	runRollbackCase(t, "create table", i,
+		"create table exist_db.not_exist_tb_1 (id int primary key);",
+		"DROP TABLE IF EXISTS `exist_db`.`not_exist_tb_1`;", nil)
+
+	runRollbackCase(t, "create table if not exists on exist table", i,
+		"create table if not exists exist_db.exist_tb_1 (id int primary key);",
+		"", nil)
+
+	runRollbackCase(t, "drop table", i,
+		"drop table exist_db.EXIST_TB_5;",
+		"CREATE TABLE `exist_db`.`EXIST_TB_5` (`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'unit test',`v1` VARCHAR(255) NOT NULL COMMENT 'unit test',`v2` VARCHAR(255) NOT NULL COMMENT 'unit test') ENGINE = InnoDB DEFAULT CHARACTER SET = UTF8MB4 COMMENT = 'uint test';", nil)
+
+	runRollbackCase(t, "alter table add column", i,
+		"alter table exist_db.exist_tb_1 add column v3 varchar(255);",
+		"ALTER TABLE `exist_db`.`exist_tb_1`\nDROP COLUMN `v3`;", nil)
+
+	runRollbackCase(t, "alter table drop column", i,
+		"alter table exist_db.exist_tb_1 drop column v2;",
+		"ALTER TABLE `exist_db`.`exist_tb_1`\nADD COLUMN `v2` varchar(255) COMMENT \"unit test\";", nil)
+
+	runRollbackCase(t, "alter table multi specs in reverse sequence", i,
+		"alter table exist_db.exist_tb_1 add column v3 varchar(255), modify column v1 int, add index idx_2(v2), rename index idx_1 to idx_3;",
+		"ALTER TABLE `exist_db`.`exist_tb_1`\n"+
+			"RENAME INDEX `idx_3` TO `idx_1`,\n"+
+			"DROP INDEX `idx_2`,\n"+
+			"MODIFY COLUMN `v1` varchar(255) NOT NULL DEFAULT \"v1\" COMMENT \"unit test\",\n"+
+			"DROP COLUMN `v3`;", nil)
+
+	runRollbackCase(t, "alter table rename", i,
+		"alter table exist_db.exist_tb_1 rename as exist_db.exist_tb_11_new, add column v3 int;",
+		"ALTER TABLE `exist_db`.`exist_tb_11_new`\nDROP COLUMN `v3`,\nRENAME AS `exist_db`.`exist_tb_1`;", nil)
+
+	runRollbackCase(t, "alter table not support", i,
+		"alter table exist_db.exist_tb_1 engine = MyISAM;",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportStatementRollback))
+}
+
+func TestDDLRollbackSql_IndexRoundTrip(t *testing.T) {
+	i := DefaultMysqlInspect()
+
+	addIndex := "ALTER TABLE `exist_db`.`exist_tb_1`\nADD INDEX `idx_2` (`v2`);"
+	dropIndex := "ALTER TABLE `exist_db`.`exist_tb_1`\nDROP INDEX `idx_2`;"
+	runRollbackCase(t, "add index", i, addIndex, dropIndex, nil)
+
+	// apply the add index, then the rollback of the drop index should be the origin add index.
+	stmt, err := util.ParseOneSql(addIndex)
+	assert.NoError(t, err)
+	i.Ctx.UpdateContext(stmt)
+	runRollbackCase(t, "drop index", i, dropIndex, addIndex, nil)
+
+	runRollbackCase(t, "drop index stmt", i,
+		"drop index idx_1 on exist_db.exist_tb_1;",
+		"ALTER TABLE `exist_db`.`exist_tb_1`\nADD INDEX `idx_1` (`v1`);", nil)
+
+	runRollbackCase(t, "create index stmt", i,
+		"create index idx_4 on exist_db.exist_tb_1(v2);",
+		"DROP INDEX `idx_4` ON `exist_db`.`exist_tb_1`;", nil)
+}
+
+func TestRollbackSql_QuoteIdentifier(t *testing.T) {
+	i := DefaultMysqlInspect()
+
+	runRollbackCase(t, "create table with backtick and dot in name", i,
+		"create table exist_db.`t``1.x` (id int primary key);",
+		"DROP TABLE IF EXISTS `exist_db`.`t``1.x`;", nil)
+
+	runRollbackCase(t, "create table with reserved word as name", i,
+		"create table exist_db.`select` (id int primary key);",
+		"DROP TABLE IF EXISTS `exist_db`.`select`;", nil)
+
+	runRollbackCase(t, "create index with backtick in name", i,
+		"create index `idx``4` on exist_db.exist_tb_1(v2);",
+		"DROP INDEX `idx``4` ON `exist_db`.`exist_tb_1`;", nil)
+}
+
+func TestDDLRollbackSql_Offline(t *testing.T) {
+	i := DefaultMysqlInspect()
+	i.isOfflineAudit = true
+	i.Ctx = session.NewContext(nil)
+
+	runRollbackCase(t, "drop table offline", i,
+		"drop table exist_db.exist_tb_1;",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportNoOriginalTableRollback))
+
+	runRollbackCase(t, "alter table offline", i,
+		"alter table exist_db.exist_tb_1 add column v3 int;",
+		"", plocale.Bundle.LocalizeAll(plocale.NotSupportNoOriginalTableRollback))
+}
@@ -2,10 +2,13 @@ package ai
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
 	"github.com/actiontech/sqle/sqle/pkg/params"
 	"github.com/pingcap/parser/ast"
 
@@ -37,6 +40,12 @@ func init() {
 				Desc:  plocale.Rule00005Params1,
 				Type:  params.ParamTypeString,
 				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "3072",
+				Desc:  plocale.Rule00005Params2,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
 			}},
 			Knowledge:    driverV2.RuleKnowledge{},
 			AllowOffline: true,
@@ -50,25 +59,16 @@ func init() {
 
 /*
 ==== Prompt start ====
-在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00005): "在 MySQL 中，避免复合索引中包含过多字段.默认参数描述: 复合索引内字段个数, 默认参数值: 5"
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00005): "在 MySQL 中，避免复合索引中包含过多字段.默认参数描述: 复合索引内字段个数, 默认参数值: 5; 默认参数描述: 索引长度上限(字节), 默认参数值: 3072"
 您应遵循以下逻辑：
-1. 对于 CREATE TABLE 语句，检查以下条件：
-   1. 定义一个字段集合。
-   2. 解析语法树，提取语句中 key 或 index 定义的字段，并将其加入集合。
-   3. 获取集合中字段的数量。
-   4. 如果字段数量大于规则变量值，则报告违反规则。
-
-2. 对于 ALTER TABLE 语句，检查以下条件：
-   1. 定义一个字段集合。
-   2. 解析语法树，提取语句中 key 或 index 定义的字段，并将其加入集合。
-   3. 获取集合中字段的数量。
-   4. 如果字段数量大于规则变量值，则报告违反规则。
-
-3. 对于 CREATE INDEX 语句，检查以下条件：
-   1. 定义一个字段集合。
-   2. 解析语法树，提取语句中 index 定义的字段，并将其加入集合。
-   3. 获取集合中字段的数量。
-   4. 如果字段数量大于规则变量值，则报告违反规则。
+1. 对于 CREATE TABLE 语句，解析语法树，获取语句中 key 或 index 定义，以及表中所有列的定义。
+2. 对于 ALTER TABLE 语句，解析语法树，获取语句中 ADD key 或 ADD index 定义，使用 input.Ctx.GetCreateTableStmt 获取表中原有列的定义，并加入语句中新增列的定义。
+3. 对于 CREATE INDEX 语句，解析语法树，获取语句中 index 定义，使用 input.Ctx.GetCreateTableStmt 获取表中列的定义。
+4. 对于每个索引定义，
+   1. 如果索引的字段数量大于第一个规则参数，则报告违反规则。
+   2. 使用辅助函数 util.GetIndexKeyPartBytes 累加每个索引字段的最大字节数（前缀索引按前缀长度计算，字符集使用列的字符集或表的默认字符集），
+      如果索引长度大于第二个规则参数，则报告违反规则。无法获取列定义（如离线审核 ALTER TABLE）、函数索引、全文索引和空间索引不检查索引长度。
+报告违反规则时，提示违反规则的索引名、字段个数和索引长度。
 ==== Prompt end ====
 */
 
@@ -81,40 +81,107 @@ func RuleSQLE00005(input *rulepkg.RuleHandlerInput) error {
 		return fmt.Errorf("param %s not found", rulepkg.DefaultSingleParamKeyName)
 	}
 	maxColumnCount := param.Int()
+	// 索引长度上限，未设置时不检查
+	maxKeyBytes := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).Int()
+
+	columns := map[string]*ast.ColumnDef{}
+	tableCharset := ""
+	addColumns := func(cols []*ast.ColumnDef) {
+		for _, col := range cols {
+			columns[col.Name.Name.L] = col
+		}
+	}
+	addOriginalColumns := func(table *ast.TableName) {
+		createTableStmt, exist, err := input.Ctx.GetCreateTableStmt(table)
+		if err != nil {
+			log.NewEntry().Errorf("get create table statement failed, sqle: %v, error: %v", input.Node.Text(), err)
+			return
+		}
+		if exist {
+			addColumns(createTableStmt.Cols)
+			if option := util.GetTableOption(createTableStmt.Options, ast.TableOptionCharset); option != nil {
+				tableCharset = option.StrValue
+			}
+		}
+	}
+	// getKeyBytes 返回索引长度，无法计算时 ok 为 false
+	getKeyBytes := func(keys []*ast.IndexPartSpecification) (keyBytes int, ok bool) {
+		for _, key := range keys {
+			if key.Column == nil {
+				// 函数索引
+				return 0, false
+			}
+			col, exist := columns[key.Column.Name.L]
+			if !exist {
+				return 0, false
+			}
+			keyBytes += util.GetIndexKeyPartBytes(col, key.Length, tableCharset)
+		}
+		return keyBytes, true
+	}
+
+	var violations []string
+	checkIndex := func(indexName string, keys []*ast.IndexPartSpecification, checkKeyBytes bool) {
+		if indexName == "" && len(keys) > 0 && keys[0].Column != nil {
+			indexName = keys[0].Column.Name.O
+		}
+		keyBytes, ok := 0, false
+		if checkKeyBytes && maxKeyBytes > 0 {
+			keyBytes, ok = getKeyBytes(keys)
+		}
+		if len(keys) > maxColumnCount || (ok && keyBytes > maxKeyBytes) {
+			keyBytesDesc := "-"
+			if ok {
+				keyBytesDesc = strconv.Itoa(keyBytes)
+			}
+			violations = append(violations, fmt.Sprintf("%s(%d, %s)", indexName, len(keys), keyBytesDesc))
+		}
+	}
+	checkConstraints := func(constraints []*ast.Constraint) {
+		for _, constraint := range util.GetTableConstraints(constraints, util.GetIndexConstraintTypes()...) {
+			switch constraint.Tp {
+			case ast.ConstraintPrimaryKey:
+				checkIndex("PRIMARY", constraint.Keys, true)
+			case ast.ConstraintFulltext, ast.ConstraintSpatial:
+				checkIndex(constraint.Name, constraint.Keys, false)
+			default:
+				checkIndex(constraint.Name, constraint.Keys, true)
+			}
+		}
+	}
 
 	switch stmt := input.Node.(type) {
 	case *ast.CreateTableStmt:
 		// "create table..."
-		constraints := util.GetTableConstraints(stmt.Constraints, util.GetIndexConstraintTypes()...)
-
-		for _, constraint := range constraints {
-			// the table is created with composite index
-			if len(constraint.Keys) > maxColumnCount {
-				rulepkg.AddResult(input.Res, input.Rule, SQLE00005, maxColumnCount)
-				return nil
-			}
+		addColumns(stmt.Cols)
+		if option := util.GetTableOption(stmt.Options, ast.TableOptionCharset); option != nil {
+			tableCharset = option.StrValue
 		}
+		checkConstraints(stmt.Constraints)
 	case *ast.CreateIndexStmt:
 		// "create index..."
-		if len(stmt.IndexPartSpecifications) > maxColumnCount {
-			rulepkg.AddResult(input.Res, input.Rule, SQLE00005, maxColumnCount)
+		addOriginalColumns(stmt.Table)
+		checkKeyBytes := stmt.KeyType != ast.IndexKeyTypeFullText && stmt.KeyType != ast.IndexKeyTypeSpatial
+		checkIndex(stmt.IndexName, stmt.IndexPartSpecifications, checkKeyBytes)
+	case *ast.AlterTableStmt:
+		// "alter table... add index..."
+		specs := util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint)
+		if len(specs) == 0 {
 			return nil
 		}
-	case *ast.AlterTableStmt:
-		// "alter table"
-		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint) {
-			// "alter table... add index..."
-			constraints := util.GetTableConstraints([]*ast.Constraint{spec.Constraint}, util.GetIndexConstraintTypes()...)
-
-			for _, constraint := range constraints {
-				// the table is created with composite index
-				if len(constraint.Keys) > maxColumnCount {
-					rulepkg.AddResult(input.Res, input.Rule, SQLE00005, maxColumnCount)
-					return nil
-				}
-			}
-
+		addOriginalColumns(stmt.Table)
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			addColumns(spec.NewColumns)
+		}
+		for _, spec := range specs {
+			checkConstraints([]*ast.Constraint{spec.Constraint})
 		}
+	default:
+		return nil
+	}
+
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00005, strings.Join(violations, ", "))
 	}
 	return nil
 }
@@ -1,9 +1,12 @@
 package ai
 
 import (
+	"strings"
+
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
 	"github.com/pingcap/parser/ast"
 	"github.com/pingcap/parser/mysql"
 
@@ -28,8 +31,14 @@ func init() {
 				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
 				plocale.RuleCategoryAuditPerformanceCost.ID: {},
 			},
-			Level:        driverV2.RuleLevelNotice,
-			Params:       []*rulepkg.SourceParam{},
+			Level: driverV2.RuleLevelNotice,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "",
+				Desc:  plocale.Rule00019Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
 			Knowledge:    driverV2.RuleKnowledge{},
 			AllowOffline: true,
 			Version:      2,
@@ -42,54 +51,66 @@ func init() {
 
 /*
 ==== Prompt start ====
-在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00019): "在 MySQL 中，不建议使用复合类型（SET和ENUM类型）数据."
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00019): "在 MySQL 中，不建议使用复合类型（SET和ENUM类型）数据.默认参数描述: 允许使用SET和ENUM类型的字段(多个用逗号分隔，格式为字段名或表名.字段名), 默认参数值: "
 您应遵循以下逻辑：
 1. 对于 "CREATE TABLE..." 语句：
    - 解析语法树以识别字段定义。
    - 检查每个字段的数据类型，使用辅助函数 IsColumnTypeEqual 检查字段类型是否为 ENUM 或 SET。
-   - 如果发现字段的数据类型为 ENUM 或 SET，则记录该字段并报告违反规则。
+   - 如果字段的数据类型为 ENUM 或 SET，且字段不在规则参数的白名单中，则记录该字段。
 
 2. 对于 "ALTER TABLE..." 语句：
-   - 解析语法树以识别字段变更或新增定义。
+   - 解析语法树以识别字段变更或新增定义（ADD COLUMN、MODIFY COLUMN、CHANGE COLUMN）。
    - 检查变更或新增字段的数据类型，使用辅助函数 IsColumnTypeEqual 检查字段类型是否为 ENUM 或 SET。
-   - 如果发现字段的数据类型为 ENUM 或 SET，则记录该字段并报告违反规则。
+   - 如果字段的数据类型为 ENUM 或 SET，且字段不在规则参数的白名单中，则记录该字段。
+
+3. 白名单中的字段可以是字段名，也可以是"表名.字段名"，不区分大小写。
+如果记录了字段，则报告违反规则，提示所有记录的字段名。
 ==== Prompt end ====
 */
 
 // ==== Rule code start ====
 // 规则函数实现开始
 func RuleSQLE00019(input *rulepkg.RuleHandlerInput) error {
-
-	// 确保输入的节点不为空
-	if input.Node == nil {
-		return nil
-	}
-
-	// 遍历所有SQL语句
+	var table string
+	var columns []*ast.ColumnDef
 	switch stmt := input.Node.(type) {
 	case *ast.CreateTableStmt:
-		// 处理 CREATE TABLE 语句
-		for _, column := range stmt.Cols {
-			if util.IsColumnTypeEqual(column, mysql.TypeEnum, mysql.TypeSet) {
-				rulepkg.AddResult(input.Res, input.Rule, SQLE00019)
-				return nil
-			}
-		}
+		table = stmt.Table.Name.L
+		columns = stmt.Cols
 	case *ast.AlterTableStmt:
-		// 处理 ALTER TABLE 语句
-		for _, spec := range stmt.Specs {
-			switch spec.Tp {
-			case ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn:
-				for _, column := range spec.NewColumns {
-					if util.IsColumnTypeEqual(column, mysql.TypeEnum, mysql.TypeSet) {
-						rulepkg.AddResult(input.Res, input.Rule, SQLE00019)
-						return nil
-					}
-				}
-			}
+		table = stmt.Table.Name.L
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			columns = append(columns, spec.NewColumns...)
+		}
+	default:
+		return nil
+	}
+
+	// 白名单中的字段允许使用 SET 和 ENUM 类型
+	allowed := map[string]struct{}{}
+	for _, name := range strings.Split(input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String(), ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			allowed[name] = struct{}{}
 		}
 	}
 
+	var violations []string
+	for _, column := range columns {
+		if !util.IsColumnTypeEqual(column, mysql.TypeEnum, mysql.TypeSet) {
+			continue
+		}
+		name := column.Name.Name.L
+		if _, ok := allowed[name]; ok {
+			continue
+		}
+		if _, ok := allowed[table+"."+name]; ok {
+			continue
+		}
+		violations = append(violations, column.Name.Name.O)
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00019, strings.Join(violations, ","))
+	}
 	return nil
 }
 
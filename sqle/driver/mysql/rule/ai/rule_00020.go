@@ -7,7 +7,6 @@ import (
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
-	"github.com/actiontech/sqle/sqle/log"
 	"github.com/actiontech/sqle/sqle/pkg/params"
 	"github.com/pingcap/parser/ast"
 
@@ -35,13 +34,13 @@ func init() {
 			Level: driverV2.RuleLevelNotice,
 			Params: []*rulepkg.SourceParam{{
 				Key:   rulepkg.DefaultSingleParamKeyName,
-				Value: "40",
+				Value: "50",
 				Desc:  plocale.Rule00020Params1,
 				Type:  params.ParamTypeString,
 				Enums: nil,
 			}},
 			Knowledge:    driverV2.RuleKnowledge{},
-			AllowOffline: false,
+			AllowOffline: true,
 			Version:      2,
 		},
 		Message: plocale.Rule00020Message,
@@ -52,18 +51,19 @@ func init() {
 
 /*
 ==== Prompt start ====
-在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00020): "在 MySQL 中，避免表中包含有太多的列.默认参数描述: 表内列数上限, 默认参数值: 40"
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00020): "在 MySQL 中，避免表中包含有太多的列.默认参数描述: 表内列数上限, 默认参数值: 50"
 您应遵循以下逻辑：
 1. 对于“CREATE TABLE ...”语句：
    - 统计定义的字段个数。
    - 若字段个数超过预设阈值，则报告违反规则。
 
 2. 对于“ALTER TABLE ...”语句：
-   1. 使用辅助函数GetCreateTableStmt获取当前表的字段个数。
-   2. 统计当前语句中的 DROP 和 ADD 操作的个数：
-      - 计算字段个数的净变化（ADD 操作增加，DROP 操作减少）。
+   1. 使用辅助函数GetCreateTableStmt获取当前表的字段个数，离线审核或无法获取表定义时，不检查。
+   2. 统计当前语句中 ADD COLUMN 新增的字段个数（一个 ADD COLUMN 可以新增多个字段）和 DROP COLUMN 删除的字段个数：
+      - 计算字段个数的净变化（新增的字段增加，删除的字段减少）。
    3. 将当前表的字段个数与净变化相加。
    4. 如果结果超过预设阈值，则报告违反规则。
+报告违反规则时，提示表的字段个数和阈值。
 ==== Prompt end ====
 */
 
@@ -79,25 +79,28 @@ func RuleSQLE00020(input *rulepkg.RuleHandlerInput) error {
 		return fmt.Errorf("param should be an integer, got: %v", param.Value)
 	}
 
+	var columnCount int
 	switch stmt := input.Node.(type) {
 	case *ast.CreateTableStmt:
-		if len(stmt.Cols) > threshold {
-			rulepkg.AddResult(input.Res, input.Rule, SQLE00020)
-			return nil
-		}
+		columnCount = len(stmt.Cols)
 	case *ast.AlterTableStmt:
-		num := len(util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns)) - len(util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableDropColumn))
+		// 离线审核或表不存在时，无法获取表原有的列数
 		createTable, err := util.GetCreateTableStmt(input.Ctx, stmt.Table)
 		if err != nil {
-			log.NewEntry().Errorf("GetCreateTableStmt failed, sqle: %v, error: %v", stmt.Text(), err)
-			return err
-		}
-		if len(createTable.Cols)+num > threshold {
-			rulepkg.AddResult(input.Res, input.Rule, SQLE00020)
 			return nil
 		}
+		columnCount = len(createTable.Cols)
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns) {
+			columnCount += len(spec.NewColumns)
+		}
+		columnCount -= len(util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableDropColumn))
+	default:
+		return nil
 	}
 
+	if columnCount > threshold {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00020, columnCount, threshold)
+	}
 	return nil
 }
 
@@ -1,11 +1,14 @@
 package ai
 
 import (
+	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
 	"github.com/pingcap/parser/ast"
 
 	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
@@ -29,8 +32,14 @@ func init() {
 				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
 				plocale.RuleCategoryAuditPerformanceCost.ID: {},
 			},
-			Level:        driverV2.RuleLevelNotice,
-			Params:       []*rulepkg.SourceParam{},
+			Level: driverV2.RuleLevelNotice,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "1",
+				Desc:  plocale.Rule00027Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}},
 			Knowledge:    driverV2.RuleKnowledge{},
 			AllowOffline: true,
 			Version:      2,
@@ -53,32 +62,41 @@ You should follow the following logic:
 6. For "alter table ... modify column ..." statement, check the modified column definition, if its comment option has only spaces or empty strings, add the column name to violation-list
 7. For "alter table ... change column ..." statement, check the new column's definition, if its option has no comment, add the column name to violation-list
 8. For "alter table ... change column ..." statement, check the new column's definition, if its comment option has only spaces or empty strings, add the column name to violation-list
-9. Generate a violation message as the checking result, including column names which violate the rule, if there is any violations
+9. For all the statements above, if the length of the trimmed comment is less than the minimum length in param, add the column name to violation-list. Generated columns are checked as well
+10. Generate a violation message as the checking result, including column names which violate the rule, if there is any violations
 ==== Prompt end ====
 */
 
 // ==== Rule code start ====
 func RuleSQLE00027(input *rulepkg.RuleHandlerInput) error {
+	param := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName)
+	if param == nil {
+		return fmt.Errorf("param %s not found", rulepkg.DefaultSingleParamKeyName)
+	}
+	minLength := param.Int()
+	if minLength < 1 {
+		minLength = 1
+	}
+	// the column has "COMMENT" option and its trimmed comment is not shorter than the minimum length
+	hasValidComment := func(col *ast.ColumnDef) bool {
+		c := util.GetColumnOption(col, ast.ColumnOptionComment)
+		return c != nil && utf8.RuneCountInString(strings.TrimSpace(util.GetValueExprStr(c.Expr))) >= minLength
+	}
+
 	violateColumns := []*ast.ColumnDef{}
 	switch stmt := input.Node.(type) {
 	case *ast.CreateTableStmt:
 		for _, col := range stmt.Cols {
-			//if the column has "COMMENT" option and its comment is not only spaces or empty strings, ignore it
-			if c := util.GetColumnOption(col, ast.ColumnOptionComment); nil != c && len(strings.TrimSpace(util.GetValueExprStr(c.Expr))) > 0 {
-				continue
+			if !hasValidComment(col) {
+				violateColumns = append(violateColumns, col)
 			}
-
-			violateColumns = append(violateColumns, col)
 		}
 	case *ast.AlterTableStmt:
 		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableChangeColumn, ast.AlterTableModifyColumn) {
 			for _, col := range spec.NewColumns {
-				//if the column has "COMMENT" option and its comment is not only spaces or empty strings, ignore it
-				if c := util.GetColumnOption(col, ast.ColumnOptionComment); nil != c && len(strings.TrimSpace(util.GetValueExprStr(c.Expr))) > 0 {
-					continue
+				if !hasValidComment(col) {
+					violateColumns = append(violateColumns, col)
 				}
-
-				violateColumns = append(violateColumns, col)
 			}
 		}
 	}
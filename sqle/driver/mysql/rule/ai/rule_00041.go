@@ -70,7 +70,7 @@ func init() {
    - 如果存在，进入步骤5。
 
 5、检查目标索引名是否包含固定前缀。
-   - 如果不包含，报告违反规则。
+   - 如果不包含，报告违反规则，并给出以固定前缀加字段名组成的建议索引名。
 ==== Prompt end ====
 */
 
@@ -84,6 +84,11 @@ func RuleSQLE00041(input *rulepkg.RuleHandlerInput) error {
 	}
 	requiredPrefix := param.String()
 
+	// 建议的索引名为固定前缀+字段名
+	suggestName := func(keys []*ast.IndexPartSpecification) string {
+		return requiredPrefix + strings.Join(getIndexColumnNames(keys), "_")
+	}
+
 	switch stmt := input.Node.(type) {
 	case *ast.AlterTableStmt:
 		renameto_map := make(map[string]string) /*oldIndexName, newIndexName*/
@@ -94,7 +99,7 @@ func RuleSQLE00041(input *rulepkg.RuleHandlerInput) error {
 			if util.IsAlterTableCommand(spec, ast.AlterTableAddConstraint) &&
 				(spec.Constraint.Tp == ast.ConstraintUniq || spec.Constraint.Tp == ast.ConstraintUniqIndex) {
 				if !strings.HasPrefix(spec.Constraint.Name, requiredPrefix) {
-					rulepkg.AddResult(input.Res, input.Rule, SQLE00041)
+					rulepkg.AddResult(input.Res, input.Rule, SQLE00041, spec.Constraint.Name, suggestName(spec.Constraint.Keys))
 					return nil
 				}
 				// alter table ... RENAME index ....
@@ -116,7 +121,7 @@ func RuleSQLE00041(input *rulepkg.RuleHandlerInput) error {
 				for _, constraint := range constraints { // origin index name
 					if newIndexName, ok := renameto_map[constraint.Name]; ok {
 						if !strings.HasPrefix(newIndexName, requiredPrefix) {
-							rulepkg.AddResult(input.Res, input.Rule, SQLE00041)
+							rulepkg.AddResult(input.Res, input.Rule, SQLE00041, newIndexName, suggestName(constraint.Keys))
 							return nil
 						}
 					}
@@ -128,15 +133,15 @@ func RuleSQLE00041(input *rulepkg.RuleHandlerInput) error {
 		for _, constraint := range stmt.Constraints {
 			if constraint.Tp == ast.ConstraintUniq || constraint.Tp == ast.ConstraintUniqIndex {
 				if !strings.HasPrefix(constraint.Name, requiredPrefix) {
-					rulepkg.AddResult(input.Res, input.Rule, SQLE00041)
+					rulepkg.AddResult(input.Res, input.Rule, SQLE00041, constraint.Name, suggestName(constraint.Keys))
 					return nil
 				}
 			}
 		}
 	case *ast.CreateIndexStmt:
-		if stmt.KeyType == ast.IndexKeyTypeNone {
+		if stmt.KeyType == ast.IndexKeyTypeUnique {
 			if !strings.HasPrefix(stmt.IndexName, requiredPrefix) {
-				rulepkg.AddResult(input.Res, input.Rule, SQLE00041)
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00041, stmt.IndexName, suggestName(stmt.IndexPartSpecifications))
 				return nil
 			}
 		}
@@ -4,6 +4,8 @@ import (
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
 
 	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
 )
@@ -26,8 +28,14 @@ func init() {
 				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
 				plocale.RuleCategoryAuditPerformanceCost.ID: {},
 			},
-			Level:        driverV2.RuleLevelNotice,
-			Params:       []*rulepkg.SourceParam{},
+			Level: driverV2.RuleLevelNotice,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "false",
+				Desc:  plocale.Rule00053Params1,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
 			Knowledge:    driverV2.RuleKnowledge{},
 			AllowOffline: true,
 			Version:      2,
@@ -45,6 +53,7 @@ func init() {
 1. 针对所有 DML 和 DQL 语句，递归检查所有 SELECT 子句：
    1. 使用辅助函数GetSelectStmt获取SELECT子句。
    2. 如果 SELECT 子句中包含单独的 * 符号（表示选择所有列），则标记为违反规则。
+   3. 如果规则参数允许，跳过 EXISTS 子查询中的 SELECT 子句。
 ==== Prompt end ====
 */
 
@@ -54,6 +63,22 @@ func RuleSQLE00053(input *rulepkg.RuleHandlerInput) error {
 	// 获取所有 SELECT 子句，包括嵌套的子查询
 	selectStmts := util.GetSelectStmt(input.Node)
 
+	// EXISTS 子查询仅判断是否存在记录，允许时跳过其中的 SELECT 子句
+	allowInExists := false
+	if param := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName); param != nil {
+		allowInExists = param.Bool()
+	}
+	selectInExists := map[*ast.SelectStmt]struct{}{}
+	if allowInExists {
+		for _, exists := range util.GetExistsSubquery(input.Node) {
+			if subquery, ok := exists.Sel.(*ast.SubqueryExpr); ok {
+				for _, selectStmt := range util.GetSelectStmt(subquery.Query) {
+					selectInExists[selectStmt] = struct{}{}
+				}
+			}
+		}
+	}
+
 	// 检查是否成功获取 SELECT 子句
 	if len(selectStmts) == 0 {
 		return nil // 如果没有 SELECT 子句，则不违反规则
@@ -61,6 +86,9 @@ func RuleSQLE00053(input *rulepkg.RuleHandlerInput) error {
 
 	// 遍历每个 SELECT 子句
 	for _, selectStmt := range selectStmts {
+		if _, ok := selectInExists[selectStmt]; ok {
+			continue
+		}
 		// 检查 SELECT 子句是否为空
 		if selectStmt.Fields == nil || len(selectStmt.Fields.Fields) == 0 {
 			continue // 如果 SELECT 子句为空，则继续下一个
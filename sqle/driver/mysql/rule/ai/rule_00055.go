@@ -1,6 +1,9 @@
 package ai
 
 import (
+	"fmt"
+	"strings"
+
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
@@ -43,9 +46,11 @@ func init() {
 ==== Prompt start ====
 In MySQL, you should check if the SQL violate the rule(SQLE00055): "For table creation and index creation statements, creating redundant indexes is prohibited .".
 You should follow the following logic:
-1. For the "CREATE TABLE ..." statements, builds a list of index columns, which is used to record all the declared indexes and their columns, checking whether the columns of each index are redundant, meaning that the index columns are exactly the same, or have the same leftmost prefix.  If it does, report a violation.
-2. For the  "CREATE INDEX ..." statements, builds a list of index columns to keep track of the existing indexes and their columns, and check that the new index is not redundant, meaning that the index field is the same as the old one or has the same leftmost prefix. If it does, report a violation.
-3. For the  "ALTER TABLE ... ADD INDEX ..." statements, perform the same check as above.
+1. For the "CREATE TABLE ..." statements, builds a list of indexes, which is used to record all the declared indexes with their names and columns, checking whether each index is redundant, meaning that the index columns are exactly the same as another index, or are the leftmost prefix of another index.  If it does, report a violation with the names of both indexes.
+2. For the  "CREATE INDEX ..." statements, builds a list of indexes to keep track of the existing indexes of the table, and check that the new index is not redundant, meaning that the index columns are the same as an existing one or are the leftmost prefix of an existing one. If it does, report a violation with the names of both indexes.
+3. For the  "ALTER TABLE ... ADD INDEX ..." statements, perform the same check as above, the new indexes are also checked against each other.
+4. A unique index or primary key enforces a constraint, so it is only redundant when another unique index or primary key has exactly the same columns. The primary key is never reported as redundant.
+5. The fulltext index, spatial index and the index on expression are not checked.
 ==== Prompt end ====
 */
 
@@ -54,21 +59,11 @@ func RuleSQLE00055(input *rulepkg.RuleHandlerInput) error {
 	switch stmt := input.Node.(type) {
 	case *ast.CreateTableStmt:
 		// "create table..."
-		indexes := [][]string{}
-
-		// get index column in column definition
-		for _, col := range stmt.Cols {
-			if util.IsColumnHasOption(col, ast.ColumnOptionUniqKey) || util.IsColumnPrimaryKey(col) {
-				indexes = append(indexes, []string{util.GetColumnName(col)})
-			}
-		}
-
-		// get index column in table constraint
-		indexes = append(indexes, extractIndexesFromConstraints(util.GetTableConstraints(stmt.Constraints, util.GetIndexConstraintTypes()...))...)
+		indexes := extractIndexesFromCreateTableStmt(stmt)
 
-		// check the index is not duplicated
-		for redundant, source := range calculateIndexRedundant(indexes) {
-			rulepkg.AddResult(input.Res, input.Rule, SQLE00055, indexes[source], indexes[redundant])
+		// check the indexes are not redundant
+		for _, redundancy := range calculateIndexRedundant(indexes, 0) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00055, redundancy.source, redundancy.redundant)
 		}
 
 	case *ast.CreateIndexStmt:
@@ -79,144 +74,175 @@ func RuleSQLE00055(input *rulepkg.RuleHandlerInput) error {
 			return err
 		}
 
-		indexes := extractIndexesFromConstraints(util.GetTableConstraints(createTableStmt.Constraints, util.GetIndexConstraintTypes()...))
-		newIndex := extractIndexesFromIndexStmt(stmt.IndexPartSpecifications)
+		indexes := extractIndexesFromCreateTableStmt(createTableStmt)
+		existingIndexCount := len(indexes)
+		if stmt.KeyType == ast.IndexKeyTypeNone || stmt.KeyType == ast.IndexKeyTypeUnique {
+			if index, ok := newRedundantCheckIndex(stmt.IndexName, stmt.IndexPartSpecifications, stmt.KeyType == ast.IndexKeyTypeUnique, false); ok {
+				indexes = append(indexes, index)
+			}
+		}
 
-		// check the index is not duplicated
-		for redundant, source := range calculateNewIndexRedundant(indexes, newIndex) {
-			rulepkg.AddResult(input.Res, input.Rule, SQLE00055, indexes[source], newIndex[redundant])
+		// check the new index is not redundant
+		for _, redundancy := range calculateIndexRedundant(indexes, existingIndexCount) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00055, redundancy.source, redundancy.redundant)
 		}
 
 	case *ast.AlterTableStmt:
 		// "alter table"
+		specs := util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint)
+		if len(specs) == 0 {
+			return nil
+		}
 
 		createTableStmt, err := util.GetCreateTableStmt(input.Ctx, stmt.Table)
 		if nil != err {
 			return err
 		}
 
-		indexes := extractIndexesFromConstraints(util.GetTableConstraints(createTableStmt.Constraints, util.GetIndexConstraintTypes()...))
-
-		newIndex := [][]string{}
-		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint) {
+		indexes := extractIndexesFromCreateTableStmt(createTableStmt)
+		existingIndexCount := len(indexes)
+		for _, spec := range specs {
 			// "alter table... add index..."
-			indexCols := extractIndexesFromConstraints(util.GetTableConstraints([]*ast.Constraint{spec.Constraint}, util.GetIndexConstraintTypes()...))
-			newIndex = append(newIndex, indexCols...)
-
+			indexes = append(indexes, extractIndexesFromConstraints([]*ast.Constraint{spec.Constraint})...)
 		}
-		// check the index is not duplicated
-		for redundant, source := range calculateNewIndexRedundant(indexes, newIndex) {
-			rulepkg.AddResult(input.Res, input.Rule, SQLE00055, indexes[source], newIndex[redundant])
+
+		// check the new indexes are not redundant
+		for _, redundancy := range calculateIndexRedundant(indexes, existingIndexCount) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00055, redundancy.source, redundancy.redundant)
 		}
 	}
 
 	return nil
 }
 
-func extractIndexesFromConstraints(constraints []*ast.Constraint) [][]string {
-	indexes := [][]string{}
+// redundantCheckIndex is an index checked by SQLE00055.
+type redundantCheckIndex struct {
+	name      string
+	columns   []string
+	isUnique  bool
+	isPrimary bool
+}
 
-	// Iterate over all constraints to extract index columns.
-	for _, constraint := range constraints {
-		indexCols := []string{}
+// String returns the name and columns of the index, e.g. "idx_1(v1,v2)".
+func (i redundantCheckIndex) String() string {
+	return fmt.Sprintf("%v(%v)", i.name, strings.Join(i.columns, ","))
+}
 
-		// Collect all column names that are part of the index represented by the constraint.
-		for _, key := range constraint.Keys {
-			colName := util.GetIndexColName(key)
-			if colName != "" {
-				indexCols = append(indexCols, colName)
-			}
+// newRedundantCheckIndex returns false if the index contains an expression, which is not checked.
+func newRedundantCheckIndex(name string, keys []*ast.IndexPartSpecification, isUnique, isPrimary bool) (redundantCheckIndex, bool) {
+	index := redundantCheckIndex{name: name, isUnique: isUnique || isPrimary, isPrimary: isPrimary}
+	for _, key := range keys {
+		if key.Column == nil {
+			return redundantCheckIndex{}, false
 		}
+		index.columns = append(index.columns, util.GetIndexColName(key))
+	}
+	if len(index.columns) == 0 {
+		return redundantCheckIndex{}, false
+	}
+	if isPrimary {
+		index.name = "PRIMARY"
+	} else if index.name == "" {
+		// MySQL names the index without name after its first column
+		index.name = index.columns[0]
+	}
+	return index, true
+}
+
+func extractIndexesFromCreateTableStmt(stmt *ast.CreateTableStmt) []redundantCheckIndex {
+	indexes := []redundantCheckIndex{}
 
-		// Only append to indexes if indexCols is not empty, avoiding adding empty index definitions.
-		if len(indexCols) > 0 {
-			indexes = append(indexes, indexCols)
+	// get index column in column definition
+	for _, col := range stmt.Cols {
+		keys := []*ast.IndexPartSpecification{{Column: col.Name}}
+		if util.IsColumnPrimaryKey(col) {
+			if index, ok := newRedundantCheckIndex("", keys, true, true); ok {
+				indexes = append(indexes, index)
+			}
+		} else if util.IsColumnHasOption(col, ast.ColumnOptionUniqKey) {
+			if index, ok := newRedundantCheckIndex("", keys, true, false); ok {
+				indexes = append(indexes, index)
+			}
 		}
 	}
 
-	return indexes
+	// get index column in table constraint
+	return append(indexes, extractIndexesFromConstraints(stmt.Constraints)...)
 }
 
-func extractIndexesFromIndexStmt(index []*ast.IndexPartSpecification) [][]string {
-	// Initialize the slice that will hold the column names for the index.
-	var indexCols []string
+func extractIndexesFromConstraints(constraints []*ast.Constraint) []redundantCheckIndex {
+	indexes := []redundantCheckIndex{}
 
-	// Iterate over each IndexPartSpecification to extract the column names.
-	for _, col := range index {
-		if colName := util.GetIndexColName(col); colName != "" {
-			indexCols = append(indexCols, colName)
+	// The fulltext and spatial indexes are not comparable with the btree indexes, so they are skipped.
+	for _, constraint := range util.GetTableConstraints(constraints,
+		ast.ConstraintIndex, ast.ConstraintKey, ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey, ast.ConstraintPrimaryKey) {
+		isPrimary := constraint.Tp == ast.ConstraintPrimaryKey
+		isUnique := constraint.Tp == ast.ConstraintUniq || constraint.Tp == ast.ConstraintUniqIndex || constraint.Tp == ast.ConstraintUniqKey
+		if index, ok := newRedundantCheckIndex(constraint.Name, constraint.Keys, isUnique, isPrimary); ok {
+			indexes = append(indexes, index)
 		}
 	}
 
-	// Return the indexes as a slice of a single index if indexCols is not empty.
-	if len(indexCols) > 0 {
-		return [][]string{indexCols}
-	}
-	return [][]string{} // Return an empty slice of indexes if no index columns were found.
+	return indexes
+}
+
+// indexRedundancy is a redundant index and the index which makes it redundant.
+type indexRedundancy struct {
+	source    redundantCheckIndex
+	redundant redundantCheckIndex
 }
 
-// calculateIndexRedundant takes a slice of string slices representing indexes and their columns
-// and returns a map where the key is the redundant index and the value is the corresponding
-// original index that makes it redundant.
-func calculateIndexRedundant(indexes [][]string) map[int]int {
-	redundantIndexes := make(map[int]int)
+// calculateIndexRedundant checks the indexes from indexes[from:] against all the indexes,
+// and returns the redundant ones in the order of definition. If an index is redundant with
+// respect to several indexes, the one with the fewest columns is taken as its source.
+func calculateIndexRedundant(indexes []redundantCheckIndex, from int) []indexRedundancy {
+	redundancies := []indexRedundancy{}
 
-	// Compare each index with every other index to check for redundancy.
-	for i, indexColumns := range indexes {
-		for j, otherIndexColumns := range indexes {
+	for i := from; i < len(indexes); i++ {
+		source := -1
+		for j := range indexes {
 			// Skip comparing the same index.
-			if i == j {
+			if i == j || !isRedundant(indexes[i], indexes[j], j < i) {
 				continue
 			}
-
-			// Check if indexColumns are redundant with respect to otherIndexColumns.
-			if isRedundant(indexColumns, otherIndexColumns) {
-				// If index i is redundant and either it's not in the map yet, or it's in the map
-				// but the current source index j has fewer columns (and thus is a 'stronger' source of redundancy),
-				// then add/update the map with the index pair (i, j).
-				if _, exists := redundantIndexes[i]; !exists || len(indexes[redundantIndexes[i]]) > len(otherIndexColumns) {
-					redundantIndexes[i] = j
-				}
+			if source == -1 || len(indexes[j].columns) < len(indexes[source].columns) {
+				source = j
 			}
 		}
-	}
-
-	return redundantIndexes
-}
-
-// calculateNewIndexRedundant takes a slice of string slices representing existing indexes and their columns
-// and a slice of string slices representing new indexes' columns. It returns a map where the key is the index
-// of the new index in `newIndexes` and the value is the index of the existing index in `indexes` that makes it redundant.
-func calculateNewIndexRedundant(indexes [][]string, newIndexes [][]string) map[int]int {
-	redundantIndexes := make(map[int]int)
-
-	// Iterate over each new index
-	for newIndexI, newIndexCols := range newIndexes {
-		// Compare against each existing index
-		for existingIndexI, existingIndexCols := range indexes {
-			if isRedundant(newIndexCols, existingIndexCols) {
-				// If the new index is redundant with respect to an existing index, add to the map.
-				redundantIndexes[newIndexI] = existingIndexI
-				// Since we only care about the first occurrence of redundancy, we can break here.
-				break
-			}
+		if source != -1 {
+			redundancies = append(redundancies, indexRedundancy{source: indexes[source], redundant: indexes[i]})
 		}
 	}
 
-	return redundantIndexes
+	return redundancies
 }
 
-// isRedundant checks if the first index is redundant with respect to the second index,
-// meaning all columns in the first index are a leftmost prefix of the second.
-func isRedundant(index1, index2 []string) bool {
-	for i, col := range index1 {
-		// If we reach the end of index2 or the columns differ,
-		// then index1 cannot be a leftmost prefix of index2.
-		if i >= len(index2) || col != index2[i] {
+// isRedundant checks if the first index is redundant with respect to the second index, meaning all
+// columns in the first index are a leftmost prefix of the second. For the indexes with the same
+// columns, only one of them is redundant, the non-unique one or the one defined later.
+func isRedundant(index1, index2 redundantCheckIndex, index2DefinedFirst bool) bool {
+	if len(index1.columns) > len(index2.columns) {
+		return false
+	}
+	for i, col := range index1.columns {
+		// If the columns differ, then index1 cannot be a leftmost prefix of index2.
+		if !strings.EqualFold(col, index2.columns[i]) {
 			return false
 		}
 	}
-	return true
+	sameColumns := len(index1.columns) == len(index2.columns)
+
+	switch {
+	case index1.isPrimary:
+		return false
+	case index1.isUnique:
+		// the unique constraint is only covered by another unique index with the same columns
+		return sameColumns && (index2.isPrimary || (index2.isUnique && index2DefinedFirst))
+	case sameColumns && !index2.isUnique:
+		return index2DefinedFirst
+	default:
+		return true
+	}
 }
 
 // ==== Rule code end ====
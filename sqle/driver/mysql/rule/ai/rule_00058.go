@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	mysqlUtil "github.com/actiontech/sqle/sqle/driver/mysql/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
 	"github.com/pingcap/parser/ast"
 
@@ -68,7 +69,8 @@ func RuleSQLE00058(input *rulepkg.RuleHandlerInput) error {
 	case *ast.AlterTableStmt:
 		// 检查 ALTER TABLE 语句中的分区修改
 		for _, spec := range stmt.Specs {
-			if len(spec.PartitionNames) > 0 || len(spec.PartDefinitions) > 0 || spec.Partition != nil {
+			// 按语句类型识别分区定义和分区维护操作，如 COALESCE PARTITION、TRUNCATE PARTITION ALL 等
+			if spec.Tp == ast.AlterTablePartition || mysqlUtil.IsPartitionMaintenanceSpec(spec) {
 				rulepkg.AddResult(input.Res, input.Rule, SQLE00058)
 				break
 			}
@@ -69,19 +69,17 @@ func init() {
      - 如果存在，进入步骤4。
 
 4、检查目标索引名是否遵从固定格式。
-   - 如果不遵从，报告违反规则。
+   - 如果不遵从，报告违反规则，并给出符合格式的建议索引名。
 ==== Prompt end ====
 */
 
 // ==== Rule code start ====
 // 规则函数实现开始
 func RuleSQLE00063(input *rulepkg.RuleHandlerInput) error {
-	// 内部匿名的辅助函数
-	isIndexNameViolate := func(indexName string, tableName string, cols []string) bool {
-		if !strings.EqualFold(indexName, fmt.Sprintf("IDX_UK_%v_%v", tableName, strings.Join(cols, "_"))) {
-			return true
-		}
-		return false
+	// 内部匿名的辅助函数，索引名不符合格式时，返回建议的索引名
+	checkIndexName := func(indexName string, tableName string, cols []string) (suggestedName string, violate bool) {
+		suggestedName = fmt.Sprintf("IDX_UK_%v_%v", tableName, strings.Join(cols, "_"))
+		return suggestedName, !strings.EqualFold(indexName, suggestedName)
 	}
 
 	switch stmt := input.Node.(type) {
@@ -94,12 +92,8 @@ func RuleSQLE00063(input *rulepkg.RuleHandlerInput) error {
 			if spec.Tp == ast.AlterTableAddConstraint {
 				if spec.Constraint != nil && spec.Constraint.Tp == ast.ConstraintUniq {
 					indexName := spec.Constraint.Name
-					var indexedCols []string
-					for _, key := range spec.Constraint.Keys {
-						indexedCols = append(indexedCols, key.Column.Name.String())
-					}
-					if isIndexNameViolate(indexName, tableName, indexedCols) {
-						rulepkg.AddResult(input.Res, input.Rule, SQLE00063)
+					if suggestedName, violate := checkIndexName(indexName, tableName, getIndexColumnNames(spec.Constraint.Keys)); violate {
+						rulepkg.AddResult(input.Res, input.Rule, SQLE00063, indexName, suggestedName)
 						return nil
 					}
 				}
@@ -116,13 +110,9 @@ func RuleSQLE00063(input *rulepkg.RuleHandlerInput) error {
 				oldIdxname := spec.FromKey.String()
 				newIdxname := spec.ToKey.String()
 				for _, constraint := range constraintUniqs {
-					if strings.EqualFold(oldIdxname, oldIdxname) {
-						var indexedCols []string
-						for _, key := range constraint.Keys {
-							indexedCols = append(indexedCols, key.Column.Name.String())
-						}
-						if isIndexNameViolate(newIdxname, tableName, indexedCols) {
-							rulepkg.AddResult(input.Res, input.Rule, SQLE00063)
+					if strings.EqualFold(constraint.Name, oldIdxname) {
+						if suggestedName, violate := checkIndexName(newIdxname, tableName, getIndexColumnNames(constraint.Keys)); violate {
+							rulepkg.AddResult(input.Res, input.Rule, SQLE00063, newIdxname, suggestedName)
 							return nil
 						}
 					}
@@ -135,12 +125,8 @@ func RuleSQLE00063(input *rulepkg.RuleHandlerInput) error {
 		if stmt.KeyType == ast.IndexKeyTypeUnique {
 			tableName := stmt.Table.Name.String()
 			indexName := stmt.IndexName
-			var indexedCols []string
-			for _, key := range stmt.IndexPartSpecifications {
-				indexedCols = append(indexedCols, key.Column.Name.String())
-			}
-			if isIndexNameViolate(indexName, tableName, indexedCols) {
-				rulepkg.AddResult(input.Res, input.Rule, SQLE00063)
+			if suggestedName, violate := checkIndexName(indexName, tableName, getIndexColumnNames(stmt.IndexPartSpecifications)); violate {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00063, indexName, suggestedName)
 				return nil
 			}
 		}
@@ -151,12 +137,8 @@ func RuleSQLE00063(input *rulepkg.RuleHandlerInput) error {
 		constraints := util.GetTableConstraints(stmt.Constraints, ast.ConstraintUniq)
 		for _, constraint := range constraints {
 			indexName := constraint.Name
-			var indexedCols []string
-			for _, key := range constraint.Keys {
-				indexedCols = append(indexedCols, key.Column.Name.String())
-			}
-			if isIndexNameViolate(indexName, tableName, indexedCols) {
-				rulepkg.AddResult(input.Res, input.Rule, SQLE00063)
+			if suggestedName, violate := checkIndexName(indexName, tableName, getIndexColumnNames(constraint.Keys)); violate {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00063, indexName, suggestedName)
 				return nil
 			}
 		}
@@ -164,5 +146,16 @@ func RuleSQLE00063(input *rulepkg.RuleHandlerInput) error {
 	return nil
 }
 
+// getIndexColumnNames 获取索引的字段名，表达式索引的表达式不包含在内
+func getIndexColumnNames(keys []*ast.IndexPartSpecification) []string {
+	var indexedCols []string
+	for _, key := range keys {
+		if key.Column != nil {
+			indexedCols = append(indexedCols, key.Column.Name.String())
+		}
+	}
+	return indexedCols
+}
+
 // 规则函数实现结束
 // ==== Rule code end ====
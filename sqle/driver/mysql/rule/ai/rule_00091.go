@@ -1,10 +1,12 @@
 package ai
 
 import (
+	"regexp"
+
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
-	"github.com/actiontech/sqle/sqle/driver/mysql/session"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
 	"github.com/pingcap/parser/ast"
 	"github.com/pingcap/parser/opcode"
 
@@ -29,8 +31,14 @@ func init() {
 				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
 				plocale.RuleCategoryAuditPerformanceCost.ID: {},
 			},
-			Level:        driverV2.RuleLevelError,
-			Params:       []*rulepkg.SourceParam{},
+			Level: driverV2.RuleLevelError,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "true",
+				Desc:  plocale.Rule00091Params1,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
 			Knowledge:    driverV2.RuleKnowledge{},
 			AllowOffline: true,
 			Version:      2,
@@ -43,139 +51,137 @@ func init() {
 
 /*
 ==== Prompt start ====
-在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00091): "在 MySQL 中，建议表连接时有连接条件."
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00091): "在 MySQL 中，建议表连接时有连接条件.默认参数描述: 允许显式的CROSS JOIN, 默认参数值: true"
 您应遵循以下逻辑：
-1. 对于所有DML语句中的SELECT子句，识别所有JOIN操作（包括隐式和显式）。
-   1. 检查每个JOIN操作的ON子句或WHERE条件节点中是否存在连接条件（例如：table1.column1 =、>、<、!=、<> table2.column1）。
-   2. 如果任何JOIN操作缺少连接条件，则报告违反规则。
-
-2. 对于UNION语句，检查其中所有SELECT子句，遵循DML语句的相同规则。
-
-3. 对于WITH语句，检查其中所有SELECT子句，遵循DML语句的相同规则。
-
-4. 对于INSERT...SELECT语句，识别SELECT子句中的所有JOIN操作（包括隐式和显式）。
-   1. 检查每个JOIN操作的ON子句或WHERE条件节点中是否存在连接条件。
-   2. 如果任何JOIN操作缺少连接条件，则报告违反规则。
+1. 对于所有DML语句中的SELECT子句（包括子查询、UNION语句中的SELECT子句、INSERT...SELECT语句中的SELECT子句），以及UPDATE、DELETE语句，递归遍历FROM子句的JOIN树，对每个连接两侧表的JOIN节点（包括隐式和显式）：
+   1. 如果使用了USING子句或NATURAL JOIN，则存在连接条件。
+   2. 检查ON子句或WHERE条件中是否存在比较运算（=、>、<、!=、<>、>=、<=、<=>），其一侧的字段属于JOIN左侧的表，另一侧的字段属于JOIN右侧的表，未指定表名的字段视为可能属于任意一侧的表。
+   3. 如果缺少连接条件，并且不是使用 CROSS JOIN 关键字显式声明的笛卡尔积，则报告违反规则。
+   4. 如果规则参数不允许显式的CROSS JOIN，则使用 CROSS JOIN 关键字且缺少连接条件时同样报告违反规则。
 
-5. 对于UPDATE语句，识别所有JOIN操作（包括隐式和显式）。
-   1. 检查每个JOIN操作的ON子句或WHERE条件节点中是否存在连接条件。
-   2. 如果任何JOIN操作缺少连接条件，则报告违反规则。
-
-6. 对于DELETE语句，执行与UPDATE语句相同的检查。
+2. 对于WITH语句，解析器暂时不支持，不做检查。
 ==== Prompt end ====
 */
 
 // ==== Rule code start ====
 func RuleSQLE00091(input *rulepkg.RuleHandlerInput) error {
-	// 内部函数: 检查dml语句的JOIN
-	checkJoin := func(stmt ast.Node) bool {
-		getWhereExpr := func(node ast.Node) (where ast.ExprNode) {
-			switch stmt := node.(type) {
-			case *ast.SelectStmt:
-				if stmt.From == nil { //If from is null skip check. EX: select 1;select version
-					return nil
-				}
-				where = stmt.Where
-			case *ast.UpdateStmt:
-				where = stmt.Where
-			case *ast.DeleteStmt:
-				where = stmt.Where
-
+	if _, ok := input.Node.(ast.DMLNode); !ok {
+		return nil
+	}
+	allowCrossJoin := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Bool()
+	text := input.Node.Text()
+
+	// 内部函数: 获取JOIN节点子树中引用的表名或别名
+	var getTableNames func(node ast.ResultSetNode, names map[string]struct{})
+	getTableNames = func(node ast.ResultSetNode, names map[string]struct{}) {
+		switch n := node.(type) {
+		case *ast.Join:
+			getTableNames(n.Left, names)
+			if n.Right != nil {
+				getTableNames(n.Right, names)
+			}
+		case *ast.TableSource:
+			if n.AsName.L != "" {
+				names[n.AsName.L] = struct{}{}
+			} else if tableName, ok := n.Source.(*ast.TableName); ok {
+				names[tableName.Name.L] = struct{}{}
 			}
-			return
 		}
+	}
 
-		doesNotJoinTables := func(tableRefs *ast.Join) bool {
-			return tableRefs == nil || tableRefs.Left == nil || tableRefs.Right == nil
+	// 内部函数: 检查条件中是否存在连接左右两侧表的比较运算
+	checkJoinCondition := func(expr ast.ExprNode, left, right map[string]struct{}) (hasCondition bool) {
+		inSide := func(col *ast.ColumnNameExpr, side map[string]struct{}) bool {
+			// 未指定表名的字段，可能属于任意一侧的表
+			if col.Name.Table.L == "" {
+				return true
+			}
+			_, ok := side[col.Name.Table.L]
+			return ok
 		}
-
-		checkJoinConditionByOn := func(expr ast.ExprNode) (hasCondition bool) {
-			util.ScanWhereStmt(func(node ast.ExprNode) bool {
-				binExpr, ok := node.(*ast.BinaryOperationExpr)
-				if !ok {
-					return false
-				}
-
-				// 检查操作符是否为连接条件相关的运算符
-				if binExpr.Op != opcode.EQ && binExpr.Op != opcode.GT &&
-					binExpr.Op != opcode.LT && binExpr.Op != opcode.NE &&
-					binExpr.Op != opcode.GE && binExpr.Op != opcode.LE {
-					return false
+		isLinked := func(cols1, cols2 []*ast.ColumnNameExpr) bool {
+			for _, col1 := range cols1 {
+				for _, col2 := range cols2 {
+					if inSide(col1, left) && inSide(col2, right) {
+						return true
+					}
 				}
-
-				// 获取左右操作数的列名
-				leftCols := util.GetColumnNameInExpr(binExpr.L)
-				rightCols := util.GetColumnNameInExpr(binExpr.R)
-
-				if len(leftCols) == 0 || len(rightCols) == 0 {
-					return false
-				}
-
-				leftTable := leftCols[0].Name.Table.L
-				rightTable := rightCols[0].Name.Table.L
-
-				// 确保比较的列来自不同的表
-				if leftTable != "" && rightTable != "" && leftTable != rightTable {
-					hasCondition = true
-					return true // 找到一个连接条件，停止扫描
-				}
-				return false
-			}, expr)
-			return hasCondition
+			}
+			return false
 		}
-
-		var checkJoinConditionInJoinNode func(ctx *session.Context, whereStmt ast.ExprNode, joinNode *ast.Join) (joinTables, hasCondition bool)
-		checkJoinConditionInJoinNode = func(ctx *session.Context, whereStmt ast.ExprNode, joinNode *ast.Join) (joinTables, hasCondition bool) {
-			if joinNode == nil {
-				return false, false
+		util.ScanWhereStmt(func(node ast.ExprNode) bool {
+			if hasCondition {
+				return true
 			}
-			if doesNotJoinTables(joinNode) {
-				// 非JOIN两表的JOIN节点 一般是叶子节点 不检查
-				return false, false
+			binExpr, ok := node.(*ast.BinaryOperationExpr)
+			if !ok {
+				return false
 			}
-
-			// 深度遍历左子树类型为ast.Join的节点 一旦有节点是JOIN两表的节点，并且没有连接条件，则返回
-			if l, ok := joinNode.Left.(*ast.Join); ok {
-				joinTables, hasCondition = checkJoinConditionInJoinNode(ctx, whereStmt, l) // 递归检测
-				if joinTables && !hasCondition {
-					return joinTables, hasCondition
-				}
+			switch binExpr.Op {
+			case opcode.EQ, opcode.GT, opcode.LT, opcode.NE, opcode.GE, opcode.LE, opcode.NullEQ:
+			default:
+				return false
+			}
+			leftCols := util.GetColumnNameInExpr(binExpr.L)
+			rightCols := util.GetColumnNameInExpr(binExpr.R)
+			if isLinked(leftCols, rightCols) || isLinked(rightCols, leftCols) {
+				hasCondition = true
+				return true
 			}
+			return false
+		}, expr)
+		return hasCondition
+	}
 
-			// 判断
-			if joinNode.Using != nil {
-				return true, true
-			} else if joinNode.On == nil || !checkJoinConditionByOn(joinNode.On.Expr) {
-				if (whereStmt == nil) || !checkJoinConditionByOn(whereStmt) {
-					return true, false
-				} else {
-					return true, true
-				}
-			} else {
-				return true, true
+	// 内部函数: 检查JOIN节点是否使用 CROSS JOIN 关键字显式声明，解析器不区分 CROSS JOIN、JOIN 和逗号，因此根据SQL文本判断
+	isExplicitCrossJoin := func(join *ast.Join) bool {
+		target := `\(`
+		if tableSource, ok := join.Right.(*ast.TableSource); ok {
+			if tableName, ok := tableSource.Source.(*ast.TableName); ok {
+				target = "(?:`?[^`\\s.]+`?\\s*\\.\\s*)?`?" + regexp.QuoteMeta(tableName.Name.O) + "`?(?:[^\\w$]|$)"
 			}
 		}
+		return regexp.MustCompile(`(?i)\bCROSS\s+JOIN\s+` + target).MatchString(text)
+	}
 
-		joinNode := util.GetFirstJoinNodeFromStmt(stmt)
-		whereStmt := getWhereExpr(stmt)
-		if joinNode == nil {
+	// 内部函数: 递归检查JOIN树中每个连接两侧表的JOIN节点
+	var checkJoin func(node ast.ResultSetNode, where ast.ExprNode) (violated bool)
+	checkJoin = func(node ast.ResultSetNode, where ast.ExprNode) (violated bool) {
+		join, ok := node.(*ast.Join)
+		if !ok || join == nil {
+			return false
+		}
+		if checkJoin(join.Left, where) {
 			return true
 		}
-		joinTables, hasCondition := checkJoinConditionInJoinNode(input.Ctx, whereStmt, joinNode)
-		if joinTables && !hasCondition {
+		if join.Right == nil {
+			// 非JOIN两表的JOIN节点
+			return false
+		}
+		if checkJoin(join.Right, where) {
 			return true
 		}
-		return false
-	}
-
-	if _, ok := input.Node.(ast.DMLNode); !ok {
-		return nil
+		if join.NaturalJoin || len(join.Using) > 0 {
+			return false
+		}
+		left, right := make(map[string]struct{}), make(map[string]struct{})
+		getTableNames(join.Left, left)
+		getTableNames(join.Right, right)
+		if join.On != nil && checkJoinCondition(join.On.Expr, left, right) {
+			return false
+		}
+		if where != nil && checkJoinCondition(where, left, right) {
+			return false
+		}
+		return !allowCrossJoin || join.Tp != ast.CrossJoin || !isExplicitCrossJoin(join)
 	}
 
-	// dml中所有的select语句
-	selectStmts := util.GetSelectStmt(input.Node)
-	for _, selectStmt := range selectStmts {
-		if checkJoin(selectStmt) {
+	// dml中所有的select语句，包括子查询和UNION语句中的select语句
+	for _, selectStmt := range util.GetSelectStmt(input.Node) {
+		if selectStmt.From == nil { //If from is null skip check. EX: select 1;select version
+			continue
+		}
+		if checkJoin(selectStmt.From.TableRefs, selectStmt.Where) {
 			rulepkg.AddResult(input.Res, input.Rule, SQLE00091)
 			return nil
 		}
@@ -183,17 +189,16 @@ func RuleSQLE00091(input *rulepkg.RuleHandlerInput) error {
 
 	// 特殊处理：update join, delete join
 	switch stmt := input.Node.(type) {
-	case *ast.UpdateStmt, *ast.DeleteStmt:
-		if checkJoin(stmt) {
+	case *ast.UpdateStmt:
+		if stmt.TableRefs != nil && checkJoin(stmt.TableRefs.TableRefs, stmt.Where) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00091)
+		}
+	case *ast.DeleteStmt:
+		if stmt.TableRefs != nil && checkJoin(stmt.TableRefs.TableRefs, stmt.Where) {
 			rulepkg.AddResult(input.Res, input.Rule, SQLE00091)
-			return nil
 		}
-
-	// TODO 针对WITH语句（CTE），解析器暂时不支持
-
-	default:
-		return nil
 	}
+	// TODO 针对WITH语句（CTE），解析器暂时不支持
 
 	return nil
 }
@@ -3,6 +3,7 @@ package ai
 import (
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	mysqlUtil "github.com/actiontech/sqle/sqle/driver/mysql/util"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
 	"github.com/pingcap/parser/ast"
 
@@ -53,6 +54,8 @@ func init() {
 3. 如果任何SQL语句中存在未指定库名的表、视图、存储过程、触发器、函数、事件或索引，标记为违反规则。
 
 4. 对于UNION语句，递归检查所有SELECT子句，确保与独立的SELECT语句一致。
+
+5. 对于CREATE EVENT、ALTER EVENT语句，使用辅助函数 util.ParseEventStmt 解析事件，检查事件名（包括RENAME TO的新事件名）是否指定了库名，并检查事件体中的语句。
 ==== Prompt end ====
 */
 
@@ -141,6 +144,23 @@ func RuleSQLE00140(input *rulepkg.RuleHandlerInput) error {
 			}
 		}
 
+	// CREATE EVENT, ALTER EVENT
+	case *ast.UnparsedStmt:
+		event, ok := mysqlUtil.ParseEventStmt(stmt.Text())
+		if !ok {
+			return nil
+		}
+		if !hasSchema(event.Schema) || (event.NewName != "" && !hasSchema(event.NewSchema)) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00140)
+			return nil
+		}
+		for _, bodyStmt := range event.BodyStmts {
+			if anyObjectMissingSchema(util.GetTableNames(bodyStmt)) {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00140)
+				return nil
+			}
+		}
+
 	// TODO: Stored Procedure Calls
 
 	// Other DDL Statements: CREATE INDEX, ALTER INDEX, etc.
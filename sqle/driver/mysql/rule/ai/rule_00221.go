@@ -0,0 +1,145 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00221 = "SQLE00221"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00221,
+			Desc:       plocale.Rule00221Desc,
+			Annotation: plocale.Rule00221Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID, plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "utf8mb4:utf8mb4_general_ci",
+				Desc:  plocale.Rule00221Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00221Message,
+		Func:    RuleSQLE00221,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00221): "在 MySQL 中，建表需显式指定字符集和排序规则.默认参数描述: 允许的字符集和排序规则组合, 默认参数值: utf8mb4:utf8mb4_general_ci"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，
+   1. 使用辅助函数util.GetTableOption获取表的 CHARACTER SET 和 COLLATE 节点，若任一不存在，则报告违反规则。
+   2. 若字符集和排序规则的组合不在参数允许的组合中，则报告违反规则。
+   3. 若字段指定的字符集或排序规则与表的不一致，则报告违反规则。
+2. 对于 "ALTER TABLE ... CONVERT TO ..." 语句，
+   1. 若未指定 COLLATE，则报告违反规则。
+   2. 若字符集和排序规则的组合不在参数允许的组合中，则报告违反规则。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00221(input *rulepkg.RuleHandlerInput) error {
+	param := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName)
+	if param == nil {
+		return fmt.Errorf("param %s not found", rulepkg.DefaultSingleParamKeyName)
+	}
+	allowedPairs := parseCharsetCollationPairs(param.String())
+	isAllowed := func(charset, collation string) bool {
+		_, ok := allowedPairs[strings.ToLower(charset)+":"+strings.ToLower(collation)]
+		return ok
+	}
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		// CREATE TABLE ... LIKE 沿用原表的定义
+		if stmt.ReferTable != nil {
+			return nil
+		}
+		charsetOption := util.GetTableOption(stmt.Options, ast.TableOptionCharset)
+		collateOption := util.GetTableOption(stmt.Options, ast.TableOptionCollate)
+		if charsetOption == nil || collateOption == nil || !isAllowed(charsetOption.StrValue, collateOption.StrValue) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00221, param.String())
+			return nil
+		}
+		// 检查字段是否指定了与表不同的字符集和排序规则
+		for _, col := range stmt.Cols {
+			if col.Tp == nil {
+				continue
+			}
+			collations := []string{col.Tp.Collate}
+			for _, option := range col.Options {
+				if option.Tp == ast.ColumnOptionCollate {
+					collations = append(collations, option.StrValue)
+				}
+			}
+			if col.Tp.Charset != "" && !strings.EqualFold(col.Tp.Charset, charsetOption.StrValue) {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00221, param.String())
+				return nil
+			}
+			for _, collation := range collations {
+				if collation != "" && !strings.EqualFold(collation, collateOption.StrValue) {
+					rulepkg.AddResult(input.Res, input.Rule, SQLE00221, param.String())
+					return nil
+				}
+			}
+		}
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableOption) {
+			charsetOption := util.GetTableOption(spec.Options, ast.TableOptionCharset)
+			if charsetOption == nil || charsetOption.UintValue != ast.TableOptionCharsetWithConvertTo {
+				continue
+			}
+			collateOption := util.GetTableOption(spec.Options, ast.TableOptionCollate)
+			if collateOption == nil || !isAllowed(charsetOption.StrValue, collateOption.StrValue) {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00221, param.String())
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// parseCharsetCollationPairs 解析参数中的字符集和排序规则组合，如 "utf8mb4:utf8mb4_general_ci,utf8mb4:utf8mb4_bin"
+func parseCharsetCollationPairs(value string) map[string]struct{} {
+	pairs := map[string]struct{}{}
+	for _, pair := range strings.Split(value, ",") {
+		charsetAndCollation := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(charsetAndCollation) != 2 {
+			continue
+		}
+		charset := strings.ToLower(strings.TrimSpace(charsetAndCollation[0]))
+		collation := strings.ToLower(strings.TrimSpace(charsetAndCollation[1]))
+		pairs[charset+":"+collation] = struct{}{}
+	}
+	return pairs
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,218 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	mysqlUtil "github.com/actiontech/sqle/sqle/driver/mysql/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/parser/opcode"
+	"github.com/pingcap/tidb/types"
+	parserdriver "github.com/pingcap/tidb/types/parser_driver"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00222 = "SQLE00222"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00222,
+			Desc:       plocale.Rule00222Desc,
+			Annotation: plocale.Rule00222Annotation,
+			Category:   plocale.RuleTypeDMLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagBusiness.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDML.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: false,
+			Version:      2,
+		},
+		Message: plocale.Rule00222Message,
+		Func:    RuleSQLE00222,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00222): "在 MySQL 中，避免WHERE或JOIN条件中字符串字段与数值比较."
+您应遵循以下逻辑：
+1. 对于 SELECT、INSERT ... SELECT、UNION、UPDATE、DELETE 语句中的每个查询，获取 WHERE 条件和 JOIN ON 条件。
+2. 对于条件中的比较（=、<>、<、<=、>、>=、<=>、IN、BETWEEN），若一侧为列字段，另一侧为常量：
+   1. 使用辅助函数 util.GetTableNameFromTableSource 匹配列字段所属的表（包括表别名），使用辅助函数 GetCreateTableStmt 获取列字段类型。
+   2. 若列字段为字符串类型而常量为数值，或列字段为数值类型而常量为字符串，则报告违反规则。
+3. 被函数包裹的列字段，其比较的类型为函数的返回值类型，不做检查。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00222(input *rulepkg.RuleHandlerInput) error {
+	violateColumns := []string{}
+	addViolateColumn := func(name string) {
+		for _, column := range violateColumns {
+			if column == name {
+				return
+			}
+		}
+		violateColumns = append(violateColumns, name)
+	}
+
+	// 在表源中查找列字段的定义
+	getColumnDef := func(tableSources []*ast.TableSource, column *ast.ColumnName) *ast.ColumnDef {
+		for _, tableSource := range tableSources {
+			tableName, ok := tableSource.Source.(*ast.TableName)
+			if !ok {
+				continue
+			}
+			if column.Table.L != "" && mysqlUtil.GetTableNameFromTableSource(tableSource) != column.Table.L {
+				continue
+			}
+			createTableStmt, err := util.GetCreateTableStmt(input.Ctx, tableName)
+			if err != nil {
+				continue
+			}
+			for _, colDef := range createTableStmt.Cols {
+				if strings.EqualFold(util.GetColumnName(colDef), column.Name.O) {
+					return colDef
+				}
+			}
+		}
+		return nil
+	}
+
+	checkCompare := func(tableSources []*ast.TableSource, left, right ast.ExprNode) {
+		column, ok := left.(*ast.ColumnNameExpr)
+		if !ok {
+			column, ok = right.(*ast.ColumnNameExpr)
+			left, right = right, left
+		}
+		// 被函数包裹的列字段不做检查
+		if !ok {
+			return
+		}
+		value, ok := right.(*parserdriver.ValueExpr)
+		if !ok {
+			return
+		}
+		colDef := getColumnDef(tableSources, column.Name)
+		if colDef == nil || colDef.Tp == nil {
+			return
+		}
+		if (isStringColumnType(colDef.Tp.Tp) && isNumericValue(value)) || (isNumericColumnType(colDef.Tp.Tp) && value.Datum.Kind() == types.KindString) {
+			addViolateColumn(column.Name.Name.O)
+		}
+	}
+
+	checkCondition := func(tableSources []*ast.TableSource, conditions ...ast.ExprNode) {
+		util.ScanWhereStmt(func(expr ast.ExprNode) (skip bool) {
+			switch x := expr.(type) {
+			case *ast.BinaryOperationExpr:
+				switch x.Op {
+				case opcode.EQ, opcode.NE, opcode.LT, opcode.LE, opcode.GT, opcode.GE, opcode.NullEQ:
+					checkCompare(tableSources, x.L, x.R)
+				}
+			case *ast.PatternInExpr:
+				for _, item := range x.List {
+					checkCompare(tableSources, x.Expr, item)
+				}
+			case *ast.BetweenExpr:
+				checkCompare(tableSources, x.Expr, x.Left)
+				checkCompare(tableSources, x.Expr, x.Right)
+			}
+			return false
+		}, conditions...)
+	}
+
+	checkJoin := func(join *ast.Join, where ast.ExprNode) {
+		if join == nil {
+			return
+		}
+		tableSources := util.GetTableSourcesFromJoin(join)
+		conditions := []ast.ExprNode{where}
+		for _, j := range getJoinsFromJoin(join) {
+			if j.On != nil {
+				conditions = append(conditions, j.On.Expr)
+			}
+		}
+		checkCondition(tableSources, conditions...)
+	}
+
+	switch stmt := input.Node.(type) {
+	case *ast.SelectStmt, *ast.UnionStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		for _, selectStmt := range util.GetSelectStmt(stmt) {
+			if selectStmt.From != nil {
+				checkJoin(selectStmt.From.TableRefs, selectStmt.Where)
+			}
+		}
+	}
+	switch stmt := input.Node.(type) {
+	case *ast.UpdateStmt:
+		if stmt.TableRefs != nil {
+			checkJoin(stmt.TableRefs.TableRefs, stmt.Where)
+		}
+	case *ast.DeleteStmt:
+		if stmt.TableRefs != nil {
+			checkJoin(stmt.TableRefs.TableRefs, stmt.Where)
+		}
+	}
+
+	if len(violateColumns) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00222, strings.Join(violateColumns, ","))
+	}
+	return nil
+}
+
+// getJoinsFromJoin 获取 JOIN 树中的所有 JOIN 节点，不包括子查询中的 JOIN
+func getJoinsFromJoin(join *ast.Join) []*ast.Join {
+	joins := []*ast.Join{join}
+	if left, ok := join.Left.(*ast.Join); ok {
+		joins = append(joins, getJoinsFromJoin(left)...)
+	}
+	if right, ok := join.Right.(*ast.Join); ok {
+		joins = append(joins, getJoinsFromJoin(right)...)
+	}
+	return joins
+}
+
+func isStringColumnType(tp byte) bool {
+	switch tp {
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		return true
+	}
+	return false
+}
+
+func isNumericColumnType(tp byte) bool {
+	switch tp {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong,
+		mysql.TypeFloat, mysql.TypeDouble, mysql.TypeNewDecimal, mysql.TypeDecimal:
+		return true
+	}
+	return false
+}
+
+func isNumericValue(value *parserdriver.ValueExpr) bool {
+	switch value.Datum.Kind() {
+	case types.KindInt64, types.KindUint64, types.KindFloat32, types.KindFloat64, types.KindMysqlDecimal:
+		return true
+	}
+	return false
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,135 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	mysqlUtil "github.com/actiontech/sqle/sqle/driver/mysql/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00223 = "SQLE00223"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00223,
+			Desc:       plocale.Rule00223Desc,
+			Annotation: plocale.Rule00223Annotation,
+			Category:   plocale.RuleTypeIndexingConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagIndex.ID, plocale.RuleTagTable.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: false,
+			Version:      2,
+		},
+		Message: plocale.Rule00223Message,
+		Func:    RuleSQLE00223,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00223): "在 MySQL 中，外键字段必须被索引覆盖."
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，收集表的索引约束（包括字段上定义的主键和唯一键），对于每个外键约束，使用辅助函数 util.IsIndex 检查外键字段是否为某个索引的最左前缀，若不是，则报告违反规则。
+2. 对于 "ALTER TABLE ... ADD FOREIGN KEY ..." 语句，
+   1. 使用辅助函数 GetCreateTableStmt 获取表已有的索引约束，并与同一语句中新增的索引约束合并。
+   2. 对于每个新增的外键约束，使用辅助函数 util.IsIndex 检查外键字段是否为某个索引的最左前缀，若不是，则报告违反规则。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00223(input *rulepkg.RuleHandlerInput) error {
+	var indexes, foreignKeys []*ast.Constraint
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		indexes = getIndexesOfCreateTableStmt(stmt)
+		foreignKeys = util.GetTableConstraints(stmt.Constraints, ast.ConstraintForeignKey)
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint) {
+			if spec.Constraint == nil {
+				continue
+			}
+			if spec.Constraint.Tp == ast.ConstraintForeignKey {
+				foreignKeys = append(foreignKeys, spec.Constraint)
+			} else {
+				indexes = append(indexes, spec.Constraint)
+			}
+		}
+		if len(foreignKeys) == 0 {
+			return nil
+		}
+		createTableStmt, err := util.GetCreateTableStmt(input.Ctx, stmt.Table)
+		if err != nil {
+			log.NewEntry().Errorf("GetCreateTableStmt failed, sqle: %v, error: %v", stmt.Text(), err)
+			return err
+		}
+		indexes = append(indexes, getIndexesOfCreateTableStmt(createTableStmt)...)
+	default:
+		return nil
+	}
+
+	// 全文索引和空间索引无法用于外键检查
+	indexes = util.GetTableConstraints(indexes,
+		ast.ConstraintIndex, ast.ConstraintUniqIndex, ast.ConstraintKey, ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintPrimaryKey)
+
+	violateColumns := []string{}
+	for _, foreignKey := range foreignKeys {
+		columnMap := make(map[string]struct{}, len(foreignKey.Keys))
+		columnNames := make([]string, 0, len(foreignKey.Keys))
+		for _, key := range foreignKey.Keys {
+			if key.Column == nil {
+				continue
+			}
+			columnMap[key.Column.Name.L] = struct{}{}
+			columnNames = append(columnNames, key.Column.Name.O)
+		}
+		if len(columnMap) > 0 && !mysqlUtil.IsIndex(columnMap, indexes) {
+			violateColumns = append(violateColumns, "("+strings.Join(columnNames, ",")+")")
+		}
+	}
+	if len(violateColumns) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00223, strings.Join(violateColumns, ","))
+	}
+	return nil
+}
+
+// getIndexesOfCreateTableStmt 获取建表语句中的索引约束，字段上定义的主键和唯一键视为单列索引
+func getIndexesOfCreateTableStmt(stmt *ast.CreateTableStmt) []*ast.Constraint {
+	indexes := util.GetTableConstraints(stmt.Constraints, util.GetIndexConstraintTypes()...)
+	for _, col := range stmt.Cols {
+		if col.Name == nil {
+			continue
+		}
+		for _, option := range col.Options {
+			switch option.Tp {
+			case ast.ColumnOptionPrimaryKey:
+				indexes = append(indexes, &ast.Constraint{Tp: ast.ConstraintPrimaryKey, Keys: []*ast.IndexPartSpecification{{Column: col.Name}}})
+			case ast.ColumnOptionUniqKey:
+				indexes = append(indexes, &ast.Constraint{Tp: ast.ConstraintUniqKey, Keys: []*ast.IndexPartSpecification{{Column: col.Name}}})
+			}
+		}
+	}
+	return indexes
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,106 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00224 = "SQLE00224"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00224,
+			Desc:       plocale.Rule00224Desc,
+			Annotation: plocale.Rule00224Annotation,
+			Category:   plocale.RuleTypeGlobalConfig,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagDatabase.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagManagement.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "STRICT_TRANS_TABLES,ONLY_FULL_GROUP_BY",
+				Desc:  plocale.Rule00224Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: false,
+			Version:      2,
+		},
+		Message: plocale.Rule00224Message,
+		Func:    RuleSQLE00224,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00224): "在 MySQL 中，数据库的sql_mode需包含必需的模式.默认参数描述: 必需的sql_mode, 默认参数值: STRICT_TRANS_TABLES,ONLY_FULL_GROUP_BY"
+您应遵循以下逻辑：
+1. 对于 DML 和 DDL 语句，使用辅助函数 GetSystemVariables 获取数据库的 sql_mode，若无法获取（如离线审核），则不检查。
+2. 若参数中的模式不在 sql_mode 中，则报告违反规则，并列出缺少的模式。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00224(input *rulepkg.RuleHandlerInput) error {
+	switch input.Node.(type) {
+	case ast.DMLNode, ast.DDLNode:
+	default:
+		return nil
+	}
+	if input.Ctx == nil {
+		return nil
+	}
+
+	variables, err := input.Ctx.GetSystemVariables(sysVarSQLMode)
+	if err != nil {
+		log.NewEntry().Errorf("get system variable %s failed, sqle: %v, error: %v", sysVarSQLMode, input.Node.Text(), err)
+		return err
+	}
+	sqlMode, ok := variables[sysVarSQLMode]
+	if !ok {
+		// 离线审核时无法获取sql_mode
+		return nil
+	}
+
+	currentModes := map[string]struct{}{}
+	for _, mode := range strings.Split(sqlMode, ",") {
+		currentModes[strings.ToUpper(strings.TrimSpace(mode))] = struct{}{}
+	}
+	missingModes := []string{}
+	for _, mode := range strings.Split(input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String(), ",") {
+		mode = strings.ToUpper(strings.TrimSpace(mode))
+		if mode == "" {
+			continue
+		}
+		if _, ok := currentModes[mode]; !ok {
+			missingModes = append(missingModes, mode)
+		}
+	}
+	if len(missingModes) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00224, strings.Join(missingModes, ","))
+	}
+	return nil
+}
+
+const sysVarSQLMode = "sql_mode"
+
+// 规则函数实现结束
+// ==== Rule code end ====
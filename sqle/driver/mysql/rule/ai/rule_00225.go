@@ -0,0 +1,160 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00225 = "SQLE00225"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00225,
+			Desc:       plocale.Rule00225Desc,
+			Annotation: plocale.Rule00225Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID, plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID, plocale.RuleTagIntegrity.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID, plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00225Message,
+		Func:    RuleSQLE00225,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00225): "在 MySQL 中，主键和唯一键的字段必须显式定义NOT NULL约束."
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，
+   1. 构建字段名到字段定义的映射。
+   2. 收集主键约束、唯一键约束的字段，以及字段定义中使用 PRIMARY KEY、UNIQUE KEY 定义的字段。
+   3. 对于收集到的每个字段，使用辅助函数 IsColumnHasOption 检查其字段定义是否包含 NOT NULL 约束，若不包含，则报告违反规则。
+2. 对于 "ALTER TABLE ..." 语句，
+   1. 使用辅助函数 GetCreateTableStmt 获取表已有的字段定义（离线审核时无法获取，仅使用语句中定义的字段），并用语句中新增、修改的字段定义覆盖。
+   2. 收集新增的主键约束、唯一键约束的字段，新增、修改的字段中使用 PRIMARY KEY、UNIQUE KEY 定义的字段，以及被修改的已有主键、唯一键字段。
+   3. 对于收集到的每个字段，执行与上述相同的检查，无法获取字段定义的字段不做检查。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00225(input *rulepkg.RuleHandlerInput) error {
+	columnDefs := make(map[string] /*lower column name*/ *ast.ColumnDef)
+	var keyColumns []string
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		for _, col := range stmt.Cols {
+			columnDefs[col.Name.Name.L] = col
+		}
+		keyColumns = getUniqueKeyColumnsOfCreateTableStmt(stmt)
+	case *ast.AlterTableStmt:
+		newColumnDefs := make(map[string]*ast.ColumnDef)
+		// 被修改的字段，旧字段名 -> 新字段名
+		modifiedColumns := make(map[string]string)
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			for _, col := range spec.NewColumns {
+				newColumnDefs[col.Name.Name.L] = col
+				if util.IsColumnPrimaryKey(col) || util.IsColumnHasOption(col, ast.ColumnOptionUniqKey) {
+					keyColumns = append(keyColumns, col.Name.Name.O)
+				}
+				switch spec.Tp {
+				case ast.AlterTableModifyColumn:
+					modifiedColumns[col.Name.Name.L] = col.Name.Name.O
+				case ast.AlterTableChangeColumn:
+					if spec.OldColumnName != nil {
+						modifiedColumns[spec.OldColumnName.Name.L] = col.Name.Name.O
+					}
+				}
+			}
+		}
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint) {
+			keyColumns = append(keyColumns, getUniqueKeyColumns([]*ast.Constraint{spec.Constraint})...)
+		}
+		if len(keyColumns) == 0 && len(modifiedColumns) == 0 {
+			return nil
+		}
+
+		// 离线审核时无法获取表结构，仅检查语句中定义的字段
+		if createTableStmt, err := util.GetCreateTableStmt(input.Ctx, stmt.Table); err == nil {
+			for _, col := range createTableStmt.Cols {
+				columnDefs[col.Name.Name.L] = col
+			}
+			for _, col := range getUniqueKeyColumnsOfCreateTableStmt(createTableStmt) {
+				if newName, ok := modifiedColumns[strings.ToLower(col)]; ok {
+					keyColumns = append(keyColumns, newName)
+				}
+			}
+		}
+		for name, col := range newColumnDefs {
+			columnDefs[name] = col
+		}
+	default:
+		return nil
+	}
+
+	violateColumns := []string{}
+	checked := make(map[string]struct{})
+	for _, col := range keyColumns {
+		name := strings.ToLower(col)
+		if _, ok := checked[name]; ok {
+			continue
+		}
+		checked[name] = struct{}{}
+		columnDef, ok := columnDefs[name]
+		if ok && !util.IsColumnHasOption(columnDef, ast.ColumnOptionNotNull) {
+			violateColumns = append(violateColumns, col)
+		}
+	}
+	if len(violateColumns) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00225, strings.Join(violateColumns, ","))
+	}
+	return nil
+}
+
+// getUniqueKeyColumnsOfCreateTableStmt 获取建表语句中主键和唯一键的字段，包括字段上定义的主键和唯一键
+func getUniqueKeyColumnsOfCreateTableStmt(stmt *ast.CreateTableStmt) []string {
+	var columns []string
+	for _, col := range stmt.Cols {
+		if util.IsColumnPrimaryKey(col) || util.IsColumnHasOption(col, ast.ColumnOptionUniqKey) {
+			columns = append(columns, col.Name.Name.O)
+		}
+	}
+	return append(columns, getUniqueKeyColumns(stmt.Constraints)...)
+}
+
+// getUniqueKeyColumns 获取主键和唯一键约束的字段，表达式不包含在内
+func getUniqueKeyColumns(constraints []*ast.Constraint) []string {
+	var columns []string
+	for _, constraint := range util.GetTableConstraints(constraints, ast.ConstraintPrimaryKey, ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex) {
+		for _, key := range constraint.Keys {
+			if key.Column != nil {
+				columns = append(columns, key.Column.Name.O)
+			}
+		}
+	}
+	return columns
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,84 @@
+package ai
+
+import (
+	"fmt"
+	"regexp"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00226 = "SQLE00226"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00226,
+			Desc:       plocale.Rule00226Desc,
+			Annotation: plocale.Rule00226Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID, plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: ".*_log$",
+				Desc:  plocale.Rule00226Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00226Message,
+		Func:    RuleSQLE00226,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00226): "在 MySQL 中，预期数据量大的表建议使用分区表.默认参数描述: 大表表名的正则表达式, 默认参数值: .*_log$"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，
+   1. 如果是临时表（CREATE TEMPORARY TABLE），则跳过检查。
+   2. 使用规则参数中的正则表达式匹配表名，如果不匹配，则跳过检查。
+   3. 检查语句是否包含 PARTITION BY 子句，如果不包含，则报告违反规则。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00226(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.CreateTableStmt)
+	if !ok || stmt.IsTemporary || stmt.Partition != nil {
+		return nil
+	}
+	pattern := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String()
+	if pattern == "" {
+		return nil
+	}
+	reg, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid table name pattern %s: %v", pattern, err)
+	}
+	if reg.MatchString(stmt.Table.Name.O) {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00226, stmt.Table.Name.O)
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,174 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00227 = "SQLE00227"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00227,
+			Desc:       plocale.Rule00227Desc,
+			Annotation: plocale.Rule00227Annotation,
+			Category:   plocale.RuleTypeIndexingConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagIndex.ID, plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelError,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "255",
+				Desc:  plocale.Rule00227Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00227Message,
+		Func:    RuleSQLE00227,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00227): "在 MySQL 中，TEXT/BLOB/JSON类型的列建立索引时必须指定前缀长度.默认参数描述: 前缀长度的最大值(0表示不限制), 默认参数值: 255"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，
+   1. 使用辅助函数 util.IsTextOrBlobColumn 找出表中 TEXT、BLOB 或 JSON 类型的列。
+   2. 如果这些列定义了 PRIMARY KEY 或 UNIQUE 列约束，则报告违反规则。
+   3. 检查主键、唯一索引和普通索引（全文索引和函数索引除外）中的这些列，如果没有指定前缀长度，或者前缀长度大于规则参数，则报告违反规则。
+2. 对于 "ALTER TABLE ..." 语句，
+   1. 从原表定义、以及 ADD COLUMN、MODIFY COLUMN、CHANGE COLUMN 子句中获取列的定义。
+   2. 对于 ADD COLUMN 等子句中新定义的列，按 1.2 检查。
+   3. 对于 ADD INDEX、ADD PRIMARY KEY 等子句，按 1.3 检查。
+3. 对于 "CREATE INDEX ..." 语句，
+   1. 从原表定义中获取列的定义。
+   2. 全文索引不检查，其他索引按 1.3 检查。
+报告违反规则时，提示违反规则的索引名和列名。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00227(input *rulepkg.RuleHandlerInput) error {
+	maxLength := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Int()
+
+	// 列名(小写) -> 列定义
+	columns := map[string]*ast.ColumnDef{}
+	addColumns := func(cols []*ast.ColumnDef) {
+		for _, col := range cols {
+			columns[col.Name.Name.L] = col
+		}
+	}
+	var violations []string
+	// 列约束的主键和唯一键无法指定前缀长度
+	checkColumnOptions := func(cols []*ast.ColumnDef) {
+		for _, col := range cols {
+			if !util.IsTextOrBlobColumn(col) {
+				continue
+			}
+			for _, option := range col.Options {
+				switch option.Tp {
+				case ast.ColumnOptionPrimaryKey:
+					violations = append(violations, fmt.Sprintf("PRIMARY(%s)", col.Name.Name.O))
+				case ast.ColumnOptionUniqKey:
+					violations = append(violations, fmt.Sprintf("%s(%s)", col.Name.Name.O, col.Name.Name.O))
+				}
+			}
+		}
+	}
+	checkIndex := func(indexName string, keys []*ast.IndexPartSpecification) {
+		for _, key := range keys {
+			if key.Column == nil {
+				// 函数索引
+				continue
+			}
+			if indexName == "" {
+				indexName = key.Column.Name.O
+			}
+			col, ok := columns[key.Column.Name.L]
+			if !ok || !util.IsTextOrBlobColumn(col) {
+				continue
+			}
+			if key.Length <= 0 || (maxLength > 0 && key.Length > maxLength) {
+				violations = append(violations, fmt.Sprintf("%s(%s)", indexName, key.Column.Name.O))
+			}
+		}
+	}
+	checkConstraint := func(constraint *ast.Constraint) {
+		switch constraint.Tp {
+		case ast.ConstraintPrimaryKey:
+			checkIndex("PRIMARY", constraint.Keys)
+		case ast.ConstraintKey, ast.ConstraintIndex, ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
+			checkIndex(constraint.Name, constraint.Keys)
+		}
+	}
+	addOriginalColumns := func(table *ast.TableName) error {
+		createTableStmt, exist, err := input.Ctx.GetCreateTableStmt(table)
+		if err != nil {
+			return err
+		}
+		if exist {
+			addColumns(createTableStmt.Cols)
+		}
+		return nil
+	}
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		addColumns(stmt.Cols)
+		checkColumnOptions(stmt.Cols)
+		for _, constraint := range stmt.Constraints {
+			checkConstraint(constraint)
+		}
+	case *ast.AlterTableStmt:
+		if err := addOriginalColumns(stmt.Table); err != nil {
+			return err
+		}
+		for _, spec := range util.GetAlterTableSpecByTp(stmt.Specs, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			addColumns(spec.NewColumns)
+			checkColumnOptions(spec.NewColumns)
+		}
+		for _, spec := range util.GetAlterTableSpecByTp(stmt.Specs, ast.AlterTableAddConstraint) {
+			checkConstraint(spec.Constraint)
+		}
+	case *ast.CreateIndexStmt:
+		if stmt.KeyType == ast.IndexKeyTypeFullText || stmt.KeyType == ast.IndexKeyTypeSpatial {
+			return nil
+		}
+		if err := addOriginalColumns(stmt.Table); err != nil {
+			return err
+		}
+		checkIndex(stmt.IndexName, stmt.IndexPartSpecifications)
+	default:
+		return nil
+	}
+
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00227, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,114 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00228 = "SQLE00228"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00228,
+			Desc:       plocale.Rule00228Desc,
+			Annotation: plocale.Rule00228Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID, plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelError,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "1024",
+				Desc:  plocale.Rule00228Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00228Message,
+		Func:    RuleSQLE00228,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00228): "在 MySQL 中，为表新增NOT NULL字段时必须指定默认值.默认参数描述: 表大小阈值(MB)，超过阈值时按规则等级提示, 默认参数值: 1024"
+您应遵循以下逻辑：
+1. 对于 "ALTER TABLE ... ADD COLUMN ..." 语句，
+   1. 检查新增的每一列，如果列定义了 NOT NULL 或 PRIMARY KEY，且没有定义 DEFAULT，则该列违反规则。自增列和生成列不检查。
+   2. 如果存在违反规则的列，使用辅助函数 GetTableSize 获取表的大小（需要在线获取，离线审核时视为0）。
+   3. 如果表的大小大于等于规则参数，按规则等级报告违反规则；否则按低于规则等级一级的等级报告违反规则。
+报告违反规则时，提示违反规则的列名。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00228(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.AlterTableStmt)
+	if !ok {
+		return nil
+	}
+	thresholdMB := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Int()
+
+	var violations []string
+	for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns) {
+		for _, col := range spec.NewColumns {
+			if util.IsColumnHasOption(col, ast.ColumnOptionDefaultValue) ||
+				util.IsColumnHasOption(col, ast.ColumnOptionAutoIncrement) ||
+				util.IsColumnHasOption(col, ast.ColumnOptionGenerated) {
+				continue
+			}
+			if util.IsColumnHasOption(col, ast.ColumnOptionNotNull) || util.IsColumnPrimaryKey(col) {
+				violations = append(violations, col.Name.Name.O)
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	// 离线审核时表的大小为0，按较低的等级提示
+	level := lowerRuleLevel(input.Rule.Level)
+	size, err := input.Ctx.GetTableSize(stmt.Table)
+	if err != nil {
+		log.NewEntry().Errorf("get table size failed, sqle: %v, error: %v", input.Node.Text(), err)
+	} else if size >= float64(thresholdMB) {
+		level = input.Rule.Level
+	}
+	rulepkg.AddResultWithLevel(input.Res, input.Rule, level, SQLE00228, strings.Join(violations, ","))
+	return nil
+}
+
+// lowerRuleLevel returns the level lower than level by one, the lowest level is notice.
+func lowerRuleLevel(level driverV2.RuleLevel) driverV2.RuleLevel {
+	switch level {
+	case driverV2.RuleLevelError:
+		return driverV2.RuleLevelWarn
+	case driverV2.RuleLevelWarn:
+		return driverV2.RuleLevelNotice
+	}
+	return level
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
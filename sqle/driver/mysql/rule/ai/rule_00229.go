@@ -0,0 +1,128 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00229 = "SQLE00229"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00229,
+			Desc:       plocale.Rule00229Desc,
+			Annotation: plocale.Rule00229Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID, plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelError,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultMultiParamsFirstKeyName,
+				Value: "InnoDB",
+				Desc:  plocale.Rule00229Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "false",
+				Desc:  plocale.Rule00229Params2,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00229Message,
+		Func:    RuleSQLE00229,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00229): "在 MySQL 中，表的存储引擎必须在允许的范围内.默认参数描述: 允许的存储引擎(多个引擎用逗号分隔), 默认参数值: InnoDB; 默认参数描述: 建表语句未指定存储引擎时是否检查默认存储引擎, 默认参数值: false"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句（CREATE TABLE ... LIKE 除外），
+   1. 使用辅助函数 util.GetTableOption 获取 ENGINE 选项，如果存储引擎不在允许的范围内（不区分大小写），则报告违反规则。
+   2. 如果没有 ENGINE 选项，且第二个规则参数为 true，使用函数 input.Ctx.GetSchemaEngine 获取默认存储引擎（需要在线获取）。
+      如果默认存储引擎不在允许的范围内，或者无法获取默认存储引擎（如离线审核），则报告违反规则。
+2. 对于 "ALTER TABLE ... ENGINE=..." 语句，使用辅助函数 util.GetTableOption 获取 ENGINE 选项，如果存储引擎不在允许的范围内，则报告违反规则。
+报告违反规则时，提示不允许的存储引擎名。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00229(input *rulepkg.RuleHandlerInput) error {
+	allowedEngines := map[string]struct{}{}
+	for _, engine := range strings.Split(input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsFirstKeyName).String(), ",") {
+		if engine = strings.TrimSpace(engine); engine != "" {
+			allowedEngines[strings.ToLower(engine)] = struct{}{}
+		}
+	}
+	if len(allowedEngines) == 0 {
+		return nil
+	}
+	checkUnspecified := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).Bool()
+	isAllowed := func(engine string) bool {
+		_, ok := allowedEngines[strings.ToLower(engine)]
+		return ok
+	}
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		if stmt.ReferTable != nil {
+			// CREATE TABLE ... LIKE 使用原表的存储引擎
+			return nil
+		}
+		if option := util.GetTableOption(stmt.Options, ast.TableOptionEngine); option != nil {
+			if !isAllowed(option.StrValue) {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00229, option.StrValue)
+			}
+			return nil
+		}
+		if !checkUnspecified {
+			return nil
+		}
+		engine, err := input.Ctx.GetSchemaEngine(stmt.Table, "")
+		if err != nil {
+			log.NewEntry().Errorf("get schema engine failed, sqle: %v, error: %v", stmt.Text(), err)
+			return nil
+		}
+		if engine == "" {
+			// 离线审核时无法得知默认存储引擎
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00229, "(default_storage_engine)")
+		} else if !isAllowed(engine) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00229, engine)
+		}
+	case *ast.AlterTableStmt:
+		var options []*ast.TableOption
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableOption) {
+			options = append(options, spec.Options...)
+		}
+		if option := util.GetTableOption(options, ast.TableOptionEngine); option != nil && !isAllowed(option.StrValue) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00229, option.StrValue)
+		}
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
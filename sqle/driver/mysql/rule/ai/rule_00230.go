@@ -0,0 +1,202 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/opcode"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00230 = "SQLE00230"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00230,
+			Desc:       plocale.Rule00230Desc,
+			Annotation: plocale.Rule00230Annotation,
+			Category:   plocale.RuleTypeDMLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDML.ID, plocale.RuleTagQuery.ID, plocale.RuleTagTransaction.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID, plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00230Message,
+		Func:    RuleSQLE00230,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00230): "在 MySQL 中，加锁读(SELECT ... FOR UPDATE/LOCK IN SHARE MODE)必须使用索引列的等值或范围条件"
+您应遵循以下逻辑：
+1. 对于 "SELECT ... FOR UPDATE" 或 "SELECT ... LOCK IN SHARE MODE" 语句，
+   1. 如果语句没有 WHERE 条件，则报告违反规则（离线审核时仅检查此项）。
+   2. 拆分 WHERE 条件中以 AND 连接的各个条件，找出列与常量之间的等值(=、<=>、IN、IS NULL)或范围(>、>=、<、<=、BETWEEN)条件。
+   3. 使用辅助函数 input.Ctx.GetCreateTableStmt 获取查询涉及的表的建表语句（需要在线获取），如果无法获取任意一张表的建表语句，则不检查。
+   4. 如果第2步中的条件列都不是任意一个索引的第一列，则报告违反规则。
+报告违反规则时，提示加锁方式和涉及的表名。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00230(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.SelectStmt)
+	if !ok || stmt.LockTp == ast.SelectLockNone || stmt.From == nil || stmt.From.TableRefs == nil {
+		return nil
+	}
+
+	var tables []*ast.TableName
+	tableByName := map[string]*ast.TableName{}
+	for _, source := range util.GetTableSourcesFromJoin(stmt.From.TableRefs) {
+		table, ok := source.Source.(*ast.TableName)
+		if !ok {
+			continue
+		}
+		tables = append(tables, table)
+		tableByName[table.Name.L] = table
+		if source.AsName.L != "" {
+			tableByName[source.AsName.L] = table
+		}
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+	tableNames := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableNames = append(tableNames, table.Name.O)
+	}
+	lockClause := strings.ToUpper(stmt.LockTp.String())
+	if stmt.LockTp == ast.SelectLockInShareMode {
+		lockClause = "LOCK " + lockClause
+	}
+
+	if stmt.Where == nil {
+		// 没有WHERE条件时会锁住扫描过的所有记录，离线审核时也可以确定
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00230, lockClause, strings.Join(tableNames, ","))
+		return nil
+	}
+
+	// 获取每张表索引的第一列，无法获取表结构(如离线审核)时不检查
+	leadingIndexColumns := map[*ast.TableName]map[string]struct{}{}
+	for _, table := range tables {
+		createTableStmt, exist, err := input.Ctx.GetCreateTableStmt(table)
+		if err != nil {
+			log.NewEntry().Errorf("get create table statement failed, sqle: %v, error: %v", input.Node.Text(), err)
+			return nil
+		}
+		if !exist || createTableStmt == nil {
+			return nil
+		}
+		leadingIndexColumns[table] = getLeadingIndexColumns(createTableStmt)
+	}
+
+	for _, col := range getLockingPredicateColumns(stmt.Where) {
+		if col.Table.L != "" {
+			table, ok := tableByName[col.Table.L]
+			if !ok {
+				continue
+			}
+			if _, ok := leadingIndexColumns[table][col.Name.L]; ok {
+				return nil
+			}
+			continue
+		}
+		for _, columns := range leadingIndexColumns {
+			if _, ok := columns[col.Name.L]; ok {
+				return nil
+			}
+		}
+	}
+	rulepkg.AddResult(input.Res, input.Rule, SQLE00230, lockClause, strings.Join(tableNames, ","))
+	return nil
+}
+
+// getLeadingIndexColumns returns the lower-case first column of each B-tree index of the table.
+func getLeadingIndexColumns(stmt *ast.CreateTableStmt) map[string]struct{} {
+	columns := map[string]struct{}{}
+	for _, col := range stmt.Cols {
+		if util.IsColumnPrimaryKey(col) || util.IsColumnHasOption(col, ast.ColumnOptionUniqKey) {
+			columns[col.Name.Name.L] = struct{}{}
+		}
+	}
+	constraints := util.GetTableConstraints(stmt.Constraints,
+		ast.ConstraintPrimaryKey, ast.ConstraintKey, ast.ConstraintIndex,
+		ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex)
+	for _, constraint := range constraints {
+		// 函数索引的第一列没有列名
+		if len(constraint.Keys) > 0 && constraint.Keys[0].Column != nil {
+			columns[constraint.Keys[0].Column.Name.L] = struct{}{}
+		}
+	}
+	return columns
+}
+
+// getLockingPredicateColumns returns the columns compared with constant values by equality or range
+// in the AND-connected conditions of where, the conditions connected by OR are ignored.
+func getLockingPredicateColumns(where ast.ExprNode) []*ast.ColumnName {
+	var columns []*ast.ColumnName
+	isConst := func(expr ast.ExprNode) bool {
+		return len(util.GetColumnNameInExpr(expr)) == 0 && len(util.GetSubquery(expr)) == 0
+	}
+	var scan func(expr ast.ExprNode)
+	scan = func(expr ast.ExprNode) {
+		switch x := expr.(type) {
+		case *ast.ParenthesesExpr:
+			scan(x.Expr)
+		case *ast.BinaryOperationExpr:
+			switch x.Op {
+			case opcode.LogicAnd:
+				scan(x.L)
+				scan(x.R)
+			case opcode.EQ, opcode.NullEQ, opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+				if col, ok := x.L.(*ast.ColumnNameExpr); ok && isConst(x.R) {
+					columns = append(columns, col.Name)
+				}
+				if col, ok := x.R.(*ast.ColumnNameExpr); ok && isConst(x.L) {
+					columns = append(columns, col.Name)
+				}
+			}
+		case *ast.PatternInExpr:
+			if col, ok := x.Expr.(*ast.ColumnNameExpr); ok && !x.Not && x.Sel == nil {
+				for _, item := range x.List {
+					if !isConst(item) {
+						return
+					}
+				}
+				columns = append(columns, col.Name)
+			}
+		case *ast.BetweenExpr:
+			if col, ok := x.Expr.(*ast.ColumnNameExpr); ok && !x.Not && isConst(x.Left) && isConst(x.Right) {
+				columns = append(columns, col.Name)
+			}
+		case *ast.IsNullExpr:
+			if col, ok := x.Expr.(*ast.ColumnNameExpr); ok && !x.Not {
+				columns = append(columns, col.Name)
+			}
+		}
+	}
+	scan(where)
+	return columns
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
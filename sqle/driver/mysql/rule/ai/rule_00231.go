@@ -0,0 +1,75 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00231 = "SQLE00231"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00231,
+			Desc:       plocale.Rule00231Desc,
+			Annotation: plocale.Rule00231Annotation,
+			Category:   plocale.RuleTypeUsageSuggestion,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID, plocale.RuleTagBusiness.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagSecurity.ID, plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelError,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00231Message,
+		Func:    RuleSQLE00231,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00231): "在 MySQL 中，删除分区会同时删除分区中的数据，执行前需要确认"
+您应遵循以下逻辑：
+1. 对于 "ALTER TABLE ... DROP PARTITION ..." 语句，使用辅助函数 util.GetAlterTableCommandsByTypes 获取所有 DROP PARTITION 操作，如果存在，则报告违反规则。
+报告违反规则时，提示表名和被删除的分区名。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00231(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.AlterTableStmt)
+	if !ok {
+		return nil
+	}
+
+	var partitions []string
+	for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableDropPartition) {
+		for _, name := range spec.PartitionNames {
+			partitions = append(partitions, name.O)
+		}
+	}
+	if len(partitions) == 0 {
+		return nil
+	}
+	rulepkg.AddResult(input.Res, input.Rule, SQLE00231, stmt.Table.Name.O, strings.Join(partitions, ","))
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
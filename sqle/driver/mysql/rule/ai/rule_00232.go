@@ -0,0 +1,133 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00232 = "SQLE00232"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00232,
+			Desc:       plocale.Rule00232Desc,
+			Annotation: plocale.Rule00232Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelNotice,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultMultiParamsFirstKeyName,
+				Value: "id",
+				Desc:  plocale.Rule00232Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "created_at,updated_at",
+				Desc:  plocale.Rule00232Params2,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsThirdKeyName,
+				Value: "false",
+				Desc:  plocale.Rule00232Params3,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00232Message,
+		Func:    RuleSQLE00232,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00232): "在 MySQL 中，建表时主键字段应为第一列，审计字段应为最后的列.默认参数描述: 主键字段名, 默认参数值: id; 默认参数描述: 审计字段名(多个字段用逗号分隔，按期望的顺序), 默认参数值: created_at,updated_at; 默认参数描述: 是否要求表必须包含审计字段, 默认参数值: false"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句（CREATE TABLE ... LIKE 除外），按声明顺序获取所有列名（不区分大小写），
+   1. 如果表中存在第一个规则参数指定的主键字段，但它不是第一列，则报告违反规则。
+   2. 获取表中存在的、第二个规则参数指定的审计字段，如果这些字段不是表的最后几列，或者顺序与规则参数中的顺序不一致，则报告违反规则。
+   3. 如果第三个规则参数为 true，且表中缺少任意一个审计字段，则报告违反规则。
+报告违反规则时，提示表名和期望的字段顺序。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00232(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.CreateTableStmt)
+	if !ok || stmt.ReferTable != nil || len(stmt.Cols) == 0 {
+		return nil
+	}
+
+	idColumn := strings.ToLower(strings.TrimSpace(input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsFirstKeyName).String()))
+	var auditColumns []string
+	for _, name := range strings.Split(input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).String(), ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			auditColumns = append(auditColumns, name)
+		}
+	}
+	requireAuditColumns := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsThirdKeyName).Bool()
+
+	columns := make([]string, 0, len(stmt.Cols))
+	columnSet := make(map[string]struct{}, len(stmt.Cols))
+	for _, col := range stmt.Cols {
+		columns = append(columns, col.Name.Name.L)
+		columnSet[col.Name.Name.L] = struct{}{}
+	}
+
+	violated := false
+	if _, ok := columnSet[idColumn]; ok && columns[0] != idColumn {
+		violated = true
+	}
+
+	// 表中存在的审计字段应按参数中的顺序作为表的最后几列
+	var existAuditColumns []string
+	for _, name := range auditColumns {
+		if _, ok := columnSet[name]; ok {
+			existAuditColumns = append(existAuditColumns, name)
+		} else if requireAuditColumns {
+			violated = true
+		}
+	}
+	tailColumns := columns[len(columns)-len(existAuditColumns):]
+	for i, name := range existAuditColumns {
+		if tailColumns[i] != name {
+			violated = true
+			break
+		}
+	}
+
+	if violated {
+		expected := []string{}
+		if idColumn != "" {
+			expected = append(expected, idColumn)
+		}
+		expected = append(expected, "...")
+		expected = append(expected, auditColumns...)
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00232, stmt.Table.Name.O, strings.Join(expected, ","))
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,83 @@
+package ai
+
+import (
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	mysqlUtil "github.com/actiontech/sqle/sqle/driver/mysql/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00233 = "SQLE00233"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00233,
+			Desc:       plocale.Rule00233Desc,
+			Annotation: plocale.Rule00233Annotation,
+			Category:   plocale.RuleTypeDMLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagBusiness.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDML.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00233Message,
+		Func:    RuleSQLE00233,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00233): "在 MySQL 中，避免使用 NOT IN (子查询)，建议改写为 NOT EXISTS"
+您应遵循以下逻辑：
+1. 对于 SELECT、UNION、INSERT ... SELECT、UPDATE、DELETE 语句，重新解析语句得到新的语法树（改写会修改语法树，避免影响其他规则），
+   使用辅助函数 mysqlUtil.RewriteNotInSubqueryToNotExists 将 "expr NOT IN (SELECT col ...)" 改写为 "NOT EXISTS (SELECT 1 ... AND col = expr)"。
+2. 如果语句中存在 NOT IN (子查询)，则报告违反规则，并提示改写后的SQL；无法改写时（如子查询为 UNION，或包含 GROUP BY、LIMIT、聚合函数）提示 "-"。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00233(input *rulepkg.RuleHandlerInput) error {
+	switch input.Node.(type) {
+	case *ast.SelectStmt, *ast.UnionStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+	default:
+		return nil
+	}
+
+	// 改写会修改语法树，使用重新解析的语句
+	stmt, err := mysqlUtil.ParseOneSql(input.Node.Text())
+	if err != nil {
+		log.NewEntry().Errorf("parse sql failed, sqle: %v, error: %v", input.Node.Text(), err)
+		return nil
+	}
+	rewrittenSql, found, rewritten, err := mysqlUtil.RewriteNotInSubqueryToNotExists(stmt)
+	if err != nil {
+		log.NewEntry().Errorf("rewrite NOT IN subquery failed, sqle: %v, error: %v", input.Node.Text(), err)
+	}
+	if found == 0 {
+		return nil
+	}
+	if rewritten == 0 || err != nil {
+		rewrittenSql = "-"
+	}
+	rulepkg.AddResult(input.Res, input.Rule, SQLE00233, rewrittenSql)
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
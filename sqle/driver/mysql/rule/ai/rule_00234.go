@@ -0,0 +1,121 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00234 = "SQLE00234"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00234,
+			Desc:       plocale.Rule00234Desc,
+			Annotation: plocale.Rule00234Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID, plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "false",
+				Desc:  plocale.Rule00234Params1,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00234Message,
+		Func:    RuleSQLE00234,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00234): "在 MySQL 中，TIMESTAMP 字段应显式指定 DEFAULT 和 NULL/NOT NULL.默认参数描述: 是否要求使用 DATETIME 代替 TIMESTAMP, 默认参数值: false"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句和 "ALTER TABLE ... ADD COLUMN ..." 语句，检查每个类型为 TIMESTAMP 的列（生成列除外），
+   1. 如果规则参数为 true，则该列违反规则。
+   2. 如果该列没有定义 DEFAULT，或者既没有定义 NULL 也没有定义 NOT NULL（主键列视为 NOT NULL），则该列违反规则。
+2. 如果存在违反规则的列，使用辅助函数 GetSystemVariables 获取 explicit_defaults_for_timestamp，
+   1. 如果值为 ON，TIMESTAMP 字段不会被隐式地自动初始化和自动更新，按低于规则等级一级的等级报告违反规则。
+   2. 否则（包括离线审核无法获取时），按规则等级报告违反规则。
+报告违反规则时，提示违反规则的列名。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00234(input *rulepkg.RuleHandlerInput) error {
+	var columns []*ast.ColumnDef
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		columns = stmt.Cols
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns) {
+			columns = append(columns, spec.NewColumns...)
+		}
+	default:
+		return nil
+	}
+	requireDatetime := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Bool()
+
+	var violations []string
+	for _, col := range columns {
+		if col.Tp == nil || col.Tp.Tp != mysql.TypeTimestamp || util.IsColumnHasOption(col, ast.ColumnOptionGenerated) {
+			continue
+		}
+		explicitNull := util.IsColumnHasOption(col, ast.ColumnOptionNull) ||
+			util.IsColumnHasOption(col, ast.ColumnOptionNotNull) ||
+			util.IsColumnPrimaryKey(col)
+		if requireDatetime || !explicitNull || !util.IsColumnHasOption(col, ast.ColumnOptionDefaultValue) {
+			violations = append(violations, col.Name.Name.O)
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	level := input.Rule.Level
+	if input.Ctx != nil {
+		variables, err := input.Ctx.GetSystemVariables(sysVarExplicitDefaultsForTimestamp)
+		if err != nil {
+			log.NewEntry().Errorf("get system variable %s failed, sqle: %v, error: %v", sysVarExplicitDefaultsForTimestamp, input.Node.Text(), err)
+		} else if value, ok := variables[sysVarExplicitDefaultsForTimestamp]; ok && isSysVarOn(value) {
+			// TIMESTAMP 字段不会被隐式地自动初始化和自动更新，按较低的等级提示
+			level = lowerRuleLevel(level)
+		}
+	}
+	rulepkg.AddResultWithLevel(input.Res, input.Rule, level, SQLE00234, strings.Join(violations, ","))
+	return nil
+}
+
+const sysVarExplicitDefaultsForTimestamp = "explicit_defaults_for_timestamp"
+
+func isSysVarOn(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.EqualFold(value, "ON") || value == "1"
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,135 @@
+package ai
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/parser/types"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00235 = "SQLE00235"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00235,
+			Desc:       plocale.Rule00235Desc,
+			Annotation: plocale.Rule00235Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID, plocale.RuleTagBusiness.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultMultiParamsFirstKeyName,
+				Value: ".*(amount|price|cost|money).*",
+				Desc:  plocale.Rule00235Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "2",
+				Desc:  plocale.Rule00235Params2,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00235Message,
+		Func:    RuleSQLE00235,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00235): "在 MySQL 中，金额字段应使用 DECIMAL 类型并保留足够的小数位.默认参数描述: 金额字段名的正则表达式, 默认参数值: .*(amount|price|cost|money).*; 默认参数描述: DECIMAL 的最小小数位数, 默认参数值: 2"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句和 "ALTER TABLE ... ADD/MODIFY/CHANGE COLUMN ..." 语句，检查每个列名（不区分大小写）匹配第一个规则参数的列，
+   1. 如果列的类型为 FLOAT 或 DOUBLE，则该列违反规则。
+   2. 如果列的类型为 DECIMAL，且小数位数（未指定时为0）小于第二个规则参数，则该列违反规则。
+报告违反规则时，提示列名和列的类型。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00235(input *rulepkg.RuleHandlerInput) error {
+	var columns []*ast.ColumnDef
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		columns = stmt.Cols
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			columns = append(columns, spec.NewColumns...)
+		}
+	default:
+		return nil
+	}
+
+	pattern := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsFirstKeyName).String()
+	if pattern == "" {
+		return nil
+	}
+	reg, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return fmt.Errorf("invalid money column name pattern %s: %v", pattern, err)
+	}
+	minScale := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).Int()
+
+	var violations []string
+	for _, col := range columns {
+		if col.Tp == nil || !reg.MatchString(col.Name.Name.O) {
+			continue
+		}
+		if isApproximateNumericType(col.Tp) || (col.Tp.Tp == mysql.TypeNewDecimal && getDecimalScale(col.Tp) < minScale) {
+			violations = append(violations, fmt.Sprintf("%s(%s)", col.Name.Name.O, restoreColumnType(col.Tp)))
+		}
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00235, strings.Join(violations, ","))
+	}
+	return nil
+}
+
+func isApproximateNumericType(tp *types.FieldType) bool {
+	return tp.Tp == mysql.TypeFloat || tp.Tp == mysql.TypeDouble
+}
+
+// getDecimalScale returns the scale of DECIMAL, it is 0 if the scale is not specified.
+func getDecimalScale(tp *types.FieldType) int {
+	if tp.Decimal == types.UnspecifiedLength {
+		return 0
+	}
+	return tp.Decimal
+}
+
+// restoreColumnType returns the type as it is declared, e.g. "decimal(10)".
+func restoreColumnType(tp *types.FieldType) string {
+	var buf strings.Builder
+	if err := tp.Restore(format.NewRestoreCtx(format.RestoreKeyWordLowercase, &buf)); err != nil {
+		return tp.CompactStr()
+	}
+	return buf.String()
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
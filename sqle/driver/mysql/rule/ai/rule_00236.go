@@ -0,0 +1,124 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00236 = "SQLE00236"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00236,
+			Desc:       plocale.Rule00236Desc,
+			Annotation: plocale.Rule00236Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagMaintenance.ID, plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultMultiParamsFirstKeyName,
+				Value: "DYNAMIC",
+				Desc:  plocale.Rule00236Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "false",
+				Desc:  plocale.Rule00236Params2,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00236Message,
+		Func:    RuleSQLE00236,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00236): "在 MySQL 中，表的行格式应为允许的行格式.默认参数描述: 允许的行格式(多个用逗号分隔), 默认参数值: DYNAMIC; 默认参数描述: 建表时是否要求显式指定行格式, 默认参数值: false"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句（CREATE TABLE ... LIKE 除外），使用辅助函数 GetTableOption 获取表选项 ROW_FORMAT，
+   1. 如果指定了 ROW_FORMAT 且不为 DEFAULT，并且不在第一个规则参数中（不区分大小写），则报告违反规则。
+   2. 如果没有指定 ROW_FORMAT 或者 ROW_FORMAT 为 DEFAULT，且第二个规则参数为 true，则报告违反规则。
+2. 对于 "ALTER TABLE ... ROW_FORMAT=..." 语句，如果修改的 ROW_FORMAT 不为 DEFAULT，且不在第一个规则参数中，则报告违反规则。
+报告违反规则时，提示表名和指定的行格式，未指定时为 "-"。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00236(input *rulepkg.RuleHandlerInput) error {
+	allowed := map[string]struct{}{}
+	for _, rowFormat := range strings.Split(input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsFirstKeyName).String(), ",") {
+		if rowFormat = strings.ToUpper(strings.TrimSpace(rowFormat)); rowFormat != "" {
+			allowed[rowFormat] = struct{}{}
+		}
+	}
+	isAllowed := func(option *ast.TableOption) bool {
+		_, ok := allowed[getRowFormatName(option)]
+		return ok
+	}
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		if stmt.ReferTable != nil {
+			return nil
+		}
+		option := util.GetTableOption(stmt.Options, ast.TableOptionRowFormat)
+		if option == nil || option.UintValue == ast.RowFormatDefault {
+			if input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).Bool() {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00236, stmt.Table.Name.O, "-")
+			}
+			return nil
+		}
+		if !isAllowed(option) {
+			rulepkg.AddResult(input.Res, input.Rule, SQLE00236, stmt.Table.Name.O, getRowFormatName(option))
+		}
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableOption) {
+			option := util.GetTableOption(spec.Options, ast.TableOptionRowFormat)
+			if option == nil || option.UintValue == ast.RowFormatDefault {
+				continue
+			}
+			if !isAllowed(option) {
+				rulepkg.AddResult(input.Res, input.Rule, SQLE00236, stmt.Table.Name.O, getRowFormatName(option))
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// getRowFormatName returns the row format of the table option, e.g. "DYNAMIC".
+func getRowFormatName(option *ast.TableOption) string {
+	var buf strings.Builder
+	if err := option.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &buf)); err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(buf.String(), "ROW_FORMAT = ")
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,201 @@
+package ai
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00237 = "SQLE00237"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00237,
+			Desc:       plocale.Rule00237Desc,
+			Annotation: plocale.Rule00237Annotation,
+			Category:   plocale.RuleTypeIndexingConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID, plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID, plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "",
+				Desc:  plocale.Rule00237Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00237Message,
+		Func:    RuleSQLE00237,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00237): "在 MySQL 中，整数类型的单列主键应设置为自增.默认参数描述: 豁免的表名或主键字段名(正则表达式，主键为自然键的表), 默认参数值: "
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE..." 语句：
+   - 通过字段的 PRIMARY KEY 属性或 PRIMARY KEY 约束获取主键字段，如果主键只有一个字段，且字段类型为整数类型（TINYINT、SMALLINT、MEDIUMINT、INT、BIGINT），使用辅助函数 IsColumnAutoIncrement 检查字段是否为自增，如果不是自增，则记录该字段。
+
+2. 对于 "ALTER TABLE..." 语句：
+   - 对于 ADD/MODIFY/CHANGE COLUMN 操作，如果字段定义了 PRIMARY KEY 属性，按步骤1检查该字段。
+   - 对于 ADD PRIMARY KEY 操作，如果主键只有一个字段，从同一语句新增或修改的字段中获取字段定义，否则使用辅助函数 GetCreateTableStmt 获取表的定义（离线审核时无法获取，不检查），按步骤1检查该字段。
+   - 对于 MODIFY/CHANGE COLUMN 操作，如果字段没有定义 PRIMARY KEY 属性，使用辅助函数 GetCreateTableStmt 获取表的定义（离线审核时无法获取，不检查），如果被修改的字段是表原有的单列主键，按步骤1检查新的字段定义。
+
+3. 如果表名或主键字段名匹配规则参数（不区分大小写），则不检查。
+报告违反规则时，提示应设置为自增的字段名。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00237(input *rulepkg.RuleHandlerInput) error {
+	var table *ast.TableName
+	var pkColumns []*ast.ColumnDef
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		table = stmt.Table
+		if col := getSinglePrimaryKeyColumn(stmt.Cols, stmt.Constraints); col != nil {
+			pkColumns = append(pkColumns, col)
+		}
+	case *ast.AlterTableStmt:
+		table = stmt.Table
+		pkColumns = getAlterTableSinglePrimaryKeyColumns(input, stmt)
+	default:
+		return nil
+	}
+
+	var exempt *regexp.Regexp
+	if pattern := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String(); pattern != "" {
+		var err error
+		exempt, err = regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return fmt.Errorf("invalid natural key pattern %s: %v", pattern, err)
+		}
+		if exempt.MatchString(table.Name.O) {
+			return nil
+		}
+	}
+
+	var violations []string
+	for _, col := range pkColumns {
+		if !isIntegerColumn(col) || util.IsColumnAutoIncrement(col) || util.IsColumnHasOption(col, ast.ColumnOptionGenerated) {
+			continue
+		}
+		if exempt != nil && exempt.MatchString(col.Name.Name.O) {
+			continue
+		}
+		violations = append(violations, col.Name.Name.O)
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00237, strings.Join(violations, ","))
+	}
+	return nil
+}
+
+// getSinglePrimaryKeyColumn returns the column definition of the primary key, it is nil if the
+// primary key has more than one column or there is no primary key.
+func getSinglePrimaryKeyColumn(cols []*ast.ColumnDef, constraints []*ast.Constraint) *ast.ColumnDef {
+	for _, col := range cols {
+		if util.IsColumnPrimaryKey(col) {
+			return col
+		}
+	}
+	constraint := util.GetTableConstraint(constraints, ast.ConstraintPrimaryKey)
+	if constraint == nil || len(constraint.Keys) != 1 || constraint.Keys[0].Column == nil {
+		return nil
+	}
+	return getColumnDefByName(cols, util.GetIndexColName(constraint.Keys[0]))
+}
+
+func getAlterTableSinglePrimaryKeyColumns(input *rulepkg.RuleHandlerInput, stmt *ast.AlterTableStmt) []*ast.ColumnDef {
+	// 离线审核或表不存在时，无法获取表原有的定义
+	var originTable *ast.CreateTableStmt
+	var loaded bool
+	getOriginTable := func() *ast.CreateTableStmt {
+		if !loaded {
+			loaded = true
+			originTable, _ = util.GetCreateTableStmt(input.Ctx, stmt.Table)
+		}
+		return originTable
+	}
+	getOriginPrimaryKey := func() *ast.ColumnDef {
+		if getOriginTable() == nil {
+			return nil
+		}
+		return getSinglePrimaryKeyColumn(originTable.Cols, originTable.Constraints)
+	}
+
+	var newColumns, pkColumns []*ast.ColumnDef
+	for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+		newColumns = append(newColumns, spec.NewColumns...)
+		for _, col := range spec.NewColumns {
+			if util.IsColumnPrimaryKey(col) {
+				pkColumns = append(pkColumns, col)
+				continue
+			}
+			if spec.Tp == ast.AlterTableAddColumns {
+				continue
+			}
+			oldName := col.Name.Name.L
+			if spec.OldColumnName != nil {
+				oldName = spec.OldColumnName.Name.L
+			}
+			if pk := getOriginPrimaryKey(); pk != nil && pk.Name.Name.L == oldName {
+				pkColumns = append(pkColumns, col)
+			}
+		}
+	}
+	for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint) {
+		constraint := spec.Constraint
+		if constraint.Tp != ast.ConstraintPrimaryKey || len(constraint.Keys) != 1 || constraint.Keys[0].Column == nil {
+			continue
+		}
+		name := util.GetIndexColName(constraint.Keys[0])
+		col := getColumnDefByName(newColumns, name)
+		if col == nil && getOriginTable() != nil {
+			col = getColumnDefByName(originTable.Cols, name)
+		}
+		if col != nil {
+			pkColumns = append(pkColumns, col)
+		}
+	}
+	return pkColumns
+}
+
+func getColumnDefByName(cols []*ast.ColumnDef, name string) *ast.ColumnDef {
+	for _, col := range cols {
+		if strings.EqualFold(col.Name.Name.O, name) {
+			return col
+		}
+	}
+	return nil
+}
+
+func isIntegerColumn(col *ast.ColumnDef) bool {
+	return col.Tp != nil && util.IsColumnTypeEqual(col, mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong)
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,111 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/charset"
+	"github.com/pingcap/parser/mysql"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00238 = "SQLE00238"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00238,
+			Desc:       plocale.Rule00238Desc,
+			Annotation: plocale.Rule00238Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelNotice,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultMultiParamsFirstKeyName,
+				Value: "16",
+				Desc:  plocale.Rule00238Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "false",
+				Desc:  plocale.Rule00238Params2,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00238Message,
+		Func:    RuleSQLE00238,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00238): "在 MySQL 中，应根据数据长度是否固定选择 CHAR 或 VARCHAR 类型.默认参数描述: CHAR最大长度, 默认参数值: 16; 默认参数描述: 是否检查长度不超过2的VARCHAR字段, 默认参数值: false"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，检查定义的每个字段；对于 "ALTER TABLE ..." 语句，检查 ADD/MODIFY/CHANGE COLUMN 中的字段：
+   1. 如果字段为 CHAR 类型（不包括 BINARY），使用辅助函数 GetColumnWidth 获取长度，长度超过第一个规则参数时，记录该字段，建议类型为相同长度的 VARCHAR。
+   2. 如果第二个规则参数为 true，字段为 VARCHAR 类型（不包括 VARBINARY），且长度为1或2时，记录该字段，建议类型为相同长度的 CHAR。
+2. 如果存在记录的字段，则报告违反规则。
+报告违反规则时，提示字段名和建议的类型。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00238(input *rulepkg.RuleHandlerInput) error {
+	maxCharLength := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsFirstKeyName).Int()
+	checkShortVarchar := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).Bool()
+
+	var columns []*ast.ColumnDef
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		columns = stmt.Cols
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			columns = append(columns, spec.NewColumns...)
+		}
+	default:
+		return nil
+	}
+
+	var violations []string
+	for _, col := range columns {
+		// BINARY 和 VARBINARY 的字符集为 binary
+		if col.Tp == nil || col.Tp.Charset == charset.CharsetBin {
+			continue
+		}
+		width := util.GetColumnWidth(col)
+		switch {
+		case col.Tp.Tp == mysql.TypeString && width > maxCharLength:
+			violations = append(violations, fmt.Sprintf("%s VARCHAR(%d)", col.Name.Name.O, width))
+		case checkShortVarchar && col.Tp.Tp == mysql.TypeVarchar && width >= 1 && width <= 2:
+			violations = append(violations, fmt.Sprintf("%s CHAR(%d)", col.Name.Name.O, width))
+		}
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00238, strings.Join(violations, ","))
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
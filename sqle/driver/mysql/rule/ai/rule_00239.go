@@ -0,0 +1,110 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00239 = "SQLE00239"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00239,
+			Desc:       plocale.Rule00239Desc,
+			Annotation: plocale.Rule00239Annotation,
+			Category:   plocale.RuleTypeNamingConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID, plocale.RuleTagIntegrity.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "",
+				Desc:  plocale.Rule00239Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00239Message,
+		Func:    RuleSQLE00239,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00239): "在 MySQL 中，外键应显式命名.默认参数描述: 外键名固定前缀(为空时不检查前缀), 默认参数值: "
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，检查表约束中的每个 FOREIGN KEY 约束；对于 "ALTER TABLE ... ADD CONSTRAINT ... FOREIGN KEY ..." 语句，检查新增的 FOREIGN KEY 约束：
+   1. 如果约束没有名字，记录该外键。
+   2. 如果规则参数不为空，且约束名不以规则参数为前缀（区分大小写），记录该外键。
+2. 如果存在记录的外键，则报告违反规则。
+报告违反规则时，按 "[CONSTRAINT 约束名] FOREIGN KEY (字段) REFERENCES 引用的表 (引用的字段)" 的格式提示外键。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00239(input *rulepkg.RuleHandlerInput) error {
+	requiredPrefix := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String()
+
+	var constraints []*ast.Constraint
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		constraints = util.GetTableConstraints(stmt.Constraints, ast.ConstraintForeignKey)
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint) {
+			if spec.Constraint.Tp == ast.ConstraintForeignKey {
+				constraints = append(constraints, spec.Constraint)
+			}
+		}
+	default:
+		return nil
+	}
+
+	var violations []string
+	for _, constraint := range constraints {
+		if constraint.Name != "" && strings.HasPrefix(constraint.Name, requiredPrefix) {
+			continue
+		}
+		violations = append(violations, formatForeignKey(constraint))
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00239, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// formatForeignKey returns the definition of foreign key, e.g. "CONSTRAINT fk_1 FOREIGN KEY (user_id) REFERENCES users (id)".
+func formatForeignKey(constraint *ast.Constraint) string {
+	fk := fmt.Sprintf("FOREIGN KEY (%s)", strings.Join(getIndexColumnNames(constraint.Keys), ","))
+	if constraint.Refer != nil && constraint.Refer.Table != nil {
+		fk = fmt.Sprintf("%s REFERENCES %s (%s)", fk, constraint.Refer.Table.Name.O,
+			strings.Join(getIndexColumnNames(constraint.Refer.IndexPartSpecifications), ","))
+	}
+	if constraint.Name != "" {
+		fk = fmt.Sprintf("CONSTRAINT %s %s", constraint.Name, fk)
+	}
+	return fk
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
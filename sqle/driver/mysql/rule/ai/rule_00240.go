@@ -0,0 +1,170 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00240 = "SQLE00240"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00240,
+			Desc:       plocale.Rule00240Desc,
+			Annotation: plocale.Rule00240Annotation,
+			Category:   plocale.RuleTypeUsageSuggestion,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagFunction.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDML.ID, plocale.RuleTagDDL.ID, plocale.RuleTagQuery.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "PASSWORD:8.0.11,OLD_PASSWORD:5.7.5,ENCODE:8.0.3,DECODE:8.0.3,ENCRYPT:8.0.3,DES_ENCRYPT:8.0.3,DES_DECRYPT:8.0.3",
+				Desc:  plocale.Rule00240Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00240Message,
+		Func:    RuleSQLE00240,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00240): "在 MySQL 中，避免使用已废弃或已移除的函数.默认参数描述: 函数名及移除的版本(格式为 函数名:版本，多个以英文逗号分隔), 默认参数值: PASSWORD:8.0.11,OLD_PASSWORD:5.7.5,ENCODE:8.0.3,DECODE:8.0.3,ENCRYPT:8.0.3,DES_ENCRYPT:8.0.3,DES_DECRYPT:8.0.3"
+您应遵循以下逻辑：
+1. 对于所有语句，遍历语句中的所有表达式，包括 SELECT 的字段、WHERE 条件、字段的 DEFAULT 表达式和生成列表达式以及嵌套在其他函数中的函数，获取调用的函数名。
+2. 如果函数名在规则参数的函数列表中（不区分大小写），记录该函数。
+3. 使用辅助函数 GetTargetVersion 获取审核的目标版本：
+   1. 如果目标版本为 MariaDB，不检查，MariaDB 仍支持这些函数。
+   2. 如果目标版本已知，且低于函数被移除的版本，该函数仍可用，不记录。
+   3. 如果目标版本未知（如离线审核时未指定目标版本），记录所有函数。
+4. 如果存在记录的函数，则报告违反规则。
+报告违反规则时，提示函数名及其被移除的版本。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00240(input *rulepkg.RuleHandlerInput) error {
+	param := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String()
+	removedFuncs, err := parseRemovedFuncs(param)
+	if err != nil {
+		return err
+	}
+	if len(removedFuncs) == 0 {
+		return nil
+	}
+
+	extractor := &funcNameExtractor{}
+	input.Node.Accept(extractor)
+	if len(extractor.names) == 0 {
+		return nil
+	}
+
+	// 目标版本未知时，检查所有函数
+	var targetVersion *semver.Version
+	if input.Ctx != nil {
+		if isMariaDB, err := input.Ctx.IsMariaDB(); err != nil {
+			return err
+		} else if isMariaDB {
+			return nil
+		}
+		version, err := input.Ctx.GetTargetVersion()
+		if err != nil {
+			return err
+		}
+		if version != "" {
+			if targetVersion, err = session.ParseVersion(version); err != nil {
+				return err
+			}
+		}
+	}
+
+	var violations []string
+	reported := map[string]bool{}
+	for _, name := range extractor.names {
+		name = strings.ToUpper(name)
+		removedVersion, ok := removedFuncs[name]
+		if !ok || reported[name] {
+			continue
+		}
+		if targetVersion != nil && targetVersion.LessThan(removedVersion) {
+			continue
+		}
+		reported[name] = true
+		violations = append(violations, fmt.Sprintf("%s: %s", name, removedVersion.Original()))
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00240, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// parseRemovedFuncs parses the param in the format of "PASSWORD:8.0.11,ENCODE:8.0.3", the key
+// of the result is the upper case function name.
+func parseRemovedFuncs(param string) (map[string]*semver.Version, error) {
+	removedFuncs := map[string]*semver.Version{}
+	for _, item := range strings.Split(param, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, version, found := strings.Cut(item, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid removed function %q, it should be in the format of name:version", item)
+		}
+		v, err := semver.NewVersion(strings.TrimSpace(version))
+		if err != nil {
+			return nil, fmt.Errorf("invalid version of removed function %q: %v", item, err)
+		}
+		removedFuncs[strings.ToUpper(strings.TrimSpace(name))] = v
+	}
+	return removedFuncs, nil
+}
+
+// funcNameExtractor collects the names of all the functions called in the node, including
+// the functions nested in the arguments of other functions.
+type funcNameExtractor struct {
+	names []string
+}
+
+func (fe *funcNameExtractor) Enter(in ast.Node) (node ast.Node, skipChildren bool) {
+	if fn, ok := in.(*ast.FuncCallExpr); ok {
+		name := fn.FnName.O
+		// PASSWORD() is parsed as a function named password_func
+		if fn.FnName.L == ast.PasswordFunc {
+			name = "PASSWORD"
+		}
+		fe.names = append(fe.names, name)
+	}
+	return in, false
+}
+
+func (fe *funcNameExtractor) Leave(in ast.Node) (node ast.Node, ok bool) {
+	return in, true
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
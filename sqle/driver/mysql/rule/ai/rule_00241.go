@@ -0,0 +1,129 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00241 = "SQLE00241"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00241,
+			Desc:       plocale.Rule00241Desc,
+			Annotation: plocale.Rule00241Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelNotice,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "0",
+				Desc:  plocale.Rule00241Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00241Message,
+		Func:    RuleSQLE00241,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00241): "在 MySQL 中，时间字段的小数秒精度应保持一致.默认参数描述: 小数秒精度(为-1时只检查一致性), 默认参数值: 0"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，获取所有 DATETIME、TIMESTAMP、TIME 类型的字段及其小数秒精度（字段类型的 Decimal，未指定时为0）。
+2. 如果规则参数不小于0，精度与规则参数不同的字段违反规则；如果规则参数为-1，且字段的精度不完全相同，所有时间字段均违反规则。
+3. 如果存在违反规则的字段，则报告违反规则，提示字段名、类型及精度。
+4. 如果同时存在 DATETIME 和 TIMESTAMP 类型的字段，单独添加一条提示级别的审核结果，提示两种类型的字段。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00241(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.CreateTableStmt)
+	if !ok {
+		return nil
+	}
+	standardFsp := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Int()
+
+	type temporalColumn struct {
+		name     string
+		typeName string
+		fsp      int
+	}
+	var temporalColumns []temporalColumn
+	var datetimeColumns, timestampColumns []string
+	fsps := map[int]bool{}
+	for _, col := range stmt.Cols {
+		if col.Tp == nil {
+			continue
+		}
+		var typeName string
+		switch col.Tp.Tp {
+		case mysql.TypeDatetime:
+			typeName = "DATETIME"
+			datetimeColumns = append(datetimeColumns, col.Name.Name.O)
+		case mysql.TypeTimestamp:
+			typeName = "TIMESTAMP"
+			timestampColumns = append(timestampColumns, col.Name.Name.O)
+		case mysql.TypeDuration:
+			typeName = "TIME"
+		default:
+			continue
+		}
+		// 未指定小数秒精度时为0
+		fsp := col.Tp.Decimal
+		if fsp < 0 {
+			fsp = 0
+		}
+		temporalColumns = append(temporalColumns, temporalColumn{name: col.Name.Name.O, typeName: typeName, fsp: fsp})
+		fsps[fsp] = true
+	}
+
+	var violations []string
+	for _, col := range temporalColumns {
+		if standardFsp >= 0 && col.fsp == standardFsp {
+			continue
+		}
+		if standardFsp < 0 && len(fsps) <= 1 {
+			continue
+		}
+		violations = append(violations, fmt.Sprintf("%s %s(%d)", col.name, col.typeName, col.fsp))
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00241, strings.Join(violations, ","))
+	}
+
+	// 混用 DATETIME 和 TIMESTAMP 与精度无关，单独提示
+	if len(datetimeColumns) > 0 && len(timestampColumns) > 0 {
+		input.Res.Add(driverV2.RuleLevelNotice, "", plocale.Bundle.LocalizeAll(plocale.Rule00241MixedTemporalTypes),
+			strings.Join(datetimeColumns, ","), strings.Join(timestampColumns, ","))
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
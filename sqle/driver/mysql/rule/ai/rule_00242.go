@@ -0,0 +1,145 @@
+package ai
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00242 = "SQLE00242"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00242,
+			Desc:       plocale.Rule00242Desc,
+			Annotation: plocale.Rule00242Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID, plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelNotice,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultMultiParamsFirstKeyName,
+				Value: "100000000",
+				Desc:  plocale.Rule00242Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "",
+				Desc:  plocale.Rule00242Params2,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00242Message,
+		Func:    RuleSQLE00242,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00242): "在 MySQL 中，预期数据量可以使用 INT 存储时，主键和自增字段不建议使用 BIGINT.默认参数描述: 预期的最大行数, 默认参数值: 100000000; 默认参数描述: 豁免的表名(多个以英文逗号分隔), 默认参数值: "
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，获取字段定义中带有 PRIMARY KEY 或 AUTO_INCREMENT 属性的字段，以及 PRIMARY KEY 约束中的字段；对于 "ALTER TABLE ..." 语句，获取 ADD/MODIFY/CHANGE COLUMN 中带有 PRIMARY KEY 或 AUTO_INCREMENT 属性的字段，以及同一语句中 ADD PRIMARY KEY 引用的新字段。
+2. 如果表名在第二个规则参数中（不区分大小写），不检查。
+3. 对于类型为 BIGINT 的字段：
+   1. 如果第一个规则参数不大于 INT 的最大值(2147483647)，建议类型为 INT；字段为 UNSIGNED 时建议类型为 INT UNSIGNED。
+   2. 如果第一个规则参数不大于 INT UNSIGNED 的最大值(4294967295)，建议类型为 INT UNSIGNED。
+   3. 否则不记录。
+4. 如果存在记录的字段，则报告违反规则，提示字段名和建议的类型。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00242(input *rulepkg.RuleHandlerInput) error {
+	expectedMaxRows := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsFirstKeyName).Int()
+	exemptTables := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).String()
+
+	var table *ast.TableName
+	var keyColumns []*ast.ColumnDef
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		table = stmt.Table
+		keyColumns = getPrimaryKeyOrAutoIncrementColumns(stmt.Cols, stmt.Constraints)
+	case *ast.AlterTableStmt:
+		table = stmt.Table
+		var newColumns []*ast.ColumnDef
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			newColumns = append(newColumns, spec.NewColumns...)
+		}
+		var constraints []*ast.Constraint
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddConstraint) {
+			constraints = append(constraints, spec.Constraint)
+		}
+		keyColumns = getPrimaryKeyOrAutoIncrementColumns(newColumns, constraints)
+	default:
+		return nil
+	}
+
+	for _, name := range strings.Split(exemptTables, ",") {
+		if strings.EqualFold(strings.TrimSpace(name), table.Name.O) {
+			return nil
+		}
+	}
+
+	var violations []string
+	for _, col := range keyColumns {
+		if col.Tp == nil || col.Tp.Tp != mysql.TypeLonglong {
+			continue
+		}
+		unsigned := mysql.HasUnsignedFlag(col.Tp.Flag)
+		switch {
+		case expectedMaxRows <= math.MaxInt32 && !unsigned:
+			violations = append(violations, fmt.Sprintf("%s INT", col.Name.Name.O))
+		case expectedMaxRows <= math.MaxUint32:
+			violations = append(violations, fmt.Sprintf("%s INT UNSIGNED", col.Name.Name.O))
+		}
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00242, strings.Join(violations, ","))
+	}
+	return nil
+}
+
+// getPrimaryKeyOrAutoIncrementColumns returns the columns which are in the primary key or
+// defined as AUTO_INCREMENT.
+func getPrimaryKeyOrAutoIncrementColumns(cols []*ast.ColumnDef, constraints []*ast.Constraint) []*ast.ColumnDef {
+	pkColumns := map[string]bool{}
+	if constraint := util.GetTableConstraint(constraints, ast.ConstraintPrimaryKey); constraint != nil {
+		for _, name := range getIndexColumnNames(constraint.Keys) {
+			pkColumns[strings.ToLower(name)] = true
+		}
+	}
+	var keyColumns []*ast.ColumnDef
+	for _, col := range cols {
+		if util.IsColumnPrimaryKey(col) || util.IsColumnAutoIncrement(col) || pkColumns[col.Name.Name.L] {
+			keyColumns = append(keyColumns, col)
+		}
+	}
+	return keyColumns
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
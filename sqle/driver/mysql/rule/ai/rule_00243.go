@@ -0,0 +1,177 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00243 = "SQLE00243"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00243,
+			Desc:       plocale.Rule00243Desc,
+			Annotation: plocale.Rule00243Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID, plocale.RuleTagFunction.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "UUID,UUID_SHORT,RAND,SYSDATE,NOW",
+				Desc:  plocale.Rule00243Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00243Message,
+		Func:    RuleSQLE00243,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00243): "在 MySQL 中，字段默认值不建议使用不确定的函数.默认参数描述: 不确定的函数(多个以英文逗号分隔), 默认参数值: UUID,UUID_SHORT,RAND,SYSDATE,NOW"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，获取所有字段的 DEFAULT 表达式；对于 "ALTER TABLE ..." 语句，获取 ADD/MODIFY/CHANGE COLUMN 中字段的 DEFAULT 表达式，以及 ALTER COLUMN ... SET DEFAULT 的表达式。
+2. 遍历 DEFAULT 表达式中调用的函数（包括嵌套的函数），CURRENT_TIMESTAMP、LOCALTIME、LOCALTIMESTAMP 视为 NOW，如果函数名在规则参数中（不区分大小写），记录该字段及其 DEFAULT 表达式。
+3. DATETIME 和 TIMESTAMP 字段直接使用 NOW() 或 CURRENT_TIMESTAMP 作为默认值是常见用法，不记录；ALTER COLUMN ... SET DEFAULT 中字段类型未知，同样不记录；NOW 只在参与其他表达式或用于其他类型的字段时记录。
+4. 使用辅助函数 GetTargetVersion 获取审核的目标版本，如果目标版本已知且不支持表达式默认值（MySQL 低于 8.0.13，MariaDB 低于 10.2.1），不检查。
+5. 如果存在记录的字段，则报告违反规则，提示字段名及其 DEFAULT 表达式。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00243(input *rulepkg.RuleHandlerInput) error {
+	nonDeterministicFuncs := map[string]bool{}
+	for _, name := range strings.Split(input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String(), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			nonDeterministicFuncs[strings.ToUpper(name)] = true
+		}
+	}
+	if len(nonDeterministicFuncs) == 0 {
+		return nil
+	}
+
+	type columnDefault struct {
+		col  *ast.ColumnDef
+		expr ast.ExprNode
+	}
+	var defaults []columnDefault
+	addDefaults := func(cols []*ast.ColumnDef) {
+		for _, col := range cols {
+			if option := util.GetColumnOption(col, ast.ColumnOptionDefaultValue); option != nil && option.Expr != nil {
+				defaults = append(defaults, columnDefault{col: col, expr: option.Expr})
+			}
+		}
+	}
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		addDefaults(stmt.Cols)
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			addDefaults(spec.NewColumns)
+		}
+		// ALTER COLUMN ... SET DEFAULT 的默认值在字段的第一个选项中，且选项类型未设置
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAlterColumn) {
+			for _, col := range spec.NewColumns {
+				if len(col.Options) > 0 && col.Options[0].Expr != nil {
+					defaults = append(defaults, columnDefault{col: col, expr: col.Options[0].Expr})
+				}
+			}
+		}
+	default:
+		return nil
+	}
+
+	var violations []string
+	for _, d := range defaults {
+		// DATETIME 和 TIMESTAMP 字段默认值为当前时间是常见用法，ALTER COLUMN 时字段类型未知，同样不记录
+		if d.col.Tp == nil || d.col.Tp.Tp == mysql.TypeDatetime || d.col.Tp.Tp == mysql.TypeTimestamp {
+			if fn, ok := d.expr.(*ast.FuncCallExpr); ok && normalizeDefaultFuncName(fn.FnName.L) == "NOW" {
+				continue
+			}
+		}
+		extractor := &funcNameExtractor{}
+		d.expr.Accept(extractor)
+		for _, name := range extractor.names {
+			if nonDeterministicFuncs[normalizeDefaultFuncName(name)] {
+				violations = append(violations, fmt.Sprintf("%s DEFAULT %s", d.col.Name.Name.O, util.ExprFormat(d.expr)))
+				break
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	supported, err := isExpressionDefaultSupported(input.Ctx)
+	if err != nil {
+		return err
+	}
+	if supported {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00243, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// normalizeDefaultFuncName returns the upper case name of the function, the synonyms of NOW are returned as NOW.
+func normalizeDefaultFuncName(name string) string {
+	name = strings.ToUpper(name)
+	switch name {
+	case "CURRENT_TIMESTAMP", "LOCALTIME", "LOCALTIMESTAMP":
+		return "NOW"
+	}
+	return name
+}
+
+// isExpressionDefaultSupported reports whether the target version supports the expression as
+// the default value, it is true if the target version is unknown.
+func isExpressionDefaultSupported(ctx *session.Context) (bool, error) {
+	if ctx == nil {
+		return true, nil
+	}
+	version, err := ctx.GetTargetVersion()
+	if err != nil || version == "" {
+		return true, err
+	}
+	targetVersion, err := session.ParseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	isMariaDB, err := ctx.IsMariaDB()
+	if err != nil {
+		return false, err
+	}
+	if isMariaDB {
+		return !targetVersion.LessThan(semver.MustParse("10.2.1")), nil
+	}
+	return !targetVersion.LessThan(semver.MustParse("8.0.13")), nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,92 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00244 = "SQLE00244"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00244,
+			Desc:       plocale.Rule00244Desc,
+			Annotation: plocale.Rule00244Annotation,
+			Category:   plocale.RuleTypeIndexOptimization,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagQuery.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: false,
+			Version:      2,
+		},
+		Message: plocale.Rule00244Message,
+		Func:    RuleSQLE00244,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00244): "在 MySQL 中，查询的执行计划中不建议出现文件排序和临时表."
+您应遵循以下逻辑：
+1. 对于 "SELECT ..." 语句（包括 UNION），执行以下步骤：
+   1. 使用辅助函数 GetExecutionPlan 获取 SQL 语句的执行计划（执行计划会被缓存，其他规则获取相同 SQL 的执行计划时不会重复执行 EXPLAIN）。
+   2. 对于执行计划中的每一行，如果 Extra 列包含 "Using filesort" 或 "Using temporary"，记录该行的表名及包含的内容。
+2. 如果存在记录的内容，则报告违反规则，提示表名及 Extra 中的文件排序和临时表。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00244(input *rulepkg.RuleHandlerInput) error {
+	switch input.Node.(type) {
+	case *ast.SelectStmt, *ast.UnionStmt:
+	default:
+		return nil
+	}
+
+	explain, err := util.GetExecutionPlan(input.Ctx, input.Node.Text())
+	if err != nil {
+		log.NewEntry().Errorf("get execution plan failed, sqle: %v, error: %v", input.Node.Text(), err)
+		return err
+	}
+
+	var violations []string
+	for _, record := range explain.Plan {
+		var extras []string
+		for _, extra := range []string{executor.ExplainRecordExtraUsingTemporary, executor.ExplainRecordExtraUsingFilesort} {
+			if strings.Contains(record.Extra, extra) {
+				extras = append(extras, extra)
+			}
+		}
+		if len(extras) > 0 {
+			violations = append(violations, fmt.Sprintf("%s(%s)", record.Table, strings.Join(extras, "; ")))
+		}
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00244, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,170 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/charset"
+	"github.com/pingcap/parser/mysql"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00245 = "SQLE00245"
+)
+
+// maxRowSize is the max row size of MySQL tables, which is counted without the BLOB and TEXT columns.
+const maxRowSize = 65535
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00245,
+			Desc:       plocale.Rule00245Desc,
+			Annotation: plocale.Rule00245Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID, plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultMultiParamsFirstKeyName,
+				Value: "1024",
+				Desc:  plocale.Rule00245Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "90",
+				Desc:  plocale.Rule00245Params2,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00245Message,
+		Func:    RuleSQLE00245,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00245): "在 MySQL 中，VARCHAR 字段的长度不建议超过阈值.默认参数描述: VARCHAR 的最大长度, 默认参数值: 1024; 默认参数描述: 行大小达到 65535 字节的百分比时提示, 默认参数值: 90"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，获取所有字段；对于 "ALTER TABLE ..." 语句，获取 ADD/MODIFY/CHANGE COLUMN 中的字段。
+2. 如果字段类型为 VARCHAR 或 VARBINARY，且定义的长度（字段类型的 Flen）大于第一个规则参数，记录该字段。
+3. 如果存在记录的字段，则报告违反规则，提示字段名及类型。
+4. 对于 "CREATE TABLE ..." 语句，估算所有 CHAR、VARCHAR、BINARY、VARBINARY 字段占用的最大字节数之和：
+   1. 字段的字符集依次取字段定义的字符集、表定义的字符集、库的默认字符集，均未知时按 utf8mb4 处理；每个字符占用的最大字节数为字符集的 Maxlen，未知的字符集按 4 字节处理。
+   2. CHAR、BINARY 字段占用 长度*每个字符的最大字节数；VARCHAR、VARBINARY 字段再加上 1 字节（不超过 255 字节时）或 2 字节的长度前缀。
+   3. 如果估算的行大小达到 65535 字节的第二个规则参数百分比，单独添加一条审核结果，提示估算的行大小。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00245(input *rulepkg.RuleHandlerInput) error {
+	maxLength := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsFirstKeyName).Int()
+	rowSizePercent := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).Int()
+
+	var cols []*ast.ColumnDef
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		cols = stmt.Cols
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			cols = append(cols, spec.NewColumns...)
+		}
+	default:
+		return nil
+	}
+
+	var violations []string
+	for _, col := range cols {
+		if col.Tp == nil || col.Tp.Tp != mysql.TypeVarchar || col.Tp.Flen <= maxLength {
+			continue
+		}
+		typeName := "VARCHAR"
+		if col.Tp.Charset == charset.CharsetBin {
+			typeName = "VARBINARY"
+		}
+		violations = append(violations, fmt.Sprintf("%s %s(%d)", col.Name.Name.O, typeName, col.Tp.Flen))
+	}
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00245, strings.Join(violations, ","))
+	}
+
+	// 行大小只检查建表语句，ALTER TABLE 需要结合表中已有的字段
+	stmt, ok := input.Node.(*ast.CreateTableStmt)
+	if !ok || rowSizePercent <= 0 {
+		return nil
+	}
+	tableCharset := ""
+	if option := util.GetTableOption(stmt.Options, ast.TableOptionCharset); option != nil {
+		tableCharset = option.StrValue
+	} else if input.Ctx != nil {
+		schemaCharset, err := input.Ctx.GetSchemaCharacter(stmt.Table, "")
+		if err != nil {
+			return err
+		}
+		tableCharset = schemaCharset
+	}
+	rowSize := 0
+	for _, col := range stmt.Cols {
+		rowSize += estimateStringColumnSize(col, tableCharset)
+	}
+	if rowSize*100 >= maxRowSize*rowSizePercent {
+		input.Res.Add(input.Rule.Level, "", plocale.Bundle.LocalizeAll(plocale.Rule00245RowSizeNearLimit), rowSize)
+	}
+	return nil
+}
+
+// estimateStringColumnSize returns the max bytes of the CHAR, VARCHAR, BINARY and VARBINARY
+// column in a row, it is 0 for the other columns.
+func estimateStringColumnSize(col *ast.ColumnDef, tableCharset string) int {
+	if col.Tp == nil || (col.Tp.Tp != mysql.TypeString && col.Tp.Tp != mysql.TypeVarchar) {
+		return 0
+	}
+	cs := col.Tp.Charset
+	if cs == "" {
+		cs = tableCharset
+	}
+	if cs == "" {
+		cs = charset.CharsetUTF8MB4
+	}
+	// 未知的字符集按 utf8mb4 的 4 字节计算
+	bytesPerChar := 4
+	if desc, err := charset.GetCharsetDesc(strings.ToLower(cs)); err == nil {
+		bytesPerChar = desc.Maxlen
+	}
+	length := col.Tp.Flen
+	if length < 0 {
+		length = 1
+	}
+	size := length * bytesPerChar
+	if col.Tp.Tp == mysql.TypeVarchar {
+		// VARCHAR 的长度前缀
+		if size > 255 {
+			size += 2
+		} else {
+			size++
+		}
+	}
+	return size
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
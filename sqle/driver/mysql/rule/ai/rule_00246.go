@@ -0,0 +1,131 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00246 = "SQLE00246"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00246,
+			Desc:       plocale.Rule00246Desc,
+			Annotation: plocale.Rule00246Annotation,
+			Category:   plocale.RuleTypeDDLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID, plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00246Message,
+		Func:    RuleSQLE00246,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00246): "在 MySQL 中，建议使用 utf8mb4 字符集代替 utf8(utf8mb3)."
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，检查表选项中的字符集和排序规则，以及每个字段定义的字符集和排序规则。
+2. 对于 "ALTER TABLE ..." 语句，检查表选项（包括 CONVERT TO CHARACTER SET）中的字符集和排序规则，以及 ADD/MODIFY/CHANGE COLUMN 中字段定义的字符集和排序规则。
+3. 解析器会将 utf8mb3 转换为 utf8，字符集为 utf8 或排序规则以 utf8_ 开头时，记录该表或字段。
+4. 如果存在记录的内容，则报告违反规则。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00246(input *rulepkg.RuleHandlerInput) error {
+	var violations []string
+	checkTableOptions := func(options []*ast.TableOption) {
+		for _, option := range options {
+			switch {
+			case option.Tp == ast.TableOptionCharset && isUTF8MB3Charset(option.StrValue):
+				if option.UintValue == ast.TableOptionCharsetWithConvertTo {
+					violations = append(violations, fmt.Sprintf("CONVERT TO CHARACTER SET %s", option.StrValue))
+				} else {
+					violations = append(violations, fmt.Sprintf("TABLE CHARSET %s", option.StrValue))
+				}
+			case option.Tp == ast.TableOptionCollate && isUTF8MB3Collation(option.StrValue):
+				violations = append(violations, fmt.Sprintf("TABLE COLLATE %s", option.StrValue))
+			}
+		}
+	}
+	checkColumns := func(cols []*ast.ColumnDef) {
+		for _, col := range cols {
+			if col.Tp == nil {
+				continue
+			}
+			if isUTF8MB3Charset(col.Tp.Charset) {
+				violations = append(violations, fmt.Sprintf("COLUMN %s CHARSET %s", col.Name.Name.O, col.Tp.Charset))
+				continue
+			}
+			collations := []string{col.Tp.Collate}
+			for _, option := range col.Options {
+				if option.Tp == ast.ColumnOptionCollate {
+					collations = append(collations, option.StrValue)
+				}
+			}
+			for _, collation := range collations {
+				if isUTF8MB3Collation(collation) {
+					violations = append(violations, fmt.Sprintf("COLUMN %s COLLATE %s", col.Name.Name.O, collation))
+					break
+				}
+			}
+		}
+	}
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		checkTableOptions(stmt.Options)
+		checkColumns(stmt.Cols)
+	case *ast.AlterTableStmt:
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableOption) {
+			checkTableOptions(spec.Options)
+		}
+		for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableAddColumns, ast.AlterTableModifyColumn, ast.AlterTableChangeColumn) {
+			checkColumns(spec.NewColumns)
+		}
+	default:
+		return nil
+	}
+
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00246, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// isUTF8MB3Charset reports whether the charset is utf8mb3, the parser converts utf8mb3 to utf8.
+func isUTF8MB3Charset(charset string) bool {
+	return strings.EqualFold(charset, "utf8") || strings.EqualFold(charset, "utf8mb3")
+}
+
+// isUTF8MB3Collation reports whether the collation belongs to utf8mb3, e.g. utf8_general_ci.
+func isUTF8MB3Collation(collation string) bool {
+	collation = strings.ToLower(collation)
+	return strings.HasPrefix(collation, "utf8_") || strings.HasPrefix(collation, "utf8mb3_")
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -0,0 +1,167 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	mysqlUtil "github.com/actiontech/sqle/sqle/driver/mysql/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00247 = "SQLE00247"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00247,
+			Desc:       plocale.Rule00247Desc,
+			Annotation: plocale.Rule00247Annotation,
+			Category:   plocale.RuleTypeIndexOptimization,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagIndex.ID, plocale.RuleTagJoin.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDML.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: false,
+			Version:      2,
+		},
+		Message: plocale.Rule00247Message,
+		Func:    RuleSQLE00247,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00247): "在 MySQL 中，JOIN 的关联字段应该有索引."
+您应遵循以下逻辑：
+1. 对于 "SELECT ..."、"UPDATE ..."、"DELETE ..." 语句，获取语句中所有的 JOIN 节点，包括子查询中的 JOIN 节点。
+2. 对于每个 JOIN 节点，确定被驱动（被查找）的一侧：RIGHT JOIN 为左侧的表，其他 JOIN 为右侧的表。
+3. 对于 ON 条件中两侧均为字段的等值条件，取属于被驱动一侧的表的字段；对于 USING 条件，取被驱动一侧的表中的同名字段。
+4. 使用辅助函数GetCreateTableStmt获取该表的建表语句，检查该字段是否为主键、唯一键，或者是某个索引的第一个字段。
+5. 如果字段没有可以使用的索引，记录该表及字段，如果存在记录的字段，则报告违反规则。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00247(input *rulepkg.RuleHandlerInput) error {
+	switch input.Node.(type) {
+	case *ast.SelectStmt, *ast.UnionStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+	default:
+		return nil
+	}
+
+	var violations []string
+	reported := map[string]struct{}{}
+	checkColumn := func(table *ast.TableName, column string) {
+		key := fmt.Sprintf("%s.%s", table.Name.O, column)
+		if _, ok := reported[strings.ToLower(key)]; ok {
+			return
+		}
+		createTableStmt, err := util.GetCreateTableStmt(input.Ctx, table)
+		if err != nil {
+			log.NewEntry().Errorf("获取表 %s 的CREATE TABLE语句失败: %v", table.Name.O, err)
+			return
+		}
+		if isJoinColumnIndexed(createTableStmt, column) {
+			return
+		}
+		reported[strings.ToLower(key)] = struct{}{}
+		violations = append(violations, key)
+	}
+
+	for _, join := range util.GetAllJoinsFromNode(input.Node) {
+		if join.Right == nil || (join.On == nil && len(join.Using) == 0) {
+			continue
+		}
+		// 被驱动的一侧，RIGHT JOIN 由右侧的表驱动左侧的表
+		lookupNode := join.Right
+		if join.Tp == ast.RightJoin {
+			lookupNode = join.Left
+		}
+		lookupTables := getJoinSideTables(lookupNode)
+
+		if join.On != nil {
+			visitor := &mysqlUtil.EqualConditionVisitor{}
+			join.On.Expr.Accept(visitor)
+			for _, cond := range visitor.ConditionList {
+				leftTable, leftOk := lookupTables[cond.Left.Table.L]
+				rightTable, rightOk := lookupTables[cond.Right.Table.L]
+				switch {
+				case leftOk && !rightOk:
+					checkColumn(leftTable, cond.Left.Name.O)
+				case rightOk && !leftOk:
+					checkColumn(rightTable, cond.Right.Name.O)
+				}
+			}
+		}
+		if len(lookupTables) == 1 {
+			for _, table := range lookupTables {
+				for _, col := range join.Using {
+					checkColumn(table, col.Name.O)
+				}
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00247, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// getJoinSideTables returns the tables of one side of the join, the key is the table alias or the table name.
+func getJoinSideTables(node ast.ResultSetNode) map[string]*ast.TableName {
+	var sources []*ast.TableSource
+	switch n := node.(type) {
+	case *ast.TableSource:
+		sources = append(sources, n)
+	case *ast.Join:
+		sources = util.GetTableSourcesFromJoin(n)
+	}
+	tables := map[string]*ast.TableName{}
+	for _, source := range sources {
+		tableName, ok := source.Source.(*ast.TableName)
+		if !ok {
+			continue
+		}
+		if source.AsName.L != "" {
+			tables[source.AsName.L] = tableName
+		} else {
+			tables[tableName.Name.L] = tableName
+		}
+	}
+	return tables
+}
+
+// isJoinColumnIndexed reports whether the column is the primary key, a unique key or the first column of an index.
+func isJoinColumnIndexed(stmt *ast.CreateTableStmt, column string) bool {
+	for _, col := range stmt.Cols {
+		if !strings.EqualFold(col.Name.Name.L, column) {
+			continue
+		}
+		for _, option := range col.Options {
+			if option.Tp == ast.ColumnOptionPrimaryKey || option.Tp == ast.ColumnOptionUniqKey {
+				return true
+			}
+		}
+	}
+	return mysqlUtil.IsIndex(map[string]struct{}{strings.ToLower(column): {}}, stmt.Constraints)
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
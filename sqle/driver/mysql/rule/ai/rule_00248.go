@@ -0,0 +1,271 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/opcode"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00248 = "SQLE00248"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00248,
+			Desc:       plocale.Rule00248Desc,
+			Annotation: plocale.Rule00248Annotation,
+			Category:   plocale.RuleTypeIndexOptimization,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDML.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagPerformance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelNotice,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: false,
+			Version:      2,
+		},
+		Message: plocale.Rule00248Message,
+		Func:    RuleSQLE00248,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00248): "在 MySQL 中，联合索引中等值条件的字段应该在范围条件和排序的字段之前."
+您应遵循以下逻辑：
+1. 对于 "SELECT ..." 语句（包括子查询和 UNION 中的 SELECT），只检查 FROM 子句中只有一张表的查询。
+2. 从 WHERE 子句中以 AND 连接的条件中获取字段：
+   1. 字段与常量的等值比较（=）或 IS NULL，记为等值字段。
+   2. 字段与常量的范围比较（>、>=、<、<=）、BETWEEN、LIKE，记为范围字段。
+3. 获取 ORDER BY 子句中的字段，记为排序字段，已经是等值字段的除外。
+4. 使用辅助函数GetCreateTableStmt获取表的建表语句中的所有联合索引，对于每个联合索引：
+   1. 从第一个字段开始，取连续出现在查询的等值、范围或排序字段中的字段，作为可以使用的前缀。
+   2. 如果前缀中有等值字段在范围字段或排序字段之后，则该索引的字段顺序不合理，建议的顺序为：前缀中的等值字段在前，其余字段保持原有的顺序在后。
+5. 如果表中已经存在以所有等值字段开头的索引，则不需要调整索引，不报告违反规则。
+6. 如果存在字段顺序不合理的索引，则报告违反规则，提示索引名、现有的字段顺序及建议的字段顺序。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00248(input *rulepkg.RuleHandlerInput) error {
+	switch input.Node.(type) {
+	case *ast.SelectStmt, *ast.UnionStmt:
+	default:
+		return nil
+	}
+
+	var violations []string
+	for _, selectStmt := range util.GetSelectStmt(input.Node) {
+		if selectStmt.From == nil || selectStmt.From.TableRefs == nil || selectStmt.From.TableRefs.Right != nil {
+			continue
+		}
+		source, ok := selectStmt.From.TableRefs.Left.(*ast.TableSource)
+		if !ok {
+			continue
+		}
+		table, ok := source.Source.(*ast.TableName)
+		if !ok {
+			continue
+		}
+		tableAlias := table.Name.L
+		if source.AsName.L != "" {
+			tableAlias = source.AsName.L
+		}
+		belongsToTable := func(col *ast.ColumnName) bool {
+			return col.Table.L == "" || col.Table.L == tableAlias
+		}
+
+		// 字段名 => 是否为等值字段
+		queryColumns := map[string]bool{}
+		if selectStmt.Where != nil {
+			for _, expr := range splitAndConditions(selectStmt.Where) {
+				col, isEqual := getIndexConditionColumn(expr)
+				if col == nil || !belongsToTable(col) {
+					continue
+				}
+				queryColumns[col.Name.L] = queryColumns[col.Name.L] || isEqual
+			}
+		}
+		if selectStmt.OrderBy != nil {
+			for _, item := range selectStmt.OrderBy.Items {
+				col, ok := item.Expr.(*ast.ColumnNameExpr)
+				if !ok || !belongsToTable(col.Name) {
+					continue
+				}
+				if _, ok := queryColumns[col.Name.Name.L]; !ok {
+					queryColumns[col.Name.Name.L] = false
+				}
+			}
+		}
+		var equalColumns []string
+		for col, isEqual := range queryColumns {
+			if isEqual {
+				equalColumns = append(equalColumns, col)
+			}
+		}
+		if len(equalColumns) == 0 {
+			continue
+		}
+
+		createTableStmt, err := util.GetCreateTableStmt(input.Ctx, table)
+		if err != nil {
+			log.NewEntry().Errorf("获取表 %s 的CREATE TABLE语句失败: %v", table.Name.O, err)
+			continue
+		}
+		indexes := util.GetTableConstraints(createTableStmt.Constraints, util.GetIndexConstraintTypes()...)
+		if hasIndexStartingWithColumns(indexes, equalColumns) {
+			continue
+		}
+		for _, index := range indexes {
+			if len(index.Keys) < 2 {
+				continue
+			}
+			var columns []string
+			for _, key := range index.Keys {
+				if key.Column == nil {
+					break
+				}
+				columns = append(columns, key.Column.Name.O)
+			}
+			suggested, ok := reorderIndexColumns(columns, queryColumns)
+			if !ok {
+				continue
+			}
+			indexName := index.Name
+			if indexName == "" {
+				indexName = "PRIMARY"
+			}
+			violations = append(violations, fmt.Sprintf("%s.%s(%s)->(%s)", table.Name.O, indexName,
+				strings.Join(columns, ","), strings.Join(suggested, ",")))
+		}
+	}
+
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00248, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// splitAndConditions splits the expression by AND into the conditions.
+func splitAndConditions(expr ast.ExprNode) []ast.ExprNode {
+	switch e := expr.(type) {
+	case *ast.BinaryOperationExpr:
+		if e.Op == opcode.LogicAnd {
+			return append(splitAndConditions(e.L), splitAndConditions(e.R)...)
+		}
+	case *ast.ParenthesesExpr:
+		return splitAndConditions(e.Expr)
+	}
+	return []ast.ExprNode{expr}
+}
+
+// getIndexConditionColumn returns the column compared with the constants in the condition,
+// isEqual is true for the equality conditions and false for the range conditions.
+func getIndexConditionColumn(expr ast.ExprNode) (col *ast.ColumnName, isEqual bool) {
+	isConstant := func(e ast.ExprNode) bool {
+		return len(util.GetColumnNameInExpr(e)) == 0 && len(util.GetSubquery(e)) == 0
+	}
+	switch e := expr.(type) {
+	case *ast.BinaryOperationExpr:
+		switch e.Op {
+		case opcode.EQ, opcode.NullEQ, opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+		default:
+			return nil, false
+		}
+		isEqual = e.Op == opcode.EQ || e.Op == opcode.NullEQ
+		if c, ok := e.L.(*ast.ColumnNameExpr); ok && isConstant(e.R) {
+			return c.Name, isEqual
+		}
+		if c, ok := e.R.(*ast.ColumnNameExpr); ok && isConstant(e.L) {
+			return c.Name, isEqual
+		}
+	case *ast.IsNullExpr:
+		if c, ok := e.Expr.(*ast.ColumnNameExpr); ok && !e.Not {
+			return c.Name, true
+		}
+	case *ast.BetweenExpr:
+		if c, ok := e.Expr.(*ast.ColumnNameExpr); ok && !e.Not && isConstant(e.Left) && isConstant(e.Right) {
+			return c.Name, false
+		}
+	case *ast.PatternLikeExpr:
+		if c, ok := e.Expr.(*ast.ColumnNameExpr); ok && !e.Not && isConstant(e.Pattern) {
+			return c.Name, false
+		}
+	}
+	return nil, false
+}
+
+// hasIndexStartingWithColumns reports whether any index starts with all the columns, in any order.
+func hasIndexStartingWithColumns(indexes []*ast.Constraint, columns []string) bool {
+	for _, index := range indexes {
+		if len(index.Keys) < len(columns) {
+			continue
+		}
+		prefix := map[string]struct{}{}
+		for _, key := range index.Keys[:len(columns)] {
+			if key.Column != nil {
+				prefix[key.Column.Name.L] = struct{}{}
+			}
+		}
+		matched := true
+		for _, col := range columns {
+			if _, ok := prefix[col]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// reorderIndexColumns moves the equality columns to the front of the prefix of the index which is
+// used by the query, ok is false if no equality column follows a range or order column in the prefix.
+func reorderIndexColumns(columns []string, queryColumns map[string]bool) (suggested []string, ok bool) {
+	prefixLen := 0
+	for _, col := range columns {
+		if _, used := queryColumns[strings.ToLower(col)]; !used {
+			break
+		}
+		prefixLen++
+	}
+	var equalColumns, otherColumns []string
+	for _, col := range columns[:prefixLen] {
+		if queryColumns[strings.ToLower(col)] {
+			if len(otherColumns) > 0 {
+				ok = true
+			}
+			equalColumns = append(equalColumns, col)
+		} else {
+			otherColumns = append(otherColumns, col)
+		}
+	}
+	if !ok {
+		return nil, false
+	}
+	suggested = append(suggested, equalColumns...)
+	suggested = append(suggested, otherColumns...)
+	suggested = append(suggested, columns[prefixLen:]...)
+	return suggested, true
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
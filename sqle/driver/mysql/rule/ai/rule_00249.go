@@ -0,0 +1,138 @@
+package ai
+
+import (
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	mysqlUtil "github.com/actiontech/sqle/sqle/driver/mysql/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00249 = "SQLE00249"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00249,
+			Desc:       plocale.Rule00249Desc,
+			Annotation: plocale.Rule00249Annotation,
+			Category:   plocale.RuleTypeUsageSuggestion,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTrigger.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagMaintenance.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "",
+				Desc:  plocale.Rule00249Params1,
+				Type:  params.ParamTypeString,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00249Message,
+		Func:    RuleSQLE00249,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00249): "在 MySQL 中，不建议在触发器中修改其他表的数据.默认参数描述: 允许修改的表(如审计日志表)，多个表名用逗号分隔, 默认参数值: "
+您应遵循以下逻辑：
+1. 对于 "CREATE TRIGGER ..." 语句（解析器不支持，为 UnparsedStmt），使用辅助函数 util.ParseTriggerStmt 解析触发器，获取触发器所在的表及触发器体中的语句。
+2. 对于触发器体中的 INSERT、REPLACE、UPDATE、DELETE 语句，获取被修改的表：
+   1. INSERT、REPLACE 语句为插入数据的表。
+   2. UPDATE 语句为 UPDATE 子句中的所有表。
+   3. DELETE 语句为删除数据的表，多表删除时为 DELETE 与 FROM 之间的表。
+3. 如果被修改的表与触发器所在的表不同（表名不区分大小写，均指定了库名时比较库名），且不在规则参数的表中，记录该表。
+4. 如果存在记录的表，则报告违反规则，提示被修改的表。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00249(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.UnparsedStmt)
+	if !ok {
+		return nil
+	}
+	trigger, ok := mysqlUtil.ParseTriggerStmt(stmt.Text())
+	if !ok {
+		return nil
+	}
+	exemptTables := map[string]struct{}{}
+	for _, name := range strings.Split(input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).String(), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			exemptTables[strings.ToLower(name)] = struct{}{}
+		}
+	}
+
+	var otherTables []string
+	reported := map[string]struct{}{}
+	for _, bodyStmt := range trigger.BodyStmts {
+		for _, table := range getDMLTargetTables(bodyStmt) {
+			if strings.EqualFold(table.Name.O, trigger.Table) &&
+				(table.Schema.O == "" || trigger.TableSchema == "" || strings.EqualFold(table.Schema.O, trigger.TableSchema)) {
+				continue
+			}
+			name := table.Name.O
+			if table.Schema.O != "" {
+				name = table.Schema.O + "." + name
+			}
+			if _, ok := exemptTables[strings.ToLower(table.Name.O)]; ok {
+				continue
+			}
+			if _, ok := exemptTables[strings.ToLower(name)]; ok {
+				continue
+			}
+			if _, ok := reported[strings.ToLower(name)]; ok {
+				continue
+			}
+			reported[strings.ToLower(name)] = struct{}{}
+			otherTables = append(otherTables, name)
+		}
+	}
+	if len(otherTables) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00249, strings.Join(otherTables, ","))
+	}
+	return nil
+}
+
+// getDMLTargetTables returns the tables whose data are modified by the INSERT, REPLACE, UPDATE or DELETE statement.
+func getDMLTargetTables(node ast.Node) []*ast.TableName {
+	switch stmt := node.(type) {
+	case *ast.InsertStmt:
+		if stmt.Table != nil {
+			return util.GetTableNames(stmt.Table)
+		}
+	case *ast.UpdateStmt:
+		if stmt.TableRefs != nil {
+			return util.GetTableNames(stmt.TableRefs)
+		}
+	case *ast.DeleteStmt:
+		if stmt.IsMultiTable && stmt.Tables != nil {
+			return stmt.Tables.Tables
+		}
+		if stmt.TableRefs != nil {
+			return util.GetTableNames(stmt.TableRefs)
+		}
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
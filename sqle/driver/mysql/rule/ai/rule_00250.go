@@ -0,0 +1,189 @@
+package ai
+
+import (
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00250 = "SQLE00250"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00250,
+			Desc:       plocale.Rule00250Desc,
+			Annotation: plocale.Rule00250Annotation,
+			Category:   plocale.RuleTypeDMLConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDML.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID, plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelError,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00250Message,
+		Func:    RuleSQLE00250,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00250): "在 MySQL 中，INSERT ... SELECT 的插入字段数应该与查询字段数一致."
+您应遵循以下逻辑：
+1. 对于 "INSERT ... SELECT ..." 和 "REPLACE ... SELECT ..." 语句，获取插入字段数：
+   1. 如果指定了插入的字段列表，插入字段数为字段列表的长度。
+   2. 否则，使用辅助函数GetCreateTableStmt获取目标表的建表语句，插入字段数为表的字段数。
+2. 获取查询字段数，UNION 语句取第一个 SELECT 的字段：
+   1. 非 * 的字段，每个计为 1。
+   2. 对于 * 或 t.*，使用辅助函数GetCreateTableStmt获取 FROM 子句中对应的表的字段数；对于子查询，递归计算子查询的字段数。
+   3. 如果 FROM 子句中存在 JOIN ... USING 或 NATURAL JOIN，* 中合并的字段只出现一次，无法仅通过表的字段数计算，跳过检查。
+3. 如果无法获取插入字段数或查询字段数（如离线审核时表结构未知），则跳过检查。
+4. 如果插入字段数与查询字段数不一致，则报告违反规则，提示插入字段数和查询字段数。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00250(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.InsertStmt)
+	if !ok || stmt.Select == nil {
+		return nil
+	}
+
+	targetCount := len(stmt.Columns)
+	if targetCount == 0 {
+		if stmt.Table == nil {
+			return nil
+		}
+		tables := util.GetTableNames(stmt.Table)
+		if len(tables) == 0 {
+			return nil
+		}
+		createTableStmt, exist, err := getCreateTableStmtIfExist(input.Ctx, tables[0])
+		if err != nil || !exist {
+			return err
+		}
+		targetCount = len(createTableStmt.Cols)
+	}
+
+	sourceCount, ok, err := countResultSetFields(input.Ctx, stmt.Select)
+	if err != nil || !ok {
+		return err
+	}
+	if targetCount != sourceCount {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00250, targetCount, sourceCount)
+	}
+	return nil
+}
+
+// getCreateTableStmtIfExist is like util.GetCreateTableStmt, but exist is false rather than an error
+// if the table is unknown, e.g. in offline audit.
+func getCreateTableStmtIfExist(ctx *session.Context, table *ast.TableName) (*ast.CreateTableStmt, bool, error) {
+	if ctx == nil {
+		return nil, false, nil
+	}
+	return ctx.GetCreateTableStmt(table)
+}
+
+// countResultSetFields returns the number of fields of the SELECT, UNION or subquery, ok is false
+// if the columns of the table selected by "*" are unknown.
+func countResultSetFields(ctx *session.Context, node ast.ResultSetNode) (count int, ok bool, err error) {
+	switch stmt := node.(type) {
+	case *ast.SelectStmt:
+		return countSelectFields(ctx, stmt)
+	case *ast.UnionStmt:
+		if stmt.SelectList == nil || len(stmt.SelectList.Selects) == 0 {
+			return 0, false, nil
+		}
+		return countSelectFields(ctx, stmt.SelectList.Selects[0])
+	case *ast.TableSource:
+		return countResultSetFields(ctx, stmt.Source)
+	default:
+		return 0, false, nil
+	}
+}
+
+func countSelectFields(ctx *session.Context, stmt *ast.SelectStmt) (count int, ok bool, err error) {
+	if stmt.Fields == nil {
+		return 0, false, nil
+	}
+	for _, field := range stmt.Fields.Fields {
+		if field.WildCard == nil {
+			count++
+			continue
+		}
+		if stmt.From == nil || stmt.From.TableRefs == nil {
+			return 0, false, nil
+		}
+		// USING 和 NATURAL JOIN 的连接字段在 * 中只出现一次
+		if field.WildCard.Table.L == "" && hasMergedJoinColumns(stmt.From.TableRefs) {
+			return 0, false, nil
+		}
+		for _, source := range util.GetTableSourcesFromJoin(stmt.From.TableRefs) {
+			if field.WildCard.Table.L != "" && !isWildCardOfTableSource(field.WildCard, source) {
+				continue
+			}
+			var n int
+			switch s := source.Source.(type) {
+			case *ast.TableName:
+				createTableStmt, exist, err := getCreateTableStmtIfExist(ctx, s)
+				if err != nil || !exist {
+					return 0, false, err
+				}
+				n = len(createTableStmt.Cols)
+			default:
+				n, ok, err = countResultSetFields(ctx, s)
+				if err != nil || !ok {
+					return 0, false, err
+				}
+			}
+			count += n
+		}
+	}
+	return count, true, nil
+}
+
+// hasMergedJoinColumns reports whether the join, or its nested join, is joined by USING or NATURAL JOIN.
+func hasMergedJoinColumns(join *ast.Join) bool {
+	if join == nil {
+		return false
+	}
+	if join.NaturalJoin || len(join.Using) > 0 {
+		return true
+	}
+	for _, node := range []ast.ResultSetNode{join.Left, join.Right} {
+		if j, ok := node.(*ast.Join); ok && hasMergedJoinColumns(j) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWildCardOfTableSource reports whether "t.*" selects the table source, which is matched by its alias or table name.
+func isWildCardOfTableSource(wildCard *ast.WildCardField, source *ast.TableSource) bool {
+	if source.AsName.L != "" {
+		return wildCard.Schema.L == "" && wildCard.Table.L == source.AsName.L
+	}
+	table, ok := source.Source.(*ast.TableName)
+	if !ok {
+		return false
+	}
+	return wildCard.Table.L == table.Name.L && (wildCard.Schema.L == "" || table.Schema.L == "" || wildCard.Schema.L == table.Schema.L)
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
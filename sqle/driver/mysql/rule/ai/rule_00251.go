@@ -0,0 +1,198 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/pkg/params"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00251 = "SQLE00251"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00251,
+			Desc:       plocale.Rule00251Desc,
+			Annotation: plocale.Rule00251Annotation,
+			Category:   plocale.RuleTypeNamingConvention,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagTable.ID, plocale.RuleTagColumn.ID, plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagMaintenance.ID, plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOffline.ID, plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level: driverV2.RuleLevelWarn,
+			Params: []*rulepkg.SourceParam{{
+				Key:   rulepkg.DefaultSingleParamKeyName,
+				Value: "64",
+				Desc:  plocale.Rule00251Params1,
+				Type:  params.ParamTypeInt,
+				Enums: nil,
+			}, {
+				Key:   rulepkg.DefaultMultiParamsSecondKeyName,
+				Value: "false",
+				Desc:  plocale.Rule00251Params2,
+				Type:  params.ParamTypeBool,
+				Enums: nil,
+			}},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: true,
+			Version:      2,
+		},
+		Message: plocale.Rule00251Message,
+		Func:    RuleSQLE00251,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00251): "在 MySQL 中，标识符长度不应超过规则参数.默认参数描述: 标识符的最大长度(字符), 默认参数值: 64; 默认参数描述: 是否检查同一张表中仅大小写不同的名称, 默认参数值: false"
+您应遵循以下逻辑：
+1. 对于 "CREATE TABLE ..." 语句，检查表名、列名、索引名和约束名（主键的名称固定为 PRIMARY，不检查）。
+2. 对于 "ALTER TABLE ..." 语句，检查 RENAME TABLE 的新表名，ADD COLUMN、CHANGE COLUMN、RENAME COLUMN 的新列名，ADD INDEX、ADD CONSTRAINT 的名称，以及 RENAME INDEX 的新索引名。
+3. 对于 "CREATE INDEX ..." 语句，检查索引名。
+4. 如果标识符的字符数大于第一个规则参数，则报告违反规则，提示标识符及其长度。
+5. 如果第二个规则参数为 true，检查同一张表中的列名、索引名、外键和检查约束的名称是否仅大小写不同：
+   1. 对于 "CREATE TABLE ..." 语句，在语句中定义的名称之间检查。
+   2. 对于 "ALTER TABLE ..." 和 "CREATE INDEX ..." 语句，使用辅助函数GetCreateTableStmt获取表中已有的名称（离线审核时无法获取，只在语句中定义的名称之间检查），
+      并按子句的顺序去掉 DROP COLUMN、CHANGE COLUMN、RENAME COLUMN、DROP INDEX、RENAME INDEX 等子句删除或重命名的名称，再加入新定义的名称进行检查。
+   3. 如果存在仅大小写不同的名称，则报告违反规则，提示这些名称。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00251(input *rulepkg.RuleHandlerInput) error {
+	maxLength := input.Rule.Params.GetParam(rulepkg.DefaultSingleParamKeyName).Int()
+	checkCase := input.Rule.Params.GetParam(rulepkg.DefaultMultiParamsSecondKeyName).Bool()
+
+	var violations []string
+	checkLength := func(name string) {
+		if length := utf8.RuneCountInString(name); maxLength > 0 && length > maxLength {
+			violations = append(violations, fmt.Sprintf("%s(%d)", name, length))
+		}
+	}
+	// 同一张表中的列名、索引名和约束名，小写名称 -> 名称，MySQL 中这些名称不区分大小写
+	columns, indexes, constraints := map[string]string{}, map[string]string{}, map[string]string{}
+	addName := func(names map[string]string, name string) {
+		if name == "" {
+			return
+		}
+		if exist, ok := names[strings.ToLower(name)]; ok && exist != name && checkCase {
+			violations = append(violations, fmt.Sprintf("%s/%s", exist, name))
+		}
+		names[strings.ToLower(name)] = name
+	}
+	delName := func(names map[string]string, name string) {
+		delete(names, strings.ToLower(name))
+	}
+	addConstraint := func(constraint *ast.Constraint) {
+		switch constraint.Tp {
+		case ast.ConstraintPrimaryKey:
+		case ast.ConstraintForeignKey, ast.ConstraintCheck:
+			checkLength(constraint.Name)
+			addName(constraints, constraint.Name)
+		default:
+			checkLength(constraint.Name)
+			addName(indexes, constraint.Name)
+		}
+	}
+	// 加载表中已有的名称，离线审核时跳过
+	loadTable := func(table *ast.TableName) error {
+		createTableStmt, exist, err := getCreateTableStmtIfExist(input.Ctx, table)
+		if err != nil || !exist {
+			return err
+		}
+		for _, col := range createTableStmt.Cols {
+			columns[col.Name.Name.L] = col.Name.Name.O
+		}
+		for _, constraint := range createTableStmt.Constraints {
+			switch constraint.Tp {
+			case ast.ConstraintPrimaryKey:
+			case ast.ConstraintForeignKey, ast.ConstraintCheck:
+				constraints[strings.ToLower(constraint.Name)] = constraint.Name
+			default:
+				indexes[strings.ToLower(constraint.Name)] = constraint.Name
+			}
+		}
+		return nil
+	}
+
+	switch stmt := input.Node.(type) {
+	case *ast.CreateTableStmt:
+		checkLength(stmt.Table.Name.O)
+		for _, col := range stmt.Cols {
+			checkLength(col.Name.Name.O)
+			addName(columns, col.Name.Name.O)
+		}
+		for _, constraint := range stmt.Constraints {
+			addConstraint(constraint)
+		}
+	case *ast.AlterTableStmt:
+		if err := loadTable(stmt.Table); err != nil {
+			return err
+		}
+		for _, spec := range stmt.Specs {
+			switch spec.Tp {
+			case ast.AlterTableRenameTable:
+				checkLength(spec.NewTable.Name.O)
+			case ast.AlterTableAddColumns, ast.AlterTableChangeColumn:
+				if spec.OldColumnName != nil {
+					delName(columns, spec.OldColumnName.Name.O)
+				}
+				for _, col := range spec.NewColumns {
+					checkLength(col.Name.Name.O)
+					addName(columns, col.Name.Name.O)
+				}
+				for _, constraint := range spec.NewConstraints {
+					addConstraint(constraint)
+				}
+			case ast.AlterTableRenameColumn:
+				delName(columns, spec.OldColumnName.Name.O)
+				checkLength(spec.NewColumnName.Name.O)
+				addName(columns, spec.NewColumnName.Name.O)
+			case ast.AlterTableDropColumn:
+				delName(columns, spec.OldColumnName.Name.O)
+			case ast.AlterTableAddConstraint:
+				addConstraint(spec.Constraint)
+			case ast.AlterTableDropIndex:
+				delName(indexes, spec.Name)
+			case ast.AlterTableDropForeignKey:
+				delName(constraints, spec.Name)
+			case ast.AlterTableDropCheck:
+				delName(constraints, spec.Constraint.Name)
+			case ast.AlterTableRenameIndex:
+				delName(indexes, spec.FromKey.O)
+				checkLength(spec.ToKey.O)
+				addName(indexes, spec.ToKey.O)
+			}
+		}
+	case *ast.CreateIndexStmt:
+		if err := loadTable(stmt.Table); err != nil {
+			return err
+		}
+		checkLength(stmt.IndexName)
+		addName(indexes, stmt.IndexName)
+	default:
+		return nil
+	}
+
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00251, maxLength, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
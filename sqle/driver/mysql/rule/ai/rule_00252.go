@@ -0,0 +1,152 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	util "github.com/actiontech/sqle/sqle/driver/mysql/rule/ai/util"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/actiontech/sqle/sqle/log"
+	"github.com/pingcap/parser/ast"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+)
+
+const (
+	SQLE00252 = "SQLE00252"
+)
+
+func init() {
+	rh := rulepkg.SourceHandler{
+		Rule: rulepkg.SourceRule{
+			Name:       SQLE00252,
+			Desc:       plocale.Rule00252Desc,
+			Annotation: plocale.Rule00252Annotation,
+			Category:   plocale.RuleTypeUsageSuggestion,
+			CategoryTags: map[string][]string{
+				plocale.RuleCategoryOperand.ID:              {plocale.RuleTagColumn.ID, plocale.RuleTagIndex.ID},
+				plocale.RuleCategorySQL.ID:                  {plocale.RuleTagDDL.ID},
+				plocale.RuleCategoryAuditPurpose.ID:         {plocale.RuleTagCorrection.ID},
+				plocale.RuleCategoryAuditAccuracy.ID:        {plocale.RuleTagOnline.ID},
+				plocale.RuleCategoryAuditPerformanceCost.ID: {},
+			},
+			Level:        driverV2.RuleLevelWarn,
+			Params:       []*rulepkg.SourceParam{},
+			Knowledge:    driverV2.RuleKnowledge{},
+			AllowOffline: false,
+			Version:      2,
+		},
+		Message: plocale.Rule00252Message,
+		Func:    RuleSQLE00252,
+	}
+	sourceRuleHandlers = append(sourceRuleHandlers, &rh)
+}
+
+/*
+==== Prompt start ====
+在 MySQL 中，您应该检查 SQL 是否违反了规则(SQLE00252): "在 MySQL 中，删除列之前应该先删除或修改引用该列的索引和外键."
+您应遵循以下逻辑：
+1. 对于 "ALTER TABLE ... DROP COLUMN ..." 语句，使用辅助函数GetCreateTableStmt获取表的建表语句。
+2. 记录同一语句中 DROP PRIMARY KEY、DROP INDEX、DROP FOREIGN KEY 子句删除的索引和外键，这些索引和外键不检查。
+3. 对于每个删除的列，检查建表语句中的主键、唯一键、普通索引、全文索引、空间索引和外键：
+   1. 如果索引或外键的字段（包括函数索引表达式中的字段）包含该列，记录该索引或外键的名称，主键的名称为 PRIMARY。
+   2. 如果列定义了 PRIMARY KEY 或 UNIQUE 列约束，同样记录主键或唯一键的名称。
+4. 如果存在记录的索引或外键，则报告违反规则，提示删除的列及引用该列的索引和外键。
+==== Prompt end ====
+*/
+
+// ==== Rule code start ====
+// 规则函数实现开始
+func RuleSQLE00252(input *rulepkg.RuleHandlerInput) error {
+	stmt, ok := input.Node.(*ast.AlterTableStmt)
+	if !ok {
+		return nil
+	}
+	dropColumnSpecs := util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableDropColumn)
+	if len(dropColumnSpecs) == 0 {
+		return nil
+	}
+
+	createTableStmt, err := util.GetCreateTableStmt(input.Ctx, stmt.Table)
+	if err != nil {
+		log.NewEntry().Errorf("获取表 %s 的CREATE TABLE语句失败: %v", stmt.Table.Name.O, err)
+		return nil
+	}
+
+	// 同一语句中删除的索引和外键，小写名称
+	droppedNames := map[string]struct{}{}
+	for _, spec := range util.GetAlterTableCommandsByTypes(stmt, ast.AlterTableDropPrimaryKey, ast.AlterTableDropIndex, ast.AlterTableDropForeignKey) {
+		if spec.Tp == ast.AlterTableDropPrimaryKey {
+			droppedNames["primary"] = struct{}{}
+			continue
+		}
+		droppedNames[strings.ToLower(spec.Name)] = struct{}{}
+	}
+
+	var violations []string
+	for _, spec := range dropColumnSpecs {
+		column := spec.OldColumnName.Name
+		var names []string
+		addName := func(name string) {
+			if _, ok := droppedNames[strings.ToLower(name)]; !ok {
+				names = append(names, name)
+			}
+		}
+		for _, col := range createTableStmt.Cols {
+			if col.Name.Name.L != column.L {
+				continue
+			}
+			for _, option := range col.Options {
+				switch option.Tp {
+				case ast.ColumnOptionPrimaryKey:
+					addName("PRIMARY")
+				case ast.ColumnOptionUniqKey:
+					addName(col.Name.Name.O)
+				}
+			}
+		}
+		for _, constraint := range createTableStmt.Constraints {
+			if isColumnInConstraint(constraint, column.L) {
+				addName(getConstraintName(constraint))
+			}
+		}
+		if len(names) > 0 {
+			violations = append(violations, fmt.Sprintf("%s(%s)", column.O, strings.Join(names, ",")))
+		}
+	}
+
+	if len(violations) > 0 {
+		rulepkg.AddResult(input.Res, input.Rule, SQLE00252, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// isColumnInConstraint reports whether the column is a key of the index or foreign key, or is used by the functional index.
+func isColumnInConstraint(constraint *ast.Constraint, column string) bool {
+	for _, key := range constraint.Keys {
+		if key.Column != nil && key.Column.Name.L == column {
+			return true
+		}
+		for _, col := range util.GetColumnNameInExpr(key.Expr) {
+			if col.Name.Name.L == column {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getConstraintName returns "PRIMARY" for the primary key, and the first column for the unnamed index as MySQL does.
+func getConstraintName(constraint *ast.Constraint) string {
+	if constraint.Tp == ast.ConstraintPrimaryKey {
+		return "PRIMARY"
+	}
+	if constraint.Name != "" || len(constraint.Keys) == 0 || constraint.Keys[0].Column == nil {
+		return constraint.Name
+	}
+	return constraint.Keys[0].Column.Name.O
+}
+
+// 规则函数实现结束
+// ==== Rule code end ====
@@ -171,6 +171,24 @@ func (te *SubqueryExprExtractor) Leave(in ast.Node) (node ast.Node, ok bool) {
 	return in, true
 }
 
+// ExistsSubqueryExprExtractor implements ast.Visitor interface.
+type ExistsSubqueryExprExtractor struct {
+	expr []*ast.ExistsSubqueryExpr
+}
+
+func (te *ExistsSubqueryExprExtractor) Enter(in ast.Node) (node ast.Node, skipChildren bool) {
+	e, ok := in.(*ast.ExistsSubqueryExpr)
+	if !ok {
+		return in, false
+	}
+	te.expr = append(te.expr, e)
+	return in, false
+}
+
+func (te *ExistsSubqueryExprExtractor) Leave(in ast.Node) (node ast.Node, ok bool) {
+	return in, true
+}
+
 type JoinExtractor struct {
 	joins []*ast.Join
 }
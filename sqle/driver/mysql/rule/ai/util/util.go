@@ -11,6 +11,7 @@ import (
 	"github.com/actiontech/sqle/sqle/driver/mysql/session"
 	"github.com/actiontech/sqle/sqle/log"
 	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/charset"
 	"github.com/pingcap/parser/model"
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/parser/opcode"
@@ -184,6 +185,78 @@ func GetColumnWidth(columnDef *ast.ColumnDef) int {
 	return columnDef.Tp.Flen
 }
 
+// a helper function to get the max length in bytes of the MySQL index key part on the column, keyLength is the prefix
+// length of the key part(0 if it is not a prefix index). The charset of the string column is the charset of the column,
+// or defaultCharset if it is not specified, the unknown charset is regarded as utf8mb4.
+func GetIndexKeyPartBytes(columnDef *ast.ColumnDef, keyLength int, defaultCharset string) int {
+	tp := columnDef.Tp
+	if tp == nil {
+		return 0
+	}
+	// 日期时间类型的小数秒部分占用 (fsp+1)/2 字节
+	fspBytes := 0
+	if tp.Decimal > 0 {
+		fspBytes = (tp.Decimal + 1) / 2
+	}
+	switch tp.Tp {
+	case mysql.TypeTiny, mysql.TypeYear:
+		return 1
+	case mysql.TypeShort, mysql.TypeEnum:
+		return 2
+	case mysql.TypeInt24, mysql.TypeDate:
+		return 3
+	case mysql.TypeLong, mysql.TypeFloat:
+		return 4
+	case mysql.TypeLonglong, mysql.TypeDouble, mysql.TypeSet:
+		return 8
+	case mysql.TypeDuration:
+		return 3 + fspBytes
+	case mysql.TypeTimestamp:
+		return 4 + fspBytes
+	case mysql.TypeDatetime:
+		return 5 + fspBytes
+	case mysql.TypeBit:
+		return (tp.Flen + 7) / 8
+	case mysql.TypeNewDecimal:
+		precision, scale := tp.Flen, tp.Decimal
+		if precision <= 0 {
+			precision = 10
+		}
+		if scale < 0 {
+			scale = 0
+		}
+		return getDecimalDigitsBytes(precision-scale) + getDecimalDigitsBytes(scale)
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+		chars := tp.Flen
+		if keyLength > 0 {
+			chars = keyLength
+		} else if IsColumnTypeEqual(columnDef, GetBlobDbTypes()...) {
+			// BLOB/TEXT 必须使用前缀索引
+			return 0
+		}
+		if chars < 0 {
+			chars = 1
+		}
+		cs := tp.Charset
+		if cs == "" {
+			cs = defaultCharset
+		}
+		maxLen := 4
+		if desc, err := charset.GetCharsetDesc(cs); err == nil {
+			maxLen = desc.Maxlen
+		}
+		return chars * maxLen
+	}
+	return 0
+}
+
+// getDecimalDigitsBytes returns the bytes used by the digits of DECIMAL, each 9 digits use 4 bytes.
+func getDecimalDigitsBytes(digits int) int {
+	leftoverBytes := []int{0, 1, 1, 2, 2, 3, 3, 4, 4}
+	return digits/9*4 + leftoverBytes[digits%9]
+}
+
 // a helper function to check if alter table is target type
 func IsAlterTableCommand(spec *ast.AlterTableSpec, expectedType ast.AlterTableType) bool {
 	return spec.Tp == expectedType
@@ -387,6 +460,16 @@ func GetSubquery(stmt ast.Node) []*ast.SubqueryExpr {
 	return e.expr
 }
 
+// a helper function to extract all exists subquery from a given AST Node
+func GetExistsSubquery(stmt ast.Node) []*ast.ExistsSubqueryExpr {
+	if stmt == nil {
+		return nil
+	}
+	e := ExistsSubqueryExprExtractor{}
+	stmt.Accept(&e)
+	return e.expr
+}
+
 // a helper function to get the default table from a given select statement
 func GetDefaultTable(stmt *ast.SelectStmt) *ast.TableName {
 	if stmt == nil {
@@ -206,6 +206,7 @@ const (
 
 // inspector config code
 const (
+	ConfigDMLRollbackMaxRows       = "dml_rollback_max_rows"
 	ConfigDDLOSCMinSize            = "ddl_osc_min_size"
 	ConfigDDLGhostMinSize          = "ddl_ghost_min_size"
 	ConfigOptimizeIndexEnabled     = "optimize_index_enabled"
@@ -223,6 +224,19 @@ type RuleHandlerInput struct {
 	Rule driverV2.Rule
 	Res  *driverV2.AuditResults
 	Node ast.Node
+	// AffectedRowsEstimator is the estimator configured by the driver, the rules checking the
+	// affected rows use util.DefaultAffectedRowsEstimator if it is nil.
+	AffectedRowsEstimator util.AffectedRowsEstimator
+}
+
+// getAffectedRowNum estimates the rows affected by the node of input by input.AffectedRowsEstimator.
+func getAffectedRowNum(input *RuleHandlerInput) (int64, error) {
+	estimator := input.AffectedRowsEstimator
+	if estimator == nil {
+		estimator = util.DefaultAffectedRowsEstimator{}
+	}
+	num, _, err := estimator.EstimateAffectedRows(context.TODO(), input.Node.Text(), input.Ctx.GetExecutor(), input.Ctx.GetExecutionPlan)
+	return num, err
 }
 
 type RuleHandlerFunc func(input *RuleHandlerInput) error
@@ -288,6 +302,20 @@ func AddResult(result *driverV2.AuditResults, currentRule driverV2.Rule, ruleNam
 	result.Add(level, ruleName, plocale.Bundle.LocalizeAll(ruleHandler.Message), args...)
 }
 
+// AddResultWithLevel is like AddResult, but the result is added with the level passed in
+// instead of the level of the rule, it is used by the rules reporting different levels
+// in different situations.
+func AddResultWithLevel(result *driverV2.AuditResults, currentRule driverV2.Rule, level driverV2.RuleLevel, ruleName string, args ...interface{}) {
+	if ruleName != currentRule.Name {
+		return
+	}
+	ruleHandler, exist := GetRuleHandlerFromAllRules(ruleName)
+	if !exist {
+		return
+	}
+	result.Add(level, ruleName, plocale.Bundle.LocalizeAll(ruleHandler.Message), args...)
+}
+
 func (rh *RuleHandler) IsAllowOfflineRule(node ast.Node) bool {
 	if !rh.Rule.AllowOffline {
 		return false
@@ -327,11 +355,55 @@ func GetRuleHandlerFromAllRules(ruleName string) (rh *RuleHandler, exist bool) {
 	return nil, false
 }
 
+// RegisterRuleHandler registers a rule which is not defined in this package, e.g. the rules
+// of the organization's own conventions. The registered rule is found by GetRuleHandlerFromAllRules
+// and reported in the rules of GetDriverMetas.
+//
+// It modifies RuleHandlers, RuleHandlerMap and AllRules without lock, so it must only be called
+// in the init function of the package defining the rule, before any rule is read.
+func RegisterRuleHandler(handler *SourceHandler) error {
+	if handler == nil {
+		return fmt.Errorf("rule handler is nil")
+	}
+	if handler.Rule.Name == "" {
+		return fmt.Errorf("rule name is empty")
+	}
+	if handler.Func == nil || handler.Message == nil {
+		return fmt.Errorf("the func or message of rule %s is nil", handler.Rule.Name)
+	}
+	if handler.Rule.Desc == nil || handler.Rule.Annotation == nil {
+		return fmt.Errorf("the desc or annotation of rule %s is nil", handler.Rule.Name)
+	}
+	if _, exist := GetRuleHandlerFromAllRules(handler.Rule.Name); exist {
+		return fmt.Errorf("rule %s is already registered", handler.Rule.Name)
+	}
+
+	rh := GenerateI18nRuleHandlers(plocale.Bundle, []*SourceHandler{handler}, driverV2.DriverTypeMySQL)[0]
+	old := RuleHandlers
+	RuleHandlers = append(RuleHandlers, rh)
+	// AllRules points to the rules in RuleHandlers, which are moved if append reallocates
+	if len(old) > 0 && &old[0] != &RuleHandlers[0] {
+		moved := make(map[*driverV2.Rule]*driverV2.Rule, len(old))
+		for i := range old {
+			moved[&old[i].Rule] = &RuleHandlers[i].Rule
+		}
+		for i, rule := range AllRules {
+			if r, ok := moved[rule]; ok {
+				AllRules[i] = r
+			}
+		}
+	}
+	RuleHandlerMap[rh.Rule.Name] = rh
+	AllRules = append(AllRules, &RuleHandlers[len(RuleHandlers)-1].Rule)
+	return nil
+}
+
 const DefaultSingleParamKeyName = "first_key" // For most of the rules, it is just has one param, this is first params.
 
 const (
 	DefaultMultiParamsFirstKeyName  = "multi_params_first_key"
 	DefaultMultiParamsSecondKeyName = "multi_params_second_key"
+	DefaultMultiParamsThirdKeyName  = "multi_params_third_key"
 )
 
 func checkMathComputationOrFuncOnIndex(input *RuleHandlerInput) error {
@@ -3192,7 +3264,7 @@ func checkTablePartition(input *RuleHandlerInput) error {
 	switch stmt := input.Node.(type) {
 	case *ast.AlterTableStmt:
 		for _, spec := range stmt.Specs {
-			if spec.PartitionNames != nil || spec.PartDefinitions != nil || spec.Partition != nil {
+			if spec.Tp == ast.AlterTablePartition || util.IsPartitionMaintenanceSpec(spec) {
 				addResult(input.Res, input.Rule, DDLCheckTablePartition)
 				return nil
 			}
@@ -4424,32 +4496,39 @@ func ddlNotAllowRenaming(input *RuleHandlerInput) error {
 
 func checkLimitOffsetNum(input *RuleHandlerInput) error {
 	maxOffset := input.Rule.Params.GetParam(DefaultSingleParamKeyName).Int()
-	switch stmt := input.Node.(type) {
-	case *ast.SelectStmt:
-		if stmt.Limit != nil && stmt.Limit.Offset != nil {
-			offsetVal, ok := stmt.Limit.Offset.(*parserdriver.ValueExpr)
-			if !ok {
-				return nil
-			}
-			offset := offsetVal.Datum.GetInt64()
-			if offset > int64(maxOffset) {
-				addResult(input.Res, input.Rule, DMLCheckLimitOffsetNum, offset, maxOffset)
-			}
+	// the subqueries, derived tables and the selects in INSERT ... SELECT are checked as well
+	extractor := util.SelectStmtExtractor{}
+	input.Node.Accept(&extractor)
+	var largestOffset int64 = -1
+	for _, stmt := range extractor.SelectStmts {
+		// both `LIMIT offset, count` and `LIMIT count OFFSET offset` are parsed into Limit.Offset
+		if stmt.Limit == nil || stmt.Limit.Offset == nil {
+			continue
 		}
-	default:
-		return nil
+		offsetVal, ok := stmt.Limit.Offset.(*parserdriver.ValueExpr)
+		if !ok {
+			continue
+		}
+		if offset := offsetVal.Datum.GetInt64(); offset > int64(maxOffset) && offset > largestOffset {
+			largestOffset = offset
+		}
+	}
+	if largestOffset >= 0 {
+		addResult(input.Res, input.Rule, DMLCheckLimitOffsetNum, largestOffset, maxOffset)
 	}
 	return nil
 }
 
 func checkUpdateOrDeleteHasWhere(input *RuleHandlerInput) error {
+	// LIMIT 只能用于单表的 UPDATE/DELETE，多表时 Limit 总是为空
+	allowLimit := input.Rule.Params.GetParam(DefaultSingleParamKeyName).Bool()
 	switch stmt := input.Node.(type) {
 	case *ast.UpdateStmt:
-		if stmt.Where == nil {
+		if stmt.Where == nil && !(allowLimit && stmt.Limit != nil) {
 			addResult(input.Res, input.Rule, DMLCheckUpdateOrDeleteHasWhere)
 		}
 	case *ast.DeleteStmt:
-		if stmt.Where == nil {
+		if stmt.Where == nil && !(allowLimit && stmt.Limit != nil) {
 			addResult(input.Res, input.Rule, DMLCheckUpdateOrDeleteHasWhere)
 		}
 	default:
@@ -4652,8 +4731,7 @@ func checkAffectedRows(input *RuleHandlerInput) error {
 		return nil
 	}
 
-	affectCount, err := util.GetAffectedRowNum(
-		context.TODO(), input.Node.Text(), input.Ctx.GetExecutor(), input.Ctx.GetExecutionPlan)
+	affectCount, err := getAffectedRowNum(input)
 	if err != nil {
 		log.NewEntry().Errorf("rule: %v; SQL: %v; get affected row number failed: %v", input.Rule.Name, input.Node.Text(), err)
 		return nil
@@ -5225,7 +5303,7 @@ func checkSelectRows(input *RuleHandlerInput) error {
 	if !notUseIndex {
 		return nil
 	}
-	affectCount, err := util.GetAffectedRowNum(context.TODO(), input.Node.Text(), input.Ctx.GetExecutor(), input.Ctx.GetExecutionPlan)
+	affectCount, err := getAffectedRowNum(input)
 	if err != nil {
 		return err
 	}
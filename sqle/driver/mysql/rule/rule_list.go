@@ -23,6 +23,25 @@ var sourceRuleHandlers = []*SourceHandler{
 		Message: plocale.DMLNotAllowInsertAutoincrementMessage,
 		Func:    notAllowInsertAutoincrement,
 	},
+	{
+		Rule: SourceRule{
+			Name:       ConfigDMLRollbackMaxRows,
+			Desc:       plocale.ConfigDMLRollbackMaxRowsDesc,
+			Annotation: plocale.ConfigDMLRollbackMaxRowsAnnotation,
+			//Value:    "1000",
+			Level:    driverV2.RuleLevelNotice,
+			Category: plocale.RuleTypeGlobalConfig,
+			Params: []*SourceParam{
+				{
+					Key:   DefaultSingleParamKeyName,
+					Value: "1000",
+					Desc:  plocale.ConfigDMLRollbackMaxRowsParams1,
+					Type:  params.ParamTypeInt,
+				},
+			},
+		},
+		Func: nil,
+	},
 	{
 		Rule: SourceRule{
 			Name:       ConfigDDLOSCMinSize,
@@ -1759,7 +1778,7 @@ var sourceRuleHandlers = []*SourceHandler{
 			Params: []*SourceParam{
 				{
 					Key:   DefaultSingleParamKeyName,
-					Value: "100",
+					Value: "10000",
 					Desc:  plocale.DMLCheckLimitOffsetNumParams1,
 					Type:  params.ParamTypeInt,
 				},
@@ -1776,6 +1795,14 @@ var sourceRuleHandlers = []*SourceHandler{
 			Level:        driverV2.RuleLevelError,
 			Category:     plocale.RuleTypeDMLConvention,
 			AllowOffline: true,
+			Params: []*SourceParam{
+				{
+					Key:   DefaultSingleParamKeyName,
+					Value: "false",
+					Desc:  plocale.DMLCheckUpdateOrDeleteHasWhereParams1,
+					Type:  params.ParamTypeBool,
+				},
+			},
 		},
 		Message: plocale.DMLCheckUpdateOrDeleteHasWhereMessage,
 		Func:    checkUpdateOrDeleteHasWhere,
@@ -6,6 +6,7 @@ import (
 	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
 	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
 	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -191,3 +192,57 @@ func TestCheckAlterTableRedundantIndex(t *testing.T) {
 	assert.Equal(t, len(redundancy), 0, "indexs3,redundancy")
 
 }
+
+func TestRegisterRuleHandler(t *testing.T) {
+	ruleHandlers, ruleHandlerMap, allRules := RuleHandlers, RuleHandlerMap, AllRules
+	RuleHandlerMap = map[string]RuleHandler{}
+	for k, v := range ruleHandlerMap {
+		RuleHandlerMap[k] = v
+	}
+	// the registered rule is appended to the copies, RuleHandlers is reallocated by append
+	RuleHandlers = ruleHandlers[:len(ruleHandlers):len(ruleHandlers)]
+	AllRules = append([]*driverV2.Rule{}, allRules...)
+	defer func() {
+		RuleHandlers, RuleHandlerMap, AllRules = ruleHandlers, ruleHandlerMap, allRules
+	}()
+
+	handler := &SourceHandler{
+		Rule: SourceRule{
+			Name:       "custom_ddl_check_table_prefix",
+			Desc:       &i18n.Message{ID: "CustomDDLCheckTablePrefixDesc", Other: "表名需要以 t_ 开头"},
+			Annotation: &i18n.Message{ID: "CustomDDLCheckTablePrefixAnnotation", Other: "统一表名前缀便于区分业务表"},
+			Level:      driverV2.RuleLevelWarn,
+			Category:   plocale.RuleTypeNamingConvention,
+		},
+		Message: &i18n.Message{ID: "CustomDDLCheckTablePrefixMessage", Other: "表名需要以 t_ 开头"},
+		Func: func(input *RuleHandlerInput) error {
+			AddResult(input.Res, input.Rule, "custom_ddl_check_table_prefix")
+			return nil
+		},
+	}
+	assert.NoError(t, RegisterRuleHandler(handler))
+
+	rh, exist := GetRuleHandlerFromAllRules("custom_ddl_check_table_prefix")
+	assert.True(t, exist)
+	assert.Equal(t, driverV2.RuleLevelWarn, rh.Rule.Level)
+	assert.Equal(t, len(allRules)+1, len(AllRules))
+	assert.Equal(t, "custom_ddl_check_table_prefix", AllRules[len(AllRules)-1].Name)
+	// AllRules still points to the rules in RuleHandlers after RuleHandlers is reallocated
+	for i := range RuleHandlers {
+		assert.Same(t, &RuleHandlers[i].Rule, AllRules[i])
+	}
+
+	results := driverV2.NewAuditResults()
+	assert.NoError(t, rh.Func(&RuleHandlerInput{Rule: rh.Rule, Res: results}))
+	assert.Equal(t, "[warn]表名需要以 t_ 开头", results.Message())
+
+	// rule name should be unique
+	assert.Error(t, RegisterRuleHandler(handler))
+	assert.Error(t, RegisterRuleHandler(&SourceHandler{Rule: SourceRule{Name: DDLCheckPKWithoutIfNotExists}, Message: handler.Message, Func: handler.Func}))
+
+	assert.Error(t, RegisterRuleHandler(nil))
+	assert.Error(t, RegisterRuleHandler(&SourceHandler{Message: handler.Message, Func: handler.Func}))
+	assert.Error(t, RegisterRuleHandler(&SourceHandler{Rule: SourceRule{Name: "custom_rule_without_func"}, Message: handler.Message}))
+	assert.Error(t, RegisterRuleHandler(&SourceHandler{Rule: SourceRule{Name: "custom_rule_without_desc", Annotation: handler.Rule.Annotation}, Message: handler.Message, Func: handler.Func}))
+	assert.Error(t, RegisterRuleHandler(&SourceHandler{Rule: SourceRule{Name: "custom_rule_without_annotation", Desc: handler.Rule.Desc}, Message: handler.Message, Func: handler.Func}))
+}
@@ -132,6 +132,60 @@ func TestRuleSQLE00005(t *testing.T) {
 		nil,
 		newTestResult(),
 	)
+
+	runSingleRuleInspectCase(rule, t, "case 16: CREATE TABLE 时索引长度超过上限", DefaultMysqlInspect(),
+		"CREATE TABLE exist_db.not_exist_tb_1 (id BIGINT, name VARCHAR(1000), INDEX idx_name (name)) DEFAULT CHARSET=utf8mb4;",
+		newTestResult().addResult(ruleName, "idx_name(1, 4000)"),
+	)
+
+	runSingleRuleInspectCase(rule, t, "case 17: CREATE TABLE 时前缀索引长度未超过上限", DefaultMysqlInspect(),
+		"CREATE TABLE exist_db.not_exist_tb_1 (id BIGINT, name VARCHAR(1000), INDEX idx_name (name(500))) DEFAULT CHARSET=utf8mb4;",
+		newTestResult(),
+	)
+
+	runSingleRuleInspectCase(rule, t, "case 18: CREATE TABLE 时使用单字节字符集的索引长度未超过上限", DefaultMysqlInspect(),
+		"CREATE TABLE exist_db.not_exist_tb_1 (id BIGINT, name VARCHAR(1000), INDEX idx_name (name)) DEFAULT CHARSET=latin1;",
+		newTestResult(),
+	)
+
+	runSingleRuleInspectCase(rule, t, "case 19: CREATE TABLE 时主键和未命名索引使用列的字符集", DefaultMysqlInspect(),
+		"CREATE TABLE exist_db.not_exist_tb_1 (a VARCHAR(500), b VARCHAR(400), c VARCHAR(1100) CHARACTER SET utf8, PRIMARY KEY (a, b), INDEX (c)) DEFAULT CHARSET=utf8mb4;",
+		newTestResult().addResult(ruleName, "PRIMARY(2, 3600), c(1, 3300)"),
+	)
+
+	runSingleRuleInspectCase(rule, t, "case 20: CREATE TABLE 时全文索引不检查索引长度", DefaultMysqlInspect(),
+		"CREATE TABLE exist_db.not_exist_tb_1 (id BIGINT, content VARCHAR(2000), FULLTEXT INDEX ft_content (content)) DEFAULT CHARSET=utf8mb4;",
+		newTestResult(),
+	)
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "16")
+	runSingleRuleInspectCase(rule, t, "case 21: CREATE TABLE 时数值和时间类型的索引长度超过上限", DefaultMysqlInspect(),
+		"CREATE TABLE exist_db.not_exist_tb_1 (id BIGINT, created DATETIME(3), amount DECIMAL(10,2), INDEX idx_c (id, created, amount));",
+		newTestResult().addResult(ruleName, "idx_c(3, 20)"),
+	)
+
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "2000")
+	runSingleRuleInspectCase(rule, t, "case 22: ALTER TABLE 时使用表中原有列计算索引长度", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 ADD INDEX idx_v (v1, v2, id);",
+		newTestResult().addResult(ruleName, "idx_v(3, 2048)"),
+	)
+
+	runSingleRuleInspectCase(rule, t, "case 23: CREATE INDEX 时使用表中原有列计算索引长度", DefaultMysqlInspect(),
+		"CREATE INDEX idx_v ON exist_db.exist_tb_1 (v1, id);",
+		newTestResult(),
+	)
+
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "0")
+	runSingleRuleInspectCase(rule, t, "case 24: 索引长度上限为0时不检查索引长度", DefaultMysqlInspect(),
+		"CREATE TABLE exist_db.not_exist_tb_1 (id BIGINT, name VARCHAR(1000), INDEX idx_name (name)) DEFAULT CHARSET=utf8mb4;",
+		newTestResult(),
+	)
+
+	runSingleRuleInspectCase(rule, t, "case 25: 离线审核 ALTER TABLE 时无法获取索引长度", DefaultMysqlInspectOffline(),
+		"ALTER TABLE customers ADD INDEX idx_customers (id, name, sex, age, mark1, mark2);",
+		newTestResult().addResult(ruleName, "idx_customers(6, -)"),
+	)
 }
 
 // ==== Rule test code end ====
@@ -16,12 +16,12 @@ func TestRuleSQLE00019(t *testing.T) {
 	runAIRuleCase(rule, t, "case 1: CREATE TABLE 使用 ENUM 类型字段",
 		"CREATE TABLE sample_table (id INT, status ENUM('active', 'inactive'));",
 		nil, /*mock context*/
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "status"))
 
 	runAIRuleCase(rule, t, "case 2: CREATE TABLE 使用 SET 类型字段",
 		"CREATE TABLE sample_table (id INT, tags SET('tag1', 'tag2', 'tag3'));",
 		nil, /*mock context*/
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "tags"))
 
 	runAIRuleCase(rule, t, "case 3: CREATE TABLE 使用标准数据类型字段",
 		"CREATE TABLE sample_table (id INT, name VARCHAR(255));",
@@ -31,17 +31,17 @@ func TestRuleSQLE00019(t *testing.T) {
 	runAIRuleCase(rule, t, "case 4: CREATE TABLE 包含多个字段，其中一个字段使用 ENUM 类型",
 		"CREATE TABLE sample_table (id INT, name VARCHAR(255), status ENUM('active', 'inactive'));",
 		nil, /*mock context*/
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "status"))
 
 	runAIRuleCase(rule, t, "case 5: ALTER TABLE 新增字段使用 ENUM 类型",
 		"ALTER TABLE sample_table ADD COLUMN role ENUM('admin', 'user');",
 		session.NewAIMockContext().WithSQL("CREATE TABLE sample_table (id INT, name VARCHAR(255));"),
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "role"))
 
 	runAIRuleCase(rule, t, "case 6: ALTER TABLE 新增字段使用 SET 类型",
 		"ALTER TABLE sample_table ADD COLUMN permissions SET('read', 'write', 'execute');",
 		session.NewAIMockContext().WithSQL("CREATE TABLE sample_table (id INT, name VARCHAR(255));"),
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "permissions"))
 
 	runAIRuleCase(rule, t, "case 7: ALTER TABLE 新增字段使用标准数据类型",
 		"ALTER TABLE sample_table ADD COLUMN age INT;",
@@ -51,7 +51,7 @@ func TestRuleSQLE00019(t *testing.T) {
 	runAIRuleCase(rule, t, "case 8: ALTER TABLE 修改字段为 ENUM 类型",
 		"ALTER TABLE sample_table MODIFY COLUMN status ENUM('active', 'inactive', 'pending');",
 		session.NewAIMockContext().WithSQL("CREATE TABLE sample_table (id INT, status VARCHAR(255));"),
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "status"))
 
 	runAIRuleCase(rule, t, "case 9: ALTER TABLE 修改字段为标准数据类型",
 		"ALTER TABLE sample_table MODIFY COLUMN name TEXT;",
@@ -66,7 +66,25 @@ func TestRuleSQLE00019(t *testing.T) {
 	runAIRuleCase(rule, t, "case 11: CREATE TABLE 使用 ENUM 类型字段，测试 ENUM 的值插入和排序问题",
 		"CREATE TABLE t1 (a INT PRIMARY KEY AUTO_INCREMENT, b ENUM('A','3','2','1') DEFAULT '3');",
 		nil, /*mock context*/
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "b"))
+
+	runSingleRuleInspectCase(rule, t, "case 12: CREATE TABLE 包含多个 ENUM 和 SET 类型字段", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, Status ENUM('a', 'b'), tags SET('x', 'y'), name VARCHAR(32));",
+		newTestResult().addResult(ruleName, "Status,tags"))
+
+	runSingleRuleInspectCase(rule, t, "case 13: ALTER TABLE CHANGE 字段为 SET 类型", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 CHANGE COLUMN v1 v4 SET('x', 'y');",
+		newTestResult().addResult(ruleName, "v4"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "status, T2.Tags")
+	runSingleRuleInspectCase(rule, t, "case 14: 白名单中的字段名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, status ENUM('a', 'b'), tags SET('x', 'y'));",
+		newTestResult().addResult(ruleName, "tags"))
+
+	runSingleRuleInspectCase(rule, t, "case 15: 白名单中的表名.字段名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t2 (id INT, status ENUM('a', 'b'), tags SET('x', 'y'));",
+		newTestResult())
 }
 
 // ==== Rule test code end ====
@@ -1,6 +1,8 @@
 package mysql
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
@@ -12,6 +14,9 @@ import (
 func TestRuleSQLE00020(t *testing.T) {
 	ruleName := ai.SQLE00020
 	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	// case 1 ~ case 11 使用阈值 40
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "40")
 
 	runAIRuleCase(rule, t, "case 1: CREATE TABLE 定义39列，符合规则",
 		"CREATE TABLE test_table_39 (col1 INT, col2 INT, col3 INT, col4 INT, col5 INT, col6 INT, col7 INT, col8 INT, col9 INT, col10 INT, col11 INT, col12 INT, col13 INT, col14 INT, col15 INT, col16 INT, col17 INT, col18 INT, col19 INT, col20 INT, col21 INT, col22 INT, col23 INT, col24 INT, col25 INT, col26 INT, col27 INT, col28 INT, col29 INT, col30 INT, col31 INT, col32 INT, col33 INT, col34 INT, col35 INT, col36 INT, col37 INT, col38 INT, col39 INT);",
@@ -89,6 +94,44 @@ func TestRuleSQLE00020(t *testing.T) {
 		nil,
 		newTestResult().addResult(ruleName),
 	)
+
+	createTableSQL := func(table string, columnCount int) string {
+		cols := make([]string, 0, columnCount)
+		for i := 1; i <= columnCount; i++ {
+			cols = append(cols, fmt.Sprintf("col%d INT", i))
+		}
+		return fmt.Sprintf("CREATE TABLE %s (%s);", table, strings.Join(cols, ", "))
+	}
+	rule = rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 12: 默认阈值, CREATE TABLE 定义50列，符合规则", DefaultMysqlInspectOffline(),
+		createTableSQL("test_table_50", 50),
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 13: 默认阈值, CREATE TABLE 定义51列，违反规则", DefaultMysqlInspectOffline(),
+		createTableSQL("test_table_51", 51),
+		newTestResult().addResult(ruleName, 51, 50))
+
+	runSingleRuleInspectCase(rule, t, "case 14: 离线审核无法获取表定义, ALTER TABLE 不检查", DefaultMysqlInspectOffline(),
+		"ALTER TABLE test_table_50 ADD COLUMN col51 INT;",
+		newTestResult())
+
+	runAIRuleCase(rule, t, "case 15: ALTER TABLE 一个 ADD COLUMN 新增多列，违反规则",
+		"ALTER TABLE test_table_49 ADD COLUMN (col50 INT, col51 INT);",
+		session.NewAIMockContext().WithSQL(createTableSQL("test_table_49", 49)),
+		nil,
+		newTestResult().addResult(ruleName, 51, 50),
+	)
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "3")
+	runSingleRuleInspectCase(rule, t, "case 16: ALTER TABLE 新增和删除字段后的列数超过阈值，违反规则", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT, ADD COLUMN v4 INT, DROP COLUMN v1;",
+		newTestResult().addResult(ruleName, 4, 3))
+
+	runSingleRuleInspectCase(rule, t, "case 17: ALTER TABLE 新增和删除字段后的列数未超过阈值，符合规则", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT, DROP COLUMN v1;",
+		newTestResult())
 }
 
 // ==== Rule test code end ====
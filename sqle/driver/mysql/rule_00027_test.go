@@ -111,6 +111,35 @@ func TestRuleSQLE00027(t *testing.T) {
 	runSingleRuleInspectCase(rule, t, "alter table modify column, with problem (empty comment for column)", DefaultMysqlInspect(), `
 				ALTER TABLE exist_db.exist_tb_1 CHANGE COLUMN v1 a int COMMENT "";
 				`, newTestResult().addResult(ruleName, "a"))
+
+	//create table, with problem (no comment for generated column)
+	runSingleRuleInspectCase(rule, t, "create table, with problem (no comment for generated column)", DefaultMysqlInspect(), `
+	CREATE TABLE  if not exists exist_db.not_exist_tb_1 (
+	id bigint unsigned NOT NULL AUTO_INCREMENT COMMENT "unit test comment",
+	v1 int NOT NULL COMMENT "unit test comment",
+	v2 int AS (v1 + 1) VIRTUAL,
+	PRIMARY KEY (id)
+	);
+	`, newTestResult().addResult(ruleName, "v2"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "4")
+
+	//create table, with problem (comment shorter than the minimum length)
+	runSingleRuleInspectCase(rule, t, "create table, with problem (comment shorter than the minimum length)", DefaultMysqlInspect(), `
+	CREATE TABLE  if not exists exist_db.not_exist_tb_1 (
+	id bigint unsigned NOT NULL AUTO_INCREMENT COMMENT "主键编号",
+	v1 varchar(255) NOT NULL COMMENT "名称",
+	v2 varchar(255) NOT NULL COMMENT " v2  ",
+	v3 varchar(255) NOT NULL COMMENT "desc",
+	PRIMARY KEY (id)
+	);
+	`, newTestResult().addResult(ruleName, "v1,v2"))
+
+	//alter table, with problem (comment shorter than the minimum length)
+	runSingleRuleInspectCase(rule, t, "alter table, with problem (comment shorter than the minimum length)", DefaultMysqlInspect(), `
+	ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v int COMMENT "v", MODIFY COLUMN v1 int COMMENT "unit test comment", CHANGE COLUMN v2 a int COMMENT "a";
+	`, newTestResult().addResult(ruleName, "v,a"))
 }
 
 // ==== Rule test code end ====
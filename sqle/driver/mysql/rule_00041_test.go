@@ -25,7 +25,7 @@ func TestRuleSQLE00041(t *testing.T) {
 		"CREATE TABLE test_table (id INT, UNIQUE INDEX idx_id (id));",
 		nil,
 		nil,
-		newTestResult().addResult(ruleName))
+		newTestResult().addResult(ruleName, "idx_id", "uniq_id"))
 
 	// case 2: CREATE TABLE with UNIQUE INDEX with correct prefix
 	runAIRuleCase(rule, t, "case 2: CREATE TABLE with UNIQUE INDEX with correct prefix",
@@ -39,7 +39,7 @@ func TestRuleSQLE00041(t *testing.T) {
 		"ALTER TABLE test_table ADD UNIQUE INDEX idx_id (id);",
 		session.NewAIMockContext().WithSQL("CREATE TABLE test_table (id INT);"),
 		nil,
-		newTestResult().addResult(ruleName))
+		newTestResult().addResult(ruleName, "idx_id", "uniq_id"))
 
 	// case 4: ALTER TABLE ADD UNIQUE INDEX with correct prefix
 	runAIRuleCase(rule, t, "case 4: ALTER TABLE ADD UNIQUE INDEX with correct prefix",
@@ -53,7 +53,7 @@ func TestRuleSQLE00041(t *testing.T) {
 		"ALTER TABLE test_table RENAME INDEX old_idx TO idx_id;",
 		session.NewAIMockContext().WithSQL("CREATE TABLE test_table (id INT, UNIQUE INDEX old_idx (id));"),
 		nil,
-		newTestResult().addResult(ruleName))
+		newTestResult().addResult(ruleName, "idx_id", "uniq_id"))
 
 	// case 6: ALTER TABLE RENAME INDEX to UNIQUE INDEX with correct prefix
 	runAIRuleCase(rule, t, "case 6: ALTER TABLE RENAME INDEX to UNIQUE INDEX with correct prefix",
@@ -96,6 +96,24 @@ func TestRuleSQLE00041(t *testing.T) {
 		session.NewAIMockContext().WithSQL("CREATE TABLE order_his (id BIGINT, name varchar(64) DEFAULT '', UNIQUE INDEX name(name));"),
 		nil,
 		newTestResult())
+
+	runAIRuleCase(rule, t, "case 11: CREATE TABLE with composite UNIQUE INDEX without prefix",
+		"CREATE TABLE orders (id INT, user_id INT, order_no VARCHAR(32), UNIQUE INDEX idx_user (user_id, order_no));",
+		nil,
+		nil,
+		newTestResult().addResult(ruleName, "idx_user", "uniq_user_id_order_no"))
+
+	runAIRuleCase(rule, t, "case 12: CREATE UNIQUE INDEX without prefix",
+		"CREATE UNIQUE INDEX idx_user ON orders (user_id);",
+		session.NewAIMockContext().WithSQL("CREATE TABLE orders (id INT, user_id INT);"),
+		nil,
+		newTestResult().addResult(ruleName, "idx_user", "uniq_user_id"))
+
+	runAIRuleCase(rule, t, "case 13: CREATE non-unique INDEX without prefix",
+		"CREATE INDEX idx_user ON orders (user_id);",
+		session.NewAIMockContext().WithSQL("CREATE TABLE orders (id INT, user_id INT);"),
+		nil,
+		newTestResult())
 }
 
 // ==== Rule test code end ====
@@ -48,6 +48,32 @@ func TestRuleSQLE00053(t *testing.T) {
 	runAIRuleCase(rule, t, "case 9: 指定列名的SELECT查询(从xml中补充)", "SELECT age FROM customers WHERE age = 25;",
 		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT, name VARCHAR(100), age INT);"),
 		nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 10: COUNT(*)不违反规则", "SELECT COUNT(*) FROM customers;",
+		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT, name VARCHAR(100), age INT);"),
+		nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 11: SELECT * 使用在UNION中", "SELECT id FROM customers UNION SELECT * FROM users;",
+		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT); CREATE TABLE users (id INT);"),
+		nil, newTestResult().addResult(ruleName))
+
+	runAIRuleCase(rule, t, "case 12: SELECT * 使用在INSERT ... SELECT中", "INSERT INTO users SELECT * FROM customers;",
+		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT); CREATE TABLE users (id INT);"),
+		nil, newTestResult().addResult(ruleName))
+
+	runAIRuleCase(rule, t, "case 13: SELECT * 使用在EXISTS子查询中", "SELECT id FROM customers WHERE EXISTS (SELECT * FROM users WHERE users.id = customers.id);",
+		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT); CREATE TABLE users (id INT);"),
+		nil, newTestResult().addResult(ruleName))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "true")
+	runAIRuleCase(rule, t, "case 14: 允许在EXISTS子查询中使用SELECT *", "SELECT id FROM customers WHERE EXISTS (SELECT * FROM users WHERE users.id = customers.id);",
+		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT); CREATE TABLE users (id INT);"),
+		nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 15: 允许在EXISTS子查询中使用SELECT *, 但外层查询违反规则", "SELECT * FROM customers WHERE NOT EXISTS (SELECT * FROM users WHERE users.id = customers.id);",
+		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT); CREATE TABLE users (id INT);"),
+		nil, newTestResult().addResult(ruleName))
 }
 
 // ==== Rule test code end ====
@@ -39,7 +39,7 @@ func TestRuleSQLE00055(t *testing.T) {
 	INDEX idx_2 (a),
 	PRIMARY KEY (id)
 	);
-	`, newTestResult().addResult(ruleName, "[id]", "[id]"))
+	`, newTestResult().addResult(ruleName, "PRIMARY(id)", "idx_1(id)"))
 
 	//create table, with index, with no redundant index
 	runSingleRuleInspectCase(rule, t, "create table, with index, with no redundant index", DefaultMysqlInspect(), `
@@ -52,8 +52,8 @@ func TestRuleSQLE00055(t *testing.T) {
 	);
 	`, newTestResult())
 
-	//create table, with index, with redundant index
-	runSingleRuleInspectCase(rule, t, "create table, with index, with redundant index", DefaultMysqlInspect(), `
+	//create table, with index, the primary key is the leftmost prefix of index
+	runSingleRuleInspectCase(rule, t, "create table, with index, the primary key is the leftmost prefix of index", DefaultMysqlInspect(), `
 	CREATE TABLE  if not exists exist_db.not_exist_tb_1 (
 	id bigint unsigned NOT NULL AUTO_INCREMENT COMMENT "unit test",
 	v1 varchar(255) NOT NULL DEFAULT "unit test" COMMENT "unit test",
@@ -61,7 +61,56 @@ func TestRuleSQLE00055(t *testing.T) {
 	INDEX idx_1 (id,v1,v2),
 	PRIMARY KEY (id, v1)
 	);
-	`, newTestResult().addResult(ruleName, "[id v1 v2]", "[id v1]"))
+	`, newTestResult())
+
+	//create table, with index, with redundant index
+	runSingleRuleInspectCase(rule, t, "create table, with index, with redundant index", DefaultMysqlInspect(), `
+	CREATE TABLE  if not exists exist_db.not_exist_tb_1 (
+	id bigint unsigned NOT NULL AUTO_INCREMENT COMMENT "unit test",
+	a int,
+	b int,
+	INDEX idx_a (a),
+	INDEX idx_a_b (a,b),
+	PRIMARY KEY (id)
+	);
+	`, newTestResult().addResult(ruleName, "idx_a_b(a,b)", "idx_a(a)"))
+
+	//create table, with duplicate indexes with different names
+	runSingleRuleInspectCase(rule, t, "create table, with duplicate indexes with different names", DefaultMysqlInspect(), `
+	CREATE TABLE  if not exists exist_db.not_exist_tb_1 (
+	id bigint unsigned NOT NULL AUTO_INCREMENT COMMENT "unit test",
+	a int,
+	b int,
+	INDEX idx_1 (a,b),
+	INDEX idx_2 (a,b),
+	PRIMARY KEY (id)
+	);
+	`, newTestResult().addResult(ruleName, "idx_1(a,b)", "idx_2(a,b)"))
+
+	//create table, the index is duplicate with unique index
+	runSingleRuleInspectCase(rule, t, "create table, the index is duplicate with unique index", DefaultMysqlInspect(), `
+	CREATE TABLE  if not exists exist_db.not_exist_tb_1 (
+	id bigint unsigned NOT NULL AUTO_INCREMENT COMMENT "unit test",
+	a int UNIQUE,
+	b int,
+	INDEX idx_1 (a),
+	UNIQUE KEY uniq_1 (b),
+	UNIQUE KEY uniq_2 (b),
+	UNIQUE KEY uniq_3 (a,b),
+	PRIMARY KEY (id)
+	);
+	`, newTestResult().addResult(ruleName, "a(a)", "idx_1(a)").addResult(ruleName, "uniq_1(b)", "uniq_2(b)"))
+
+	//create table, fulltext index is not checked
+	runSingleRuleInspectCase(rule, t, "create table, fulltext index is not checked", DefaultMysqlInspect(), `
+	CREATE TABLE  if not exists exist_db.not_exist_tb_1 (
+	id bigint unsigned NOT NULL AUTO_INCREMENT COMMENT "unit test",
+	v1 varchar(255),
+	INDEX idx_1 (v1),
+	FULLTEXT INDEX ft_1 (v1),
+	PRIMARY KEY (id)
+	);
+	`, newTestResult())
 
 	//create table, with index, with repeat index
 	runSingleRuleInspectCase(rule, t, "create table, with index, with repeat index", DefaultMysqlInspect(), `
@@ -72,7 +121,7 @@ func TestRuleSQLE00055(t *testing.T) {
 		PRIMARY KEY (id),
 		INDEX idx_2 (id)
 		)ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=utf8mb4 COMMENT="unit test";
-	`, newTestResult().addResult(ruleName, "[id]", "[id]"))
+	`, newTestResult().addResult(ruleName, "PRIMARY(id)", "idx_2(id)"))
 
 	//create index, with no redundant index
 	runSingleRuleInspectCase(rule, t, "create index, with no redundant index", DefaultMysqlInspect(), `
@@ -82,12 +131,12 @@ func TestRuleSQLE00055(t *testing.T) {
 	//create index, with redundant index
 	runSingleRuleInspectCase(rule, t, "create index, with column, with redundant index", DefaultMysqlInspect(), `
 	CREATE INDEX idx_2 on exist_db.exist_tb_9(v1, v2(10));
-	`, newTestResult().addResult(ruleName, "[v1 v2 v3 v4]", "[v1 v2]"))
+	`, newTestResult().addResult(ruleName, "idx_1(v1,v2,v3,v4)", "idx_2(v1,v2)"))
 
 	//create index, with repeat index
 	runSingleRuleInspectCase(rule, t, "create index, with repeat index", DefaultMysqlInspect(), `
 	CREATE INDEX idx_2 on exist_db.exist_tb_9(v1,v2,v3, v4);
-	`, newTestResult().addResult(ruleName, "[v1 v2 v3 v4]", "[v1 v2 v3 v4]"))
+	`, newTestResult().addResult(ruleName, "idx_1(v1,v2,v3,v4)", "idx_2(v1,v2,v3,v4)"))
 
 	//alter table, no index
 	runSingleRuleInspectCase(rule, t, "alter table, no index", DefaultMysqlInspect(), `
@@ -104,14 +153,32 @@ ADD INDEX idx_3 (v4);
 	runSingleRuleInspectCase(rule, t, "alter table, add index, with redundant index", DefaultMysqlInspect(), `
 ALTER TABLE exist_db.exist_tb_9 
 ADD INDEX idx_3 (v1);
-`, newTestResult().addResult(ruleName, "[v1 v2 v3 v4]", "[v1]"))
+`, newTestResult().addResult(ruleName, "idx_1(v1,v2,v3,v4)", "idx_3(v1)"))
 
 	// Alter table, add index, with repeat index
 	runSingleRuleInspectCase(rule, t, "alter table, add index, with repeat index", DefaultMysqlInspect(), `
 ALTER TABLE exist_db.exist_tb_9 
 ADD INDEX idx_2 (v2,v3),
 ADD INDEX idx_3 (v3);
-`, newTestResult().addResult(ruleName, "[v2 v3]", "[v2 v3]").addResult(ruleName, "[v3]", "[v3]"))
+`, newTestResult().addResult(ruleName, "uniq_1(v2,v3)", "idx_2(v2,v3)").addResult(ruleName, "idx_100(v3)", "idx_3(v3)"))
+
+	// Alter table, add indexes, the new indexes are redundant with each other
+	runSingleRuleInspectCase(rule, t, "alter table, add indexes, the new indexes are redundant with each other", DefaultMysqlInspect(), `
+ALTER TABLE exist_db.exist_tb_9 
+ADD INDEX idx_3 (v4),
+ADD INDEX idx_4 (v4, v5);
+`, newTestResult().addResult(ruleName, "idx_4(v4,v5)", "idx_3(v4)"))
+
+	// Alter table, add unique index, the unique index is not redundant
+	runSingleRuleInspectCase(rule, t, "alter table, add unique index, the unique index is not redundant", DefaultMysqlInspect(), `
+ALTER TABLE exist_db.exist_tb_9 
+ADD UNIQUE INDEX uniq_2 (v1);
+`, newTestResult())
+
+	// Create unique index, duplicate with existing unique index
+	runSingleRuleInspectCase(rule, t, "create unique index, duplicate with existing unique index", DefaultMysqlInspect(), `
+	CREATE UNIQUE INDEX uniq_2 on exist_db.exist_tb_9(v2, v3);
+	`, newTestResult().addResult(ruleName, "uniq_1(v2,v3)", "uniq_2(v2,v3)"))
 
 	// Alter table, drop index, no effect on redundancy
 	runSingleRuleInspectCase(rule, t, "alter table, drop index, no effect on redundancy", DefaultMysqlInspect(), `
@@ -89,6 +89,27 @@ func TestRuleSQLE00058(t *testing.T) {
 		nil,
 		newTestResult(),
 	)
+
+	runAIRuleCase(rule, t, "case 15: ALTER TABLE 合并分区",
+		"ALTER TABLE exist_db.exist_tb_3 COALESCE PARTITION 2;",
+		nil,
+		nil,
+		newTestResult().addResult(ruleName),
+	)
+
+	runAIRuleCase(rule, t, "case 16: ALTER TABLE 清空所有分区",
+		"ALTER TABLE exist_db.exist_tb_3 TRUNCATE PARTITION ALL;",
+		nil,
+		nil,
+		newTestResult().addResult(ruleName),
+	)
+
+	runAIRuleCase(rule, t, "case 17: ALTER TABLE 移除分区",
+		"ALTER TABLE exist_db.exist_tb_3 REMOVE PARTITIONING;",
+		nil,
+		nil,
+		newTestResult(),
+	)
 }
 
 // ==== Rule test code end ====
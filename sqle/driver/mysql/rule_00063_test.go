@@ -17,7 +17,7 @@ func TestRuleSQLE00063(t *testing.T) {
 	runAIRuleCase(rule, t, "case 1: CREATE语句中唯一索引名不符合格式",
 		"CREATE TABLE test_table (id INT, UNIQUE INDEX idx_id (id));",
 		nil, /*mock context*/
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "idx_id", "IDX_UK_test_table_id"))
 
 	// case 2: CREATE语句中唯一索引名符合格式
 	runAIRuleCase(rule, t, "case 2: CREATE语句中唯一索引名符合格式",
@@ -29,7 +29,7 @@ func TestRuleSQLE00063(t *testing.T) {
 	runAIRuleCase(rule, t, "case 3: ALTER语句中添加唯一索引名不符合格式",
 		"ALTER TABLE test_table ADD UNIQUE INDEX idx_id (id);",
 		session.NewAIMockContext().WithSQL("CREATE TABLE test_table (id INT);"),
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "idx_id", "IDX_UK_test_table_id"))
 
 	// case 4: ALTER语句中添加唯一索引名符合格式
 	runAIRuleCase(rule, t, "case 4: ALTER语句中添加唯一索引名符合格式",
@@ -41,7 +41,7 @@ func TestRuleSQLE00063(t *testing.T) {
 	runAIRuleCase(rule, t, "case 5: ALTER语句中重命名唯一索引名不符合格式",
 		"ALTER TABLE test_table RENAME INDEX IDX_UK_OLD_NAME TO idx_new_name;",
 		session.NewAIMockContext().WithSQL("CREATE TABLE test_table (id INT,UNIQUE INDEX IDX_UK_OLD_NAME(id));"),
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "idx_new_name", "IDX_UK_test_table_id"))
 
 	// case 6: ALTER语句中重命名唯一索引名符合格式
 	runAIRuleCase(rule, t, "case 6: ALTER语句中重命名唯一索引名符合格式",
@@ -65,13 +65,25 @@ func TestRuleSQLE00063(t *testing.T) {
 	runAIRuleCase(rule, t, "case 9: ALTER语句中添加唯一索引名符合格式(从xml中补充)",
 		"ALTER TABLE order_his ADD UNIQUE INDEX IDX_UK_ORDER_HIS_NAME(id);",
 		session.NewAIMockContext().WithSQL("CREATE TABLE order_his (id BIGINT, name varchar(64) DEFAULT '');"),
-		nil, newTestResult().addResult(ruleName))
+		nil, newTestResult().addResult(ruleName, "IDX_UK_ORDER_HIS_NAME", "IDX_UK_order_his_id"))
 
 	// case 10: ALTER语句中重命名唯一索引名符合格式(从xml中补充)
 	runAIRuleCase(rule, t, "case 10: ALTER语句中重命名唯一索引名符合格式(从xml中补充)",
 		"ALTER TABLE order_his RENAME INDEX name_idx TO IDX_UK_ORDER_HIS_NAME;",
 		session.NewAIMockContext().WithSQL("CREATE TABLE order_his (id BIGINT, name varchar(64) DEFAULT '', UNIQUE INDEX name_idx(name)); "),
 		nil, newTestResult())
+
+	// case 11: CREATE语句中联合唯一索引名不符合格式，建议的索引名包含所有字段
+	runAIRuleCase(rule, t, "case 11: CREATE语句中联合唯一索引名不符合格式",
+		"CREATE TABLE orders (id BIGINT, user_id BIGINT, order_no VARCHAR(32), UNIQUE INDEX uk_user(user_id, order_no));",
+		nil, /*mock context*/
+		nil, newTestResult().addResult(ruleName, "uk_user", "IDX_UK_orders_user_id_order_no"))
+
+	// case 12: CREATE UNIQUE INDEX语句中唯一索引名不符合格式
+	runAIRuleCase(rule, t, "case 12: CREATE UNIQUE INDEX语句中唯一索引名不符合格式",
+		"CREATE UNIQUE INDEX uk_user ON orders (user_id);",
+		session.NewAIMockContext().WithSQL("CREATE TABLE orders (id BIGINT, user_id BIGINT);"),
+		nil, newTestResult().addResult(ruleName, "uk_user", "IDX_UK_orders_user_id"))
 }
 
 // ==== Rule test code end ====
@@ -117,6 +117,53 @@ func TestRuleSQLE00091(t *testing.T) {
 		"DELETE FROM customers WHERE id IN (SELECT a.id FROM customers a, orders b WHERE a.id = b.c_id);",
 		session.NewAIMockContext().WithSQL("CREATE TABLE customers (id INT, name VARCHAR(50), sex VARCHAR(10), city VARCHAR(50), age INT); CREATE TABLE orders (id INT, c_id INT, amount DECIMAL(10,2));"),
 		nil, newTestResult())
+
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL("CREATE TABLE table1 (id INT, name VARCHAR(50)); CREATE TABLE table2 (id INT, description VARCHAR(100)); CREATE TABLE table3 (id INT, value VARCHAR(50));")
+	}
+
+	runAIRuleCase(rule, t, "case 21: SELECT语句中多表隐式JOIN，其中一个表缺少连接条件",
+		"SELECT * FROM table1, table2, table3 WHERE table1.id = table2.id;",
+		newContext(), nil, newTestResult().addResult(ruleName))
+
+	runAIRuleCase(rule, t, "case 22: SELECT语句中多表隐式JOIN，每个表都有连接条件",
+		"SELECT * FROM table1, table2, table3 WHERE table1.id = table2.id AND table2.id = table3.id;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 23: SELECT语句中右侧嵌套的JOIN缺少连接条件",
+		"SELECT * FROM table1 JOIN (table2 JOIN table3) ON table1.id = table2.id;",
+		newContext(), nil, newTestResult().addResult(ruleName))
+
+	runAIRuleCase(rule, t, "case 24: SELECT语句中使用未指定表名的字段作为连接条件",
+		"SELECT * FROM table1 a JOIN table3 b ON a.id = value;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 25: SELECT语句中使用CROSS JOIN显式声明笛卡尔积",
+		"SELECT * FROM table1 CROSS JOIN table2;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 26: SELECT语句中使用CROSS JOIN显式声明笛卡尔积，其他JOIN缺少连接条件",
+		"SELECT * FROM table1 CROSS JOIN `table2` JOIN table3;",
+		newContext(), nil, newTestResult().addResult(ruleName))
+
+	runAIRuleCase(rule, t, "case 27: 子查询中使用NATURAL JOIN",
+		"SELECT * FROM table1 WHERE id IN (SELECT id FROM table2 NATURAL JOIN table3);",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 28: UNION语句的第二个SELECT子句缺少连接条件",
+		"SELECT id FROM table1 UNION ALL SELECT table2.id FROM table2, table3;",
+		newContext(), nil, newTestResult().addResult(ruleName))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "false")
+
+	runAIRuleCase(rule, t, "case 29: 不允许显式的CROSS JOIN",
+		"SELECT * FROM table1 CROSS JOIN table2;",
+		newContext(), nil, newTestResult().addResult(ruleName))
+
+	runAIRuleCase(rule, t, "case 30: 不允许显式的CROSS JOIN，CROSS JOIN包含连接条件",
+		"SELECT * FROM table1 CROSS JOIN table2 WHERE table1.id = table2.id;",
+		newContext(), nil, newTestResult())
 }
 
 // ==== Rule test code end ====
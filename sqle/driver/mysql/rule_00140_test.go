@@ -6,6 +6,7 @@ import (
 	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
 	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
 	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
 )
 
 // ==== Rule test code start ====
@@ -112,6 +113,27 @@ func TestRuleSQLE00140(t *testing.T) {
 		"CREATE INDEX idx_t1_id ON t1(id);",
 		session.NewAIMockContext().WithSQL("CREATE TABLE t1 (id INT);"),
 		nil, newTestResult().addResult(ruleName))
+
+	for _, sql := range []string{
+		"CREATE EVENT e1 ON SCHEDULE EVERY 1 DAY DO DELETE FROM testdb.t1 WHERE id < 10;",
+		"CREATE EVENT testdb.e1 ON SCHEDULE EVERY 1 DAY DO DELETE FROM t1 WHERE id < 10;",
+		"CREATE DEFINER = `root`@`%` EVENT testdb.e1 ON SCHEDULE EVERY 1 DAY DO BEGIN DELETE FROM testdb.t1 WHERE id < 10; INSERT INTO t2 SELECT * FROM testdb.t1; END",
+		"ALTER EVENT testdb.e1 RENAME TO e2;",
+	} {
+		runAIRuleCase(rule, t, "case 31: EVENT 时未指定库名", sql,
+			session.NewAIMockContext().WithSQL("CREATE DATABASE testdb; CREATE TABLE testdb.t1 (id INT);"),
+			nil, newTestResult().add(driverV2.RuleLevelWarn, "", "语法错误或者解析器不支持，请人工确认SQL正确性").addResult(ruleName))
+	}
+
+	for _, sql := range []string{
+		"CREATE EVENT testdb.e1 ON SCHEDULE EVERY 1 DAY DO DELETE FROM testdb.t1 WHERE id < 10;",
+		"CREATE EVENT testdb.e1 ON SCHEDULE AT CURRENT_TIMESTAMP + INTERVAL 1 HOUR DO BEGIN DELETE FROM testdb.t1 WHERE id < 10; UPDATE testdb.t1 SET id = 1; END",
+		"ALTER EVENT testdb.e1 ON SCHEDULE EVERY 2 DAY RENAME TO testdb.e2 DISABLE;",
+	} {
+		runAIRuleCase(rule, t, "case 32: EVENT 时指定库名", sql,
+			session.NewAIMockContext().WithSQL("CREATE DATABASE testdb; CREATE TABLE testdb.t1 (id INT);"),
+			nil, newTestResult().add(driverV2.RuleLevelWarn, "", "语法错误或者解析器不支持，请人工确认SQL正确性"))
+	}
 }
 
 // ==== Rule test code end ====
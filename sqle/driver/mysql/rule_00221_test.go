@@ -0,0 +1,55 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00221(t *testing.T) {
+	ruleName := ai.SQLE00221
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	param := "utf8mb4:utf8mb4_general_ci"
+
+	runAIRuleCase(rule, t, "case 1: CREATE TABLE 指定允许的字符集和排序规则", "CREATE TABLE exist_db.no_exist_tb_1 (id INT) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 2: CREATE TABLE 未指定字符集和排序规则", "CREATE TABLE exist_db.no_exist_tb_1 (id INT);",
+		nil, nil, newTestResult().addResult(ruleName, param))
+
+	runAIRuleCase(rule, t, "case 3: CREATE TABLE 仅指定字符集", "CREATE TABLE exist_db.no_exist_tb_1 (id INT) DEFAULT CHARSET=utf8mb4;",
+		nil, nil, newTestResult().addResult(ruleName, param))
+
+	runAIRuleCase(rule, t, "case 4: CREATE TABLE 指定不允许的排序规则", "CREATE TABLE exist_db.no_exist_tb_1 (id INT) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;",
+		nil, nil, newTestResult().addResult(ruleName, param))
+
+	runAIRuleCase(rule, t, "case 5: CREATE TABLE 字段指定与表不同的字符集", "CREATE TABLE exist_db.no_exist_tb_1 (id INT, name VARCHAR(32) CHARACTER SET latin1) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;",
+		nil, nil, newTestResult().addResult(ruleName, param))
+
+	runAIRuleCase(rule, t, "case 6: CREATE TABLE 字段指定与表不同的排序规则", "CREATE TABLE exist_db.no_exist_tb_1 (id INT, name VARCHAR(32) COLLATE utf8mb4_bin) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;",
+		nil, nil, newTestResult().addResult(ruleName, param))
+
+	runAIRuleCase(rule, t, "case 7: CREATE TABLE 字段指定与表相同的字符集和排序规则", "CREATE TABLE exist_db.no_exist_tb_1 (id INT, name VARCHAR(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: ALTER TABLE CONVERT TO 指定允许的字符集和排序规则", "ALTER TABLE exist_db.exist_tb_1 CONVERT TO CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 9: ALTER TABLE CONVERT TO 未指定排序规则", "ALTER TABLE exist_db.exist_tb_1 CONVERT TO CHARACTER SET utf8mb4;",
+		nil, nil, newTestResult().addResult(ruleName, param))
+
+	runAIRuleCase(rule, t, "case 10: ALTER TABLE CONVERT TO 指定不允许的字符集", "ALTER TABLE exist_db.exist_tb_1 CONVERT TO CHARACTER SET latin1 COLLATE latin1_swedish_ci;",
+		nil, nil, newTestResult().addResult(ruleName, param))
+
+	runAIRuleCase(rule, t, "case 11: ALTER TABLE 未转换字符集", "ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT;",
+		nil, nil, newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "utf8mb4:utf8mb4_general_ci, utf8mb4:utf8mb4_bin")
+	runAIRuleCase(rule, t, "case 12: 参数允许多个组合", "CREATE TABLE exist_db.no_exist_tb_1 (id INT) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;",
+		nil, nil, newTestResult())
+}
+
+// ==== Rule test code end ====
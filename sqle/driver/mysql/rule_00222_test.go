@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00222(t *testing.T) {
+	ruleName := ai.SQLE00222
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL("CREATE TABLE users (id INT, phone VARCHAR(20), name VARCHAR(100), age INT);" +
+			"CREATE TABLE orders (id INT, user_phone VARCHAR(20), amount DECIMAL(10,2));")
+	}
+
+	runAIRuleCase(rule, t, "case 1: 字符串字段与数值比较", "SELECT id FROM users WHERE phone = 13800000000;",
+		newContext(), nil, newTestResult().addResult(ruleName, "phone"))
+
+	runAIRuleCase(rule, t, "case 2: 字符串字段与字符串比较", "SELECT id FROM users WHERE phone = '13800000000';",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 3: 数值字段与字符串比较", "SELECT id FROM users WHERE age > '18';",
+		newContext(), nil, newTestResult().addResult(ruleName, "age"))
+
+	runAIRuleCase(rule, t, "case 4: 常量在左侧", "SELECT id FROM users WHERE 13800000000 = phone;",
+		newContext(), nil, newTestResult().addResult(ruleName, "phone"))
+
+	runAIRuleCase(rule, t, "case 5: 表别名中的字段与数值比较", "SELECT u.id FROM users AS u JOIN orders AS o ON u.phone = o.user_phone WHERE o.user_phone IN (13800000000, 13900000000);",
+		newContext(), nil, newTestResult().addResult(ruleName, "user_phone"))
+
+	runAIRuleCase(rule, t, "case 6: JOIN ON条件中字段与数值比较", "SELECT u.id FROM users u LEFT JOIN orders o ON u.phone = o.user_phone AND o.user_phone = 1;",
+		newContext(), nil, newTestResult().addResult(ruleName, "user_phone"))
+
+	runAIRuleCase(rule, t, "case 7: 被函数包裹的字段不检查", "SELECT id FROM users WHERE LENGTH(phone) = 11;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: UPDATE语句", "UPDATE users SET name = 'a' WHERE phone BETWEEN 100 AND 200;",
+		newContext(), nil, newTestResult().addResult(ruleName, "phone"))
+
+	runAIRuleCase(rule, t, "case 9: DELETE语句", "DELETE FROM users WHERE phone = '1' AND age = 1;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 10: 子查询中字符串字段与数值比较", "SELECT id FROM orders WHERE amount > 1 AND user_phone IN (SELECT phone FROM users WHERE phone = 1);",
+		newContext(), nil, newTestResult().addResult(ruleName, "phone"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00223(t *testing.T) {
+	ruleName := ai.SQLE00223
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL("CREATE TABLE users (id INT PRIMARY KEY, name VARCHAR(32));" +
+			"CREATE TABLE orders (id INT PRIMARY KEY, user_id INT, shop_id INT, KEY idx_shop_user (shop_id, user_id));")
+	}
+
+	runAIRuleCase(rule, t, "case 1: 建表时外键字段没有索引", "CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT, CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id));",
+		newContext(), nil, newTestResult().addResult(ruleName, "(user_id)"))
+
+	runAIRuleCase(rule, t, "case 2: 建表时外键字段是索引的最左前缀", "CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT, name VARCHAR(32), KEY idx_user_name (user_id, name), CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id));",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 3: 建表时外键字段不是索引的最左前缀", "CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT, name VARCHAR(32), KEY idx_name_user (name, user_id), CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id));",
+		newContext(), nil, newTestResult().addResult(ruleName, "(user_id)"))
+
+	runAIRuleCase(rule, t, "case 4: 建表时外键字段为字段上定义的唯一键", "CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT UNIQUE KEY, CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id));",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 5: 建表时外键字段只有全文索引", "CREATE TABLE t1 (id INT PRIMARY KEY, user_name VARCHAR(32), FULLTEXT KEY idx_name (user_name), CONSTRAINT fk_user FOREIGN KEY (user_name) REFERENCES users (name));",
+		newContext(), nil, newTestResult().addResult(ruleName, "(user_name)"))
+
+	runAIRuleCase(rule, t, "case 6: 建表时多个外键", "CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT, shop_id INT, order_id INT, KEY idx_order (order_id), FOREIGN KEY (user_id, shop_id) REFERENCES users (id, name), FOREIGN KEY (order_id) REFERENCES orders (id));",
+		newContext(), nil, newTestResult().addResult(ruleName, "(user_id,shop_id)"))
+
+	runAIRuleCase(rule, t, "case 7: 修改表时外键字段被已有索引覆盖", "ALTER TABLE orders ADD CONSTRAINT fk_shop FOREIGN KEY (shop_id) REFERENCES users (id);",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: 修改表时外键字段没有索引", "ALTER TABLE orders ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id);",
+		newContext(), nil, newTestResult().addResult(ruleName, "(user_id)"))
+
+	runAIRuleCase(rule, t, "case 9: 修改表时同一语句中新增索引", "ALTER TABLE orders ADD KEY idx_user (user_id), ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id);",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 10: 修改表时不添加外键", "ALTER TABLE orders ADD COLUMN c1 INT;",
+		newContext(), nil, newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00224(t *testing.T) {
+	ruleName := ai.SQLE00224
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	newSQLModeExpectation := func(sqlMode string) []*AIMockSQLExpectation {
+		return []*AIMockSQLExpectation{{
+			Query: "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('sql_mode')",
+			Rows:  sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", sqlMode),
+		}}
+	}
+
+	runAIRuleCase(rule, t, "case 1: sql_mode包含所有必需的模式", "INSERT INTO t1 VALUES (1);",
+		session.NewAIMockContext().WithSQL("CREATE TABLE t1 (id INT);"),
+		newSQLModeExpectation("ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION"),
+		newTestResult())
+
+	runAIRuleCase(rule, t, "case 2: sql_mode缺少STRICT_TRANS_TABLES", "UPDATE t1 SET id = 2;",
+		session.NewAIMockContext().WithSQL("CREATE TABLE t1 (id INT);"),
+		newSQLModeExpectation("ONLY_FULL_GROUP_BY,NO_ENGINE_SUBSTITUTION"),
+		newTestResult().addResult(ruleName, "STRICT_TRANS_TABLES"))
+
+	runAIRuleCase(rule, t, "case 3: sql_mode为空", "CREATE TABLE t2 (id INT);",
+		session.NewAIMockContext(),
+		newSQLModeExpectation(""),
+		newTestResult().addResult(ruleName, "STRICT_TRANS_TABLES,ONLY_FULL_GROUP_BY"))
+
+	runAIRuleCase(rule, t, "case 4: 非DML和DDL语句不检查", "SET autocommit = 1;",
+		session.NewAIMockContext(),
+		newSQLModeExpectation(""),
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "strict_all_tables, NO_ZERO_DATE")
+	runAIRuleCase(rule, t, "case 5: 自定义必需的模式", "SELECT * FROM t1;",
+		session.NewAIMockContext().WithSQL("CREATE TABLE t1 (id INT);"),
+		newSQLModeExpectation("STRICT_TRANS_TABLES,NO_ZERO_DATE"),
+		newTestResult().addResult(ruleName, "STRICT_ALL_TABLES"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00225(t *testing.T) {
+	ruleName := ai.SQLE00225
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL("CREATE TABLE orders (shop_id INT NOT NULL, order_no VARCHAR(32) NOT NULL, user_id INT, remark VARCHAR(32), PRIMARY KEY (shop_id, order_no), UNIQUE KEY uniq_user (user_id));")
+	}
+
+	runAIRuleCase(rule, t, "case 1: 建表时联合主键的字段都定义了NOT NULL", "CREATE TABLE t1 (a INT NOT NULL, b INT NOT NULL, c INT, PRIMARY KEY (a, b));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 2: 建表时联合主键的字段未定义NOT NULL", "CREATE TABLE t1 (a INT NOT NULL, b INT, c INT, PRIMARY KEY (a, b));",
+		nil, nil, newTestResult().addResult(ruleName, "b"))
+
+	runAIRuleCase(rule, t, "case 3: 建表时字段上定义的主键未定义NOT NULL", "CREATE TABLE t1 (id INT PRIMARY KEY, c INT);",
+		nil, nil, newTestResult().addResult(ruleName, "id"))
+
+	runAIRuleCase(rule, t, "case 4: 建表时唯一键的字段定义为NULL", "CREATE TABLE t1 (id INT NOT NULL PRIMARY KEY, code VARCHAR(32) NULL, name VARCHAR(32) UNIQUE, UNIQUE KEY uniq_code (code));",
+		nil, nil, newTestResult().addResult(ruleName, "name,code"))
+
+	runAIRuleCase(rule, t, "case 5: 建表时普通索引的字段可以为空", "CREATE TABLE t1 (id INT NOT NULL PRIMARY KEY, c INT, KEY idx_c (c));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 6: 修改表时新增唯一键的字段可以为空", "ALTER TABLE orders ADD UNIQUE KEY uniq_remark (remark);",
+		newContext(), nil, newTestResult().addResult(ruleName, "remark"))
+
+	runAIRuleCase(rule, t, "case 7: 修改表时新增字段并定义为唯一键", "ALTER TABLE orders ADD COLUMN code VARCHAR(32) UNIQUE, ADD COLUMN code2 VARCHAR(32) NOT NULL UNIQUE;",
+		newContext(), nil, newTestResult().addResult(ruleName, "code"))
+
+	runAIRuleCase(rule, t, "case 8: 修改表时将主键字段修改为可空", "ALTER TABLE orders MODIFY COLUMN order_no VARCHAR(64) NULL;",
+		newContext(), nil, newTestResult().addResult(ruleName, "order_no"))
+
+	runAIRuleCase(rule, t, "case 9: 修改表时重命名唯一键字段且未定义NOT NULL", "ALTER TABLE orders CHANGE COLUMN user_id uid INT;",
+		newContext(), nil, newTestResult().addResult(ruleName, "uid"))
+
+	runAIRuleCase(rule, t, "case 10: 修改表时修改普通字段", "ALTER TABLE orders MODIFY COLUMN remark VARCHAR(64);",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 11: 修改表时新增唯一键并同时修改字段为NOT NULL", "ALTER TABLE orders MODIFY COLUMN remark VARCHAR(32) NOT NULL, ADD UNIQUE KEY uniq_shop_remark (shop_id, remark);",
+		newContext(), nil, newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 12: 离线审核时仅检查语句中定义的字段", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN a INT, ADD COLUMN b INT NOT NULL, ADD UNIQUE KEY uniq_a_b_c (a, b, c);",
+		newTestResult().addResult(ruleName, "a"))
+}
+
+// ==== Rule test code end ====
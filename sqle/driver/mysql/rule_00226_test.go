@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00226(t *testing.T) {
+	ruleName := ai.SQLE00226
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runAIRuleCase(rule, t, "case 1: 表名匹配大表的正则表达式，未使用分区", "CREATE TABLE access_log (id BIGINT NOT NULL, create_time DATETIME NOT NULL, PRIMARY KEY (id));",
+		nil, nil, newTestResult().addResult(ruleName, "access_log"))
+
+	runAIRuleCase(rule, t, "case 2: 表名匹配大表的正则表达式，使用了RANGE分区", "CREATE TABLE access_log (id BIGINT NOT NULL, create_time DATETIME NOT NULL, PRIMARY KEY (id, create_time)) PARTITION BY RANGE (TO_DAYS(create_time)) (PARTITION p202401 VALUES LESS THAN (TO_DAYS('2024-02-01')), PARTITION pmax VALUES LESS THAN MAXVALUE);",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 3: 表名不匹配大表的正则表达式", "CREATE TABLE users (id BIGINT NOT NULL, PRIMARY KEY (id));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 4: 临时表不做检查", "CREATE TEMPORARY TABLE tmp_log (id BIGINT NOT NULL);",
+		nil, nil, newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: 离线审核，表名匹配大表的正则表达式，未使用分区", DefaultMysqlInspectOffline(),
+		"CREATE TABLE exist_db.audit_log (id BIGINT NOT NULL);", newTestResult().addResult(ruleName, "audit_log"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "^(order|payment)_.*")
+
+	runAIRuleCase(rule, t, "case 6: 自定义大表的正则表达式，未使用分区", "CREATE TABLE order_detail (id BIGINT NOT NULL);",
+		nil, nil, newTestResult().addResult(ruleName, "order_detail"))
+
+	runAIRuleCase(rule, t, "case 7: 自定义大表的正则表达式，使用了HASH分区", "CREATE TABLE payment_flow (id BIGINT NOT NULL) PARTITION BY HASH (id) PARTITIONS 4;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: 自定义大表的正则表达式，表名不匹配", "CREATE TABLE access_log (id BIGINT NOT NULL);",
+		nil, nil, newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,61 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00227(t *testing.T) {
+	ruleName := ai.SQLE00227
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runAIRuleCase(rule, t, "case 1: CREATE TABLE 中TEXT列的索引未指定前缀长度", "CREATE TABLE t1 (id INT PRIMARY KEY, content TEXT, KEY idx_content (content));",
+		nil, nil, newTestResult().addResult(ruleName, "idx_content(content)"))
+
+	runAIRuleCase(rule, t, "case 2: CREATE TABLE 中TEXT列的索引指定了前缀长度", "CREATE TABLE t1 (id INT PRIMARY KEY, content TEXT, KEY idx_content (content(100)));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 3: CREATE TABLE 中BLOB列的前缀长度超过参数", "CREATE TABLE t1 (id INT PRIMARY KEY, data BLOB, UNIQUE KEY uk_data (data(500)));",
+		nil, nil, newTestResult().addResult(ruleName, "uk_data(data)"))
+
+	runAIRuleCase(rule, t, "case 4: CREATE TABLE 中JSON列和未命名的复合索引", "CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32), attrs JSON, INDEX (name, attrs));",
+		nil, nil, newTestResult().addResult(ruleName, "name(attrs)"))
+
+	runAIRuleCase(rule, t, "case 5: CREATE TABLE 中TEXT列定义了列约束的主键和唯一键", "CREATE TABLE t1 (id TEXT PRIMARY KEY, content MEDIUMTEXT UNIQUE);",
+		nil, nil, newTestResult().addResult(ruleName, "PRIMARY(id), content(content)"))
+
+	runAIRuleCase(rule, t, "case 6: CREATE TABLE 中TEXT列的全文索引不检查", "CREATE TABLE t1 (id INT PRIMARY KEY, content TEXT, FULLTEXT KEY ft_content (content));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 7: ALTER TABLE 对原表的BLOB列添加索引", "ALTER TABLE exist_db.exist_tb_12 ADD INDEX idx_v1 (v1);",
+		nil, nil, newTestResult().addResult(ruleName, "idx_v1(v1)"))
+
+	runAIRuleCase(rule, t, "case 8: ALTER TABLE 新增TEXT列并添加前缀索引", "ALTER TABLE exist_db.exist_tb_12 ADD COLUMN v4 TEXT, ADD INDEX idx_v4 (v4(64));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 9: ALTER TABLE 新增TEXT列并添加唯一列约束", "ALTER TABLE exist_db.exist_tb_12 ADD COLUMN v4 TEXT UNIQUE;",
+		nil, nil, newTestResult().addResult(ruleName, "v4(v4)"))
+
+	runAIRuleCase(rule, t, "case 10: CREATE INDEX 对原表的BLOB列未指定前缀长度", "CREATE INDEX idx_v1_v2 ON exist_db.exist_tb_12 (v2, v1);",
+		nil, nil, newTestResult().addResult(ruleName, "idx_v1_v2(v1)"))
+
+	runAIRuleCase(rule, t, "case 11: CREATE INDEX 对原表的BLOB列指定了前缀长度", "CREATE INDEX idx_v1 ON exist_db.exist_tb_12 (v1(32));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 12: CREATE INDEX 不涉及TEXT/BLOB/JSON列", "CREATE INDEX idx_v2 ON exist_db.exist_tb_12 (v2);",
+		nil, nil, newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 13: 离线审核，CREATE TABLE 中TEXT列的索引未指定前缀长度", DefaultMysqlInspectOffline(),
+		"CREATE TABLE exist_db.t1 (id INT PRIMARY KEY, content TEXT, KEY idx_content (content));", newTestResult().addResult(ruleName, "idx_content(content)"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "0")
+
+	runAIRuleCase(rule, t, "case 14: 不限制前缀长度的最大值", "CREATE TABLE t1 (id INT PRIMARY KEY, data BLOB, UNIQUE KEY uk_data (data(500)));",
+		nil, nil, newTestResult())
+}
+
+// ==== Rule test code end ====
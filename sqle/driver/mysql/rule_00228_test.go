@@ -0,0 +1,54 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00228(t *testing.T) {
+	ruleName := ai.SQLE00228
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	message := "在 MySQL 中，为表新增NOT NULL字段时必须指定默认值，字段: %v"
+
+	runAIRuleCase(rule, t, "case 1: ALTER TABLE 为小表新增没有默认值的NOT NULL字段", "ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT NOT NULL;",
+		nil, nil, newTestResult().add(driverV2.RuleLevelWarn, ruleName, message, "v3"))
+
+	runAIRuleCase(rule, t, "case 2: ALTER TABLE 新增有默认值的NOT NULL字段", "ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT NOT NULL DEFAULT 0;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 3: ALTER TABLE 新增可为空的字段", "ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT, ADD COLUMN v4 VARCHAR(32) NULL;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 4: ALTER TABLE 新增多个字段，包括自增列和生成列", "ALTER TABLE exist_db.exist_tb_1 ADD COLUMN (v3 INT NOT NULL, v4 BIGINT NOT NULL AUTO_INCREMENT, v5 INT AS (id + 1) STORED NOT NULL, v6 INT NULL);",
+		nil, nil, newTestResult().add(driverV2.RuleLevelWarn, ruleName, message, "v3"))
+
+	runAIRuleCase(rule, t, "case 5: ALTER TABLE 不是新增字段", "ALTER TABLE exist_db.exist_tb_1 MODIFY COLUMN v1 VARCHAR(255) NOT NULL;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 6: CREATE TABLE 不检查", "CREATE TABLE exist_db.t1 (id INT NOT NULL, v1 INT NOT NULL);",
+		nil, nil, newTestResult())
+
+	// exist_tb_4 的大小为100MB
+	runAIRuleCase(rule, t, "case 7: ALTER TABLE 为未超过阈值的表新增没有默认值的NOT NULL字段", "ALTER TABLE exist_db.exist_tb_4 ADD COLUMN v9 INT NOT NULL;",
+		nil, nil, newTestResult().add(driverV2.RuleLevelWarn, ruleName, message, "v9"))
+
+	largeTableRule := rule
+	largeTableRule.Params = rule.Params.Copy()
+	largeTableRule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "100")
+	runAIRuleCase(largeTableRule, t, "case 8: ALTER TABLE 为超过阈值的大表新增没有默认值的NOT NULL字段", "ALTER TABLE exist_db.exist_tb_4 ADD COLUMN v9 INT NOT NULL;",
+		nil, nil, newTestResult().addResult(ruleName, "v9"))
+
+	warnRule := largeTableRule
+	warnRule.Level = driverV2.RuleLevelWarn
+	runAIRuleCase(warnRule, t, "case 9: 规则等级为warn时，小表按notice提示", "ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT NOT NULL;",
+		nil, nil, newTestResult().add(driverV2.RuleLevelNotice, ruleName, message, "v3"))
+
+	runSingleRuleInspectCase(rule, t, "case 10: 离线审核时按较低的等级提示", DefaultMysqlInspectOffline(), "ALTER TABLE exist_db.exist_tb_4 ADD COLUMN v9 INT NOT NULL;",
+		newTestResult().add(driverV2.RuleLevelWarn, ruleName, message, "v9"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00229(t *testing.T) {
+	ruleName := ai.SQLE00229
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runAIRuleCase(rule, t, "case 1: CREATE TABLE 使用MyISAM存储引擎", "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY) ENGINE=MyISAM;",
+		nil, nil, newTestResult().addResult(ruleName, "MyISAM"))
+
+	runAIRuleCase(rule, t, "case 2: CREATE TABLE 使用InnoDB存储引擎，不区分大小写", "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY) ENGINE=innodb;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 3: CREATE TABLE 未指定存储引擎，默认不检查", "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY);",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 4: CREATE TABLE ... LIKE 不检查", "CREATE TABLE exist_db.t1 LIKE exist_db.exist_tb_1;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 5: ALTER TABLE 修改为MEMORY存储引擎", "ALTER TABLE exist_db.exist_tb_1 ENGINE=MEMORY;",
+		nil, nil, newTestResult().addResult(ruleName, "MEMORY"))
+
+	runAIRuleCase(rule, t, "case 6: ALTER TABLE 修改为InnoDB存储引擎", "ALTER TABLE exist_db.exist_tb_1 ENGINE=InnoDB;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 7: ALTER TABLE 不修改存储引擎", "ALTER TABLE exist_db.exist_tb_1 COMMENT='test';",
+		nil, nil, newTestResult())
+
+	multiEngineRule := rule
+	multiEngineRule.Params = rule.Params.Copy()
+	multiEngineRule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "InnoDB, RocksDB")
+	runAIRuleCase(multiEngineRule, t, "case 8: 允许多个存储引擎", "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY) ENGINE=RocksDB;",
+		nil, nil, newTestResult())
+	runAIRuleCase(multiEngineRule, t, "case 9: 允许多个存储引擎，使用不允许的存储引擎", "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY) ENGINE=ARCHIVE;",
+		nil, nil, newTestResult().addResult(ruleName, "ARCHIVE"))
+
+	checkUnspecifiedRule := rule
+	checkUnspecifiedRule.Params = rule.Params.Copy()
+	checkUnspecifiedRule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "true")
+	runAIRuleCase(checkUnspecifiedRule, t, "case 10: 未指定存储引擎，默认存储引擎为InnoDB", "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY);",
+		nil, nil, newTestResult())
+	runSingleRuleInspectCase(checkUnspecifiedRule, t, "case 11: 离线审核时未指定存储引擎", DefaultMysqlInspectOffline(), "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY);",
+		newTestResult().addResult(ruleName, "(default_storage_engine)"))
+
+	checkUnspecifiedRule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "RocksDB")
+	runAIRuleCase(checkUnspecifiedRule, t, "case 12: 未指定存储引擎，默认存储引擎不在允许的范围内", "CREATE TABLE exist_db.t1 (id INT PRIMARY KEY);",
+		nil, nil, newTestResult().addResult(ruleName, "InnoDB"))
+
+	runSingleRuleInspectCase(rule, t, "case 13: 离线审核时使用MyISAM存储引擎", DefaultMysqlInspectOffline(), "CREATE TABLE t1 (id INT PRIMARY KEY) ENGINE=MyISAM;",
+		newTestResult().addResult(ruleName, "MyISAM"))
+}
+
+// ==== Rule test code end ====
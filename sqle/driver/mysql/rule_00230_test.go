@@ -0,0 +1,55 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00230(t *testing.T) {
+	ruleName := ai.SQLE00230
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runAIRuleCase(rule, t, "case 1: SELECT ... FOR UPDATE 没有WHERE条件", "SELECT * FROM exist_db.exist_tb_1 FOR UPDATE;",
+		nil, nil, newTestResult().addResult(ruleName, "FOR UPDATE", "exist_tb_1"))
+
+	runAIRuleCase(rule, t, "case 2: SELECT ... FOR UPDATE 使用主键等值条件", "SELECT * FROM exist_db.exist_tb_1 WHERE id = 1 FOR UPDATE;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 3: SELECT ... FOR UPDATE 使用非索引第一列的条件", "SELECT * FROM exist_db.exist_tb_1 WHERE v2 = 'a' FOR UPDATE;",
+		nil, nil, newTestResult().addResult(ruleName, "FOR UPDATE", "exist_tb_1"))
+
+	runAIRuleCase(rule, t, "case 4: LOCK IN SHARE MODE 使用索引列的范围条件", "SELECT * FROM exist_db.exist_tb_1 WHERE v1 > 'a' AND v2 = 'b' LOCK IN SHARE MODE;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 5: LOCK IN SHARE MODE 没有WHERE条件", "SELECT * FROM exist_db.exist_tb_1 LOCK IN SHARE MODE;",
+		nil, nil, newTestResult().addResult(ruleName, "LOCK IN SHARE MODE", "exist_tb_1"))
+
+	runAIRuleCase(rule, t, "case 6: SELECT ... FOR UPDATE 使用IN和BETWEEN条件", "SELECT * FROM exist_db.exist_tb_1 WHERE (id IN (1, 2) OR v2 = 'a') AND v1 BETWEEN 'a' AND 'c' FOR UPDATE;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 7: SELECT ... FOR UPDATE 使用OR连接的条件", "SELECT * FROM exist_db.exist_tb_1 WHERE id = 1 OR v2 = 'a' FOR UPDATE;",
+		nil, nil, newTestResult().addResult(ruleName, "FOR UPDATE", "exist_tb_1"))
+
+	runAIRuleCase(rule, t, "case 8: SELECT ... FOR UPDATE 对索引列使用函数", "SELECT * FROM exist_db.exist_tb_1 WHERE id + 1 = 2 FOR UPDATE;",
+		nil, nil, newTestResult().addResult(ruleName, "FOR UPDATE", "exist_tb_1"))
+
+	runAIRuleCase(rule, t, "case 9: 不加锁的SELECT不检查", "SELECT * FROM exist_db.exist_tb_1 WHERE v2 = 'a';",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 10: 多表加锁读使用别名和索引列条件", "SELECT * FROM exist_db.exist_tb_1 t1 JOIN exist_db.exist_tb_2 t2 ON t1.id = t2.user_id WHERE t2.id = 1 FOR UPDATE;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 11: 多表加锁读只有连接条件", "SELECT * FROM exist_db.exist_tb_1 t1, exist_db.exist_tb_2 t2 WHERE t1.id = t2.user_id AND t2.v2 = 'a' FOR UPDATE;",
+		nil, nil, newTestResult().addResult(ruleName, "FOR UPDATE", "exist_tb_1,exist_tb_2"))
+
+	runSingleRuleInspectCase(rule, t, "case 12: 离线审核时没有WHERE条件", DefaultMysqlInspectOffline(), "SELECT * FROM exist_tb_1 FOR UPDATE;",
+		newTestResult().addResult(ruleName, "FOR UPDATE", "exist_tb_1"))
+
+	runSingleRuleInspectCase(rule, t, "case 13: 离线审核时无法获取索引信息，有WHERE条件时不检查", DefaultMysqlInspectOffline(), "SELECT * FROM exist_tb_1 WHERE v2 = 'a' FOR UPDATE;",
+		newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,37 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00231(t *testing.T) {
+	ruleName := ai.SQLE00231
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runAIRuleCase(rule, t, "case 1: ALTER TABLE 删除分区", "ALTER TABLE exist_db.exist_tb_3 DROP PARTITION p1;",
+		nil, nil, newTestResult().addResult(ruleName, "exist_tb_3", "p1"))
+
+	runAIRuleCase(rule, t, "case 2: ALTER TABLE 删除多个分区", "ALTER TABLE exist_db.exist_tb_3 DROP PARTITION p1, p2;",
+		nil, nil, newTestResult().addResult(ruleName, "exist_tb_3", "p1,p2"))
+
+	runAIRuleCase(rule, t, "case 3: ALTER TABLE 新增分区", "ALTER TABLE exist_db.exist_tb_3 ADD PARTITION (PARTITION p4 VALUES IN (10, 11));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 4: ALTER TABLE 重组分区", "ALTER TABLE exist_db.exist_tb_3 REORGANIZE PARTITION p1 INTO (PARTITION p1a VALUES IN (1), PARTITION p1b VALUES IN (2, 3));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 5: ALTER TABLE 清空分区", "ALTER TABLE exist_db.exist_tb_3 TRUNCATE PARTITION p1;",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 6: ALTER TABLE 删除字段", "ALTER TABLE exist_db.exist_tb_3 DROP COLUMN v3;",
+		nil, nil, newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 7: 离线审核时删除分区", DefaultMysqlInspectOffline(), "ALTER TABLE exist_tb_3 DROP PARTITION p2;",
+		newTestResult().addResult(ruleName, "exist_tb_3", "p2"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00232(t *testing.T) {
+	ruleName := ai.SQLE00232
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runAIRuleCase(rule, t, "case 1: 字段顺序符合规范", "CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32), created_at DATETIME, updated_at DATETIME);",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 2: 主键字段不是第一列", "CREATE TABLE t1 (name VARCHAR(32), id INT PRIMARY KEY, created_at DATETIME, updated_at DATETIME);",
+		nil, nil, newTestResult().addResult(ruleName, "t1", "id,...,created_at,updated_at"))
+
+	runAIRuleCase(rule, t, "case 3: 审计字段不是最后的列", "CREATE TABLE t1 (id INT PRIMARY KEY, created_at DATETIME, updated_at DATETIME, name VARCHAR(32));",
+		nil, nil, newTestResult().addResult(ruleName, "t1", "id,...,created_at,updated_at"))
+
+	runAIRuleCase(rule, t, "case 4: 审计字段顺序与参数不一致", "CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32), updated_at DATETIME, created_at DATETIME);",
+		nil, nil, newTestResult().addResult(ruleName, "t1", "id,...,created_at,updated_at"))
+
+	runAIRuleCase(rule, t, "case 5: 表中没有审计字段", "CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32));",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 6: 表中只有部分审计字段，且为最后一列", "CREATE TABLE t1 (ID INT PRIMARY KEY, name VARCHAR(32), Updated_At DATETIME);",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 7: 表中没有主键字段", "CREATE TABLE t1 (name VARCHAR(32), created_at DATETIME, updated_at DATETIME);",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: CREATE TABLE ... LIKE 不检查", "CREATE TABLE t1 LIKE exist_db.exist_tb_1;",
+		nil, nil, newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsThirdKeyName, "true")
+	runAIRuleCase(rule, t, "case 9: 要求包含审计字段，表中缺少审计字段", "CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32), created_at DATETIME);",
+		nil, nil, newTestResult().addResult(ruleName, "t1", "id,...,created_at,updated_at"))
+
+	runAIRuleCase(rule, t, "case 10: 要求包含审计字段，字段顺序符合规范", "CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32), created_at DATETIME, updated_at DATETIME);",
+		nil, nil, newTestResult())
+
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "pk_id")
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "create_time, update_time, version")
+	runAIRuleCase(rule, t, "case 11: 自定义主键字段和审计字段", "CREATE TABLE t1 (pk_id INT PRIMARY KEY, name VARCHAR(32), create_time DATETIME, update_time DATETIME, version INT);",
+		nil, nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 12: 自定义主键字段不是第一列", "CREATE TABLE t1 (name VARCHAR(32), pk_id INT PRIMARY KEY, create_time DATETIME, update_time DATETIME, version INT);",
+		nil, nil, newTestResult().addResult(ruleName, "t1", "pk_id,...,create_time,update_time,version"))
+
+	runSingleRuleInspectCase(rule, t, "case 13: 离线审核", DefaultMysqlInspectOffline(), "CREATE TABLE t1 (pk_id INT PRIMARY KEY, create_time DATETIME, update_time DATETIME, version INT, name VARCHAR(32));",
+		newTestResult().addResult(ruleName, "t1", "pk_id,...,create_time,update_time,version"))
+}
+
+// ==== Rule test code end ====
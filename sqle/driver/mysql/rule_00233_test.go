@@ -0,0 +1,48 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00233(t *testing.T) {
+	ruleName := ai.SQLE00233
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: SELECT 使用 NOT IN 非关联子查询", DefaultMysqlInspect(),
+		"SELECT * FROM exist_db.exist_tb_1 WHERE v1 NOT IN (SELECT v1 FROM exist_db.exist_tb_2);",
+		newTestResult().addResult(ruleName, "SELECT * FROM `exist_db`.`exist_tb_1` WHERE NOT EXISTS (SELECT 1 FROM `exist_db`.`exist_tb_2` WHERE `v1`=`exist_db`.`exist_tb_1`.`v1`)"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: SELECT 使用 NOT IN 关联子查询", DefaultMysqlInspect(),
+		"SELECT * FROM exist_db.exist_tb_1 t1 WHERE v1 NOT IN (SELECT v1 FROM exist_db.exist_tb_2 t2 WHERE t2.v2 = t1.v2);",
+		newTestResult().addResult(ruleName, "SELECT * FROM `exist_db`.`exist_tb_1` AS `t1` WHERE NOT EXISTS (SELECT 1 FROM `exist_db`.`exist_tb_2` AS `t2` WHERE `t2`.`v2`=`t1`.`v2` AND `v1`=`t1`.`v1`)"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: DELETE 使用 NOT IN 子查询", DefaultMysqlInspect(),
+		"DELETE FROM exist_db.exist_tb_1 WHERE id NOT IN (SELECT user_id FROM exist_db.exist_tb_2);",
+		newTestResult().addResult(ruleName, "DELETE FROM `exist_db`.`exist_tb_1` WHERE NOT EXISTS (SELECT 1 FROM `exist_db`.`exist_tb_2` WHERE `user_id`=`exist_db`.`exist_tb_1`.`id`)"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: NOT IN 子查询包含聚合函数，无法改写", DefaultMysqlInspect(),
+		"SELECT * FROM exist_db.exist_tb_1 WHERE id NOT IN (SELECT MAX(user_id) FROM exist_db.exist_tb_2);",
+		newTestResult().addResult(ruleName, "-"))
+
+	runSingleRuleInspectCase(rule, t, "case 5: NOT IN 值列表不检查", DefaultMysqlInspect(),
+		"SELECT * FROM exist_db.exist_tb_1 WHERE id NOT IN (1, 2, 3);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 6: IN 子查询不检查", DefaultMysqlInspect(),
+		"SELECT * FROM exist_db.exist_tb_1 WHERE id IN (SELECT user_id FROM exist_db.exist_tb_2);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 7: 离线审核 UPDATE 使用 NOT IN 子查询", DefaultMysqlInspectOffline(),
+		"UPDATE t1 SET v1 = 'a' WHERE id NOT IN (SELECT user_id FROM t2 WHERE v2 = 'b');",
+		newTestResult().addResult(ruleName, "UPDATE `t1` SET `v1`='a' WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE `v2`='b' AND `user_id`=`t1`.`id`)"))
+
+	runSingleRuleInspectCase(rule, t, "case 8: INSERT ... SELECT 使用 NOT IN 子查询", DefaultMysqlInspect(),
+		"INSERT INTO exist_db.exist_tb_2 (user_id) SELECT id FROM exist_db.exist_tb_1 WHERE id NOT IN (SELECT user_id FROM exist_db.exist_tb_2);",
+		newTestResult().addResult(ruleName, "INSERT INTO `exist_db`.`exist_tb_2` (`user_id`) SELECT `id` FROM `exist_db`.`exist_tb_1` WHERE NOT EXISTS (SELECT 1 FROM `exist_db`.`exist_tb_2` WHERE `user_id`=`exist_db`.`exist_tb_1`.`id`)"))
+}
+
+// ==== Rule test code end ====
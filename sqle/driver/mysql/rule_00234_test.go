@@ -0,0 +1,76 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00234(t *testing.T) {
+	ruleName := ai.SQLE00234
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	message := "在 MySQL 中，TIMESTAMP 字段应显式指定 DEFAULT 和 NULL/NOT NULL，或使用 DATETIME 代替，字段: %v"
+	newExplicitDefaultsExpectation := func(value string) []*AIMockSQLExpectation {
+		return []*AIMockSQLExpectation{{
+			Query: "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('explicit_defaults_for_timestamp')",
+			Rows:  sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("explicit_defaults_for_timestamp", value),
+		}}
+	}
+
+	runAIRuleCase(rule, t, "case 1: CREATE TABLE 中 TIMESTAMP 字段显式指定 DEFAULT 和 NOT NULL", "CREATE TABLE t1 (id INT PRIMARY KEY, created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_at TIMESTAMP NULL DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP);",
+		session.NewAIMockContext(),
+		newExplicitDefaultsExpectation("OFF"),
+		newTestResult())
+
+	runAIRuleCase(rule, t, "case 2: CREATE TABLE 中 TIMESTAMP 字段未指定 DEFAULT 和 NULL，explicit_defaults_for_timestamp 为 OFF", "CREATE TABLE t1 (id INT PRIMARY KEY, created_at TIMESTAMP);",
+		session.NewAIMockContext(),
+		newExplicitDefaultsExpectation("OFF"),
+		newTestResult().addResult(ruleName, "created_at"))
+
+	runAIRuleCase(rule, t, "case 3: CREATE TABLE 中 TIMESTAMP 字段未指定 DEFAULT，explicit_defaults_for_timestamp 为 ON", "CREATE TABLE t1 (id INT PRIMARY KEY, created_at TIMESTAMP NOT NULL);",
+		session.NewAIMockContext(),
+		newExplicitDefaultsExpectation("ON"),
+		newTestResult().add(driverV2.RuleLevelNotice, ruleName, message, "created_at"))
+
+	runAIRuleCase(rule, t, "case 4: CREATE TABLE 中 TIMESTAMP 字段只指定 DEFAULT", "CREATE TABLE t1 (id INT PRIMARY KEY, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, v1 DATETIME);",
+		session.NewAIMockContext(),
+		newExplicitDefaultsExpectation("OFF"),
+		newTestResult().addResult(ruleName, "created_at"))
+
+	runAIRuleCase(rule, t, "case 5: ALTER TABLE 新增 TIMESTAMP 字段未指定 DEFAULT", "ALTER TABLE t1 ADD COLUMN updated_at TIMESTAMP NULL, ADD COLUMN v2 INT;",
+		session.NewAIMockContext().WithSQL("CREATE TABLE t1 (id INT PRIMARY KEY, v1 INT);"),
+		newExplicitDefaultsExpectation("OFF"),
+		newTestResult().addResult(ruleName, "updated_at"))
+
+	runAIRuleCase(rule, t, "case 6: ALTER TABLE 新增 DATETIME 字段", "ALTER TABLE t1 ADD COLUMN updated_at DATETIME;",
+		session.NewAIMockContext().WithSQL("CREATE TABLE t1 (id INT PRIMARY KEY, v1 INT);"),
+		newExplicitDefaultsExpectation("OFF"),
+		newTestResult())
+
+	runAIRuleCase(rule, t, "case 7: 生成列不检查", "CREATE TABLE t1 (id INT PRIMARY KEY, d DATETIME NOT NULL DEFAULT '2000-01-01 00:00:00', ts TIMESTAMP AS (d));",
+		session.NewAIMockContext(),
+		newExplicitDefaultsExpectation("OFF"),
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 8: 离线审核 TIMESTAMP 字段未指定 DEFAULT", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a TIMESTAMP, b TIMESTAMP NOT NULL DEFAULT '2000-01-01 00:00:00', c TIMESTAMP NOT NULL);",
+		newTestResult().addResult(ruleName, "a,c"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "true")
+	runAIRuleCase(rule, t, "case 9: 要求使用 DATETIME 代替 TIMESTAMP", "CREATE TABLE t1 (id INT PRIMARY KEY, created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP);",
+		session.NewAIMockContext(),
+		newExplicitDefaultsExpectation("OFF"),
+		newTestResult().addResult(ruleName, "created_at"))
+
+	runSingleRuleInspectCase(rule, t, "case 10: 离线审核 要求使用 DATETIME 代替 TIMESTAMP", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP);",
+		newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00235(t *testing.T) {
+	ruleName := ai.SQLE00235
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE 金额字段使用 DECIMAL", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, order_amount DECIMAL(18,2), unit_price DECIMAL(10,4), weight DOUBLE);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE 金额字段使用 FLOAT 和 DOUBLE", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, Total_Amount FLOAT, price DOUBLE(10,2), discount_cost REAL);",
+		newTestResult().addResult(ruleName, "Total_Amount(float),price(double(10,2)),discount_cost(double)"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: CREATE TABLE 金额字段 DECIMAL 小数位不足", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, amount DECIMAL(10,1), money DECIMAL(10), cost DECIMAL);",
+		newTestResult().addResult(ruleName, "amount(decimal(10,1)),money(decimal(10)),cost(decimal)"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: ALTER TABLE 新增 DOUBLE 金额字段", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 ADD COLUMN refund_amount DOUBLE;",
+		newTestResult().addResult(ruleName, "refund_amount(double)"))
+
+	runSingleRuleInspectCase(rule, t, "case 5: ALTER TABLE 修改金额字段为 FLOAT", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 MODIFY COLUMN v1 FLOAT, CHANGE COLUMN v2 price FLOAT(8,3);",
+		newTestResult().addResult(ruleName, "price(float(8,3))"))
+
+	runSingleRuleInspectCase(rule, t, "case 6: ALTER TABLE 修改金额字段为 DECIMAL", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 CHANGE COLUMN v2 price DECIMAL(12,2);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 7: 非金额字段不检查", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, score FLOAT, rate DECIMAL(5,0));",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "^(fee|balance)$")
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "4")
+	runSingleRuleInspectCase(rule, t, "case 8: 自定义金额字段名和最小小数位数", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, fee DECIMAL(18,2), balance DECIMAL(18,4), amount FLOAT);",
+		newTestResult().addResult(ruleName, "fee(decimal(18,2))"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00236(t *testing.T) {
+	ruleName := ai.SQLE00236
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE 使用 DYNAMIC 行格式", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY) ENGINE=InnoDB ROW_FORMAT=DYNAMIC;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE 使用 COMPACT 行格式", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY) ENGINE=InnoDB ROW_FORMAT=COMPACT;",
+		newTestResult().addResult(ruleName, "t1", "COMPACT"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: CREATE TABLE 未指定行格式", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY) ENGINE=InnoDB;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 4: CREATE TABLE 行格式为 DEFAULT", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY) ROW_FORMAT=DEFAULT;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: ALTER TABLE 修改为 REDUNDANT 行格式", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT, ROW_FORMAT=REDUNDANT;",
+		newTestResult().addResult(ruleName, "exist_tb_1", "REDUNDANT"))
+
+	runSingleRuleInspectCase(rule, t, "case 6: ALTER TABLE 修改为 DYNAMIC 行格式", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 ROW_FORMAT=dynamic;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 7: ALTER TABLE 不修改行格式", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 ENGINE=InnoDB;",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "dynamic, compressed")
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "true")
+	runSingleRuleInspectCase(rule, t, "case 8: 允许多个行格式", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY) ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 9: 要求建表时指定行格式", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY) ENGINE=InnoDB;",
+		newTestResult().addResult(ruleName, "t1", "-"))
+
+	runSingleRuleInspectCase(rule, t, "case 10: CREATE TABLE LIKE 不检查", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 LIKE t2;",
+		newTestResult())
+}
+
+// ==== Rule test code end ====
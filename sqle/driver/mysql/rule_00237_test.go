@@ -0,0 +1,74 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00237(t *testing.T) {
+	ruleName := ai.SQLE00237
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE 整数主键未设置自增", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id BIGINT UNSIGNED NOT NULL PRIMARY KEY, name VARCHAR(32));",
+		newTestResult().addResult(ruleName, "id"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE 整数主键设置自增", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT, name VARCHAR(32), PRIMARY KEY (id));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 3: CREATE TABLE 主键约束中的整数字段未设置自增", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (user_id INT NOT NULL, name VARCHAR(32), CONSTRAINT pk_t1 PRIMARY KEY (user_id));",
+		newTestResult().addResult(ruleName, "user_id"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: CREATE TABLE 非整数主键", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (code VARCHAR(32) NOT NULL PRIMARY KEY, name VARCHAR(32));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: CREATE TABLE 多列主键", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (a INT NOT NULL, b INT NOT NULL, PRIMARY KEY (a, b));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 6: ALTER TABLE 新增整数主键字段未设置自增", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN id INT NOT NULL PRIMARY KEY;",
+		newTestResult().addResult(ruleName, "id"))
+
+	runSingleRuleInspectCase(rule, t, "case 7: ALTER TABLE 为同一语句修改的字段添加主键", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 MODIFY COLUMN id INT NOT NULL, ADD PRIMARY KEY (id);",
+		newTestResult().addResult(ruleName, "id"))
+
+	runSingleRuleInspectCase(rule, t, "case 8: ALTER TABLE 为已有字段添加主键，离线审核无法获取字段定义", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD PRIMARY KEY (id);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 9: ALTER TABLE 修改原有主键字段并去掉自增", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 MODIFY COLUMN id BIGINT UNSIGNED NOT NULL;",
+		newTestResult().addResult(ruleName, "id"))
+
+	runSingleRuleInspectCase(rule, t, "case 10: ALTER TABLE 修改原有主键字段并保留自增", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 CHANGE COLUMN id uid BIGINT UNSIGNED NOT NULL AUTO_INCREMENT;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 11: ALTER TABLE 修改非主键字段", DefaultMysqlInspect(),
+		"ALTER TABLE exist_db.exist_tb_1 MODIFY COLUMN v2 INT;",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "^dict_|_code$")
+	runSingleRuleInspectCase(rule, t, "case 12: 豁免的表名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE DICT_region (id INT NOT NULL PRIMARY KEY, name VARCHAR(32));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 13: 豁免的主键字段名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (region_code INT NOT NULL PRIMARY KEY, name VARCHAR(32));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 14: 不匹配豁免规则", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT NOT NULL PRIMARY KEY, name VARCHAR(32));",
+		newTestResult().addResult(ruleName, "id"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00238(t *testing.T) {
+	ruleName := ai.SQLE00238
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE CHAR长度超过阈值", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, name CHAR(32), code CHAR(16));",
+		newTestResult().addResult(ruleName, "name VARCHAR(32)"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE CHAR长度未超过阈值", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, code CHAR(16), flag CHAR, remark VARCHAR(255));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 3: CREATE TABLE BINARY字段不检查", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, hash BINARY(32), tag VARBINARY(2));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 4: 默认不检查短VARCHAR字段", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, gender VARCHAR(1));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: ALTER TABLE 新增和修改的字段CHAR长度超过阈值", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN title CHAR(64), MODIFY COLUMN code CHAR(8), CHANGE COLUMN addr address CHAR(100);",
+		newTestResult().addResult(ruleName, "title VARCHAR(64),address VARCHAR(100)"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "true")
+	runSingleRuleInspectCase(rule, t, "case 6: 检查短VARCHAR字段", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, gender VARCHAR(1), status VARCHAR(2), city VARCHAR(3), name CHAR(20));",
+		newTestResult().addResult(ruleName, "gender CHAR(1),status CHAR(2),name VARCHAR(20)"))
+
+	runSingleRuleInspectCase(rule, t, "case 7: ALTER TABLE 修改为短VARCHAR字段", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 MODIFY COLUMN gender VARCHAR(1);",
+		newTestResult().addResult(ruleName, "gender CHAR(1)"))
+
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "32")
+	runSingleRuleInspectCase(rule, t, "case 8: 调整CHAR最大长度", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, name CHAR(32), title CHAR(33));",
+		newTestResult().addResult(ruleName, "title VARCHAR(33)"))
+}
+
+// ==== Rule test code end ====
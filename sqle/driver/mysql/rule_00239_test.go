@@ -0,0 +1,54 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00239(t *testing.T) {
+	ruleName := ai.SQLE00239
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE 外键未命名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT, FOREIGN KEY (user_id) REFERENCES users (id));",
+		newTestResult().addResult(ruleName, "FOREIGN KEY (user_id) REFERENCES users (id)"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE 外键已命名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT, CONSTRAINT fk_t1_user FOREIGN KEY (user_id) REFERENCES users (id));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 3: CREATE TABLE 多个外键部分未命名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a INT, b INT, c INT, CONSTRAINT fk_a FOREIGN KEY (a) REFERENCES t2 (id), FOREIGN KEY (b, c) REFERENCES t3 (x, y));",
+		newTestResult().addResult(ruleName, "FOREIGN KEY (b,c) REFERENCES t3 (x,y)"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: ALTER TABLE 新增外键未命名", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD FOREIGN KEY (user_id) REFERENCES users (id);",
+		newTestResult().addResult(ruleName, "FOREIGN KEY (user_id) REFERENCES users (id)"))
+
+	runSingleRuleInspectCase(rule, t, "case 5: ALTER TABLE 新增外键已命名", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD CONSTRAINT fk_t1_user FOREIGN KEY (user_id) REFERENCES users (id);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 6: 非外键约束不检查", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD UNIQUE KEY (user_id), ADD INDEX (name);",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "fk_")
+	runSingleRuleInspectCase(rule, t, "case 7: 外键名不符合前缀要求", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, user_id INT, CONSTRAINT t1_user FOREIGN KEY (user_id) REFERENCES users (id));",
+		newTestResult().addResult(ruleName, "CONSTRAINT t1_user FOREIGN KEY (user_id) REFERENCES users (id)"))
+
+	runSingleRuleInspectCase(rule, t, "case 8: 外键名符合前缀要求", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD CONSTRAINT fk_t1_user FOREIGN KEY (user_id) REFERENCES users (id);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 9: 配置前缀时未命名外键", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD FOREIGN KEY (user_id) REFERENCES users (id);",
+		newTestResult().addResult(ruleName, "FOREIGN KEY (user_id) REFERENCES users (id)"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,61 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00240(t *testing.T) {
+	ruleName := ai.SQLE00240
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	inspectWithVersion := func(version string) *MysqlDriverImpl {
+		i := DefaultMysqlInspectOffline()
+		i.Ctx = session.NewContext(nil, session.WithTargetVersion(version))
+		return i
+	}
+
+	runSingleRuleInspectCase(rule, t, "case 1: SELECT 字段中使用已移除的函数，目标版本未知", DefaultMysqlInspectOffline(),
+		"SELECT PASSWORD('abc'), id FROM t1;",
+		newTestResult().addResult(ruleName, "PASSWORD: 8.0.11"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: WHERE 条件中使用已移除的函数", DefaultMysqlInspectOffline(),
+		"SELECT id FROM t1 WHERE secret = ENCODE('abc', 'key') AND name = decode(secret, 'key');",
+		newTestResult().addResult(ruleName, "ENCODE: 8.0.3, DECODE: 8.0.3"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: 嵌套函数中使用已移除的函数，重复的函数只提示一次", DefaultMysqlInspectOffline(),
+		"UPDATE t1 SET a = UPPER(DES_ENCRYPT(a)), b = DES_ENCRYPT(b) WHERE id = 1;",
+		newTestResult().addResult(ruleName, "DES_ENCRYPT: 8.0.3"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: 生成列表达式中使用已移除的函数", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, name VARCHAR(64), secret VARCHAR(64) AS (ENCRYPT(name)));",
+		newTestResult().addResult(ruleName, "ENCRYPT: 8.0.3"))
+
+	runSingleRuleInspectCase(rule, t, "case 5: 未使用已移除的函数", DefaultMysqlInspectOffline(),
+		"SELECT SHA2('abc', 256), AES_ENCRYPT('abc', 'key') FROM t1 WHERE id = 1;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 6: 目标版本中已移除", inspectWithVersion("8.0.30"),
+		"INSERT INTO t1 (a, b) VALUES (PASSWORD('abc'), OLD_PASSWORD('abc'));",
+		newTestResult().addResult(ruleName, "PASSWORD: 8.0.11, OLD_PASSWORD: 5.7.5"))
+
+	runSingleRuleInspectCase(rule, t, "case 7: 目标版本中部分函数仍可用", inspectWithVersion("5.7.44-log"),
+		"INSERT INTO t1 (a, b) VALUES (PASSWORD('abc'), OLD_PASSWORD('abc'));",
+		newTestResult().addResult(ruleName, "OLD_PASSWORD: 5.7.5"))
+
+	runSingleRuleInspectCase(rule, t, "case 8: MariaDB 不检查", inspectWithVersion("10.6.12-MariaDB"),
+		"SELECT PASSWORD('abc');",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "md5:9.0, PASSWORD:8.0.11")
+	runSingleRuleInspectCase(rule, t, "case 9: 自定义函数列表", inspectWithVersion("9.1.0"),
+		"SELECT MD5(name), PASSWORD(name) FROM t1;",
+		newTestResult().addResult(ruleName, "MD5: 9.0, PASSWORD: 8.0.11"))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,52 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00241(t *testing.T) {
+	ruleName := ai.SQLE00241
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	mixedTypes := plocale.Bundle.LocalizeMsgByLang(i18nPkg.DefaultLang, plocale.Rule00241MixedTemporalTypes)
+
+	runSingleRuleInspectCase(rule, t, "case 1: 时间字段精度与默认精度一致", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, created_at DATETIME, updated_at DATETIME(0), duration TIME, birthday DATE);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 2: 时间字段精度与默认精度不一致", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, created_at DATETIME, updated_at DATETIME(6), duration TIME(3));",
+		newTestResult().addResult(ruleName, "updated_at DATETIME(6),duration TIME(3)"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: 非 CREATE TABLE 语句不检查", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN updated_at DATETIME(6);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 4: 同时使用 DATETIME 和 TIMESTAMP", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, created_at DATETIME, updated_at TIMESTAMP, deleted_at TIMESTAMP);",
+		newTestResult().add(driverV2.RuleLevelNotice, "", mixedTypes, "created_at", "updated_at,deleted_at"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "6")
+	runSingleRuleInspectCase(rule, t, "case 5: 时间字段精度与指定精度不一致", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, created_at DATETIME(6), updated_at DATETIME, expired_at TIMESTAMP(6));",
+		newTestResult().addResult(ruleName, "updated_at DATETIME(0)").
+			add(driverV2.RuleLevelNotice, "", mixedTypes, "created_at,updated_at", "expired_at"))
+
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "-1")
+	runSingleRuleInspectCase(rule, t, "case 6: 只检查一致性，精度一致", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, created_at DATETIME(3), updated_at DATETIME(3));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 7: 只检查一致性，精度不一致", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, created_at DATETIME(3), updated_at DATETIME(6));",
+		newTestResult().addResult(ruleName, "created_at DATETIME(3),updated_at DATETIME(6)"))
+}
+
+// ==== Rule test code end ====
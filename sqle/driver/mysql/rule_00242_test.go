@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"golang.org/x/text/language"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00242(t *testing.T) {
+	ruleName := ai.SQLE00242
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE 主键为 BIGINT", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id BIGINT PRIMARY KEY, name VARCHAR(32));",
+		newTestResult().addResult(ruleName, "id INT"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE 自增字段为 BIGINT UNSIGNED", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id BIGINT UNSIGNED AUTO_INCREMENT, name VARCHAR(32), KEY idx_id (id));",
+		newTestResult().addResult(ruleName, "id INT UNSIGNED"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: CREATE TABLE 主键约束中的 BIGINT 字段", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (a BIGINT, b BIGINT, c BIGINT, PRIMARY KEY (a, b));",
+		newTestResult().addResult(ruleName, "a INT,b INT"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: CREATE TABLE 主键为 INT", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT AUTO_INCREMENT PRIMARY KEY, total BIGINT);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: ALTER TABLE 修改主键为 BIGINT", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 MODIFY COLUMN id BIGINT NOT NULL AUTO_INCREMENT;",
+		newTestResult().addResult(ruleName, "id INT"))
+
+	// 离线审核时无法确认表上是否已有主键
+	runSingleRuleInspectCase(rule, t, "case 6: ALTER TABLE 新增 BIGINT 字段并设为主键", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN uid BIGINT NOT NULL, ADD PRIMARY KEY (uid);",
+		newTestResult().add(driverV2.RuleLevelError, "", plocale.Bundle.LocalizeMsgByLang(language.Chinese, plocale.PrimaryKeyExistMessage)).
+			addResult(ruleName, "uid INT"))
+
+	runSingleRuleInspectCase(rule, t, "case 7: ALTER TABLE 新增非主键 BIGINT 字段", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN total BIGINT;",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "3000000000")
+	runSingleRuleInspectCase(rule, t, "case 8: 预期行数超过 INT 但未超过 INT UNSIGNED", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id BIGINT PRIMARY KEY);",
+		newTestResult().addResult(ruleName, "id INT UNSIGNED"))
+
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "5000000000")
+	runSingleRuleInspectCase(rule, t, "case 9: 预期行数超过 INT UNSIGNED", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id BIGINT PRIMARY KEY);",
+		newTestResult())
+
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "100000000")
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "t_log, T1")
+	runSingleRuleInspectCase(rule, t, "case 10: 豁免的表", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id BIGINT PRIMARY KEY);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 11: 非豁免的表", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t2 (id BIGINT PRIMARY KEY);",
+		newTestResult().addResult(ruleName, "id INT"))
+}
+
+// ==== Rule test code end ====
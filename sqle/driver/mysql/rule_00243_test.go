@@ -0,0 +1,66 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+// 当前的解析器只支持 ALTER COLUMN ... SET DEFAULT (expr) 形式的表达式默认值
+func TestRuleSQLE00243(t *testing.T) {
+	ruleName := ai.SQLE00243
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	inspectWithVersion := func(version string) *MysqlDriverImpl {
+		i := DefaultMysqlInspectOffline()
+		i.Ctx = session.NewContext(nil, session.WithTargetVersion(version))
+		return i
+	}
+
+	runSingleRuleInspectCase(rule, t, "case 1: 默认值使用 UUID()", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (UUID());",
+		newTestResult().addResult(ruleName, "v1 DEFAULT uuid()"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: 默认值中嵌套 RAND()", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (FLOOR(RAND() * 100)), ALTER COLUMN v2 SET DEFAULT (1);",
+		newTestResult().addResult(ruleName, "v1 DEFAULT floor(rand() * 100)"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: 默认值为当前时间", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (NOW());",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 4: DATETIME 字段默认值为 CURRENT_TIMESTAMP", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, created_at DATETIME DEFAULT CURRENT_TIMESTAMP, updated_at TIMESTAMP DEFAULT NOW());",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: NOW() 参与其他表达式", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (NOW() + INTERVAL 1 DAY);",
+		newTestResult().addResult(ruleName, "v1 DEFAULT date_add(now(), INTERVAL 1 DAY)"))
+
+	runSingleRuleInspectCase(rule, t, "case 6: 删除默认值", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ALTER COLUMN v1 DROP DEFAULT;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 7: 目标版本不支持表达式默认值", inspectWithVersion("5.7.40"),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (UUID());",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 8: 目标版本支持表达式默认值", inspectWithVersion("8.0.13"),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (UUID());",
+		newTestResult().addResult(ruleName, "v1 DEFAULT uuid()"))
+
+	runSingleRuleInspectCase(rule, t, "case 9: MariaDB 支持表达式默认值", inspectWithVersion("10.6.12-MariaDB"),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (UUID());",
+		newTestResult().addResult(ruleName, "v1 DEFAULT uuid()"))
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "RAND")
+	runSingleRuleInspectCase(rule, t, "case 10: 函数不在规则参数中", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ALTER COLUMN v1 SET DEFAULT (UUID());",
+		newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,111 @@
+package mysql
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00244(t *testing.T) {
+	ruleName := ai.SQLE00244
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	createTableSQL := "CREATE TABLE employees (id INT, last_name VARCHAR(50), department_id INT);"
+
+	runAIRuleCase(rule, t, "case 1: SELECT 语句使用文件排序",
+		"SELECT * FROM employees ORDER BY last_name;",
+		session.NewAIMockContext().WithSQL(createTableSQL),
+		[]*AIMockSQLExpectation{
+			{
+				Query: "EXPLAIN SELECT * FROM employees ORDER BY last_name;",
+				Rows:  sqlmock.NewRows([]string{"table", "Extra"}).AddRow("employees", "Using filesort"),
+			},
+			{
+				Query: "SHOW WARNINGS",
+				Rows:  sqlmock.NewRows(nil),
+			},
+		}, newTestResult().addResult(ruleName, "employees(Using filesort)"))
+
+	runAIRuleCase(rule, t, "case 2: SELECT 语句使用临时表和文件排序",
+		"SELECT department_id, COUNT(*) FROM employees GROUP BY department_id ORDER BY COUNT(*);",
+		session.NewAIMockContext().WithSQL(createTableSQL),
+		[]*AIMockSQLExpectation{
+			{
+				Query: "EXPLAIN SELECT department_id, COUNT(*) FROM employees GROUP BY department_id ORDER BY COUNT(*);",
+				Rows:  sqlmock.NewRows([]string{"table", "Extra"}).AddRow("employees", "Using where; Using temporary; Using filesort"),
+			},
+			{
+				Query: "SHOW WARNINGS",
+				Rows:  sqlmock.NewRows(nil),
+			},
+		}, newTestResult().addResult(ruleName, "employees(Using temporary; Using filesort)"))
+
+	runAIRuleCase(rule, t, "case 3: UNION 语句使用临时表",
+		"SELECT id FROM employees UNION SELECT department_id FROM employees;",
+		session.NewAIMockContext().WithSQL(createTableSQL),
+		[]*AIMockSQLExpectation{
+			{
+				Query: "EXPLAIN SELECT id FROM employees UNION SELECT department_id FROM employees;",
+				Rows: sqlmock.NewRows([]string{"table", "Extra"}).
+					AddRow("employees", "").
+					AddRow("employees", "").
+					AddRow("<union1,2>", "Using temporary"),
+			},
+			{
+				Query: "SHOW WARNINGS",
+				Rows:  sqlmock.NewRows(nil),
+			},
+		}, newTestResult().addResult(ruleName, "<union1,2>(Using temporary)"))
+
+	runAIRuleCase(rule, t, "case 4: SELECT 语句使用索引排序",
+		"SELECT * FROM employees WHERE department_id = 10 ORDER BY id;",
+		session.NewAIMockContext().WithSQL(createTableSQL),
+		[]*AIMockSQLExpectation{
+			{
+				Query: "EXPLAIN SELECT * FROM employees WHERE department_id = 10 ORDER BY id;",
+				Rows:  sqlmock.NewRows([]string{"table", "Extra"}).AddRow("employees", "Using where"),
+			},
+			{
+				Query: "SHOW WARNINGS",
+				Rows:  sqlmock.NewRows(nil),
+			},
+		}, newTestResult())
+
+	runAIRuleCase(rule, t, "case 5: 非查询语句不检查",
+		"UPDATE employees SET last_name = 'a' ORDER BY id LIMIT 1;",
+		session.NewAIMockContext().WithSQL(createTableSQL),
+		nil, newTestResult())
+}
+
+// the execution plan is shared with the other rules, EXPLAIN is executed only once.
+func TestRuleSQLE00244_ShareExecutionPlan(t *testing.T) {
+	sql := "SELECT * FROM employees ORDER BY last_name;"
+	e, err := AIMockExecutor([]*AIMockSQLExpectation{
+		{
+			Query: "EXPLAIN SELECT * FROM employees ORDER BY last_name;",
+			Rows:  sqlmock.NewRows([]string{"table", "Extra"}).AddRow("employees", "Using filesort"),
+		},
+		{
+			Query: "SHOW WARNINGS",
+			Rows:  sqlmock.NewRows(nil),
+		},
+	})
+	assert.NoError(t, err)
+	ctx, err := session.InitializeMockContext(e, session.NewAIMockContext().WithSQL("CREATE TABLE employees (id INT, last_name VARCHAR(50));"))
+	assert.NoError(t, err)
+
+	filesortRule := rulepkg.AIRuleHandlerMap[ai.SQLE00082].Rule
+	rule := rulepkg.AIRuleHandlerMap[ai.SQLE00244].Rule
+	inspect := NewMockInspect(e)
+	inspect.Ctx = ctx
+	inspect.rules = []*driverV2.Rule{&filesortRule, &rule}
+	inspectAICase(t, "filesort is reported by both rules", inspect, sql,
+		newTestResult().addResult(ai.SQLE00082).addResult(ai.SQLE00244, "employees(Using filesort)"))
+}
+
+// ==== Rule test code end ====
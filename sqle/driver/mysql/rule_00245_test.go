@@ -0,0 +1,52 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"golang.org/x/text/language"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00245(t *testing.T) {
+	ruleName := ai.SQLE00245
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	rowSizeNearLimit := plocale.Bundle.LocalizeMsgByLang(language.Chinese, plocale.Rule00245RowSizeNearLimit)
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE VARCHAR 长度超过阈值", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a VARCHAR(2000), b VARCHAR(1024), c VARBINARY(4096));",
+		newTestResult().addResult(ruleName, "a VARCHAR(2000),c VARBINARY(4096)"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE VARCHAR 长度未超过阈值", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a VARCHAR(255), b CHAR(32), c TEXT);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 3: ALTER TABLE 新增和修改字段", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN a VARCHAR(4000), MODIFY COLUMN b VARCHAR(100), CHANGE COLUMN c d VARCHAR(21844);",
+		newTestResult().addResult(ruleName, "a VARCHAR(4000),d VARCHAR(21844)"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: 行大小接近上限", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a VARCHAR(8000), b VARCHAR(8000)) DEFAULT CHARSET=utf8mb4;",
+		newTestResult().addResult(ruleName, "a VARCHAR(8000),b VARCHAR(8000)").
+			add(driverV2.RuleLevelWarn, "", rowSizeNearLimit, 64004))
+
+	runSingleRuleInspectCase(rule, t, "case 5: 按字段的字符集计算行大小", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a CHAR(255) CHARSET latin1, b VARCHAR(1000) CHARSET latin1) DEFAULT CHARSET=utf8mb4;",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsFirstKeyName, "65535")
+	runSingleRuleInspectCase(rule, t, "case 6: 单个字段的行大小接近上限", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a VARCHAR(60000)) CHARSET=latin1;",
+		newTestResult().add(driverV2.RuleLevelWarn, "", rowSizeNearLimit, 60002))
+
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "0")
+	runSingleRuleInspectCase(rule, t, "case 7: 不检查行大小", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, a VARCHAR(60000)) CHARSET=latin1;",
+		newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,44 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00246(t *testing.T) {
+	ruleName := ai.SQLE00246
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE 表字符集为 utf8", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32)) DEFAULT CHARSET=utf8;",
+		newTestResult().addResult(ruleName, "TABLE CHARSET utf8"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE 字段字符集为 utf8mb3", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32) CHARACTER SET utf8mb3, code VARCHAR(32) COLLATE utf8_bin) DEFAULT CHARSET=utf8mb4;",
+		newTestResult().addResult(ruleName, "COLUMN name CHARSET utf8, COLUMN code COLLATE utf8_bin"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: CREATE TABLE 表排序规则为 utf8", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY) COLLATE=utf8_general_ci;",
+		newTestResult().addResult(ruleName, "TABLE COLLATE utf8_general_ci"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: CREATE TABLE 使用 utf8mb4", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, name VARCHAR(32) COLLATE utf8mb4_bin) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: ALTER TABLE CONVERT TO CHARACTER SET utf8", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 CONVERT TO CHARACTER SET utf8;",
+		newTestResult().addResult(ruleName, "CONVERT TO CHARACTER SET utf8"))
+
+	runSingleRuleInspectCase(rule, t, "case 6: ALTER TABLE 修改表字符集和新增字段", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 DEFAULT CHARSET=utf8, ADD COLUMN name VARCHAR(32) CHARACTER SET utf8;",
+		newTestResult().addResult(ruleName, "TABLE CHARSET utf8, COLUMN name CHARSET utf8"))
+
+	runSingleRuleInspectCase(rule, t, "case 7: ALTER TABLE 转换为 utf8mb4", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 CONVERT TO CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci;",
+		newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,56 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00247(t *testing.T) {
+	ruleName := ai.SQLE00247
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL(
+			"CREATE TABLE orders (id INT PRIMARY KEY, customer_id INT, product_id INT, KEY idx_customer (customer_id));" +
+				"CREATE TABLE customers (id INT PRIMARY KEY, code VARCHAR(32), region_id INT);" +
+				"CREATE TABLE products (id INT, sku VARCHAR(32), UNIQUE KEY uk_sku (sku));",
+		)
+	}
+
+	runAIRuleCase(rule, t, "case 1: 被驱动表的关联字段有主键",
+		"SELECT * FROM orders o JOIN customers c ON o.customer_id = c.id;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 2: 被驱动表的关联字段没有索引",
+		"SELECT * FROM orders o JOIN customers c ON o.customer_id = c.region_id;",
+		newContext(), nil, newTestResult().addResult(ruleName, "customers.region_id"))
+
+	runAIRuleCase(rule, t, "case 3: RIGHT JOIN 检查左侧的表",
+		"SELECT * FROM products p RIGHT JOIN orders o ON p.id = o.product_id;",
+		newContext(), nil, newTestResult().addResult(ruleName, "products.id"))
+
+	runAIRuleCase(rule, t, "case 4: 多表关联",
+		"SELECT * FROM customers c LEFT JOIN orders o ON c.id = o.customer_id LEFT JOIN products p ON o.product_id = p.id AND p.sku = c.code;",
+		newContext(), nil, newTestResult().addResult(ruleName, "products.id"))
+
+	runAIRuleCase(rule, t, "case 5: 子查询中的 JOIN",
+		"SELECT * FROM customers WHERE id IN (SELECT o.customer_id FROM orders o JOIN products p ON o.product_id = p.id);",
+		newContext(), nil, newTestResult().addResult(ruleName, "products.id"))
+
+	runAIRuleCase(rule, t, "case 6: USING 条件的字段没有索引",
+		"UPDATE orders JOIN products USING (id) SET orders.product_id = 1;",
+		newContext(), nil, newTestResult().addResult(ruleName, "products.id"))
+
+	runAIRuleCase(rule, t, "case 7: DELETE 语句的关联字段有索引",
+		"DELETE o FROM customers c JOIN orders o ON c.id = o.customer_id WHERE c.code = 'a';",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: 非 DML 语句不检查",
+		"INSERT INTO orders (id, customer_id) VALUES (1, 1);",
+		newContext(), nil, newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00248(t *testing.T) {
+	ruleName := ai.SQLE00248
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL(
+			"CREATE TABLE orders (id INT PRIMARY KEY, status INT, created_at DATETIME, customer_id INT, amount INT," +
+				" KEY idx_created_status (created_at, status, amount), KEY idx_customer (customer_id));",
+		)
+	}
+
+	runAIRuleCase(rule, t, "case 1: 等值字段在范围字段之后",
+		"SELECT * FROM orders WHERE created_at > '2024-01-01' AND status = 1;",
+		newContext(), nil, newTestResult().addResult(ruleName, "orders.idx_created_status(created_at,status,amount)->(status,created_at,amount)"))
+
+	runAIRuleCase(rule, t, "case 2: 等值字段在排序字段之后",
+		"SELECT * FROM orders o WHERE o.status = 1 AND o.amount = 10 ORDER BY o.created_at;",
+		newContext(), nil, newTestResult().addResult(ruleName, "orders.idx_created_status(created_at,status,amount)->(status,amount,created_at)"))
+
+	runAIRuleCase(rule, t, "case 3: 等值字段在前",
+		"SELECT * FROM orders WHERE created_at = '2024-01-01' AND status > 1 ORDER BY amount;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 4: 已存在以等值字段开头的索引",
+		"SELECT * FROM orders WHERE customer_id = 1 ORDER BY created_at;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 5: 查询不使用索引的第一个字段",
+		"SELECT * FROM orders WHERE status = 1 AND amount > 10;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 6: 子查询",
+		"SELECT * FROM customers WHERE id IN (SELECT customer_id FROM orders WHERE status = 1 AND created_at BETWEEN '2024-01-01' AND '2024-02-01');",
+		newContext().WithSQL("CREATE TABLE customers (id INT PRIMARY KEY);"),
+		nil, newTestResult().addResult(ruleName, "orders.idx_created_status(created_at,status,amount)->(status,created_at,amount)"))
+
+	runAIRuleCase(rule, t, "case 7: 多表关联不检查",
+		"SELECT * FROM orders o JOIN customers c ON o.customer_id = c.id WHERE o.created_at > '2024-01-01' AND o.status = 1;",
+		newContext().WithSQL("CREATE TABLE customers (id INT PRIMARY KEY);"),
+		nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: 非查询语句不检查",
+		"UPDATE orders SET amount = 1 WHERE created_at > '2024-01-01' AND status = 1;",
+		newContext(), nil, newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,55 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00249(t *testing.T) {
+	ruleName := ai.SQLE00249
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	unparsed := func() *testResult {
+		return newTestResult().add(driverV2.RuleLevelWarn, "", "语法错误或者解析器不支持，请人工确认SQL正确性")
+	}
+
+	runSingleRuleInspectCase(rule, t, "case 1: 触发器插入其他表", DefaultMysqlInspectOffline(),
+		"CREATE TRIGGER trg1 AFTER INSERT ON t1 FOR EACH ROW INSERT INTO t1_history (id, name) VALUES (NEW.id, NEW.name);",
+		unparsed().addResult(ruleName, "t1_history"))
+
+	runSingleRuleInspectCase(rule, t, "case 2: 触发器体中修改多张其他表", DefaultMysqlInspectOffline(),
+		"CREATE TRIGGER trg1 AFTER DELETE ON db1.t1 FOR EACH ROW BEGIN "+
+			"IF OLD.amount > 0 THEN UPDATE db1.account SET balance = balance - OLD.amount WHERE id = OLD.account_id; END IF; "+
+			"DELETE FROM t2 WHERE t1_id = OLD.id; "+
+			"DELETE t3 FROM t3 JOIN t4 ON t3.id = t4.id WHERE t4.t1_id = OLD.id; "+
+			"UPDATE db1.account SET updated = NOW() WHERE id = OLD.account_id; "+
+			"END;",
+		unparsed().addResult(ruleName, "db1.account,t2,t3"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: 触发器只修改当前行", DefaultMysqlInspectOffline(),
+		"CREATE TRIGGER trg1 BEFORE INSERT ON t1 FOR EACH ROW SET NEW.name = UPPER(NEW.name);",
+		unparsed())
+
+	runSingleRuleInspectCase(rule, t, "case 4: 触发器修改所在的表", DefaultMysqlInspectOffline(),
+		"CREATE TRIGGER trg1 AFTER UPDATE ON db1.T1 FOR EACH ROW BEGIN UPDATE t1 SET cnt = cnt + 1 WHERE id = 0; DELETE FROM db1.t1 WHERE id = -1; END;",
+		unparsed())
+
+	runSingleRuleInspectCase(rule, t, "case 5: 触发器体中只查询其他表", DefaultMysqlInspectOffline(),
+		"CREATE TRIGGER trg1 BEFORE INSERT ON t1 FOR EACH ROW SET NEW.total = (SELECT SUM(amount) FROM t2 WHERE t2.id = NEW.id);",
+		unparsed())
+
+	runSingleRuleInspectCase(rule, t, "case 6: 非触发器语句不检查", DefaultMysqlInspectOffline(),
+		"INSERT INTO t1 (id) VALUES (1);",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "audit_log, db1.t2")
+	runSingleRuleInspectCase(rule, t, "case 7: 允许修改的审计日志表", DefaultMysqlInspectOffline(),
+		"CREATE TRIGGER trg1 AFTER UPDATE ON t1 FOR EACH ROW BEGIN INSERT INTO AUDIT_LOG (id) VALUES (NEW.id); DELETE FROM db1.t2 WHERE id = NEW.id; REPLACE INTO t3 VALUES (NEW.id); END;",
+		unparsed().addResult(ruleName, "t3"))
+}
+
+// ==== Rule test code end ====
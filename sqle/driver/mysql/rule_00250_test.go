@@ -0,0 +1,77 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00250(t *testing.T) {
+	ruleName := ai.SQLE00250
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	runSingleRuleInspectCase(rule, t, "case 1: 离线审核，插入字段数大于查询字段数", DefaultMysqlInspectOffline(),
+		"INSERT INTO t1 (a, b, c) SELECT x, y FROM t2;",
+		newTestResult().addResult(ruleName, 3, 2))
+
+	runSingleRuleInspectCase(rule, t, "case 2: 离线审核，UNION 查询的字段数不一致", DefaultMysqlInspectOffline(),
+		"INSERT INTO t1 (a, b) SELECT x, y, z FROM t2 UNION SELECT x, y, z FROM t3;",
+		newTestResult().addResult(ruleName, 2, 3))
+
+	runSingleRuleInspectCase(rule, t, "case 3: 离线审核，字段数一致", DefaultMysqlInspectOffline(),
+		"INSERT INTO t1 (a, b) SELECT x, COUNT(*) FROM t2 GROUP BY x;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 4: 离线审核，表结构未知时跳过", DefaultMysqlInspectOffline(),
+		"INSERT INTO t1 SELECT x, y FROM t2;",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 5: 离线审核，SELECT * 的表结构未知时跳过", DefaultMysqlInspectOffline(),
+		"INSERT INTO t1 (a, b) SELECT * FROM t2;",
+		newTestResult())
+
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL(
+			"CREATE TABLE orders (id INT PRIMARY KEY, customer_id INT, amount INT);" +
+				"CREATE TABLE orders_archive (id INT PRIMARY KEY, customer_id INT, amount INT, archived_at DATETIME);" +
+				"CREATE TABLE customers (id INT PRIMARY KEY, name VARCHAR(32));",
+		)
+	}
+
+	runAIRuleCase(rule, t, "case 6: 未指定插入字段，使用目标表的字段数",
+		"INSERT INTO orders_archive SELECT * FROM orders;",
+		newContext(), nil, newTestResult().addResult(ruleName, 4, 3))
+
+	runAIRuleCase(rule, t, "case 7: SELECT * 与额外的字段",
+		"INSERT INTO orders_archive SELECT *, NOW() FROM orders;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 8: 多表关联的 t.*",
+		"INSERT INTO orders (id, customer_id, amount) SELECT o.*, c.* FROM orders_archive o JOIN customers c ON o.customer_id = c.id;",
+		newContext(), nil, newTestResult().addResult(ruleName, 3, 6))
+
+	runAIRuleCase(rule, t, "case 9: 子查询的 *",
+		"INSERT INTO customers SELECT * FROM (SELECT id, name FROM customers WHERE id > 10) AS t;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 10: INSERT ... VALUES 不检查",
+		"INSERT INTO customers VALUES (1, 'a');",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 11: JOIN ... USING 的 * 跳过检查",
+		"INSERT INTO orders SELECT * FROM orders_archive JOIN orders USING (id, customer_id, amount);",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 12: NATURAL JOIN 的 * 跳过检查",
+		"INSERT INTO orders_archive SELECT * FROM orders NATURAL JOIN orders_archive;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 13: JOIN ... USING 的 t.* 仍然检查",
+		"INSERT INTO orders SELECT o.* FROM orders_archive o JOIN customers c USING (id);",
+		newContext(), nil, newTestResult().addResult(ruleName, 3, 4))
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,85 @@
+package mysql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
+	"github.com/actiontech/sqle/sqle/driver/mysql/plocale"
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00251(t *testing.T) {
+	ruleName := ai.SQLE00251
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+	long := "t_" + strings.Repeat("a", 63)
+
+	runSingleRuleInspectCase(rule, t, "case 1: CREATE TABLE 的名称长度不超过64", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT PRIMARY KEY, "+strings.Repeat("a", 64)+" INT, KEY idx_a ("+strings.Repeat("a", 64)+"));",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 2: CREATE TABLE 的表名、列名、索引名和约束名过长", DefaultMysqlInspectOffline(),
+		"CREATE TABLE "+long+" (id INT, "+long+"_c INT, KEY "+long+"_idx (id), CONSTRAINT "+long+"_chk CHECK (id > 0), CONSTRAINT "+long+"_pk PRIMARY KEY (id));",
+		newTestResult().addResult(ruleName, 64, long+"(65), "+long+"_c(67), "+long+"_idx(69), "+long+"_chk(69)"))
+
+	runSingleRuleInspectCase(rule, t, "case 3: ALTER TABLE 的新名称过长", DefaultMysqlInspectOffline(),
+		"ALTER TABLE t1 ADD COLUMN "+long+" INT, CHANGE COLUMN a b INT, RENAME COLUMN c TO "+long+"_c, ADD INDEX "+long+"_idx (b), RENAME INDEX idx_a TO "+long+"_i, RENAME TO "+long+"_t;",
+		newTestResult().addResult(ruleName, 64, long+"(65), "+long+"_c(67), "+long+"_idx(69), "+long+"_i(67), "+long+"_t(67)"))
+
+	runSingleRuleInspectCase(rule, t, "case 4: CREATE INDEX 的索引名过长", DefaultMysqlInspectOffline(),
+		"CREATE INDEX "+long+" ON t1 (a);",
+		newTestResult().addResult(ruleName, 64, long+"(65)"))
+
+	runSingleRuleInspectCase(rule, t, "case 5: 按字符计算长度", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, "+strings.Repeat("列", 64)+" INT);",
+		newTestResult())
+
+	runSingleRuleInspectCase(rule, t, "case 6: 默认不检查仅大小写不同的名称", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, KEY idx_a (id), KEY IDX_A (id));",
+		newTestResult())
+
+	rule.Params = rule.Params.Copy()
+	rule.Params.SetParamValue(rulepkg.DefaultSingleParamKeyName, "10")
+	rule.Params.SetParamValue(rulepkg.DefaultMultiParamsSecondKeyName, "true")
+
+	runSingleRuleInspectCase(rule, t, "case 7: 自定义最大长度", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t_order_item (id INT);",
+		newTestResult().addResult(ruleName, 10, "t_order_item(12)"))
+
+	runSingleRuleInspectCase(rule, t, "case 8: CREATE TABLE 中仅大小写不同的列名和索引名", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, Name INT, name INT, KEY idx_a (id), KEY IDX_A (id), CONSTRAINT fk_a FOREIGN KEY (id) REFERENCES t2 (id), CONSTRAINT FK_A CHECK (id > 0));",
+		newTestResult().add(driverV2.RuleLevelError, "", plocale.Bundle.LocalizeMsgByLang(i18nPkg.DefaultLang, plocale.DuplicateColumnsMessage), "name").
+			addResult(ruleName, 10, "Name/name, idx_a/IDX_A, fk_a/FK_A"))
+
+	runSingleRuleInspectCase(rule, t, "case 9: 索引名与列名相同不违反规则", DefaultMysqlInspectOffline(),
+		"CREATE TABLE t1 (id INT, name INT, KEY NAME (name));",
+		newTestResult())
+
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL("CREATE TABLE t1 (id INT PRIMARY KEY, name INT, code INT, KEY idx_name (name));")
+	}
+
+	runAIRuleCase(rule, t, "case 10: ALTER TABLE 新增的名称与已有的名称仅大小写不同",
+		"ALTER TABLE t1 ADD COLUMN Name INT, ADD INDEX IDX_NAME (code);",
+		newContext(), nil, newTestResult().add(driverV2.RuleLevelError, "", plocale.Bundle.LocalizeMsgByLang(i18nPkg.DefaultLang, plocale.ColumnExistMessage), "name").
+			add(driverV2.RuleLevelError, "", plocale.Bundle.LocalizeMsgByLang(i18nPkg.DefaultLang, plocale.IndexExistMessage), "IDX_NAME").
+			addResult(ruleName, 10, "name/Name, idx_name/IDX_NAME"))
+
+	runAIRuleCase(rule, t, "case 11: ALTER TABLE 先删除或重命名已有的名称",
+		"ALTER TABLE t1 DROP COLUMN name, ADD COLUMN Name INT, CHANGE COLUMN code CODE INT, DROP INDEX idx_name;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 12: CREATE INDEX 的索引名与已有的索引名仅大小写不同",
+		"CREATE INDEX Idx_Name ON t1 (code);",
+		newContext(), nil, newTestResult().addResult(ruleName, 10, "idx_name/Idx_Name"))
+
+	runAIRuleCase(rule, t, "case 13: 非 DDL 语句不检查",
+		"SELECT id AS "+long+" FROM t1;",
+		newContext(), nil, newTestResult())
+}
+
+// ==== Rule test code end ====
@@ -0,0 +1,58 @@
+package mysql
+
+import (
+	"testing"
+
+	rulepkg "github.com/actiontech/sqle/sqle/driver/mysql/rule"
+	"github.com/actiontech/sqle/sqle/driver/mysql/rule/ai"
+	"github.com/actiontech/sqle/sqle/driver/mysql/session"
+)
+
+// ==== Rule test code start ====
+func TestRuleSQLE00252(t *testing.T) {
+	ruleName := ai.SQLE00252
+	rule := rulepkg.AIRuleHandlerMap[ruleName].Rule
+
+	newContext := func() *session.AIMockContext {
+		return session.NewAIMockContext().WithSQL(
+			"CREATE TABLE customers (id INT PRIMARY KEY, name VARCHAR(32));" +
+				"CREATE TABLE orders (id INT, customer_id INT, code VARCHAR(32) UNIQUE, status INT, amount INT, remark VARCHAR(255), " +
+				"PRIMARY KEY (id), KEY idx_status_amount (status, amount), KEY idx_double_amount ((amount * 2)), " +
+				"CONSTRAINT fk_customer FOREIGN KEY (customer_id) REFERENCES customers (id));",
+		)
+	}
+
+	runAIRuleCase(rule, t, "case 1: 删除没有被引用的列",
+		"ALTER TABLE orders DROP COLUMN remark;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 2: 删除主键列",
+		"ALTER TABLE orders DROP COLUMN id;",
+		newContext(), nil, newTestResult().addResult(ruleName, "id(PRIMARY)"))
+
+	runAIRuleCase(rule, t, "case 3: 删除复合索引和函数索引引用的列",
+		"ALTER TABLE orders DROP COLUMN amount;",
+		newContext(), nil, newTestResult().addResult(ruleName, "amount(idx_status_amount,idx_double_amount)"))
+
+	runAIRuleCase(rule, t, "case 4: 删除多个被引用的列",
+		"ALTER TABLE orders DROP COLUMN customer_id, DROP COLUMN code, DROP COLUMN remark;",
+		newContext(), nil, newTestResult().addResult(ruleName, "customer_id(fk_customer), code(code)"))
+
+	runAIRuleCase(rule, t, "case 5: 同一语句中先删除了索引和外键",
+		"ALTER TABLE orders DROP FOREIGN KEY fk_customer, DROP INDEX IDX_STATUS_AMOUNT, DROP COLUMN customer_id, DROP COLUMN status;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 6: 同一语句中删除了主键",
+		"ALTER TABLE orders DROP PRIMARY KEY, DROP COLUMN id;",
+		newContext(), nil, newTestResult())
+
+	runAIRuleCase(rule, t, "case 7: 列约束定义的主键",
+		"ALTER TABLE customers DROP COLUMN id, DROP COLUMN name;",
+		newContext(), nil, newTestResult().addResult(ruleName, "id(PRIMARY)"))
+
+	runSingleRuleInspectCase(rule, t, "case 8: 离线审核不检查", DefaultMysqlInspectOffline(),
+		"ALTER TABLE orders DROP COLUMN id;",
+		newTestResult())
+}
+
+// ==== Rule test code end ====
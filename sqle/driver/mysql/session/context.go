@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
 	"github.com/actiontech/sqle/sqle/driver/mysql/util"
 	"github.com/actiontech/sqle/sqle/log"
 	"github.com/actiontech/sqle/sqle/utils"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/pingcap/parser/ast"
 	"github.com/pingcap/parser/model"
 	"github.com/pkg/errors"
@@ -81,14 +83,30 @@ type Context struct {
 	// if schemas info has collected, set true
 	schemaHasLoad bool
 
-	// executionPlan store batch SQLs' execution plan during one inspect context.
+	// executionPlan store batch SQLs' execution plan during one inspect context,
+	// the key is the normalized SQL, see executionPlanKey. It is cleared after DDL.
 	executionPlan map[string]*executor.ExplainWithWarningsResult
 
 	// sysVars keep some MySQL global system variables during one inspect context.
 	sysVars map[string]string
 
+	// serverVersion is the result of "SELECT VERSION()", it is queried once during one inspect context.
+	serverVersion     string
+	serverVersionLoad bool
+	// targetVersion is the version which the SQL is audited against, it takes
+	// precedence over serverVersion, see driverV2.Config.TargetVersion.
+	targetVersion string
+
+	// serverInfo is the capabilities of the server which the SQL is executed on, it is
+	// queried once by the driver, see SetServerInfo.
+	serverInfo *ServerInfo
+
 	// historySqlInfo historical sql information record
 	historySqlInfo *HistorySQLInfo
+
+	// createTableStmts caches the results of "SHOW CREATE TABLE", it is shared by the clones of the
+	// context, so that the rules audited in parallel do not query the same table repeatedly.
+	createTableStmts *createTableStmtCache
 }
 
 type contextOption func(*Context)
@@ -104,6 +122,8 @@ func NewContext(parent *Context, opts ...contextOption) *Context {
 		executionPlan:  map[string]*executor.ExplainWithWarningsResult{},
 		sysVars:        map[string]string{},
 		historySqlInfo: &HistorySQLInfo{},
+
+		createTableStmts: newCreateTableStmtCache(),
 	}
 
 	for _, opt := range opts {
@@ -113,6 +133,9 @@ func NewContext(parent *Context, opts ...contextOption) *Context {
 	if parent == nil {
 		return ctx
 	}
+	if ctx.e == nil {
+		ctx.e = parent.e
+	}
 	ctx.schemaHasLoad = parent.schemaHasLoad
 	ctx.currentSchema = parent.currentSchema
 	for schemaName, schema := range parent.schemas {
@@ -138,6 +161,82 @@ func NewContext(parent *Context, opts ...contextOption) *Context {
 	for k, v := range parent.sysVars {
 		ctx.sysVars[k] = v
 	}
+	ctx.serverVersion = parent.serverVersion
+	ctx.serverVersionLoad = parent.serverVersionLoad
+	if ctx.targetVersion == "" {
+		ctx.targetVersion = parent.targetVersion
+	}
+	ctx.serverInfo = parent.serverInfo
+	return ctx
+}
+
+// Clone returns a copy of the context sharing the executor, the information lazily
+// loaded by the copy is not saved to c. It is used to read the context concurrently,
+// e.g. by the rules audited in parallel, each goroutine reads its own copy. The parsed
+// table statements are shared, so they must not be modified by the copy.
+func (c *Context) Clone() *Context {
+	if c.createTableStmts == nil {
+		c.createTableStmts = newCreateTableStmtCache()
+	}
+	ctx := &Context{
+		e:                 c.e,
+		currentSchema:     c.currentSchema,
+		schemas:           make(map[string]*SchemaInfo, len(c.schemas)),
+		schemaHasLoad:     c.schemaHasLoad,
+		executionPlan:     make(map[string]*executor.ExplainWithWarningsResult, len(c.executionPlan)),
+		sysVars:           make(map[string]string, len(c.sysVars)),
+		historySqlInfo:    &HistorySQLInfo{},
+		serverVersion:     c.serverVersion,
+		serverVersionLoad: c.serverVersionLoad,
+		targetVersion:     c.targetVersion,
+		serverInfo:        c.serverInfo,
+		createTableStmts:  c.createTableStmts,
+	}
+	for schemaName, schema := range c.schemas {
+		if schema == nil {
+			ctx.schemas[schemaName] = nil
+			continue
+		}
+		newSchema := *schema
+		newSchema.Tables = make(map[string]*TableInfo, len(schema.Tables))
+		for tableName, table := range schema.Tables {
+			if table == nil {
+				newSchema.Tables[tableName] = nil
+				continue
+			}
+			newTable := *table
+			if table.columns != nil {
+				newTable.columns = make(map[string]*columnInfo, len(table.columns))
+				for name, column := range table.columns {
+					newTable.columns[name] = column
+				}
+			}
+			if table.Selectivity != nil {
+				newTable.Selectivity = make(map[string]float64, len(table.Selectivity))
+				for name, selectivity := range table.Selectivity {
+					newTable.Selectivity[name] = selectivity
+				}
+			}
+			newSchema.Tables[tableName] = &newTable
+		}
+		ctx.schemas[schemaName] = &newSchema
+	}
+	for k, v := range c.executionPlan {
+		ctx.executionPlan[k] = v
+	}
+	for k, v := range c.sysVars {
+		ctx.sysVars[k] = v
+	}
+	*ctx.historySqlInfo = *c.GetHistorySQLInfo()
+	return ctx
+}
+
+// CloneWithExecutor is like Clone, but the copy uses e instead of the executor of c, e.g.
+// the session returned by executor.Executor.NewSession, so that the copy does not share
+// the connection with c.
+func (c *Context) CloneWithExecutor(e *executor.Executor) *Context {
+	ctx := c.Clone()
+	ctx.e = e
 	return ctx
 }
 
@@ -148,6 +247,14 @@ func WithExecutor(e *executor.Executor) contextOption {
 	}
 }
 
+// WithTargetVersion sets the version which the SQL is audited against, the version
+// of the instance is used if it is empty, see driverV2.Config.TargetVersion.
+func WithTargetVersion(version string) contextOption {
+	return func(ctx *Context) {
+		ctx.targetVersion = strings.TrimSpace(version)
+	}
+}
+
 func (c *Context) GetHistorySQLInfo() *HistorySQLInfo {
 	if c.historySqlInfo == nil {
 		c.historySqlInfo = &HistorySQLInfo{}
@@ -322,6 +429,9 @@ func (c *Context) UpdateContext(node ast.Node) {
 		c.GetHistorySQLInfo().HasDML = true
 	case ast.DDLNode:
 		c.GetHistorySQLInfo().HasDDL = true
+		// the execution plan may be changed by DDL, e.g. add index
+		c.executionPlan = map[string]*executor.ExplainWithWarningsResult{}
+		c.createTableStmts.clear()
 	default:
 	}
 	// from the point of view of specific sql types
@@ -403,6 +513,81 @@ func (c *Context) UpdateContext(node ast.Node) {
 	}
 }
 
+// ApplyDDL updates the context by the DDL statements which are executed outside the driver, the
+// same way as the context is updated after auditing them, so that the statements audited later
+// see the changes. USE statements are accepted to change the current schema, and the statements
+// are not applied if any of them is neither DDL nor USE.
+//
+// The statements are only applied to the objects loaded before. The objects not loaded yet are
+// loaded lazily from the instance later, which already reflects the changes. The schemas are
+// loaded for USE before applying any statement, so nothing is applied if loading them failed.
+func (c *Context) ApplyDDL(sql string) error {
+	stmts, err := util.ParseSql(sql)
+	if err != nil {
+		return fmt.Errorf("parse sql failed: %v", err)
+	}
+	var useStmt *ast.UseStmt
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.UseStmt:
+			if useStmt == nil {
+				useStmt = s
+			}
+		case ast.DDLNode:
+		default:
+			return fmt.Errorf("sql %q is not a DDL statement", stmt.Text())
+		}
+	}
+	if _, err := c.GetSystemVariable(SysVarLowerCaseTableNames); err != nil {
+		return fmt.Errorf("load system variable %s failed: %v", SysVarLowerCaseTableNames, err)
+	}
+	if useStmt != nil {
+		if _, err := c.IsSchemaExist(useStmt.DBName); err != nil {
+			return fmt.Errorf("load schemas for sql %q failed: %v", useStmt.Text(), err)
+		}
+	}
+
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.AlterTableStmt:
+			if info, exist := c.GetTableInfo(s.Table); !exist || (info.MergedTable == nil && info.OriginalTable == nil) {
+				c.alterUnloadedTable(s)
+				continue
+			}
+		case *ast.CreateDatabaseStmt:
+			c.UpdateContext(s)
+			// the schema is created on the instance, unlike the one audited
+			if schema, exist := c.getSchema(s.Name); exist {
+				schema.IsRealSchema = true
+			}
+			continue
+		}
+		c.UpdateContext(stmt)
+	}
+	return nil
+}
+
+// alterUnloadedTable updates the context by the ALTER TABLE statement executed outside the driver,
+// whose table definition is not loaded. The definition loaded later is already altered, so only
+// the renaming is applied to the loaded tables.
+func (c *Context) alterUnloadedTable(s *ast.AlterTableStmt) {
+	c.GetHistorySQLInfo().HasDDL = true
+	c.executionPlan = map[string]*executor.ExplainWithWarningsResult{}
+	c.createTableStmts.clear()
+
+	info, exist := c.GetTableInfo(s.Table)
+	if !exist {
+		return
+	}
+	renameSpecs := util.GetAlterTableSpecByTp(s.Specs, ast.AlterTableRenameTable)
+	if len(renameSpecs) == 0 {
+		return
+	}
+	schemaName := c.GetSchemaName(s.Table)
+	c.delTable(schemaName, s.Table.Name.String())
+	c.addTable(schemaName, renameSpecs[len(renameSpecs)-1].NewTable.Name.String(), info)
+}
+
 // GetSchemaName get schema name from AST or current schema.
 func (c *Context) GetSchemaName(stmt *ast.TableName) string {
 	if stmt.Schema.String() == "" {
@@ -499,32 +684,141 @@ const (
 
 // GetSystemVariable get system variable.
 func (c *Context) GetSystemVariable(name string) (string, error) {
-	v, exist := c.sysVars[name]
-	if exist {
-		return v, nil
-	}
-
 	if c.e == nil {
+		if v, exist := c.sysVars[name]; exist {
+			return v, nil
+		}
 		return "", nil
 	}
-
-	results, err := c.e.Db.Query(fmt.Sprintf(`SHOW GLOBAL VARIABLES LIKE '%v'`, name))
+	values, err := c.GetSystemVariables(name)
 	if err != nil {
 		return "", err
 	}
-	if len(results) != 1 {
+	v, exist := values[name]
+	if !exist {
 		return "", fmt.Errorf("unexpected results when query system variable")
 	}
+	return v, nil
+}
 
-	value := results[0]["Value"]
-	c.AddSystemVariable(name, value.String)
-	return value.String, nil
+// GetSystemVariables gets system variables in one query, the variables which are
+// not cached are queried and cached. The variables which do not exist are not in
+// the result.
+func (c *Context) GetSystemVariables(names ...string) (map[string]string, error) {
+	values := make(map[string]string, len(names))
+	// lower case name -> the names requested
+	missing := map[string][]string{}
+	quotedNames := []string{}
+	for _, name := range names {
+		if v, exist := c.sysVars[name]; exist {
+			values[name] = v
+			continue
+		}
+		lowerName := strings.ToLower(name)
+		if _, ok := missing[lowerName]; !ok {
+			quotedNames = append(quotedNames, fmt.Sprintf("'%s'", strings.ReplaceAll(lowerName, "'", "''")))
+		}
+		missing[lowerName] = append(missing[lowerName], name)
+	}
+	if len(missing) == 0 || c.e == nil {
+		return values, nil
+	}
+
+	results, err := c.e.Db.Query(fmt.Sprintf("SHOW GLOBAL VARIABLES WHERE Variable_name IN (%s)", strings.Join(quotedNames, ", ")))
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		for _, name := range missing[strings.ToLower(result["Variable_name"].String)] {
+			c.AddSystemVariable(name, result["Value"].String)
+			values[name] = result["Value"].String
+		}
+	}
+	return values, nil
 }
 
 func (c *Context) AddSystemVariable(name, value string) {
 	c.sysVars[name] = value
 }
 
+// GetServerVersion gets the server version by "SELECT VERSION()", e.g. "8.0.30" or
+// "10.6.12-MariaDB-log". It is empty if the context has no executor.
+func (c *Context) GetServerVersion() (string, error) {
+	if c.serverVersionLoad || c.e == nil {
+		return c.serverVersion, nil
+	}
+	results, err := c.e.Db.Query("SELECT VERSION()")
+	if err != nil {
+		return "", err
+	}
+	if len(results) != 1 {
+		return "", fmt.Errorf("unexpected results when query server version")
+	}
+	c.serverVersion = results[0]["VERSION()"].String
+	c.serverVersionLoad = true
+	return c.serverVersion, nil
+}
+
+// GetTargetVersion gets the version which the SQL is audited against, it is the target
+// version if it is set, otherwise it is the server version. It is empty if the target
+// version is not set and the context has no executor.
+func (c *Context) GetTargetVersion() (string, error) {
+	if c.targetVersion != "" {
+		return c.targetVersion, nil
+	}
+	return c.GetServerVersion()
+}
+
+// HasTargetVersion reports whether the target version is set.
+func (c *Context) HasTargetVersion() bool {
+	return c.targetVersion != ""
+}
+
+// GetServerInfo gets the cached server info, it is nil if it is not loaded.
+func (c *Context) GetServerInfo() *ServerInfo {
+	return c.serverInfo
+}
+
+// SetServerInfo caches the server info, it is shared by the copies of the context
+// and must not be modified.
+func (c *Context) SetServerInfo(info *ServerInfo) {
+	c.serverInfo = info
+}
+
+// ParseVersion parses the version in the format of "SELECT VERSION()", the suffix
+// following "major.minor.patch" is ignored, e.g. "5.7.44-log" is parsed as 5.7.44.
+// The missing minor and patch are 0, e.g. "8.0" is parsed as 8.0.0.
+func ParseVersion(version string) (*semver.Version, error) {
+	version = strings.TrimSpace(version)
+	end := 0
+	dots := 0
+	for ; end < len(version); end++ {
+		c := version[end]
+		if c == '.' && dots < 2 && end > 0 && version[end-1] != '.' {
+			dots++
+			continue
+		}
+		if c < '0' || c > '9' {
+			break
+		}
+	}
+	v, err := semver.NewVersion(strings.TrimSuffix(version[:end], "."))
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %v", version, err)
+	}
+	return v, nil
+}
+
+// IsMariaDB reports whether the target version is MariaDB, see GetTargetVersion.
+// It is false if the version is unknown.
+func (c *Context) IsMariaDB() (bool, error) {
+	version, err := c.GetTargetVersion()
+	if err != nil {
+		return false, err
+	}
+	return strings.Contains(strings.ToLower(version), "mariadb"), nil
+}
+
 type ParseShowCreateTableContentError struct { // todo #1630 临时返回一个指定的错误类型，方便跳过解析建表语句的错误
 	Msg string
 }
@@ -564,22 +858,98 @@ func (c *Context) GetCreateTableStmt(stmt *ast.TableName) (*ast.CreateTableStmt,
 		return nil, false, info.OriginalTableError
 	}
 
-	createTableSql, err := c.e.ShowCreateTable(utils.SupplementalQuotationMarks(c.GetSchemaName(stmt)), utils.SupplementalQuotationMarks(stmt.Name.String()))
+	createStmt, err := c.createTableStmts.get(c.createTableStmtCacheKey(stmt), func() (*ast.CreateTableStmt, error) {
+		return c.showCreateTableStmt(stmt)
+	})
 	if err != nil {
+		if IsParseShowCreateTableContentErr(err) {
+			info.OriginalTableError = err
+		}
 		return nil, exist, err
 	}
+	info.OriginalTable = createStmt
+	return createStmt, exist, nil
+}
+
+// showCreateTableStmt queries "SHOW CREATE TABLE" and parses the result.
+func (c *Context) showCreateTableStmt(stmt *ast.TableName) (*ast.CreateTableStmt, error) {
+	createTableSql, err := c.e.ShowCreateTable(utils.SupplementalQuotationMarks(c.GetSchemaName(stmt)), utils.SupplementalQuotationMarks(stmt.Name.String()))
+	if err != nil {
+		return nil, err
+	}
 	createStmt, errByMysqlParser := util.ParseCreateTableStmt(createTableSql)
 	if errByMysqlParser != nil {
 		//todo to be compatible with OceanBase-MySQL-Mode
 		log.Logger().Warnf("parse create table stmt failed. try to parse it with compatible method. err:%v", errByMysqlParser)
 		createStmt, err = c.parseCreateTableSqlCompatibly(createTableSql)
 		if err != nil {
-			info.OriginalTableError = &ParseShowCreateTableContentError{Msg: errByMysqlParser.Error()}
-			return nil, exist, info.OriginalTableError
+			return nil, &ParseShowCreateTableContentError{Msg: errByMysqlParser.Error()}
 		}
 	}
-	info.OriginalTable = createStmt
-	return createStmt, exist, nil
+	return createStmt, nil
+}
+
+func (c *Context) createTableStmtCacheKey(stmt *ast.TableName) string {
+	key := fmt.Sprintf("%s.%s", c.GetSchemaName(stmt), stmt.Name.String())
+	if c.IsLowerCaseTableName() {
+		key = strings.ToLower(key)
+	}
+	return key
+}
+
+// createTableStmtCache caches the parsed results of "SHOW CREATE TABLE" by schema and table name,
+// the concurrent lookups of the same table share one query. The failure of parsing the result is
+// cached too, while the other errors, e.g. the connection errors, are not cached.
+type createTableStmtCache struct {
+	mu      sync.Mutex
+	entries map[string]*createTableStmtCacheEntry
+}
+
+type createTableStmtCacheEntry struct {
+	once sync.Once
+	stmt *ast.CreateTableStmt
+	err  error
+}
+
+func newCreateTableStmtCache() *createTableStmtCache {
+	return &createTableStmtCache{entries: map[string]*createTableStmtCacheEntry{}}
+}
+
+// get returns the cached result of the key, load is called if it is not cached. The cache is not
+// used if it is nil, e.g. the context is not created by NewContext.
+func (c *createTableStmtCache) get(key string, load func() (*ast.CreateTableStmt, error)) (*ast.CreateTableStmt, error) {
+	if c == nil {
+		return load()
+	}
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &createTableStmtCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.stmt, entry.err = load()
+	})
+	if entry.err != nil && !IsParseShowCreateTableContentErr(entry.err) {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+	return entry.stmt, entry.err
+}
+
+// clear removes all cached results, the table definitions may be changed by DDL.
+func (c *createTableStmtCache) clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.entries = map[string]*createTableStmtCacheEntry{}
+	c.mu.Unlock()
 }
 
 /*
@@ -1090,7 +1460,7 @@ func (c *Context) SetTableSize(schemaName, tableName string, sizeMB float64) err
 
 // GetExecutionPlan get execution plan of SQL.
 func (c *Context) GetExecutionPlan(sql string) ([]*executor.ExplainRecord, error) {
-	key := fmt.Sprintf("%s.%s", c.currentSchema, sql)
+	key := c.executionPlanKey(sql)
 	if ep, ok := c.executionPlan[key]; ok {
 		return ep.Plan, nil
 	}
@@ -1110,7 +1480,7 @@ func (c *Context) GetExecutionPlan(sql string) ([]*executor.ExplainRecord, error
 
 // GetExecutionPlanWithWarnings get execution plan and warnings of SQL.
 func (c *Context) GetExecutionPlanWithWarnings(sql string) (*executor.ExplainWithWarningsResult, error) {
-	key := fmt.Sprintf("%s.%s", c.currentSchema, sql)
+	key := c.executionPlanKey(sql)
 	if ep, ok := c.executionPlan[key]; ok {
 		return ep, nil
 	}
@@ -1124,6 +1494,17 @@ func (c *Context) GetExecutionPlanWithWarnings(sql string) (*executor.ExplainWit
 	return r, nil
 }
 
+// executionPlanKey returns the cache key of execution plan. The SQL is normalized so
+// that the same SQL in different formats shares the plan. The fingerprint is not used
+// because the plans of the SQLs with different values are different, e.g. the rows.
+func (c *Context) executionPlanKey(sql string) string {
+	normalized, err := util.NormalizeSql(sql)
+	if err != nil {
+		normalized = sql
+	}
+	return fmt.Sprintf("%s.%s", c.currentSchema, normalized)
+}
+
 // GetTableRowCount get table row count by show table status.
 func (c *Context) GetTableRowCount(tn *ast.TableName) (int, error) {
 	ti, exist := c.GetTableInfo(tn)
@@ -1259,6 +1640,9 @@ func (c *Context) fetchExecutionPlanWithWarnings(sql string) (*executor.ExplainW
 	if c.e == nil {
 		return nil, fmt.Errorf("executor is not initialized")
 	}
+	if util.HasParamMarker(sql) {
+		return nil, util.ErrSqlWithParamMarker
+	}
 
 	explainRecords, err := c.e.GetExplainRecord(sql)
 	if err != nil {
@@ -1,9 +1,16 @@
 package session
 
 import (
+	"errors"
+	"regexp"
 	"testing"
 	"unicode"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	"github.com/actiontech/sqle/sqle/driver/mysql/util"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,3 +63,304 @@ func Test_LoadTablesLowerCaseTableNamesOpen(t *testing.T) {
 		}
 	}
 }
+
+func TestGetExecutionPlanCache(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	ctx := NewMockContext(e)
+
+	expectExplain := func() {
+		handler.ExpectQuery(regexp.QuoteMeta("EXPLAIN select * from exist_tb_1 where id = 1")).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "table", "type", "rows"}).AddRow("1", "exist_tb_1", "const", "1"))
+		handler.ExpectQuery(regexp.QuoteMeta("SHOW WARNINGS")).
+			WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}))
+	}
+
+	// the same SQL in different formats shares the plan
+	expectExplain()
+	plan, err := ctx.GetExecutionPlan("select * from exist_tb_1 where id = 1")
+	assert.NoError(t, err)
+	assert.Len(t, plan, 1)
+	plan, err = ctx.GetExecutionPlan("SELECT *  FROM `exist_tb_1` WHERE id=1")
+	assert.NoError(t, err)
+	assert.Len(t, plan, 1)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the plan is cleared after DDL
+	node, err := util.ParseOneSql("alter table exist_tb_1 add index idx_1(v1)")
+	assert.NoError(t, err)
+	ctx.UpdateContext(node)
+	expectExplain()
+	_, err = ctx.GetExecutionPlan("select * from exist_tb_1 where id = 1")
+	assert.NoError(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestGetSystemVariables(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	ctx := NewMockContext(e)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('sql_mode', 'innodb_file_per_table', 'foreign_key_checks')")).
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("foreign_key_checks", "ON").
+			AddRow("innodb_file_per_table", "ON").
+			AddRow("sql_mode", "STRICT_TRANS_TABLES"))
+	values, err := ctx.GetSystemVariables("sql_mode", "innodb_file_per_table", "foreign_key_checks")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"sql_mode":              "STRICT_TRANS_TABLES",
+		"innodb_file_per_table": "ON",
+		"foreign_key_checks":    "ON",
+	}, values)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the cached variables are not queried again
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version', 'not_exist')")).
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "8.0.30"))
+	values, err = ctx.GetSystemVariables("sql_mode", "version", "not_exist")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"sql_mode": "STRICT_TRANS_TABLES", "version": "8.0.30"}, values)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the single variable getter shares the cache
+	value, err := ctx.GetSystemVariable("foreign_key_checks")
+	assert.NoError(t, err)
+	assert.Equal(t, "ON", value)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SHOW GLOBAL VARIABLES WHERE Variable_name IN ('not_exist')")).
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}))
+	_, err = ctx.GetSystemVariable("not_exist")
+	assert.Error(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestIsMariaDB(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	ctx := NewMockContext(e)
+
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT VERSION()")).
+		WillReturnRows(sqlmock.NewRows([]string{"VERSION()"}).AddRow("10.6.12-MariaDB-log"))
+	isMariaDB, err := ctx.IsMariaDB()
+	assert.NoError(t, err)
+	assert.True(t, isMariaDB)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the version is cached, and shared by the child context
+	version, err := NewContext(ctx).GetServerVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "10.6.12-MariaDB-log", version)
+
+	e, handler, err = executor.NewMockExecutor()
+	assert.NoError(t, err)
+	ctx = NewMockContext(e)
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT VERSION()")).
+		WillReturnRows(sqlmock.NewRows([]string{"VERSION()"}).AddRow("8.0.30"))
+	isMariaDB, err = ctx.IsMariaDB()
+	assert.NoError(t, err)
+	assert.False(t, isMariaDB)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	isMariaDB, err = NewMockContext(nil).IsMariaDB()
+	assert.NoError(t, err)
+	assert.False(t, isMariaDB)
+}
+
+func TestGetTargetVersion(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+
+	// the target version takes precedence over the server version
+	ctx := NewContext(nil, WithExecutor(e), WithTargetVersion(" 5.7.44-log "))
+	version, err := ctx.GetTargetVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "5.7.44-log", version)
+	assert.True(t, ctx.HasTargetVersion())
+	assert.True(t, NewContext(ctx).HasTargetVersion())
+	assert.True(t, ctx.Clone().HasTargetVersion())
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	ctx = NewContext(nil, WithExecutor(e))
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT VERSION()")).
+		WillReturnRows(sqlmock.NewRows([]string{"VERSION()"}).AddRow("8.0.30"))
+	version, err = ctx.GetTargetVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "8.0.30", version)
+	assert.False(t, ctx.HasTargetVersion())
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the version is unknown in offline audit without target version
+	version, err = NewContext(nil).GetTargetVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "", version)
+
+	isMariaDB, err := NewContext(nil, WithTargetVersion("10.6.12-MariaDB")).IsMariaDB()
+	assert.NoError(t, err)
+	assert.True(t, isMariaDB)
+}
+
+func TestParseVersion(t *testing.T) {
+	for version, expected := range map[string]string{
+		"8.0.30":              "8.0.30",
+		"5.7.44-log":          "5.7.44",
+		"10.6.12-MariaDB-log": "10.6.12",
+		"8.0":                 "8.0.0",
+		"8":                   "8.0.0",
+		"8.0.":                "8.0.0",
+		"5.7.44.1":            "5.7.44",
+	} {
+		v, err := ParseVersion(version)
+		assert.NoError(t, err, version)
+		assert.Equal(t, expected, v.String(), version)
+	}
+	for _, version := range []string{"", "MariaDB", ".8.0"} {
+		_, err := ParseVersion(version)
+		assert.Error(t, err, version)
+	}
+}
+
+func TestGetCreateTableStmtCache(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	ctx := NewMockContext(e)
+	ctx.schemas["exist_db"].Tables["not_loaded_tb"] = &TableInfo{isLoad: true}
+	ctx.schemas["exist_db"].Tables["invalid_tb"] = &TableInfo{isLoad: true}
+	table := &ast.TableName{Schema: model.NewCIStr("exist_db"), Name: model.NewCIStr("not_loaded_tb")}
+
+	expectShowCreateTable := func() {
+		handler.ExpectQuery(regexp.QuoteMeta("show create table `exist_db`.`not_loaded_tb`")).
+			WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}).
+				AddRow("not_loaded_tb", "CREATE TABLE `not_loaded_tb` (`id` int NOT NULL, PRIMARY KEY (`id`))"))
+	}
+
+	// the clones of the context share the result
+	expectShowCreateTable()
+	for _, c := range []*Context{ctx.Clone(), ctx.Clone()} {
+		stmt, exist, err := c.GetCreateTableStmt(table)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+		assert.Equal(t, "not_loaded_tb", stmt.Table.Name.O)
+	}
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	stmt, _, err := ctx.GetCreateTableStmt(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "not_loaded_tb", stmt.Table.Name.O)
+	assert.NoError(t, handler.ExpectationsWereMet())
+	ctx.schemas["exist_db"].Tables["not_loaded_tb"] = &TableInfo{isLoad: true}
+
+	// the cache is cleared after DDL
+	node, err := util.ParseOneSql("create table exist_db.new_tb(id int primary key)")
+	assert.NoError(t, err)
+	ctx.UpdateContext(node)
+	expectShowCreateTable()
+	_, _, err = ctx.Clone().GetCreateTableStmt(table)
+	assert.NoError(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the error of the query is not cached
+	ctx.UpdateContext(node)
+	handler.ExpectQuery(regexp.QuoteMeta("show create table `exist_db`.`not_loaded_tb`")).WillReturnError(errors.New("connection refused"))
+	_, _, err = ctx.Clone().GetCreateTableStmt(table)
+	assert.Error(t, err)
+	expectShowCreateTable()
+	_, _, err = ctx.Clone().GetCreateTableStmt(table)
+	assert.NoError(t, err)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the failure of parsing the result is cached
+	invalidTable := &ast.TableName{Schema: model.NewCIStr("exist_db"), Name: model.NewCIStr("invalid_tb")}
+	handler.ExpectQuery(regexp.QuoteMeta("show create table `exist_db`.`invalid_tb`")).
+		WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("invalid_tb", "CREATE TABLE `invalid_tb` ("))
+	for _, c := range []*Context{ctx.Clone(), ctx.Clone()} {
+		_, _, err = c.GetCreateTableStmt(invalidTable)
+		assert.True(t, IsParseShowCreateTableContentErr(err))
+	}
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestContext_ApplyDDL(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	ctx := NewMockContext(e)
+	ctx.schemas["exist_db"].Tables["not_loaded_tb"] = &TableInfo{isLoad: true}
+	ctx.schemas["not_loaded_db"] = &SchemaInfo{IsRealSchema: true}
+	tableName := func(schema, table string) *ast.TableName {
+		return &ast.TableName{Schema: model.NewCIStr(schema), Name: model.NewCIStr(table)}
+	}
+	countColumn := func(stmt *ast.CreateTableStmt, name string) int {
+		count := 0
+		for _, col := range stmt.Cols {
+			if col.Name.Name.L == name {
+				count++
+			}
+		}
+		return count
+	}
+
+	assert.NoError(t, ctx.ApplyDDL("ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT; ALTER TABLE exist_db.not_loaded_tb ADD COLUMN v3 INT;"))
+	assert.True(t, ctx.GetHistorySQLInfo().HasDDL)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the loaded table is altered
+	stmt, exist, err := ctx.GetCreateTableStmt(tableName("exist_db", "exist_tb_1"))
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, 1, countColumn(stmt, "v3"))
+
+	// the table not loaded is loaded as it is, which is already altered
+	handler.ExpectQuery(regexp.QuoteMeta("show create table `exist_db`.`not_loaded_tb`")).
+		WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow("not_loaded_tb", "CREATE TABLE `not_loaded_tb` (`id` int NOT NULL, `v3` int DEFAULT NULL, PRIMARY KEY (`id`))"))
+	stmt, exist, err = ctx.GetCreateTableStmt(tableName("exist_db", "not_loaded_tb"))
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, 1, countColumn(stmt, "v3"))
+	info, _ := ctx.GetTableInfo(tableName("exist_db", "not_loaded_tb"))
+	assert.Nil(t, info.MergedTable)
+	assert.Empty(t, info.AlterTables)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the renaming is applied to the table whose definition is not loaded
+	ctx.schemas["exist_db"].Tables["not_loaded_tb"] = &TableInfo{isLoad: true}
+	assert.NoError(t, ctx.ApplyDDL("ALTER TABLE exist_db.not_loaded_tb ADD COLUMN v4 INT, RENAME TO renamed_tb;"))
+	assert.False(t, ctx.hasTable("exist_db", "not_loaded_tb"))
+	assert.True(t, ctx.hasTable("exist_db", "renamed_tb"))
+
+	// the tables of the schema not loaded are loaded as they are, which are already created
+	assert.NoError(t, ctx.ApplyDDL("CREATE TABLE not_loaded_db.t1 (id INT); ALTER TABLE not_loaded_db.t1 ADD COLUMN v3 INT;"))
+	handler.ExpectQuery(regexp.QuoteMeta("select TABLE_NAME from information_schema.tables where table_schema='not_loaded_db'")).
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}).AddRow("t1"))
+	exist, err = ctx.IsTableExist(tableName("not_loaded_db", "t1"))
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	info, _ = ctx.GetTableInfo(tableName("not_loaded_db", "t1"))
+	assert.Nil(t, info.OriginalTable)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// the created schema exists on the instance
+	handler.ExpectExec(regexp.QuoteMeta("use `new_db`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.NoError(t, ctx.ApplyDDL("CREATE DATABASE new_db; USE new_db; CREATE TABLE t1 (id INT);"))
+	assert.Equal(t, "new_db", ctx.CurrentSchema())
+	assert.True(t, ctx.hasTable("new_db", "t1"))
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestContext_ApplyDDLLoadFailed(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+	ctx := NewMockContext(e)
+	ctx.schemaHasLoad = false
+
+	handler.ExpectQuery(regexp.QuoteMeta("show databases")).WillReturnError(errors.New("connection refused"))
+	err = ctx.ApplyDDL("ALTER TABLE exist_db.exist_tb_1 ADD COLUMN v3 INT; USE exist_db;")
+	assert.EqualError(t, err, `load schemas for sql "USE exist_db;" failed: connection refused`)
+	assert.NoError(t, handler.ExpectationsWereMet())
+
+	// nothing is applied
+	assert.False(t, ctx.GetHistorySQLInfo().HasDDL)
+	info, _ := ctx.GetTableInfo(&ast.TableName{Schema: model.NewCIStr("exist_db"), Name: model.NewCIStr("exist_tb_1")})
+	assert.Nil(t, info.MergedTable)
+	assert.Empty(t, info.AlterTables)
+}
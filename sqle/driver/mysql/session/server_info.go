@@ -0,0 +1,185 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+)
+
+const (
+	ServerFlavorMySQL   = "MySQL"
+	ServerFlavorMariaDB = "MariaDB"
+	ServerFlavorPercona = "Percona"
+)
+
+// ServerInfo is the capabilities of the server, it is used to warn the user before
+// auditing, e.g. gh-ost does not work if binlog_format is STATEMENT. See LoadServerInfo.
+type ServerInfo struct {
+	// Version is the same as the result of "SELECT VERSION()", e.g. "8.0.30" or "10.6.12-MariaDB-log".
+	Version string
+	// Flavor is one of ServerFlavorMySQL, ServerFlavorMariaDB and ServerFlavorPercona.
+	Flavor              string
+	SQLMode             string
+	LowerCaseTableNames string
+	// PerformanceSchema reports whether performance_schema is enabled.
+	PerformanceSchema bool
+	// LogBin reports whether the binary log is enabled.
+	LogBin       bool
+	BinlogFormat string
+	// BinlogRowImage is empty if the server does not support binlog_row_image.
+	BinlogRowImage string
+	// Grants is the result of "SHOW GRANTS FOR CURRENT_USER()".
+	Grants []string
+}
+
+var serverInfoVariables = []string{
+	"version",
+	"version_comment",
+	"sql_mode",
+	SysVarLowerCaseTableNames,
+	"performance_schema",
+	"log_bin",
+	"binlog_format",
+	"binlog_row_image",
+}
+
+// LoadServerInfo queries the server info by two queries, one for the global variables
+// and the other for the grants of the current user.
+func LoadServerInfo(ctx context.Context, db executor.Db) (*ServerInfo, error) {
+	quotedNames := make([]string, 0, len(serverInfoVariables))
+	for _, name := range serverInfoVariables {
+		quotedNames = append(quotedNames, fmt.Sprintf("'%s'", name))
+	}
+	_, rows, err := db.QueryWithContext(ctx, fmt.Sprintf("SHOW GLOBAL VARIABLES WHERE Variable_name IN (%s)", strings.Join(quotedNames, ", ")))
+	if err != nil {
+		return nil, fmt.Errorf("query global variables failed: %v", err)
+	}
+	vars := make(map[string]string, len(rows))
+	for _, row := range rows {
+		if len(row) < 2 {
+			return nil, fmt.Errorf("unexpected results when query global variables")
+		}
+		vars[strings.ToLower(row[0].String)] = row[1].String
+	}
+
+	info := &ServerInfo{
+		Version:             vars["version"],
+		Flavor:              ServerFlavorMySQL,
+		SQLMode:             vars["sql_mode"],
+		LowerCaseTableNames: vars[SysVarLowerCaseTableNames],
+		PerformanceSchema:   isVariableOn(vars["performance_schema"]),
+		LogBin:              isVariableOn(vars["log_bin"]),
+		BinlogFormat:        strings.ToUpper(vars["binlog_format"]),
+		BinlogRowImage:      strings.ToUpper(vars["binlog_row_image"]),
+	}
+	switch {
+	case strings.Contains(strings.ToLower(info.Version), "mariadb"):
+		info.Flavor = ServerFlavorMariaDB
+	case strings.Contains(strings.ToLower(vars["version_comment"]), "percona"):
+		info.Flavor = ServerFlavorPercona
+	}
+
+	_, rows, err = db.QueryWithContext(ctx, "SHOW GRANTS FOR CURRENT_USER()")
+	if err != nil {
+		return nil, fmt.Errorf("query grants failed: %v", err)
+	}
+	for _, row := range rows {
+		if len(row) > 0 {
+			info.Grants = append(info.Grants, row[0].String)
+		}
+	}
+	return info, nil
+}
+
+func isVariableOn(value string) bool {
+	return strings.EqualFold(value, "ON") || value == "1"
+}
+
+// grantPattern matches the privileges and the level of "GRANT ... ON ... TO ...", the grants
+// of roles and PROXY do not match.
+var grantPattern = regexp.MustCompile(`(?is)^GRANT\s+(.+?)\s+ON\s+(\S+)\s+TO\s`)
+
+// MissingGlobalPrivileges returns the privileges which are not granted to the current user
+// on *.*, e.g. "REPLICATION SLAVE". ALL PRIVILEGES covers all the privileges.
+func (s *ServerInfo) MissingGlobalPrivileges(privileges ...string) []string {
+	return s.MissingSchemaPrivileges("", privileges...)
+}
+
+// MissingSchemaPrivileges returns the privileges which are not granted to the current user
+// on *.* or on all the tables of the schema. Only the global privileges are checked if the
+// schema is empty.
+func (s *ServerInfo) MissingSchemaPrivileges(schema string, privileges ...string) []string {
+	levels := map[string]bool{"*.*": true}
+	if schema != "" {
+		levels[fmt.Sprintf("%s.*", schema)] = true
+		levels[fmt.Sprintf("`%s`.*", strings.ReplaceAll(schema, "`", "``"))] = true
+	}
+	granted := map[string]bool{}
+	for _, grant := range s.Grants {
+		matches := grantPattern.FindStringSubmatch(grant)
+		if matches == nil || !levels[matches[2]] {
+			continue
+		}
+		for _, privilege := range strings.Split(matches[1], ",") {
+			granted[strings.ToUpper(strings.Join(strings.Fields(privilege), " "))] = true
+		}
+	}
+	if granted["ALL"] || granted["ALL PRIVILEGES"] {
+		return nil
+	}
+	var missing []string
+	for _, privilege := range privileges {
+		if !granted[strings.ToUpper(privilege)] {
+			missing = append(missing, privilege)
+		}
+	}
+	return missing
+}
+
+var (
+	// ref: https://github.com/github/gh-ost/blob/master/doc/requirements-and-limitations.md#privileges
+	ghostSchemaPrivileges = []string{"ALTER", "CREATE", "DELETE", "DROP", "INDEX", "INSERT", "LOCK TABLES", "SELECT", "TRIGGER", "UPDATE"}
+	ghostGlobalPrivileges = []string{"REPLICATION SLAVE", "REPLICATION CLIENT"}
+
+	// ref: https://docs.percona.com/percona-toolkit/pt-online-schema-change.html
+	ptOscSchemaPrivileges = []string{"ALTER", "CREATE", "DELETE", "DROP", "INSERT", "SELECT", "TRIGGER", "UPDATE"}
+	ptOscGlobalPrivileges = []string{"PROCESS"}
+)
+
+// GhostIssues returns the reasons why gh-ost does not work on the tables of the schema, it is
+// empty if the binlog settings and the privileges required by gh-ost are met.
+func (s *ServerInfo) GhostIssues(schema string) []string {
+	var issues []string
+	if !s.LogBin {
+		issues = append(issues, "binary log is not enabled")
+	}
+	if s.BinlogFormat != "ROW" {
+		issues = append(issues, fmt.Sprintf("binlog_format is %s, gh-ost requires ROW", s.BinlogFormat))
+	}
+	if s.BinlogRowImage != "" && s.BinlogRowImage != "FULL" {
+		issues = append(issues, fmt.Sprintf("binlog_row_image is %s, gh-ost requires FULL", s.BinlogRowImage))
+	}
+	return append(issues, s.privilegeIssues(schema, ghostSchemaPrivileges, ghostGlobalPrivileges)...)
+}
+
+// PtOscIssues returns the reasons why pt-online-schema-change does not work on the tables
+// of the schema, it is empty if the privileges required by pt-online-schema-change are met.
+func (s *ServerInfo) PtOscIssues(schema string) []string {
+	// pt-online-schema-change syncs the rows changed during copying by triggers,
+	// and finds the replicas by SHOW PROCESSLIST.
+	return s.privilegeIssues(schema, ptOscSchemaPrivileges, ptOscGlobalPrivileges)
+}
+
+func (s *ServerInfo) privilegeIssues(schema string, schemaPrivileges, globalPrivileges []string) []string {
+	var issues []string
+	if missing := s.MissingSchemaPrivileges(schema, schemaPrivileges...); len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("missing privileges on schema %s: %s", schema, strings.Join(missing, ", ")))
+	}
+	if missing := s.MissingGlobalPrivileges(globalPrivileges...); len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("missing global privileges: %s", strings.Join(missing, ", ")))
+	}
+	return issues
+}
@@ -104,7 +104,8 @@ func (s *splitter) getNextSql(sqlText string) (*singleSQL, error) {
 			lineNumber:         s.delimiter.line + lineBeforeStart + 1,
 			isDelimiterCommand: matchedDelimiterCommand,
 		}
-		s.delimiter.line += s.scanner.ScannedLines() // pos().Line-1表示的是该SQL中有多少换行
+		// 通过切分位置之前的换行数计算行数，自定义分隔符语法通过SetCursor跳过的文本不会被scanner计入行数
+		s.delimiter.line += strings.Count(sqlText[:s.scanner.Offset()], "\n")
 		return result, nil
 	}
 	// 处理剩余SQL文本
@@ -164,6 +165,13 @@ func (s *splitter) skipBeginEndBlock(token *parser.Token) *parser.Token {
 	}
 	for len(blockStack) > 0 {
 		token = s.scanner.NextToken()
+		// mysql client 不识别语句块，自定义分隔符在语句块中同样结束SQL，如 END$$ 会被识别为一个identifier，
+		// 此时返回该TOKEN，由isTokenMatchDelimiter匹配分隔符
+		if s.delimiter.DelimiterStr != DefaultDelimiterString {
+			if _, ok := s.matchDelimiterInToken(token); ok {
+				break
+			}
+		}
 		for _, block := range allBlocks {
 			if block.MatchBegin(token) {
 				blockStack = append(blockStack, block)
@@ -213,23 +221,8 @@ func (s *splitter) isTokenMatchDelimiter(token *parser.Token) bool {
 					1. 当分隔符第一个token值与stringLit的token值不等，那么一定不是分隔符，则跳过
 					2. 当分隔符第一个token值与stringLit的token值相等， 如："'abc'd" '"abc"d'会因为字符串不匹配而跳过
 		*/
-		// 1. 当分隔符第一个token值与stringLit的token值不等，那么一定不是分隔符，则跳过
-		if token.TokenType() == parser.StringLit && token.Ident() != s.delimiter.FirstTokenValueOfDelimiter {
-			return false
-		}
-		// 2. 定位特征的第一个字符所处的位置
-		indexInToken := strings.Index(token.Ident(), s.delimiter.FirstTokenValueOfDelimiter)
-		if indexInToken == -1 {
-			return false
-		}
-		// 3. 字符串匹配
-		begin := s.scanner.Offset() + indexInToken
-		end := begin + len(s.delimiter.DelimiterStr)
-		if begin < 0 || end > len(s.scanner.Text()) {
-			return false
-		}
-		expected := s.scanner.Text()[begin:end]
-		if expected != s.delimiter.DelimiterStr {
+		end, ok := s.matchDelimiterInToken(token)
+		if !ok {
 			return false
 		}
 		s.scanner.SetCursor(end)
@@ -241,6 +234,33 @@ func (s *splitter) isTokenMatchDelimiter(token *parser.Token) bool {
 	return false
 }
 
+// matchDelimiterInToken 判断当前token中是否包含分隔符，若包含则返回分隔符的结束位置，不移动游标
+func (s *splitter) matchDelimiterInToken(token *parser.Token) (int, bool) {
+	if token.TokenType() != s.delimiter.FirstTokenTypeOfDelimiter {
+		return 0, false
+	}
+	// 1. 当分隔符第一个token值与stringLit的token值不等，那么一定不是分隔符，则跳过
+	if token.TokenType() == parser.StringLit && token.Ident() != s.delimiter.FirstTokenValueOfDelimiter {
+		return 0, false
+	}
+	// 2. 定位特征的第一个字符所处的位置
+	indexInToken := strings.Index(token.Ident(), s.delimiter.FirstTokenValueOfDelimiter)
+	if indexInToken == -1 {
+		return 0, false
+	}
+	// 3. 字符串匹配
+	begin := s.scanner.Offset() + indexInToken
+	end := begin + len(s.delimiter.DelimiterStr)
+	if begin < 0 || end > len(s.scanner.Text()) {
+		return 0, false
+	}
+	expected := s.scanner.Text()[begin:end]
+	if expected != s.delimiter.DelimiterStr {
+		return 0, false
+	}
+	return end, true
+}
+
 /*
 该方法检测sql文本开头是否是自定义分隔符语法，若是匹配并更新分隔符:
 
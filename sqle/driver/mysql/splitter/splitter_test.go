@@ -160,6 +160,56 @@ func TestSkipQuotedDelimiter(t *testing.T) {
 	}
 }
 
+func TestCustomDelimiterWithNestedBlock(t *testing.T) {
+	s := NewSplitter()
+	assert.NoError(t, s.delimiter.reset())
+	splitResults, err := s.splitSqlText(`select 1;
+DELIMITER $$
+CREATE PROCEDURE p1()
+BEGIN
+  DECLARE i INT DEFAULT 0;
+  BEGIN
+    IF i > 0 THEN
+      SELECT 1;
+    END IF;
+  END;
+  label1: LOOP
+    SET i = i + 1;
+    IF i > 3 THEN LEAVE label1; END IF;
+  END LOOP;
+END$$
+CREATE TRIGGER tr1 BEFORE INSERT ON t1 FOR EACH ROW
+BEGIN
+  SET NEW.c = 1;
+END $$
+DELIMITER ;
+select 2;
+delimiter //
+create procedure p2() begin while i > 0 do set i = i - 1; end while; end//
+delimiter ;
+select 3`)
+	assert.NoError(t, err)
+	assert.Len(t, splitResults, 6)
+
+	expects := []struct {
+		lineNumber int
+		prefix     string
+		suffix     string
+	}{
+		{1, "select 1", ";"},
+		{3, "CREATE PROCEDURE p1()", "END LOOP;\nEND;"},
+		{16, "CREATE TRIGGER tr1", "END ;"},
+		{21, "select 2", ";"},
+		{23, "create procedure p2()", "end while; end;"},
+		{25, "select 3", "select 3"},
+	}
+	for i, expect := range expects {
+		assert.Equal(t, expect.lineNumber, splitResults[i].lineNumber, splitResults[i].originSql)
+		assert.True(t, strings.HasPrefix(splitResults[i].originSql, expect.prefix), splitResults[i].originSql)
+		assert.True(t, strings.HasSuffix(splitResults[i].originSql, expect.suffix), splitResults[i].originSql)
+	}
+}
+
 func TestStartLine(t *testing.T) {
 	// 测试用例第2个到第5个sql是解析器不能解析的sql
 	p := NewSplitter()
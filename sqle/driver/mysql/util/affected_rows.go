@@ -0,0 +1,86 @@
+package util
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+)
+
+// AffectedRowsEstimator estimates the rows affected by the statement, it is the strategy of
+// EstimateSQLAffectRows of the driver, which trades off the accuracy and the cost on the instance.
+//
+// conn is the executor connected to the instance, and explainRecordFunc returns the execution
+// plan of the SQL. The errors ErrUnsupportedSqlType and ErrSqlWithParamMarker are reported as
+// the message of the estimation instead of failure, the estimator should return them, possibly
+// wrapped, for the statements it can not estimate.
+type AffectedRowsEstimator interface {
+	EstimateAffectedRows(ctx context.Context, originSql string, conn *executor.Executor, explainRecordFunc func(string) ([]*executor.ExplainRecord, error)) (int64, driverV2.AffectRowsMethod, error)
+}
+
+// NewAffectedRowsEstimatorFunc creates the AffectedRowsEstimator with the options configured by
+// the driver, the estimator may ignore the options it does not support.
+type NewAffectedRowsEstimatorFunc func(opts AffectedRowNumOptions) AffectedRowsEstimator
+
+// names of the built-in AffectedRowsEstimator.
+const (
+	// AffectedRowsEstimatorDefault is DefaultAffectedRowsEstimator, it is used if no estimator is configured.
+	AffectedRowsEstimatorDefault = "default"
+	// AffectedRowsEstimatorExplain is DefaultAffectedRowsEstimator with AffectedRowNumOptions.ExplainOnly,
+	// which never executes SELECT COUNT on the instance.
+	AffectedRowsEstimatorExplain = "explain"
+)
+
+var affectedRowsEstimators = map[string]NewAffectedRowsEstimatorFunc{
+	AffectedRowsEstimatorDefault: func(opts AffectedRowNumOptions) AffectedRowsEstimator {
+		return DefaultAffectedRowsEstimator{Options: opts}
+	},
+	AffectedRowsEstimatorExplain: func(opts AffectedRowNumOptions) AffectedRowsEstimator {
+		opts.ExplainOnly = true
+		return DefaultAffectedRowsEstimator{Options: opts}
+	},
+}
+
+// RegisterAffectedRowsEstimator registers the estimator which is not defined in this package, e.g.
+// the estimator by sampling, it is selected by name in the config of the driver.
+//
+// It is not safe for concurrent use with estimating, it should be called on startup (e.g. in the
+// init function of the package defining the estimator).
+func RegisterAffectedRowsEstimator(name string, newFunc NewAffectedRowsEstimatorFunc) error {
+	if name == "" {
+		return fmt.Errorf("affected rows estimator name is empty")
+	}
+	if newFunc == nil {
+		return fmt.Errorf("the func of affected rows estimator %s is nil", name)
+	}
+	if _, exist := affectedRowsEstimators[name]; exist {
+		return fmt.Errorf("affected rows estimator %s is already registered", name)
+	}
+	affectedRowsEstimators[name] = newFunc
+	return nil
+}
+
+// NewAffectedRowsEstimator creates the registered estimator by name, the default estimator is
+// created if name is empty.
+func NewAffectedRowsEstimator(name string, opts AffectedRowNumOptions) (AffectedRowsEstimator, error) {
+	if name == "" {
+		name = AffectedRowsEstimatorDefault
+	}
+	newFunc, exist := affectedRowsEstimators[name]
+	if !exist {
+		return nil, fmt.Errorf("affected rows estimator %s is not registered", name)
+	}
+	return newFunc(opts), nil
+}
+
+// DefaultAffectedRowsEstimator is the AffectedRowsEstimator of EstimateAffectedRowNum: the rows
+// of EXPLAIN are used if the table is scanned fully or the rows are more than 100000, otherwise
+// the rows are counted by SELECT COUNT, see AffectedRowNumOptions for the tuning.
+type DefaultAffectedRowsEstimator struct {
+	Options AffectedRowNumOptions
+}
+
+func (e DefaultAffectedRowsEstimator) EstimateAffectedRows(ctx context.Context, originSql string, conn *executor.Executor, explainRecordFunc func(string) ([]*executor.ExplainRecord, error)) (int64, driverV2.AffectRowsMethod, error) {
+	return EstimateAffectedRowNum(ctx, originSql, conn, explainRecordFunc, e.Options)
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixedAffectedRowsEstimator struct {
+	rows int64
+}
+
+func (e fixedAffectedRowsEstimator) EstimateAffectedRows(ctx context.Context, originSql string, conn *executor.Executor, explainRecordFunc func(string) ([]*executor.ExplainRecord, error)) (int64, driverV2.AffectRowsMethod, error) {
+	return e.rows, driverV2.AffectRowsMethodStatistics, nil
+}
+
+func TestNewAffectedRowsEstimator(t *testing.T) {
+	sql := "delete from t1 where id > 10"
+	countSQL := regexp.QuoteMeta("SELECT COUNT(1) FROM `t1` WHERE `id`>10")
+	explainRange := func(string) ([]*executor.ExplainRecord, error) {
+		return []*executor.ExplainRecord{{Type: "range", Rows: 100}}, nil
+	}
+
+	t.Run("default estimator counts the rows", func(t *testing.T) {
+		for _, name := range []string{"", AffectedRowsEstimatorDefault} {
+			estimator, err := NewAffectedRowsEstimator(name, AffectedRowNumOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, DefaultAffectedRowsEstimator{}, estimator)
+
+			conn, mock, err := executor.NewMockExecutor()
+			assert.NoError(t, err)
+			mock.ExpectQuery(countSQL).WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow("90"))
+			num, method, err := estimator.EstimateAffectedRows(context.TODO(), sql, conn, explainRange)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(90), num)
+			assert.Equal(t, driverV2.AffectRowsMethodCount, method)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		}
+	})
+
+	t.Run("explain estimator never counts the rows", func(t *testing.T) {
+		estimator, err := NewAffectedRowsEstimator(AffectedRowsEstimatorExplain, AffectedRowNumOptions{AccurateMode: true, CountMaxTableRows: 100000})
+		assert.NoError(t, err)
+
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		num, method, err := estimator.EstimateAffectedRows(context.TODO(), sql, conn, explainRange)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), num)
+		assert.Equal(t, driverV2.AffectRowsMethodExplain, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+
+		// the rows of INSERT ... VALUES are still counted from the statement
+		num, method, err = estimator.EstimateAffectedRows(context.TODO(), "insert into t1 (id) values (1), (2)", nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), num)
+		assert.Equal(t, driverV2.AffectRowsMethodStatement, method)
+	})
+
+	t.Run("unregistered estimator", func(t *testing.T) {
+		_, err := NewAffectedRowsEstimator("not_exist", AffectedRowNumOptions{})
+		assert.EqualError(t, err, "affected rows estimator not_exist is not registered")
+	})
+
+	t.Run("registered estimator", func(t *testing.T) {
+		name := "test_fixed"
+		defer delete(affectedRowsEstimators, name)
+		assert.NoError(t, RegisterAffectedRowsEstimator(name, func(opts AffectedRowNumOptions) AffectedRowsEstimator {
+			return fixedAffectedRowsEstimator{rows: opts.CountMaxTableRows}
+		}))
+		assert.EqualError(t, RegisterAffectedRowsEstimator(name, func(opts AffectedRowNumOptions) AffectedRowsEstimator {
+			return fixedAffectedRowsEstimator{}
+		}), "affected rows estimator test_fixed is already registered")
+		assert.Error(t, RegisterAffectedRowsEstimator("", nil))
+		assert.Error(t, RegisterAffectedRowsEstimator("test_nil", nil))
+
+		estimator, err := NewAffectedRowsEstimator(name, AffectedRowNumOptions{CountMaxTableRows: 42})
+		assert.NoError(t, err)
+		num, method, err := estimator.EstimateAffectedRows(context.TODO(), sql, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), num)
+		assert.Equal(t, driverV2.AffectRowsMethodStatistics, method)
+	})
+}
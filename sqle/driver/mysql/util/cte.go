@@ -0,0 +1,94 @@
+package util
+
+import "strings"
+
+// withClause is the WITH clause of common table expressions, which is not supported by the parser.
+//
+//	WITH [RECURSIVE]
+//		cte_name [(col_name [, col_name] ...)] AS (subquery)
+//		[, cte_name [(col_name [, col_name] ...)] AS (subquery)] ...
+//
+// ref: https://dev.mysql.com/doc/refman/8.0/en/with.html
+type withClause struct {
+	// Text is the text of WITH clause, e.g. "WITH cte AS (SELECT 1)".
+	Text      string
+	Recursive bool
+	// Names are the names of common table expressions in lower case.
+	Names []string
+}
+
+// hasName reports whether name is the name of a common table expression.
+func (w *withClause) hasName(name string) bool {
+	for _, n := range w.Names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitWithClause splits sql into the leading WITH clause and the statement following it,
+// ok is false if sql does not begin with a WITH clause or the WITH clause is incomplete.
+func splitWithClause(sql string) (with *withClause, stmt string, ok bool) {
+	tokens := scanSqlTokens(sql)
+	if len(tokens) == 0 || !tokens[0].isWord("WITH") {
+		return nil, "", false
+	}
+	with = &withClause{}
+	pos := 1
+	if pos < len(tokens) && tokens[pos].isWord("RECURSIVE") {
+		with.Recursive = true
+		pos++
+	}
+	for {
+		if pos >= len(tokens) {
+			return nil, "", false
+		}
+		with.Names = append(with.Names, strings.ToLower(tokens[pos].unquoted()))
+		pos++
+		// the optional column list
+		if pos < len(tokens) && tokens[pos].isPunctuation("(") {
+			if pos = skipParentheses(tokens, pos); pos < 0 {
+				return nil, "", false
+			}
+		}
+		if pos >= len(tokens) || !tokens[pos].isWord("AS") {
+			return nil, "", false
+		}
+		pos++
+		if pos >= len(tokens) || !tokens[pos].isPunctuation("(") {
+			return nil, "", false
+		}
+		if pos = skipParentheses(tokens, pos); pos < 0 {
+			return nil, "", false
+		}
+		if pos < len(tokens) && tokens[pos].isPunctuation(",") {
+			pos++
+			continue
+		}
+		break
+	}
+	if pos >= len(tokens) {
+		return nil, "", false
+	}
+	with.Text = sql[tokens[0].start:tokens[pos-1].end]
+	return with, strings.TrimSpace(sql[tokens[pos].start:]), true
+}
+
+// skipParentheses returns the position after the parenthesis matching tokens[pos],
+// it is -1 if the parentheses are not matched.
+func skipParentheses(tokens []sqlToken, pos int) int {
+	depth := 0
+	for ; pos < len(tokens); pos++ {
+		switch {
+		case tokens[pos].isPunctuation("("):
+			depth++
+		case tokens[pos].isPunctuation(")"):
+			depth--
+			if depth == 0 {
+				return pos + 1
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitWithClause(t *testing.T) {
+	with, stmt, ok := splitWithClause("WITH cte AS (SELECT id FROM t2 WHERE name = ')'), `c2` (a, b) AS (SELECT 1, 2)\nDELETE FROM t1 WHERE id IN (SELECT id FROM cte)")
+	assert.True(t, ok)
+	assert.False(t, with.Recursive)
+	assert.Equal(t, []string{"cte", "c2"}, with.Names)
+	assert.Equal(t, "WITH cte AS (SELECT id FROM t2 WHERE name = ')'), `c2` (a, b) AS (SELECT 1, 2)", with.Text)
+	assert.Equal(t, "DELETE FROM t1 WHERE id IN (SELECT id FROM cte)", stmt)
+	assert.True(t, with.hasName("CTE"))
+	assert.False(t, with.hasName("t1"))
+
+	with, stmt, ok = splitWithClause("with recursive seq(n) as (select 1 union all select n + 1 from seq where n < 10) select * from seq")
+	assert.True(t, ok)
+	assert.True(t, with.Recursive)
+	assert.Equal(t, []string{"seq"}, with.Names)
+	assert.Equal(t, "with recursive seq(n) as (select 1 union all select n + 1 from seq where n < 10)", with.Text)
+	assert.Equal(t, "select * from seq", stmt)
+
+	for _, sql := range []string{
+		"SELECT * FROM t1",
+		"WITH cte AS (SELECT 1)",
+		"WITH cte AS (SELECT 1 SELECT * FROM cte",
+		"WITH cte (SELECT 1) SELECT * FROM cte",
+		"-- WITH cte AS (SELECT 1)\nSELECT 1",
+	} {
+		_, _, ok := splitWithClause(sql)
+		assert.False(t, ok, sql)
+	}
+}
@@ -0,0 +1,274 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/pingcap/parser/ast"
+)
+
+// EventStmt is the result of parsing CREATE EVENT and ALTER EVENT statements,
+// which are not supported by the parser and are audited as *ast.UnparsedStmt.
+//
+//	CREATE [DEFINER = user] EVENT [IF NOT EXISTS] event_name
+//		ON SCHEDULE schedule
+//		[ON COMPLETION [NOT] PRESERVE]
+//		[ENABLE | DISABLE | DISABLE ON SLAVE]
+//		[COMMENT 'string']
+//		DO event_body
+//
+//	ALTER [DEFINER = user] EVENT event_name
+//		[ON SCHEDULE schedule]
+//		[ON COMPLETION [NOT] PRESERVE]
+//		[RENAME TO new_event_name]
+//		[ENABLE | DISABLE | DISABLE ON SLAVE]
+//		[COMMENT 'string']
+//		[DO event_body]
+//
+// ref: https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+type EventStmt struct {
+	IsAlter     bool
+	Definer     string
+	IfNotExists bool
+	Schema      string
+	Name        string
+	// NewSchema and NewName are set by RENAME TO of ALTER EVENT.
+	NewSchema string
+	NewName   string
+	// Schedule is the text of schedule, e.g. "EVERY 1 DAY STARTS '2023-01-01 00:00:00'".
+	Schedule string
+	Comment  string
+	// Body is the text of event body, BodyStmts is the statements parsed from it.
+	// The statements in BEGIN ... END are parsed one by one, the statement which
+	// can not be parsed is *ast.UnparsedStmt.
+	Body      string
+	BodyStmts []ast.StmtNode
+}
+
+// ParseEventStmt is a lightweight parser for CREATE EVENT and ALTER EVENT statements,
+// ok is false if sql is not an event statement. The clauses which are missing or can
+// not be recognized are left empty.
+func ParseEventStmt(sql string) (stmt *EventStmt, ok bool) {
+	p := &eventParser{sql: sql, tokens: scanSqlTokens(sql)}
+	stmt = &EventStmt{}
+	switch {
+	case p.acceptWord("CREATE"):
+	case p.acceptWord("ALTER"):
+		stmt.IsAlter = true
+	default:
+		return nil, false
+	}
+	if p.acceptWord("DEFINER") {
+		if !p.accept("=") {
+			return nil, false
+		}
+		start := p.pos
+		for p.pos < len(p.tokens) && !p.isWord("EVENT") {
+			p.pos++
+		}
+		stmt.Definer = p.textOf(start, p.pos)
+	}
+	if !p.acceptWord("EVENT") {
+		return nil, false
+	}
+	if !stmt.IsAlter && p.acceptWord("IF", "NOT", "EXISTS") {
+		stmt.IfNotExists = true
+	}
+	var name string
+	stmt.Schema, name, ok = p.acceptObjectName()
+	if !ok {
+		return nil, false
+	}
+	stmt.Name = name
+
+	for p.pos < len(p.tokens) {
+		switch {
+		case p.acceptWord("ON", "SCHEDULE"):
+			start := p.pos
+			for p.pos < len(p.tokens) && !p.isClauseStart() {
+				p.pos++
+			}
+			stmt.Schedule = p.textOf(start, p.pos)
+		case p.acceptWord("ON", "COMPLETION"):
+			p.acceptWord("NOT")
+			p.acceptWord("PRESERVE")
+		case p.acceptWord("RENAME", "TO"):
+			stmt.NewSchema, stmt.NewName, _ = p.acceptObjectName()
+		case p.acceptWord("ENABLE"):
+		case p.acceptWord("DISABLE"):
+			p.acceptWord("ON", "SLAVE")
+		case p.acceptWord("COMMENT"):
+			if p.pos < len(p.tokens) {
+				stmt.Comment = p.tokens[p.pos].unquoted()
+				p.pos++
+			}
+		case p.acceptWord("DO"):
+			stmt.Body = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(p.sql[p.tokens[p.pos-1].end:]), ";"))
+			stmt.BodyStmts = parseEventBody(stmt.Body)
+			return stmt, true
+		default:
+			// skip the token which can not be recognized
+			p.pos++
+		}
+	}
+	return stmt, true
+}
+
+// parseEventBody parses the statements in event body, nil is returned if it can not be parsed.
+func parseEventBody(body string) []ast.StmtNode {
+	tokens := scanSqlTokens(body)
+	if len(tokens) >= 2 && tokens[0].isWord("BEGIN") && tokens[len(tokens)-1].isWord("END") {
+		body = body[tokens[0].end:tokens[len(tokens)-1].start]
+	}
+	stmts, err := ParseSql(body)
+	if err != nil {
+		return nil
+	}
+	return stmts
+}
+
+type sqlToken struct {
+	text       string
+	start, end int
+	quoted     bool
+}
+
+func (t sqlToken) isWord(word string) bool {
+	return !t.quoted && strings.EqualFold(t.text, word)
+}
+
+func (t sqlToken) isPunctuation(punctuation string) bool {
+	return !t.quoted && t.text == punctuation
+}
+
+func (t sqlToken) unquoted() string {
+	if !t.quoted || len(t.text) < 2 {
+		return t.text
+	}
+	quote := t.text[:1]
+	s := t.text[1 : len(t.text)-1]
+	return strings.ReplaceAll(s, quote+quote, quote)
+}
+
+// scanSqlTokens splits sql into words, quoted strings, quoted identifiers and
+// punctuations, the blanks and comments are skipped.
+func scanSqlTokens(sql string) []sqlToken {
+	tokens := []sqlToken{}
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(sql[i:], "-- "):
+			if end := strings.IndexByte(sql[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(sql)
+			}
+		case strings.HasPrefix(sql[i:], "/*"):
+			if end := strings.Index(sql[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(sql)
+			}
+		case c == '\'' || c == '"' || c == '`':
+			start := i
+			for i++; i < len(sql); i++ {
+				if sql[i] == '\\' && c != '`' {
+					i++
+				} else if sql[i] == c {
+					if i+1 < len(sql) && sql[i+1] == c {
+						i++
+					} else {
+						break
+					}
+				}
+			}
+			if i < len(sql) {
+				i++
+			}
+			tokens = append(tokens, sqlToken{text: sql[start:i], start: start, end: i, quoted: true})
+		case isSqlWordChar(c):
+			start := i
+			for i < len(sql) && isSqlWordChar(sql[i]) {
+				i++
+			}
+			tokens = append(tokens, sqlToken{text: sql[start:i], start: start, end: i})
+		default:
+			tokens = append(tokens, sqlToken{text: sql[i : i+1], start: i, end: i + 1})
+			i++
+		}
+	}
+	return tokens
+}
+
+func isSqlWordChar(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+type eventParser struct {
+	sql    string
+	tokens []sqlToken
+	pos    int
+}
+
+func (p *eventParser) isWord(word string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].isWord(word)
+}
+
+// acceptWord consumes the words if all of them match the following tokens.
+func (p *eventParser) acceptWord(words ...string) bool {
+	if p.pos+len(words) > len(p.tokens) {
+		return false
+	}
+	for i, word := range words {
+		if !p.tokens[p.pos+i].isWord(word) {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+func (p *eventParser) accept(punctuation string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].isPunctuation(punctuation) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// acceptObjectName consumes name or schema.name.
+func (p *eventParser) acceptObjectName() (schema, name string, ok bool) {
+	if p.pos >= len(p.tokens) {
+		return "", "", false
+	}
+	name = p.tokens[p.pos].unquoted()
+	p.pos++
+	if p.accept(".") {
+		if p.pos >= len(p.tokens) {
+			return "", "", false
+		}
+		schema = name
+		name = p.tokens[p.pos].unquoted()
+		p.pos++
+	}
+	return schema, name, true
+}
+
+func (p *eventParser) isClauseStart() bool {
+	for _, words := range [][]string{{"ON", "COMPLETION"}, {"RENAME", "TO"}, {"ENABLE"}, {"DISABLE"}, {"COMMENT"}, {"DO"}} {
+		pos := p.pos
+		if p.acceptWord(words...) {
+			p.pos = pos
+			return true
+		}
+	}
+	return false
+}
+
+func (p *eventParser) textOf(start, end int) string {
+	if start >= end {
+		return ""
+	}
+	return strings.TrimSpace(p.sql[p.tokens[start].start:p.tokens[end-1].end])
+}
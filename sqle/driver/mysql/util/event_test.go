@@ -0,0 +1,63 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEventStmt(t *testing.T) {
+	stmt, ok := ParseEventStmt("CREATE DEFINER = `root`@`%` EVENT IF NOT EXISTS `db1`.`e1`\n" +
+		"ON SCHEDULE EVERY 1 DAY STARTS '2023-01-01 00:00:00'\n" +
+		"ON COMPLETION NOT PRESERVE ENABLE COMMENT 'clean ''old'' rows'\n" +
+		"DO DELETE FROM db1.t1 WHERE create_time < NOW() - INTERVAL 7 DAY;")
+	assert.True(t, ok)
+	assert.False(t, stmt.IsAlter)
+	assert.Equal(t, "`root`@`%`", stmt.Definer)
+	assert.True(t, stmt.IfNotExists)
+	assert.Equal(t, "db1", stmt.Schema)
+	assert.Equal(t, "e1", stmt.Name)
+	assert.Equal(t, "EVERY 1 DAY STARTS '2023-01-01 00:00:00'", stmt.Schedule)
+	assert.Equal(t, "clean 'old' rows", stmt.Comment)
+	assert.Equal(t, "DELETE FROM db1.t1 WHERE create_time < NOW() - INTERVAL 7 DAY", stmt.Body)
+	assert.Len(t, stmt.BodyStmts, 1)
+	assert.IsType(t, &ast.DeleteStmt{}, stmt.BodyStmts[0])
+
+	stmt, ok = ParseEventStmt(`create event e1 on schedule at current_timestamp + interval 1 hour
+	do
+	begin
+		-- comment
+		delete from t1 where id = 1;
+		insert into t2 select * from t1;
+	end`)
+	assert.True(t, ok)
+	assert.Equal(t, "", stmt.Schema)
+	assert.Equal(t, "e1", stmt.Name)
+	assert.Equal(t, "at current_timestamp + interval 1 hour", stmt.Schedule)
+	assert.Len(t, stmt.BodyStmts, 2)
+	assert.IsType(t, &ast.DeleteStmt{}, stmt.BodyStmts[0])
+	assert.IsType(t, &ast.InsertStmt{}, stmt.BodyStmts[1])
+
+	stmt, ok = ParseEventStmt("ALTER EVENT db1.e1 ON SCHEDULE EVERY 2 HOUR RENAME TO db2.e2 DISABLE ON SLAVE")
+	assert.True(t, ok)
+	assert.True(t, stmt.IsAlter)
+	assert.Equal(t, "db1", stmt.Schema)
+	assert.Equal(t, "e1", stmt.Name)
+	assert.Equal(t, "EVERY 2 HOUR", stmt.Schedule)
+	assert.Equal(t, "db2", stmt.NewSchema)
+	assert.Equal(t, "e2", stmt.NewName)
+	assert.Equal(t, "", stmt.Body)
+	assert.Nil(t, stmt.BodyStmts)
+
+	for _, sql := range []string{
+		"CREATE TABLE t1 (id INT)",
+		"CREATE PROCEDURE p1() BEGIN SELECT 1; END",
+		"SELECT * FROM event",
+		"CREATE EVENT",
+		"-- CREATE EVENT e1\nSELECT 1",
+	} {
+		_, ok := ParseEventStmt(sql)
+		assert.False(t, ok, sql)
+	}
+}
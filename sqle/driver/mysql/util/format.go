@@ -29,7 +29,7 @@ func AlterTableSpecFormat(stmt *ast.AlterTableSpec) string {
 	case ast.AlterTableRenameTable:
 		return fmt.Sprintf("RENAME AS %s", GetTableNameWithQuote(stmt.NewTable))
 	case ast.AlterTableDropColumn:
-		return fmt.Sprintf("DROP COLUMN `%s`", stmt.OldColumnName)
+		return fmt.Sprintf("DROP COLUMN %s", QuoteIdentifier(stmt.OldColumnName.Name.String()))
 	case ast.AlterTableAddColumns:
 		if stmt.NewColumns != nil {
 			columns := []string{}
@@ -44,8 +44,8 @@ func AlterTableSpecFormat(stmt *ast.AlterTableSpec) string {
 		}
 	case ast.AlterTableChangeColumn:
 		if stmt.NewColumns != nil {
-			return fmt.Sprintf("CHANGE COLUMN `%s` %s",
-				stmt.OldColumnName.Name.String(), columnDefFormat(stmt.NewColumns[0]))
+			return fmt.Sprintf("CHANGE COLUMN %s %s",
+				QuoteIdentifier(stmt.OldColumnName.Name.String()), columnDefFormat(stmt.NewColumns[0]))
 		}
 	case ast.AlterTableModifyColumn:
 		if stmt.NewColumns != nil {
@@ -55,11 +55,11 @@ func AlterTableSpecFormat(stmt *ast.AlterTableSpec) string {
 		if stmt.NewColumns != nil {
 			col := stmt.NewColumns[0]
 			if col.Options != nil {
-				return fmt.Sprintf("ALTER COLUMN `%s` SET DEFAULT %s",
-					col.Name.Name.String(), ExprFormat(col.Options[0].Expr))
+				return fmt.Sprintf("ALTER COLUMN %s SET DEFAULT %s",
+					QuoteIdentifier(col.Name.Name.String()), ExprFormat(col.Options[0].Expr))
 			} else {
-				return fmt.Sprintf("ALTER COLUMN `%s` DROP DEFAULT",
-					col.Name.Name.String())
+				return fmt.Sprintf("ALTER COLUMN %s DROP DEFAULT",
+					QuoteIdentifier(col.Name.Name.String()))
 			}
 		}
 	case ast.AlterTableAddConstraint:
@@ -69,13 +69,13 @@ func AlterTableSpecFormat(stmt *ast.AlterTableSpec) string {
 		case ast.ConstraintPrimaryKey:
 			format = "ADD PRIMARY KEY"
 		case ast.ConstraintIndex, ast.ConstraintKey:
-			format = fmt.Sprintf("ADD INDEX `%s`", constraint.Name)
+			format = fmt.Sprintf("ADD INDEX %s", QuoteIdentifier(constraint.Name))
 		case ast.ConstraintUniqIndex, ast.ConstraintUniqKey, ast.ConstraintUniq:
-			format = fmt.Sprintf("ADD UNIQUE INDEX `%s`", constraint.Name)
+			format = fmt.Sprintf("ADD UNIQUE INDEX %s", QuoteIdentifier(constraint.Name))
 		case ast.ConstraintFulltext:
-			format = fmt.Sprintf("ADD FULLTEXT INDEX `%s`", constraint.Name)
+			format = fmt.Sprintf("ADD FULLTEXT INDEX %s", QuoteIdentifier(constraint.Name))
 		case ast.ConstraintForeignKey:
-			format = fmt.Sprintf("ADD CONSTRAINT `%s` FOREIGN KEY", constraint.Name)
+			format = fmt.Sprintf("ADD CONSTRAINT %s FOREIGN KEY", QuoteIdentifier(constraint.Name))
 		default:
 			log.NewEntry().Errorf("constraint tp %d not support on format alterTableStmt", constraint.Tp)
 		}
@@ -93,13 +93,13 @@ func AlterTableSpecFormat(stmt *ast.AlterTableSpec) string {
 		return format
 
 	case ast.AlterTableDropIndex:
-		return fmt.Sprintf("DROP INDEX `%s`", stmt.Name)
+		return fmt.Sprintf("DROP INDEX %s", QuoteIdentifier(stmt.Name))
 	case ast.AlterTableDropPrimaryKey:
 		return "DROP PRIMARY KEY"
 	case ast.AlterTableDropForeignKey:
-		return fmt.Sprintf("DROP FOREIGN KEY `%s`", stmt.Name)
+		return fmt.Sprintf("DROP FOREIGN KEY %s", QuoteIdentifier(stmt.Name))
 	case ast.AlterTableRenameIndex:
-		return fmt.Sprintf("RENAME INDEX `%s` TO `%s`", stmt.FromKey, stmt.ToKey)
+		return fmt.Sprintf("RENAME INDEX %s TO %s", QuoteIdentifier(stmt.FromKey.String()), QuoteIdentifier(stmt.ToKey.String()))
 	}
 	return ""
 }
@@ -132,7 +132,7 @@ func columnDefFormat(col *ast.ColumnDef) string {
 			}
 		}
 	}
-	format := fmt.Sprintf("`%s` %s", col.Name, col.Tp)
+	format := fmt.Sprintf("%s %s", QuoteIdentifier(col.Name.Name.String()), col.Tp)
 	if len(ops) > 0 {
 		format = fmt.Sprintf("%s %s", format, strings.Join(ops, " "))
 	}
@@ -176,7 +176,7 @@ func indexColumnsFormat(keys []*ast.IndexPartSpecification) string {
 	}
 	columnsName := make([]string, 0, len(keys))
 	for _, key := range keys {
-		columnsName = append(columnsName, fmt.Sprintf("`%s`", key.Column.Name.String()))
+		columnsName = append(columnsName, QuoteIdentifier(key.Column.Name.String()))
 	}
 	if len(columnsName) > 0 {
 		return fmt.Sprintf("(%s)", strings.Join(columnsName, ","))
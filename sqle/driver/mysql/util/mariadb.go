@@ -0,0 +1,92 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/pingcap/parser"
+	"github.com/pingcap/parser/ast"
+)
+
+const (
+	MariaDBSyntaxCreateOrReplaceTable = "CREATE OR REPLACE TABLE"
+	MariaDBSyntaxSequence             = "SEQUENCE"
+	MariaDBSyntaxReturning            = "RETURNING"
+)
+
+// GetMariaDBSyntax returns the MariaDB-only syntax used by sql, ok is false if sql
+// doesn't use any of them. The following syntax is recognized:
+//
+//	CREATE OR REPLACE [TEMPORARY] TABLE ...
+//	{CREATE | ALTER | DROP} [OR REPLACE] [TEMPORARY] SEQUENCE ...
+//	{DELETE | INSERT | REPLACE} ... RETURNING select_expr [, select_expr] ...
+//
+// ref: https://mariadb.com/kb/en/create-table/#create-or-replace
+// ref: https://mariadb.com/kb/en/sequences/
+// ref: https://mariadb.com/kb/en/insertreturning/
+func GetMariaDBSyntax(sql string) (syntax string, ok bool) {
+	tokens := scanSqlTokens(sql)
+	if len(tokens) == 0 {
+		return "", false
+	}
+	pos := 1
+	switch {
+	case tokens[0].isWord("CREATE"), tokens[0].isWord("ALTER"), tokens[0].isWord("DROP"):
+		orReplace := false
+		if pos+1 < len(tokens) && tokens[pos].isWord("OR") && tokens[pos+1].isWord("REPLACE") {
+			orReplace = true
+			pos += 2
+		}
+		if pos < len(tokens) && tokens[pos].isWord("TEMPORARY") {
+			pos++
+		}
+		if pos >= len(tokens) {
+			return "", false
+		}
+		switch {
+		case tokens[pos].isWord("SEQUENCE"):
+			return MariaDBSyntaxSequence, true
+		case orReplace && tokens[pos].isWord("TABLE"):
+			return MariaDBSyntaxCreateOrReplaceTable, true
+		}
+	case tokens[0].isWord("DELETE"), tokens[0].isWord("INSERT"), tokens[0].isWord("REPLACE"):
+		if returningPos(tokens) >= 0 {
+			return MariaDBSyntaxReturning, true
+		}
+	}
+	return "", false
+}
+
+// returningPos returns the position of RETURNING which is not in parentheses, it is -1 if not found.
+func returningPos(tokens []sqlToken) int {
+	depth := 0
+	for i, token := range tokens {
+		switch {
+		case token.isPunctuation("("):
+			depth++
+		case token.isPunctuation(")"):
+			depth--
+		case depth == 0 && token.isWord("RETURNING"):
+			return i
+		}
+	}
+	return -1
+}
+
+// parseMariaDBStmt parses the statement using MariaDB-only syntax which the parser
+// doesn't support, ok is false if it can not be parsed. Only the RETURNING clause of
+// DML is supported, the statement without it is parsed, e.g.
+// "DELETE FROM t1 WHERE id = 1 RETURNING id" is parsed as "DELETE FROM t1 WHERE id = 1".
+// The text of the statement is still the original sql.
+func parseMariaDBStmt(sql string) (stmt ast.StmtNode, ok bool) {
+	if syntax, ok := GetMariaDBSyntax(sql); !ok || syntax != MariaDBSyntaxReturning {
+		return nil, false
+	}
+	tokens := scanSqlTokens(sql)
+	pos := returningPos(tokens)
+	stmt, err := parser.New().ParseOneStmt(strings.TrimSpace(sql[:tokens[pos].start]), "", "")
+	if err != nil {
+		return nil, false
+	}
+	stmt.SetText(sql)
+	return stmt, true
+}
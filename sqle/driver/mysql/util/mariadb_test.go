@@ -0,0 +1,55 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMariaDBSyntax(t *testing.T) {
+	for sql, expect := range map[string]string{
+		"CREATE OR REPLACE TABLE t1 (id INT)":            MariaDBSyntaxCreateOrReplaceTable,
+		"create or replace temporary table t1 (id int)":  MariaDBSyntaxCreateOrReplaceTable,
+		"CREATE SEQUENCE s1 START WITH 1 INCREMENT BY 1": MariaDBSyntaxSequence,
+		"CREATE OR REPLACE SEQUENCE s1":                  MariaDBSyntaxSequence,
+		"ALTER SEQUENCE s1 RESTART 10":                   MariaDBSyntaxSequence,
+		"/* comment */ DROP SEQUENCE s1":                 MariaDBSyntaxSequence,
+		"DELETE FROM t1 WHERE id = 1 RETURNING id":       MariaDBSyntaxReturning,
+		"INSERT INTO t1 VALUES (1) RETURNING id, name":   MariaDBSyntaxReturning,
+		"REPLACE INTO t1 SELECT * FROM t2 RETURNING *":   MariaDBSyntaxReturning,
+	} {
+		syntax, ok := GetMariaDBSyntax(sql)
+		assert.True(t, ok, sql)
+		assert.Equal(t, expect, syntax, sql)
+	}
+
+	for _, sql := range []string{
+		"CREATE TABLE t1 (id INT)",
+		"CREATE OR REPLACE VIEW v1 AS SELECT 1",
+		"DELETE FROM t1 WHERE name = 'returning'",
+		"INSERT INTO t1 SELECT * FROM t2 WHERE id IN (SELECT returning FROM t3)",
+		"SELECT * FROM sequence",
+		"",
+	} {
+		_, ok := GetMariaDBSyntax(sql)
+		assert.False(t, ok, sql)
+	}
+}
+
+func TestParseSqlWithMariaDBSyntax(t *testing.T) {
+	stmts, err := ParseSql("select 1;\n" +
+		"delete from t1 where id = 1 returning id;\n" +
+		"create or replace table t1 (id int);\n" +
+		"alter sequence s1 restart 10;")
+	assert.NoError(t, err)
+	assert.Len(t, stmts, 4)
+
+	// RETURNING is removed when parsing, the text and start line are kept
+	assert.IsType(t, &ast.DeleteStmt{}, stmts[1])
+	assert.Equal(t, "delete from t1 where id = 1 returning id;", stmts[1].Text())
+	assert.Equal(t, 2, stmts[1].StartLine())
+
+	assert.IsType(t, &ast.UnparsedStmt{}, stmts[2])
+	assert.IsType(t, &ast.UnparsedStmt{}, stmts[3])
+}
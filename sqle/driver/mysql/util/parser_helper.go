@@ -24,9 +24,31 @@ func ParseSql(sql string) ([]ast.StmtNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	// 解析器不支持的 MariaDB 语法，尝试兼容解析，无法解析的语句仍为 UnparsedStmt
+	for i, stmt := range stmts {
+		if _, ok := stmt.(*ast.UnparsedStmt); !ok {
+			continue
+		}
+		if node, ok := parseMariaDBStmt(stmt.Text()); ok {
+			node.SetStartLine(stmt.StartLine())
+			stmts[i] = node
+		}
+	}
 	return stmts, nil
 }
 
+// HasParamMarker reports whether sql contains the param markers "?" of prepared statements,
+// e.g. "SELECT * FROM t1 WHERE id = ?". The "?" in strings, quoted identifiers and comments
+// is ignored. The param markers are parsed as *driver.ParamMarkerExpr.
+func HasParamMarker(sql string) bool {
+	for _, token := range scanSqlTokens(sql) {
+		if token.isPunctuation("?") {
+			return true
+		}
+	}
+	return false
+}
+
 func ParseOneSql(sql string) (ast.StmtNode, error) {
 	p := parser.New()
 	stmt, err := p.ParseOneStmt(sql, "", "")
@@ -143,12 +165,19 @@ func GetTableSources(stmt *ast.Join) []*ast.TableSource {
 	return sources
 }
 
+// QuoteIdentifier quotes the identifier with backticks as mysqldump does, each backtick in
+// it is escaped by doubling it, so the name containing backticks or dots is kept as is.
+func QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// GetTableNameWithQuote returns the quoted table name, the schema and table are quoted
+// separately, e.g. "`db`.`t.1`".
 func GetTableNameWithQuote(stmt *ast.TableName) string {
 	if stmt.Schema.String() == "" {
-		return fmt.Sprintf("`%s`", stmt.Name)
-	} else {
-		return fmt.Sprintf("`%s`.`%s`", stmt.Schema, stmt.Name)
+		return QuoteIdentifier(stmt.Name.String())
 	}
+	return QuoteIdentifier(stmt.Schema.String()) + "." + QuoteIdentifier(stmt.Name.String())
 }
 
 func RemoveArrayRepeat(input []string) (output []string) {
@@ -538,6 +567,34 @@ func GetAlterTableSpecByTp(specs []*ast.AlterTableSpec, ts ...ast.AlterTableType
 	return s
 }
 
+// PartitionMaintenanceAlterTableTypes are the ALTER TABLE spec types which maintain the partitions
+// of an already partitioned table, e.g. ADD/DROP/REORGANIZE PARTITION. Defining the partitioning
+// (PARTITION BY) and REMOVE PARTITIONING are not included.
+var PartitionMaintenanceAlterTableTypes = []ast.AlterTableType{
+	ast.AlterTableAddPartitions,
+	ast.AlterTableCoalescePartitions,
+	ast.AlterTableDropPartition,
+	ast.AlterTableTruncatePartition,
+	ast.AlterTableRebuildPartition,
+	ast.AlterTableReorganizePartition,
+	ast.AlterTableCheckPartitions,
+	ast.AlterTableExchangePartition,
+	ast.AlterTableOptimizePartition,
+	ast.AlterTableRepairPartition,
+	ast.AlterTableImportPartitionTablespace,
+	ast.AlterTableDiscardPartitionTablespace,
+}
+
+// IsPartitionMaintenanceSpec returns true if the spec maintains the partitions of the table.
+func IsPartitionMaintenanceSpec(spec *ast.AlterTableSpec) bool {
+	return len(GetAlterTableSpecByTp([]*ast.AlterTableSpec{spec}, PartitionMaintenanceAlterTableTypes...)) > 0
+}
+
+// GetPartitionMaintenanceSpecs returns the specs which maintain the partitions of the table.
+func GetPartitionMaintenanceSpecs(specs []*ast.AlterTableSpec) []*ast.AlterTableSpec {
+	return GetAlterTableSpecByTp(specs, PartitionMaintenanceAlterTableTypes...)
+}
+
 func NewTableName(schema, table string) *ast.TableName {
 	return &ast.TableName{
 		Name:   _model.NewCIStr(table),
@@ -762,9 +819,82 @@ func MergeAlterToTable(oldTable *ast.CreateTableStmt, alterTable *ast.AlterTable
 			newTable.Constraints = append(newTable.Constraints, spec.Constraint)
 		}
 	}
+
+	for _, spec := range GetAlterTableSpecByTp(alterTable.Specs, ast.AlterTablePartition) {
+		newTable.Partition = spec.Partition
+	}
+	for _, spec := range GetAlterTableSpecByTp(alterTable.Specs, ast.AlterTableRemovePartitioning) {
+		_ = spec
+		newTable.Partition = nil
+	}
+	for _, spec := range GetAlterTableSpecByTp(alterTable.Specs, ast.AlterTableAddPartitions,
+		ast.AlterTableDropPartition, ast.AlterTableReorganizePartition) {
+		if newTable.Partition == nil {
+			return oldTable, nil
+		}
+		definitions, ok := mergePartitionDefinitions(newTable.Partition.Definitions, spec)
+		if !ok {
+			return oldTable, nil
+		}
+		partition := *newTable.Partition
+		partition.Definitions = definitions
+		newTable.Partition = &partition
+	}
 	return newTable, nil
 }
 
+// mergePartitionDefinitions applies ADD/DROP/REORGANIZE PARTITION to the partition definitions
+// and returns a new slice, it returns false if a partition to add already exists or a partition
+// to drop or reorganize does not exist.
+func mergePartitionDefinitions(definitions []*ast.PartitionDefinition, spec *ast.AlterTableSpec) ([]*ast.PartitionDefinition, bool) {
+	indexOf := func(name _model.CIStr) int {
+		for i, def := range definitions {
+			if def.Name.L == name.L {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch spec.Tp {
+	case ast.AlterTableAddPartitions:
+		for _, def := range spec.PartDefinitions {
+			if indexOf(def.Name) >= 0 {
+				return nil, false
+			}
+		}
+		merged := make([]*ast.PartitionDefinition, 0, len(definitions)+len(spec.PartDefinitions))
+		merged = append(merged, definitions...)
+		return append(merged, spec.PartDefinitions...), true
+	case ast.AlterTableDropPartition, ast.AlterTableReorganizePartition:
+		removed := map[string]struct{}{}
+		for _, name := range spec.PartitionNames {
+			if indexOf(name) < 0 {
+				if spec.IfExists {
+					continue
+				}
+				return nil, false
+			}
+			removed[name.L] = struct{}{}
+		}
+		merged := make([]*ast.PartitionDefinition, 0, len(definitions)+len(spec.PartDefinitions))
+		inserted := false
+		for _, def := range definitions {
+			if _, ok := removed[def.Name.L]; !ok {
+				merged = append(merged, def)
+				continue
+			}
+			// REORGANIZE PARTITION 的新分区替换原分区所在的位置
+			if spec.Tp == ast.AlterTableReorganizePartition && !inserted {
+				merged = append(merged, spec.PartDefinitions...)
+				inserted = true
+			}
+		}
+		return merged, true
+	}
+	return definitions, true
+}
+
 type TableChecker struct {
 	schemaTables map[string]map[string]*ast.CreateTableStmt
 }
@@ -875,6 +1005,16 @@ func Fingerprint(oneSql string, isCaseSensitive bool) (fingerprint string, err e
 	return
 }
 
+// NormalizeSql restores the SQL in a uniform format, e.g. keywords in upper case and
+// names in back quotes. Different from Fingerprint, the values are kept.
+func NormalizeSql(oneSql string) (string, error) {
+	node, err := ParseOneSql(oneSql)
+	if err != nil {
+		return "", err
+	}
+	return restoreToSqlWithFlag(format.RestoreKeyWordUppercase|format.RestoreNameBackQuotes|format.RestoreStringSingleQuotes, node)
+}
+
 // ExtractIndexFromCreateTableStmt extract index from create table statement.
 func ExtractIndexFromCreateTableStmt(table *ast.CreateTableStmt) map[string] /*index name*/ []string /*indexed column*/ {
 	var result = make(map[string][]string)
@@ -203,12 +203,45 @@ func TestFingerprint(t *testing.T) {
 			input:  "insert into tb values(1)",
 			expect: "INSERT INTO `tb` VALUES (?)",
 		},
+		// value list of varying length
+		{
+			input:  "select * from tb1 where a in (1, 2, 3) and b in ('x')",
+			expect: "SELECT * FROM `tb1` WHERE `a` IN (?) AND `b` IN (?)",
+		},
+		{
+			input:  "select * from tb1 where (a, b) in ((1, 2), (3, 4))",
+			expect: "SELECT * FROM `tb1` WHERE ROW(`a`,`b`) IN (ROW(?,?))",
+		},
+		{
+			input:  "select * from tb1 where a in (b, 1, 2)",
+			expect: "SELECT * FROM `tb1` WHERE `a` IN (`b`,?,?)",
+		},
+		{
+			input:  "insert into tb1 (a, b) values (1, 2), (3, 4), (5, 6)",
+			expect: "INSERT INTO `tb1` (`a`,`b`) VALUES (?,?)",
+		},
 	}
 	for _, c := range cases {
 		testFingerprint(t, c.input, c.expect)
 	}
 }
 
+func TestFingerprintValueListOfVaryingLength(t *testing.T) {
+	for _, isCaseSensitive := range []bool{true, false} {
+		fp1, err := Fingerprint("select * from tb1 where a in (1, 2, 3)", isCaseSensitive)
+		assert.NoError(t, err)
+		fp2, err := Fingerprint("select * from TB1 where a in (1, 2, 3, 4, 5)", isCaseSensitive)
+		assert.NoError(t, err)
+		assert.Equal(t, !isCaseSensitive, fp1 == fp2)
+
+		fp1, err = Fingerprint("insert into tb1 values (1, 2)", isCaseSensitive)
+		assert.NoError(t, err)
+		fp2, err = Fingerprint("insert into tb1 values (1, 2), (3, 4)", isCaseSensitive)
+		assert.NoError(t, err)
+		assert.Equal(t, fp1, fp2)
+	}
+}
+
 func testFingerprint(t *testing.T, input, expect string) {
 	actual, err := Fingerprint(input, true)
 	assert.NoError(t, err)
@@ -217,3 +250,130 @@ func testFingerprint(t *testing.T, input, expect string) {
 	}
 	assert.Equal(t, expect, actual)
 }
+
+func TestHasParamMarker(t *testing.T) {
+	for _, sql := range []string{
+		"select * from t1 where id = ?",
+		"insert into t1 (id, name) values (?, ?)",
+		"update t1 set name=? where id=?",
+		"select * from t1 limit ?",
+	} {
+		assert.True(t, HasParamMarker(sql), sql)
+	}
+	for _, sql := range []string{
+		"select * from t1 where id = 1",
+		"select * from t1 where name = '?' and `a?` = \"?\"",
+		"select * from t1 -- id = ?\nwhere id = 1 /* ? */",
+	} {
+		assert.False(t, HasParamMarker(sql), sql)
+	}
+}
+
+func TestFingerprintWithParamMarker(t *testing.T) {
+	for sql, expect := range map[string]string{
+		"insert into t1 (id, name) values (?, ?)":     "insert into t1 (id, name) values (1, 'a')",
+		"update t1 set name = ? where id = ?":         "update t1 set name = 'a' where id = 1",
+		"select * from t1 where id in (?, ?) limit ?": "select * from t1 where id in (1, 2, 3) limit 10",
+	} {
+		fp, err := Fingerprint(sql, true)
+		assert.NoError(t, err, sql)
+		expectFp, err := Fingerprint(expect, true)
+		assert.NoError(t, err, expect)
+		assert.Equal(t, expectFp, fp, sql)
+	}
+}
+
+func TestMergeAlterToTable_Partition(t *testing.T) {
+	parseOne := func(sql string) ast.StmtNode {
+		node, err := ParseOneSql(sql)
+		assert.NoError(t, err, sql)
+		return node
+	}
+	partitionNames := func(table *ast.CreateTableStmt) []string {
+		if table.Partition == nil {
+			return nil
+		}
+		names := []string{}
+		for _, def := range table.Partition.Definitions {
+			names = append(names, def.Name.O)
+		}
+		return names
+	}
+	oldTable := parseOne("CREATE TABLE t1 (id INT) PARTITION BY LIST(id) (PARTITION p1 VALUES IN (1), PARTITION p2 VALUES IN (2), PARTITION p3 VALUES IN (3))").(*ast.CreateTableStmt)
+
+	for sql, expect := range map[string][]string{
+		"ALTER TABLE t1 ADD PARTITION (PARTITION p4 VALUES IN (4))":                                              {"p1", "p2", "p3", "p4"},
+		"ALTER TABLE t1 DROP PARTITION p1, p3":                                                                   {"p2"},
+		"ALTER TABLE t1 REORGANIZE PARTITION p2 INTO (PARTITION p2a VALUES IN (2), PARTITION p2b VALUES IN (5))": {"p1", "p2a", "p2b", "p3"},
+		"ALTER TABLE t1 TRUNCATE PARTITION p1":                                                                   {"p1", "p2", "p3"},
+		"ALTER TABLE t1 REMOVE PARTITIONING":                                                                     nil,
+		"ALTER TABLE t1 PARTITION BY HASH(id) PARTITIONS 4":                                                      {},
+		// 分区不存在或已存在时保持原表结构
+		"ALTER TABLE t1 DROP PARTITION p9":                          {"p1", "p2", "p3"},
+		"ALTER TABLE t1 ADD PARTITION (PARTITION p1 VALUES IN (9))": {"p1", "p2", "p3"},
+		"ALTER TABLE t1 DROP PARTITION IF EXISTS p9":                {"p1", "p2", "p3"},
+	} {
+		newTable, err := MergeAlterToTable(oldTable, parseOne(sql).(*ast.AlterTableStmt))
+		assert.NoError(t, err, sql)
+		assert.Equal(t, expect, partitionNames(newTable), sql)
+	}
+	assert.Equal(t, []string{"p1", "p2", "p3"}, partitionNames(oldTable))
+
+	notPartitioned := parseOne("CREATE TABLE t2 (id INT)").(*ast.CreateTableStmt)
+	newTable, err := MergeAlterToTable(notPartitioned, parseOne("ALTER TABLE t2 DROP PARTITION p1").(*ast.AlterTableStmt))
+	assert.NoError(t, err)
+	assert.Nil(t, newTable.Partition)
+}
+
+func TestIsPartitionMaintenanceSpec(t *testing.T) {
+	for sql, expect := range map[string]bool{
+		"ALTER TABLE t1 DROP PARTITION p1":                          true,
+		"ALTER TABLE t1 ADD PARTITION (PARTITION p4 VALUES IN (4))": true,
+		"ALTER TABLE t1 COALESCE PARTITION 2":                       true,
+		"ALTER TABLE t1 TRUNCATE PARTITION ALL":                     true,
+		"ALTER TABLE t1 EXCHANGE PARTITION p1 WITH TABLE t2":        true,
+		"ALTER TABLE t1 PARTITION BY HASH(id) PARTITIONS 4":         false,
+		"ALTER TABLE t1 REMOVE PARTITIONING":                        false,
+		"ALTER TABLE t1 ADD COLUMN c1 INT":                          false,
+	} {
+		node, err := ParseOneSql(sql)
+		assert.NoError(t, err, sql)
+		stmt := node.(*ast.AlterTableStmt)
+		assert.Equal(t, expect, IsPartitionMaintenanceSpec(stmt.Specs[0]), sql)
+		assert.Equal(t, expect, len(GetPartitionMaintenanceSpecs(stmt.Specs)) == 1, sql)
+	}
+}
+
+func TestGetTableNameWithQuote(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+	}{
+		{"select * from t1", "`t1`"},
+		{"select * from db1.t1", "`db1`.`t1`"},
+		// reserved words
+		{"select * from `select`.`from`", "`select`.`from`"},
+		// the dot in the quoted name is not a separator
+		{"select * from `db.1`.`t.1`", "`db.1`.`t.1`"},
+		// the embedded backticks are doubled
+		{"select * from `db``1`.`t``1`", "`db``1`.`t``1`"},
+	}
+	for _, tt := range tests {
+		stmt, err := ParseOneSql(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		table := GetTableSources(stmt.(*ast.SelectStmt).From.TableRefs)[0].Source.(*ast.TableName)
+		assert.Equal(t, tt.want, GetTableNameWithQuote(table), tt.sql)
+	}
+}
+
+func TestAlterTableSpecFormat_QuoteIdentifier(t *testing.T) {
+	stmt, err := ParseOneSql("alter table `t``1` add column `a``b` int, drop column `order`, " +
+		"add index `idx.1` (`a``b`), rename index `idx``2` to `idx``3`, drop foreign key `fk``1`")
+	assert.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE `t``1`\n"+
+		"ADD COLUMN `a``b` int(11),\n"+
+		"DROP COLUMN `order`,\n"+
+		"ADD INDEX `idx.1` (`a``b`),\n"+
+		"RENAME INDEX `idx``2` TO `idx``3`,\n"+
+		"DROP FOREIGN KEY `fk``1`;", AlterTableStmtFormat(stmt.(*ast.AlterTableStmt)))
+}
@@ -0,0 +1,199 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/pingcap/parser/ast"
+)
+
+// ProcedureStmt is the result of parsing CREATE PROCEDURE statement, which is
+// not supported by the parser and is audited as *ast.UnparsedStmt.
+//
+//	CREATE [DEFINER = user] PROCEDURE [IF NOT EXISTS] sp_name ([proc_parameter[,...]])
+//		[characteristic ...] routine_body
+//
+// ref: https://dev.mysql.com/doc/refman/8.0/en/create-procedure.html
+type ProcedureStmt struct {
+	Definer     string
+	IfNotExists bool
+	Schema      string
+	Name        string
+	// Params is the text of the parameter list without parentheses, e.g. "IN id INT, OUT total INT".
+	Params string
+	// Body is the text of routine body, BodyStmts is the statements parsed from it.
+	// The compound statements (BEGIN ... END, IF, CASE, LOOP, REPEAT and WHILE) are
+	// flattened, only the statements in them which can be parsed are kept, the local
+	// declarations and the cursor and flow control statements (DECLARE, OPEN, FETCH,
+	// CLOSE, LEAVE, ITERATE and RETURN) are skipped.
+	Body      string
+	BodyStmts []ast.StmtNode
+}
+
+// ParseProcedureStmt is a lightweight parser for CREATE PROCEDURE statement, ok is false
+// if sql is not a procedure statement.
+func ParseProcedureStmt(sql string) (stmt *ProcedureStmt, ok bool) {
+	p := &eventParser{sql: sql, tokens: scanSqlTokens(sql)}
+	stmt = &ProcedureStmt{}
+	if !p.acceptWord("CREATE") {
+		return nil, false
+	}
+	if p.acceptWord("DEFINER") {
+		if !p.accept("=") {
+			return nil, false
+		}
+		start := p.pos
+		for p.pos < len(p.tokens) && !p.isWord("PROCEDURE") {
+			p.pos++
+		}
+		stmt.Definer = p.textOf(start, p.pos)
+	}
+	if !p.acceptWord("PROCEDURE") {
+		return nil, false
+	}
+	if p.acceptWord("IF", "NOT", "EXISTS") {
+		stmt.IfNotExists = true
+	}
+	stmt.Schema, stmt.Name, ok = p.acceptObjectName()
+	if !ok || !p.accept("(") {
+		return nil, false
+	}
+	start := p.pos
+	for depth := 1; ; p.pos++ {
+		if p.pos >= len(p.tokens) {
+			return nil, false
+		}
+		if p.tokens[p.pos].isPunctuation("(") {
+			depth++
+		} else if p.tokens[p.pos].isPunctuation(")") {
+			depth--
+			if depth == 0 {
+				break
+			}
+		}
+	}
+	stmt.Params = p.textOf(start, p.pos)
+	p.pos++
+
+	// skip the characteristics
+	for p.pos < len(p.tokens) {
+		switch {
+		case p.acceptWord("COMMENT"):
+			p.pos++
+		case p.acceptWord("LANGUAGE", "SQL"):
+		case p.acceptWord("NOT", "DETERMINISTIC"):
+		case p.acceptWord("DETERMINISTIC"):
+		case p.acceptWord("CONTAINS", "SQL"):
+		case p.acceptWord("NO", "SQL"):
+		case p.acceptWord("READS", "SQL", "DATA"):
+		case p.acceptWord("MODIFIES", "SQL", "DATA"):
+		case p.acceptWord("SQL", "SECURITY"):
+			p.pos++
+		default:
+			stmt.Body = strings.TrimSpace(strings.TrimSuffix(p.textOf(p.pos, len(p.tokens)), ";"))
+			stmt.BodyStmts = parseRoutineBody(stmt.Body)
+			return stmt, true
+		}
+	}
+	return stmt, true
+}
+
+// parseRoutineBody parses the statements in routine body, see splitRoutineBody.
+func parseRoutineBody(body string) []ast.StmtNode {
+	var stmts []ast.StmtNode
+	for _, sql := range splitRoutineBody(body) {
+		nodes, err := ParseSql(sql)
+		if err != nil {
+			continue
+		}
+		for _, node := range nodes {
+			if _, ok := node.(*ast.UnparsedStmt); ok {
+				continue
+			}
+			stmts = append(stmts, node)
+		}
+	}
+	return stmts
+}
+
+// splitRoutineBody splits routine body into the statements in it, the compound statements
+// are flattened, e.g.
+//
+//	BEGIN
+//		DECLARE n INT;
+//		IF n > 0 THEN
+//			DELETE FROM t1;
+//		ELSE
+//			UPDATE t1 SET a = 1;
+//		END IF;
+//	END
+//
+// is split into "DELETE FROM t1" and "UPDATE t1 SET a = 1".
+func splitRoutineBody(body string) []string {
+	tokens := scanSqlTokens(body)
+	var stmts []string
+	for pos := 0; pos < len(tokens); {
+		token := tokens[pos]
+		switch {
+		case token.isPunctuation(";"):
+			pos++
+		case pos+1 < len(tokens) && !token.quoted && tokens[pos+1].isPunctuation(":"):
+			// label of BEGIN, LOOP, REPEAT or WHILE
+			pos += 2
+		case token.isWord("BEGIN"), token.isWord("ELSE"), token.isWord("LOOP"), token.isWord("REPEAT"):
+			pos++
+		case token.isWord("IF"), token.isWord("ELSEIF"), token.isWord("WHEN"), token.isWord("WHILE"):
+			pos = skipRoutineCondition(tokens, pos+1)
+		case token.isWord("CASE"):
+			// the value of simple CASE statement is skipped until the first WHEN
+			for pos++; pos < len(tokens) && !tokens[pos].isWord("WHEN"); pos++ {
+			}
+		case token.isWord("DECLARE"):
+			// the statement of DECLARE ... HANDLER is kept if it is a BEGIN ... END block
+			for pos++; pos < len(tokens) && !tokens[pos].isPunctuation(";") && !tokens[pos].isWord("BEGIN"); pos++ {
+			}
+		case token.isWord("END"), token.isWord("UNTIL"),
+			token.isWord("OPEN"), token.isWord("FETCH"), token.isWord("CLOSE"),
+			token.isWord("LEAVE"), token.isWord("ITERATE"), token.isWord("RETURN"):
+			pos = skipRoutineStmt(tokens, pos)
+		default:
+			end := skipRoutineStmt(tokens, pos)
+			stmts = append(stmts, body[token.start:tokens[end-1].end])
+			pos = end
+		}
+	}
+	return stmts
+}
+
+// skipRoutineCondition skips the search condition of IF, ELSEIF, WHEN and WHILE, it returns
+// the position after THEN or DO. The CASE expressions in the condition are skipped as a whole.
+func skipRoutineCondition(tokens []sqlToken, pos int) int {
+	depth := 0
+	for ; pos < len(tokens); pos++ {
+		switch {
+		case tokens[pos].isWord("CASE"):
+			depth++
+		case tokens[pos].isWord("END") && depth > 0:
+			depth--
+		case depth == 0 && (tokens[pos].isWord("THEN") || tokens[pos].isWord("DO")):
+			return pos + 1
+		}
+	}
+	return pos
+}
+
+// skipRoutineStmt returns the position of the semicolon which ends the statement starting
+// at pos, or the end of tokens if there is no such semicolon.
+func skipRoutineStmt(tokens []sqlToken, pos int) int {
+	depth := 0
+	for ; pos < len(tokens); pos++ {
+		switch {
+		case tokens[pos].isPunctuation("("):
+			depth++
+		case tokens[pos].isPunctuation(")"):
+			depth--
+		case depth <= 0 && tokens[pos].isPunctuation(";"):
+			return pos
+		}
+	}
+	return pos
+}
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProcedureStmt(t *testing.T) {
+	stmt, ok := ParseProcedureStmt("CREATE DEFINER = `root`@`%` PROCEDURE IF NOT EXISTS `db1`.`p1`(IN id INT, OUT total DECIMAL(10, 2))\n" +
+		"COMMENT 'clean ''old'' rows' NOT DETERMINISTIC MODIFIES SQL DATA SQL SECURITY INVOKER\n" +
+		"DELETE FROM db1.t1 WHERE id = id;")
+	assert.True(t, ok)
+	assert.Equal(t, "`root`@`%`", stmt.Definer)
+	assert.True(t, stmt.IfNotExists)
+	assert.Equal(t, "db1", stmt.Schema)
+	assert.Equal(t, "p1", stmt.Name)
+	assert.Equal(t, "IN id INT, OUT total DECIMAL(10, 2)", stmt.Params)
+	assert.Equal(t, "DELETE FROM db1.t1 WHERE id = id", stmt.Body)
+	assert.Len(t, stmt.BodyStmts, 1)
+	assert.IsType(t, &ast.DeleteStmt{}, stmt.BodyStmts[0])
+
+	stmt, ok = ParseProcedureStmt(`create procedure p2()
+	lbl: begin
+		declare done int default 0;
+		declare cur cursor for select id from t1;
+		declare continue handler for not found set done = 1;
+		-- comment
+		if (select count(*) from t1) > 10 then
+			delete from t1;
+		elseif case when done = 1 then true else false end then
+			update t1 set a = 1 where id = 1;
+		else
+			insert into t2 select * from t1;
+		end if;
+		open cur;
+		read_loop: loop
+			fetch cur into done;
+			if done then
+				leave read_loop;
+			end if;
+			case done
+				when 1 then delete from t2 where id = 1;
+				else begin
+					update t2 set a = 2;
+				end;
+			end case;
+		end loop read_loop;
+		close cur;
+		while done < 10 do
+			set done = done + 1;
+		end while;
+		repeat
+			delete from t3 where id = done;
+		until done > 0 end repeat;
+	end lbl`)
+	assert.True(t, ok)
+	assert.Equal(t, "", stmt.Schema)
+	assert.Equal(t, "p2", stmt.Name)
+	assert.Equal(t, "", stmt.Params)
+	assert.Equal(t, []string{
+		"delete from t1",
+		"update t1 set a = 1 where id = 1",
+		"insert into t2 select * from t1",
+		"delete from t2 where id = 1",
+		"update t2 set a = 2",
+		"set done = done + 1",
+		"delete from t3 where id = done",
+	}, splitRoutineBody(stmt.Body))
+	assert.Len(t, stmt.BodyStmts, 7)
+	assert.IsType(t, &ast.DeleteStmt{}, stmt.BodyStmts[0])
+	assert.IsType(t, &ast.UpdateStmt{}, stmt.BodyStmts[1])
+	assert.IsType(t, &ast.InsertStmt{}, stmt.BodyStmts[2])
+	assert.IsType(t, &ast.SetStmt{}, stmt.BodyStmts[5])
+
+	for _, sql := range []string{
+		"CREATE TABLE t1 (id INT)",
+		"CREATE EVENT e1 ON SCHEDULE EVERY 1 DAY DO DELETE FROM t1",
+		"CREATE FUNCTION f1() RETURNS INT RETURN 1",
+		"SELECT * FROM procedure",
+		"CREATE PROCEDURE p1",
+		"CREATE PROCEDURE p1(IN id INT",
+	} {
+		_, ok := ParseProcedureStmt(sql)
+		assert.False(t, ok, sql)
+	}
+}
@@ -0,0 +1,196 @@
+package util
+
+import (
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/opcode"
+)
+
+// RewriteNotInSubqueryToNotExists rewrites the "expr NOT IN (SELECT col ...)" of the statement to
+// "NOT EXISTS (SELECT 1 ... AND col = expr)", the statement is modified. found is the number of the
+// NOT IN subqueries in the statement, and rewrittenSql is the restored statement if any of them is
+// rewritten, see NotInSubqueryRewriter for the subqueries which are not rewritten.
+func RewriteNotInSubqueryToNotExists(stmt ast.StmtNode) (rewrittenSql string, found, rewritten int, err error) {
+	rewriter := &NotInSubqueryRewriter{}
+	stmt.Accept(rewriter)
+	if rewriter.Rewritten == 0 {
+		return "", rewriter.Found, 0, nil
+	}
+	rewrittenSql, err = restoreToSqlWithFlag(format.DefaultRestoreFlags, stmt)
+	if err != nil {
+		return "", rewriter.Found, 0, err
+	}
+	return rewrittenSql, rewriter.Found, rewriter.Rewritten, nil
+}
+
+// NotInSubqueryRewriter implements ast.Visitor interface, it replaces "expr NOT IN (subquery)" with
+// "NOT EXISTS (subquery)". NOT IN returns no rows if the subquery returns NULL, which is rarely expected,
+// NOT EXISTS is equivalent to it if both expr and the column of the subquery are not nullable.
+//
+// The columns in expr are qualified by the table of the enclosing query, so that they are not resolved
+// to the tables of the subquery after being moved into it. The outer columns referenced by a correlated
+// subquery are kept as they are. The subquery is not rewritten if:
+//  1. it is a UNION, or it has GROUP BY, HAVING or LIMIT, or its fields contain * or aggregate functions;
+//  2. the unqualified columns in expr can not be qualified, i.e. the enclosing query has more than one table;
+//  3. the qualifier of the columns in expr is also the name or alias of a table in the subquery.
+type NotInSubqueryRewriter struct {
+	// scopes are the table sources of the queries enclosing the current node, the last one is the innermost.
+	scopes [][]*ast.TableSource
+	// Found and Rewritten are the number of the NOT IN subqueries found and rewritten.
+	Found     int
+	Rewritten int
+}
+
+func (r *NotInSubqueryRewriter) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
+	switch stmt := n.(type) {
+	case *ast.SelectStmt:
+		var sources []*ast.TableSource
+		if stmt.From != nil {
+			sources = GetTableSources(stmt.From.TableRefs)
+		}
+		r.scopes = append(r.scopes, sources)
+	case *ast.UpdateStmt:
+		r.scopes = append(r.scopes, GetTableSources(stmt.TableRefs.TableRefs))
+	case *ast.DeleteStmt:
+		r.scopes = append(r.scopes, GetTableSources(stmt.TableRefs.TableRefs))
+	}
+	return n, false
+}
+
+func (r *NotInSubqueryRewriter) Leave(n ast.Node) (node ast.Node, ok bool) {
+	switch stmt := n.(type) {
+	case *ast.SelectStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		r.scopes = r.scopes[:len(r.scopes)-1]
+	case *ast.PatternInExpr:
+		if !stmt.Not || stmt.Sel == nil {
+			return n, true
+		}
+		r.Found++
+		if exists, ok := r.rewrite(stmt); ok {
+			r.Rewritten++
+			return exists, true
+		}
+	}
+	return n, true
+}
+
+func (r *NotInSubqueryRewriter) rewrite(in *ast.PatternInExpr) (*ast.ExistsSubqueryExpr, bool) {
+	subquery, ok := in.Sel.(*ast.SubqueryExpr)
+	if !ok {
+		return nil, false
+	}
+	sel, ok := subquery.Query.(*ast.SelectStmt)
+	if !ok || sel.From == nil || sel.Fields == nil || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, false
+	}
+	outerExprs := []ast.ExprNode{in.Expr}
+	if row, ok := in.Expr.(*ast.RowExpr); ok {
+		outerExprs = row.Values
+	}
+	if len(outerExprs) != len(sel.Fields.Fields) {
+		return nil, false
+	}
+	for _, field := range sel.Fields.Fields {
+		if field.WildCard != nil || field.Expr == nil || hasAggregateFunc(field.Expr) {
+			return nil, false
+		}
+	}
+	if len(r.scopes) == 0 {
+		return nil, false
+	}
+	outerSources := r.scopes[len(r.scopes)-1]
+	innerSources := GetTableSources(sel.From.TableRefs)
+	for _, expr := range outerExprs {
+		if !qualifyOuterColumns(expr, outerSources, innerSources) {
+			return nil, false
+		}
+	}
+
+	where := sel.Where
+	if binary, ok := where.(*ast.BinaryOperationExpr); ok && (binary.Op == opcode.LogicOr || binary.Op == opcode.LogicXor) {
+		where = &ast.ParenthesesExpr{Expr: where}
+	}
+	for i, expr := range outerExprs {
+		cond := &ast.BinaryOperationExpr{
+			Op: opcode.EQ,
+			L:  wrapLogicExpr(sel.Fields.Fields[i].Expr),
+			R:  wrapLogicExpr(expr),
+		}
+		if where == nil {
+			where = cond
+		} else {
+			where = &ast.BinaryOperationExpr{Op: opcode.LogicAnd, L: where, R: cond}
+		}
+	}
+	sel.Where = where
+	sel.Fields.Fields = []*ast.SelectField{{Expr: ast.NewValueExpr(1, "", "")}}
+	sel.Distinct = false
+	sel.OrderBy = nil
+	subquery.Exists = true
+	return &ast.ExistsSubqueryExpr{Sel: subquery, Not: true}, true
+}
+
+// qualifyOuterColumns qualifies the unqualified columns in expr by the only table in outerSources,
+// it returns false if the columns can not be qualified or the qualifier is shadowed by innerSources.
+func qualifyOuterColumns(expr ast.ExprNode, outerSources, innerSources []*ast.TableSource) bool {
+	innerNames := map[string]struct{}{}
+	for _, source := range innerSources {
+		if _, table, ok := getTableSourceQualifier(source); ok {
+			innerNames[table.L] = struct{}{}
+		}
+	}
+
+	subqueries := &SelectStmtExtractor{}
+	expr.Accept(subqueries)
+	if len(subqueries.SelectStmts) > 0 {
+		return false
+	}
+	columns := &ColumnNameVisitor{}
+	expr.Accept(columns)
+	for _, column := range columns.ColumnNameList {
+		if column.Name.Table.L == "" {
+			if len(outerSources) != 1 {
+				return false
+			}
+			schema, table, ok := getTableSourceQualifier(outerSources[0])
+			if !ok {
+				return false
+			}
+			column.Name.Schema, column.Name.Table = schema, table
+		}
+		if _, shadowed := innerNames[column.Name.Table.L]; shadowed {
+			return false
+		}
+	}
+	return true
+}
+
+// getTableSourceQualifier returns the name used to qualify the columns of the table source, which is
+// the alias if it is set, otherwise the schema and the name of the table.
+func getTableSourceQualifier(source *ast.TableSource) (schema, table model.CIStr, ok bool) {
+	if source.AsName.L != "" {
+		return model.CIStr{}, source.AsName, true
+	}
+	if name, isTable := source.Source.(*ast.TableName); isTable {
+		return name.Schema, name.Name, true
+	}
+	return model.CIStr{}, model.CIStr{}, false
+}
+
+// wrapLogicExpr wraps the logic operation with parentheses, so that it can be an operand of other operations.
+func wrapLogicExpr(expr ast.ExprNode) ast.ExprNode {
+	if binary, ok := expr.(*ast.BinaryOperationExpr); ok {
+		switch binary.Op {
+		case opcode.LogicOr, opcode.LogicXor, opcode.LogicAnd:
+			return &ast.ParenthesesExpr{Expr: expr}
+		}
+	}
+	return expr
+}
+
+func hasAggregateFunc(expr ast.ExprNode) bool {
+	checker := &AggregateFuncChecker{}
+	expr.Accept(checker)
+	return checker.HasAggregateFunc
+}
@@ -0,0 +1,99 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewriteNotInSubqueryToNotExists(t *testing.T) {
+	tests := []struct {
+		sql       string
+		found     int
+		rewritten int
+		want      string
+	}{
+		{
+			sql:       "select * from t1 where a not in (select b from t2)",
+			found:     1,
+			rewritten: 1,
+			want:      "SELECT * FROM `t1` WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE `b`=`t1`.`a`)",
+		},
+		{
+			sql:       "select * from db1.t1 as o where o.a not in (select distinct b from t2 where c > 1 or d = 2 order by b)",
+			found:     1,
+			rewritten: 1,
+			want:      "SELECT * FROM `db1`.`t1` AS `o` WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE (`c`>1 OR `d`=2) AND `b`=`o`.`a`)",
+		},
+		{
+			// correlated subquery
+			sql:       "select * from t1 where a not in (select b from t2 where t2.c = t1.c)",
+			found:     1,
+			rewritten: 1,
+			want:      "SELECT * FROM `t1` WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE `t2`.`c`=`t1`.`c` AND `b`=`t1`.`a`)",
+		},
+		{
+			sql:       "select * from db1.t1 where (a, b) not in (select c, d from t2) and e = 1",
+			found:     1,
+			rewritten: 1,
+			want:      "SELECT * FROM `db1`.`t1` WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE `c`=`db1`.`t1`.`a` AND `d`=`db1`.`t1`.`b`) AND `e`=1",
+		},
+		{
+			sql:       "update t1 set a = 1 where b not in (select b from t2 where t2.c = 1)",
+			found:     1,
+			rewritten: 1,
+			want:      "UPDATE `t1` SET `a`=1 WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE `t2`.`c`=1 AND `b`=`t1`.`b`)",
+		},
+		{
+			sql:       "delete from t1 where b not in (select b from t2)",
+			found:     1,
+			rewritten: 1,
+			want:      "DELETE FROM `t1` WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE `b`=`t1`.`b`)",
+		},
+		{
+			// nested subqueries, the innermost one is rewritten as well
+			sql:       "select * from t1 where a not in (select b from t2 where c not in (select c from t3))",
+			found:     2,
+			rewritten: 2,
+			want:      "SELECT * FROM `t1` WHERE NOT EXISTS (SELECT 1 FROM `t2` WHERE NOT EXISTS (SELECT 1 FROM `t3` WHERE `c`=`t2`.`c`) AND `b`=`t1`.`a`)",
+		},
+		{
+			// the unqualified column of the enclosing query with more than one table
+			sql:   "select * from t1 join t3 on t1.id = t3.id where a not in (select b from t2)",
+			found: 1,
+		},
+		{
+			// the qualifier is shadowed by the table of the subquery
+			sql:   "select * from t1 where a not in (select b from t1 where c = 1)",
+			found: 1,
+		},
+		{
+			sql:   "select * from t1 where a not in (select max(b) from t2)",
+			found: 1,
+		},
+		{
+			sql:   "select * from t1 where a not in (select b from t2 group by b)",
+			found: 1,
+		},
+		{
+			sql:   "select * from t1 where a not in (select b from t2 limit 10)",
+			found: 1,
+		},
+		{
+			sql:   "select * from t1 where a not in (select b from t2 union select b from t3)",
+			found: 1,
+		},
+		{
+			sql: "select * from t1 where a not in (1, 2) and b in (select b from t2)",
+		},
+	}
+	for _, tt := range tests {
+		stmt, err := ParseOneSql(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		sql, found, rewritten, err := RewriteNotInSubqueryToNotExists(stmt)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.found, found, tt.sql)
+		assert.Equal(t, tt.rewritten, rewritten, tt.sql)
+		assert.Equal(t, tt.want, sql, tt.sql)
+	}
+}
@@ -0,0 +1,298 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/parser/types"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+)
+
+type SchemaChangeType string
+
+const (
+	SchemaChangeAdd    SchemaChangeType = "ADD"
+	SchemaChangeDrop   SchemaChangeType = "DROP"
+	SchemaChangeModify SchemaChangeType = "MODIFY"
+)
+
+type SchemaObjectType string
+
+const (
+	SchemaObjectColumn SchemaObjectType = "COLUMN"
+	// SchemaObjectIndex is the primary key, unique key, normal, fulltext and spatial index.
+	SchemaObjectIndex SchemaObjectType = "INDEX"
+	// SchemaObjectConstraint is the foreign key and check constraint.
+	SchemaObjectConstraint SchemaObjectType = "CONSTRAINT"
+	SchemaObjectOption     SchemaObjectType = "OPTION"
+)
+
+// SchemaChange is a change of the table definition found by DiffCreateTable.
+type SchemaChange struct {
+	Type   SchemaChangeType
+	Object SchemaObjectType
+	// Name is the column name, the index name ("PRIMARY" for primary key), the constraint name
+	// or the option name, e.g. "ENGINE". The unnamed constraint is named by its definition.
+	Name string
+	// Old and New are the normalized definitions, e.g. "BIGINT NOT NULL" of a column, Old is
+	// empty for the added objects and New is empty for the dropped objects.
+	Old string
+	New string
+}
+
+func (c SchemaChange) String() string {
+	switch c.Type {
+	case SchemaChangeAdd:
+		return fmt.Sprintf("%s %s %s: %s", c.Type, c.Object, c.Name, c.New)
+	case SchemaChangeDrop:
+		return fmt.Sprintf("%s %s %s: %s", c.Type, c.Object, c.Name, c.Old)
+	default:
+		return fmt.Sprintf("%s %s %s: %s -> %s", c.Type, c.Object, c.Name, c.Old, c.New)
+	}
+}
+
+// DiffCreateTable returns the changes from oldStmt to newStmt of columns, indexes, constraints and
+// table options. The changes of each kind are in the order of oldStmt for the dropped and modified
+// ones, followed by the added ones in the order of newStmt.
+//
+// The equivalent definitions are not reported, e.g. "INT" and "INT(11)", "KEY" and "INDEX", the
+// column charset or collation which is the same as the default of the table, "NULL" and "DEFAULT
+// NULL" of the nullable column, and the PRIMARY KEY or UNIQUE defined by the column or the table.
+// The column order, AUTO_INCREMENT option and partitions are not compared.
+func DiffCreateTable(oldStmt, newStmt *ast.CreateTableStmt) []SchemaChange {
+	oldDef, newDef := newTableDefinition(oldStmt), newTableDefinition(newStmt)
+	var changes []SchemaChange
+	changes = append(changes, diffDefinitions(SchemaObjectColumn, oldDef.columns, newDef.columns)...)
+	changes = append(changes, diffDefinitions(SchemaObjectIndex, oldDef.indexes, newDef.indexes)...)
+	changes = append(changes, diffDefinitions(SchemaObjectConstraint, oldDef.constraints, newDef.constraints)...)
+	changes = append(changes, diffDefinitions(SchemaObjectOption, oldDef.options, newDef.options)...)
+	return changes
+}
+
+// namedDefinition is the normalized definition of a column, index, constraint or option.
+type namedDefinition struct {
+	name       string
+	definition string
+}
+
+type tableDefinition struct {
+	columns     []namedDefinition
+	indexes     []namedDefinition
+	constraints []namedDefinition
+	options     []namedDefinition
+}
+
+func diffDefinitions(object SchemaObjectType, oldDefs, newDefs []namedDefinition) []SchemaChange {
+	newDefMap := make(map[string]namedDefinition, len(newDefs))
+	for _, def := range newDefs {
+		newDefMap[strings.ToLower(def.name)] = def
+	}
+	oldNames := make(map[string]struct{}, len(oldDefs))
+	var changes []SchemaChange
+	for _, oldDef := range oldDefs {
+		oldNames[strings.ToLower(oldDef.name)] = struct{}{}
+		newDef, ok := newDefMap[strings.ToLower(oldDef.name)]
+		switch {
+		case !ok:
+			changes = append(changes, SchemaChange{Type: SchemaChangeDrop, Object: object, Name: oldDef.name, Old: oldDef.definition})
+		case newDef.definition != oldDef.definition:
+			changes = append(changes, SchemaChange{Type: SchemaChangeModify, Object: object, Name: newDef.name, Old: oldDef.definition, New: newDef.definition})
+		}
+	}
+	for _, newDef := range newDefs {
+		if _, ok := oldNames[strings.ToLower(newDef.name)]; !ok {
+			changes = append(changes, SchemaChange{Type: SchemaChangeAdd, Object: object, Name: newDef.name, New: newDef.definition})
+		}
+	}
+	return changes
+}
+
+func newTableDefinition(stmt *ast.CreateTableStmt) *tableDefinition {
+	def := &tableDefinition{}
+	if stmt == nil {
+		return def
+	}
+	var tableCharset, tableCollate string
+	for _, option := range stmt.Options {
+		switch option.Tp {
+		case ast.TableOptionCharset:
+			tableCharset = option.StrValue
+		case ast.TableOptionCollate:
+			tableCollate = option.StrValue
+		}
+	}
+
+	// the columns of primary key are NOT NULL even if it is not declared
+	primaryKeyColumns, _ := GetPrimaryKey(stmt)
+	var constraints []*ast.Constraint
+	for _, col := range stmt.Cols {
+		_, isPrimaryKey := primaryKeyColumns[col.Name.Name.L]
+		colDef, colConstraints := normalizeColumnDefinition(col, isPrimaryKey, tableCharset, tableCollate)
+		def.columns = append(def.columns, namedDefinition{name: col.Name.Name.O, definition: colDef})
+		constraints = append(constraints, colConstraints...)
+	}
+	constraints = append(constraints, stmt.Constraints...)
+
+	for _, constraint := range constraints {
+		switch constraint.Tp {
+		case ast.ConstraintForeignKey, ast.ConstraintCheck:
+			unnamed := *constraint
+			unnamed.Name = ""
+			definition := strings.TrimPrefix(restoreDefinition(&unnamed), "CONSTRAINT ")
+			name := constraint.Name
+			if name == "" {
+				name = definition
+			}
+			def.constraints = append(def.constraints, namedDefinition{name: name, definition: definition})
+		case ast.ConstraintNoConstraint:
+		default:
+			def.indexes = append(def.indexes, namedDefinition{name: indexName(constraint), definition: normalizeIndexDefinition(constraint)})
+		}
+	}
+
+	for _, option := range stmt.Options {
+		if option.Tp == ast.TableOptionAutoIncrement {
+			continue
+		}
+		normalized := *option
+		switch option.Tp {
+		case ast.TableOptionEngine, ast.TableOptionCharset, ast.TableOptionCollate:
+			normalized.StrValue = strings.ToLower(option.StrValue)
+		}
+		definition := restoreDefinition(&normalized)
+		name := definition
+		if idx := strings.Index(definition, " ="); idx > 0 {
+			name = definition[:idx]
+		}
+		def.options = append(def.options, namedDefinition{name: strings.TrimPrefix(name, "DEFAULT "), definition: definition})
+	}
+	return def
+}
+
+// normalizeColumnDefinition returns the normalized definition of the column without name, and
+// the PRIMARY KEY or UNIQUE defined by the column as the constraints.
+func normalizeColumnDefinition(col *ast.ColumnDef, isPrimaryKey bool, tableCharset, tableCollate string) (string, []*ast.Constraint) {
+	var constraints []*ast.Constraint
+	keys := []*ast.IndexPartSpecification{{Column: col.Name}}
+	isNotNull := isPrimaryKey || HasOneInOptions(col.Options, ast.ColumnOptionNotNull)
+
+	var options []string
+	for _, option := range col.Options {
+		switch option.Tp {
+		case ast.ColumnOptionPrimaryKey:
+			constraints = append(constraints, &ast.Constraint{Tp: ast.ConstraintPrimaryKey, Keys: keys})
+			continue
+		case ast.ColumnOptionUniqKey:
+			constraints = append(constraints, &ast.Constraint{Tp: ast.ConstraintUniq, Keys: keys})
+			continue
+		case ast.ColumnOptionNull:
+			continue
+		case ast.ColumnOptionDefaultValue:
+			if value, ok := option.Expr.(*driver.ValueExpr); ok && value.Datum.IsNull() && !isNotNull {
+				continue
+			}
+		case ast.ColumnOptionCollate:
+			if strings.EqualFold(option.StrValue, tableCollate) {
+				continue
+			}
+		}
+		options = append(options, restoreDefinition(option))
+	}
+	if isPrimaryKey && !HasOneInOptions(col.Options, ast.ColumnOptionNotNull) {
+		options = append(options, restoreDefinition(&ast.ColumnOption{Tp: ast.ColumnOptionNotNull}))
+	}
+	sort.Strings(options)
+
+	if col.Tp == nil {
+		return strings.Join(options, " "), constraints
+	}
+	tp := *col.Tp
+	switch tp.Tp {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong:
+		// the display width of integer is meaningless without ZEROFILL
+		if !mysql.HasZerofillFlag(tp.Flag) {
+			tp.Flen = types.UnspecifiedLength
+		}
+	case mysql.TypeNewDecimal:
+		// DECIMAL is DECIMAL(10,0)
+		if tp.Flen == types.UnspecifiedLength {
+			tp.Flen, tp.Decimal = 10, 0
+		} else if tp.Decimal == types.UnspecifiedLength {
+			tp.Decimal = 0
+		}
+	case mysql.TypeString:
+		if tp.Flen == types.UnspecifiedLength {
+			tp.Flen = 1
+		}
+	}
+	if strings.EqualFold(tp.Charset, tableCharset) && (tp.Collate == "" || strings.EqualFold(tp.Collate, tableCollate)) {
+		tp.Charset, tp.Collate = "", ""
+	}
+	if strings.EqualFold(tp.Collate, tableCollate) {
+		tp.Collate = ""
+	}
+	tp.Collate = strings.ToLower(tp.Collate)
+	return strings.TrimSpace(restoreDefinition(&tp) + " " + strings.Join(options, " ")), constraints
+}
+
+// indexName returns the name of the index, the unnamed index is named by its first column as MySQL does.
+func indexName(constraint *ast.Constraint) string {
+	if constraint.Tp == ast.ConstraintPrimaryKey {
+		return "PRIMARY"
+	}
+	if constraint.Name != "" || len(constraint.Keys) == 0 || constraint.Keys[0].Column == nil {
+		return constraint.Name
+	}
+	return constraint.Keys[0].Column.Name.O
+}
+
+func normalizeIndexDefinition(constraint *ast.Constraint) string {
+	var definition string
+	switch constraint.Tp {
+	case ast.ConstraintPrimaryKey:
+		definition = "PRIMARY KEY"
+	case ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
+		definition = "UNIQUE KEY"
+	case ast.ConstraintFulltext:
+		definition = "FULLTEXT KEY"
+	case ast.ConstraintSpatial:
+		definition = "SPATIAL KEY"
+	default:
+		definition = "KEY"
+	}
+	parts := make([]string, 0, len(constraint.Keys))
+	for _, key := range constraint.Keys {
+		var part string
+		if key.Expr != nil {
+			part = "(" + restoreDefinition(key.Expr) + ")"
+		} else if key.Column != nil {
+			part = QuoteIdentifier(key.Column.Name.L)
+		}
+		if key.Length > 0 {
+			part += fmt.Sprintf("(%d)", key.Length)
+		}
+		parts = append(parts, part)
+	}
+	definition += " (" + strings.Join(parts, ",") + ")"
+	if constraint.Option != nil {
+		if option := restoreDefinition(constraint.Option); option != "" {
+			definition += " " + option
+		}
+	}
+	return definition
+}
+
+func restoreDefinition(node interface {
+	Restore(ctx *format.RestoreCtx) error
+}) string {
+	buf := new(bytes.Buffer)
+	if err := node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, buf)); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,146 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffCreateTable(t *testing.T) {
+	parse := func(sql string) *ast.CreateTableStmt {
+		node, err := ParseOneSql(sql)
+		assert.NoError(t, err)
+		stmt, ok := node.(*ast.CreateTableStmt)
+		assert.True(t, ok, sql)
+		return stmt
+	}
+	cases := []struct {
+		desc     string
+		oldSql   string
+		newSql   string
+		expected []SchemaChange
+	}{
+		{
+			desc:   "equivalent definitions",
+			oldSql: "CREATE TABLE t1 (id INT(11) NOT NULL, a INTEGER NULL DEFAULT NULL, b VARCHAR(10) CHARACTER SET utf8mb4, c DECIMAL, d CHAR, e INT UNSIGNED DEFAULT 0 NOT NULL, PRIMARY KEY (id), INDEX idx_a (a), UNIQUE (b)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 AUTO_INCREMENT=10",
+			newSql: "create table t1 (ID int primary key, a int, b varchar(10), c decimal(10,0), d char(1), e int(10) unsigned not null default 0, key IDX_A (A), unique key b (B)) engine=innodb charset=UTF8MB4 auto_increment=100",
+		},
+		{
+			desc:   "column type changes",
+			oldSql: "CREATE TABLE t1 (id INT, a INT, b VARCHAR(10), c DECIMAL(10,2), d INT(4) ZEROFILL, e DATETIME, f ENUM('a','b'))",
+			newSql: "CREATE TABLE t1 (id INT, a BIGINT, b VARCHAR(20), c DECIMAL(12,2), d INT(6) ZEROFILL, e DATETIME(3), f ENUM('a','b','c'))",
+			expected: []SchemaChange{
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "a", Old: "INT", New: "BIGINT"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "b", Old: "VARCHAR(10)", New: "VARCHAR(20)"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "c", Old: "DECIMAL(10,2)", New: "DECIMAL(12,2)"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "d", Old: "INT(4) UNSIGNED ZEROFILL", New: "INT(6) UNSIGNED ZEROFILL"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "e", Old: "DATETIME", New: "DATETIME(3)"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "f", Old: "ENUM('a','b')", New: "ENUM('a','b','c')"},
+			},
+		},
+		{
+			desc:   "column attribute changes",
+			oldSql: "CREATE TABLE t1 (a INT, b INT NOT NULL DEFAULT 0, c VARCHAR(10) COMMENT 'c', d VARCHAR(10), e INT UNSIGNED) DEFAULT CHARSET=utf8mb4",
+			newSql: "CREATE TABLE t1 (a INT NOT NULL, b INT NOT NULL DEFAULT 1, c VARCHAR(10) COMMENT 'new c', d VARCHAR(10) CHARACTER SET latin1, e INT) DEFAULT CHARSET=utf8mb4",
+			expected: []SchemaChange{
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "a", Old: "INT", New: "INT NOT NULL"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "b", Old: "INT DEFAULT 0 NOT NULL", New: "INT DEFAULT 1 NOT NULL"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "c", Old: "VARCHAR(10) COMMENT 'c'", New: "VARCHAR(10) COMMENT 'new c'"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "d", Old: "VARCHAR(10)", New: "VARCHAR(10) CHARACTER SET LATIN1"},
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "e", Old: "INT UNSIGNED", New: "INT"},
+			},
+		},
+		{
+			desc:   "added and dropped columns",
+			oldSql: "CREATE TABLE t1 (id INT, a INT, b VARCHAR(10))",
+			newSql: "CREATE TABLE t1 (id INT, c DATETIME NOT NULL, b VARCHAR(10), d TEXT)",
+			expected: []SchemaChange{
+				{Type: SchemaChangeDrop, Object: SchemaObjectColumn, Name: "a", Old: "INT"},
+				{Type: SchemaChangeAdd, Object: SchemaObjectColumn, Name: "c", New: "DATETIME NOT NULL"},
+				{Type: SchemaChangeAdd, Object: SchemaObjectColumn, Name: "d", New: "TEXT"},
+			},
+		},
+		{
+			desc:   "column order is not compared",
+			oldSql: "CREATE TABLE t1 (id INT, a INT, b INT)",
+			newSql: "CREATE TABLE t1 (b INT, id INT, a INT)",
+		},
+		{
+			desc:   "index changes",
+			oldSql: "CREATE TABLE t1 (id INT PRIMARY KEY, a INT, b INT, c VARCHAR(100), KEY idx_a (a), KEY idx_ab (a, b), UNIQUE KEY uk_c (c), KEY (b))",
+			newSql: "CREATE TABLE t1 (id INT, a INT, b INT, c VARCHAR(100), PRIMARY KEY (id, a), KEY idx_ab (b, a), UNIQUE KEY uk_c (c(20)), KEY idx_c (c) COMMENT 'c', FULLTEXT KEY ft_c (c))",
+			expected: []SchemaChange{
+				// the column of primary key is NOT NULL
+				{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "a", Old: "INT", New: "INT NOT NULL"},
+				{Type: SchemaChangeModify, Object: SchemaObjectIndex, Name: "PRIMARY", Old: "PRIMARY KEY (`id`)", New: "PRIMARY KEY (`id`,`a`)"},
+				{Type: SchemaChangeDrop, Object: SchemaObjectIndex, Name: "idx_a", Old: "KEY (`a`)"},
+				{Type: SchemaChangeModify, Object: SchemaObjectIndex, Name: "idx_ab", Old: "KEY (`a`,`b`)", New: "KEY (`b`,`a`)"},
+				{Type: SchemaChangeModify, Object: SchemaObjectIndex, Name: "uk_c", Old: "UNIQUE KEY (`c`)", New: "UNIQUE KEY (`c`(20))"},
+				{Type: SchemaChangeDrop, Object: SchemaObjectIndex, Name: "b", Old: "KEY (`b`)"},
+				{Type: SchemaChangeAdd, Object: SchemaObjectIndex, Name: "idx_c", New: "KEY (`c`) COMMENT 'c'"},
+				{Type: SchemaChangeAdd, Object: SchemaObjectIndex, Name: "ft_c", New: "FULLTEXT KEY (`c`)"},
+			},
+		},
+		{
+			desc:   "index type changes",
+			oldSql: "CREATE TABLE t1 (id INT, a INT, KEY idx_a (a))",
+			newSql: "CREATE TABLE t1 (id INT, a INT UNIQUE, UNIQUE INDEX idx_a (a))",
+			expected: []SchemaChange{
+				{Type: SchemaChangeModify, Object: SchemaObjectIndex, Name: "idx_a", Old: "KEY (`a`)", New: "UNIQUE KEY (`a`)"},
+				{Type: SchemaChangeAdd, Object: SchemaObjectIndex, Name: "a", New: "UNIQUE KEY (`a`)"},
+			},
+		},
+		{
+			desc:   "constraint changes",
+			oldSql: "CREATE TABLE t1 (id INT, pid INT, a INT, CONSTRAINT fk_pid FOREIGN KEY (pid) REFERENCES t2 (id), CONSTRAINT chk_a CHECK (a > 0))",
+			newSql: "CREATE TABLE t1 (id INT, pid INT, a INT, CONSTRAINT fk_pid FOREIGN KEY (pid) REFERENCES t2 (id) ON DELETE CASCADE)",
+			expected: []SchemaChange{
+				{Type: SchemaChangeModify, Object: SchemaObjectConstraint, Name: "fk_pid", Old: "FOREIGN KEY (`pid`) REFERENCES `t2`(`id`)", New: "FOREIGN KEY (`pid`) REFERENCES `t2`(`id`) ON DELETE CASCADE"},
+				{Type: SchemaChangeDrop, Object: SchemaObjectConstraint, Name: "chk_a", Old: "CHECK(`a`>0) ENFORCED"},
+			},
+		},
+		{
+			desc:   "option changes",
+			oldSql: "CREATE TABLE t1 (id INT, name VARCHAR(10)) ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT='old'",
+			newSql: "CREATE TABLE t1 (id INT, name VARCHAR(10)) ENGINE=MyISAM DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin",
+			expected: []SchemaChange{
+				{Type: SchemaChangeModify, Object: SchemaObjectOption, Name: "ENGINE", Old: "ENGINE = innodb", New: "ENGINE = myisam"},
+				{Type: SchemaChangeModify, Object: SchemaObjectOption, Name: "CHARACTER SET", Old: "DEFAULT CHARACTER SET = UTF8", New: "DEFAULT CHARACTER SET = UTF8MB4"},
+				{Type: SchemaChangeDrop, Object: SchemaObjectOption, Name: "COMMENT", Old: "COMMENT = 'old'"},
+				{Type: SchemaChangeAdd, Object: SchemaObjectOption, Name: "COLLATE", New: "DEFAULT COLLATE = UTF8MB4_BIN"},
+			},
+		},
+		{
+			desc:   "column charset is compared with the default charset of the table",
+			oldSql: "CREATE TABLE t1 (name VARCHAR(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin",
+			newSql: "CREATE TABLE t1 (name VARCHAR(10)) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			assert.Equal(t, c.expected, DiffCreateTable(parse(c.oldSql), parse(c.newSql)))
+		})
+	}
+}
+
+func TestDiffCreateTable_Nil(t *testing.T) {
+	node, err := ParseOneSql("CREATE TABLE t1 (id INT PRIMARY KEY) ENGINE=InnoDB")
+	assert.NoError(t, err)
+	stmt := node.(*ast.CreateTableStmt)
+	assert.Equal(t, []SchemaChange{
+		{Type: SchemaChangeAdd, Object: SchemaObjectColumn, Name: "id", New: "INT NOT NULL"},
+		{Type: SchemaChangeAdd, Object: SchemaObjectIndex, Name: "PRIMARY", New: "PRIMARY KEY (`id`)"},
+		{Type: SchemaChangeAdd, Object: SchemaObjectOption, Name: "ENGINE", New: "ENGINE = innodb"},
+	}, DiffCreateTable(nil, stmt))
+	assert.Nil(t, DiffCreateTable(stmt, stmt))
+}
+
+func TestSchemaChange_String(t *testing.T) {
+	assert.Equal(t, "ADD COLUMN c: DATETIME NOT NULL",
+		SchemaChange{Type: SchemaChangeAdd, Object: SchemaObjectColumn, Name: "c", New: "DATETIME NOT NULL"}.String())
+	assert.Equal(t, "DROP INDEX idx_a: KEY (`a`)",
+		SchemaChange{Type: SchemaChangeDrop, Object: SchemaObjectIndex, Name: "idx_a", Old: "KEY (`a`)"}.String())
+	assert.Equal(t, "MODIFY COLUMN a: INT -> BIGINT",
+		SchemaChange{Type: SchemaChangeModify, Object: SchemaObjectColumn, Name: "a", Old: "INT", New: "BIGINT"}.String())
+}
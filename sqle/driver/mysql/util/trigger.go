@@ -0,0 +1,89 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/pingcap/parser/ast"
+)
+
+// TriggerStmt is the result of parsing CREATE TRIGGER statement, which is
+// not supported by the parser and is audited as *ast.UnparsedStmt.
+//
+//	CREATE [DEFINER = user] TRIGGER [IF NOT EXISTS] trigger_name
+//		{ BEFORE | AFTER } { INSERT | UPDATE | DELETE }
+//		ON tbl_name FOR EACH ROW
+//		[{ FOLLOWS | PRECEDES } other_trigger_name]
+//		trigger_body
+//
+// ref: https://dev.mysql.com/doc/refman/8.0/en/create-trigger.html
+type TriggerStmt struct {
+	Definer     string
+	IfNotExists bool
+	Schema      string
+	Name        string
+	// Time is "BEFORE" or "AFTER", Event is "INSERT", "UPDATE" or "DELETE", in upper case.
+	Time  string
+	Event string
+	// TableSchema and Table are the table which the trigger is associated with.
+	TableSchema string
+	Table       string
+	// Body is the text of trigger body, BodyStmts is the statements parsed from it,
+	// the compound statements are flattened as ProcedureStmt.BodyStmts.
+	Body      string
+	BodyStmts []ast.StmtNode
+}
+
+// ParseTriggerStmt is a lightweight parser for CREATE TRIGGER statement, ok is false
+// if sql is not a trigger statement.
+func ParseTriggerStmt(sql string) (stmt *TriggerStmt, ok bool) {
+	p := &eventParser{sql: sql, tokens: scanSqlTokens(sql)}
+	stmt = &TriggerStmt{}
+	if !p.acceptWord("CREATE") {
+		return nil, false
+	}
+	if p.acceptWord("DEFINER") {
+		if !p.accept("=") {
+			return nil, false
+		}
+		start := p.pos
+		for p.pos < len(p.tokens) && !p.isWord("TRIGGER") {
+			p.pos++
+		}
+		stmt.Definer = p.textOf(start, p.pos)
+	}
+	if !p.acceptWord("TRIGGER") {
+		return nil, false
+	}
+	if p.acceptWord("IF", "NOT", "EXISTS") {
+		stmt.IfNotExists = true
+	}
+	stmt.Schema, stmt.Name, ok = p.acceptObjectName()
+	if !ok {
+		return nil, false
+	}
+	for _, time := range []string{"BEFORE", "AFTER"} {
+		if p.acceptWord(time) {
+			stmt.Time = time
+		}
+	}
+	for _, event := range []string{"INSERT", "UPDATE", "DELETE"} {
+		if p.acceptWord(event) {
+			stmt.Event = event
+		}
+	}
+	if stmt.Time == "" || stmt.Event == "" || !p.acceptWord("ON") {
+		return nil, false
+	}
+	stmt.TableSchema, stmt.Table, ok = p.acceptObjectName()
+	if !ok || !p.acceptWord("FOR", "EACH", "ROW") {
+		return nil, false
+	}
+	if p.acceptWord("FOLLOWS") || p.acceptWord("PRECEDES") {
+		if _, _, ok = p.acceptObjectName(); !ok {
+			return nil, false
+		}
+	}
+	stmt.Body = strings.TrimSpace(strings.TrimSuffix(p.textOf(p.pos, len(p.tokens)), ";"))
+	stmt.BodyStmts = parseRoutineBody(stmt.Body)
+	return stmt, true
+}
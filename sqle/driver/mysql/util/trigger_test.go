@@ -0,0 +1,56 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTriggerStmt(t *testing.T) {
+	stmt, ok := ParseTriggerStmt("CREATE DEFINER = `root`@`%` TRIGGER IF NOT EXISTS `db1`.`trg1` AFTER INSERT ON `db1`.`t1`\n" +
+		"FOR EACH ROW INSERT INTO audit_log (id) VALUES (NEW.id);")
+	assert.True(t, ok)
+	assert.Equal(t, "`root`@`%`", stmt.Definer)
+	assert.True(t, stmt.IfNotExists)
+	assert.Equal(t, "db1", stmt.Schema)
+	assert.Equal(t, "trg1", stmt.Name)
+	assert.Equal(t, "AFTER", stmt.Time)
+	assert.Equal(t, "INSERT", stmt.Event)
+	assert.Equal(t, "db1", stmt.TableSchema)
+	assert.Equal(t, "t1", stmt.Table)
+	assert.Equal(t, "INSERT INTO audit_log (id) VALUES (NEW.id)", stmt.Body)
+	assert.Len(t, stmt.BodyStmts, 1)
+	assert.IsType(t, &ast.InsertStmt{}, stmt.BodyStmts[0])
+
+	stmt, ok = ParseTriggerStmt(`create trigger trg2 before update on t1 for each row follows trg1
+	begin
+		if new.amount < 0 then
+			delete from t2 where id = old.id;
+		else
+			update t3 set total = total + new.amount where id = new.id;
+		end if;
+	end`)
+	assert.True(t, ok)
+	assert.Equal(t, "", stmt.Schema)
+	assert.Equal(t, "trg2", stmt.Name)
+	assert.Equal(t, "BEFORE", stmt.Time)
+	assert.Equal(t, "UPDATE", stmt.Event)
+	assert.Equal(t, "", stmt.TableSchema)
+	assert.Equal(t, "t1", stmt.Table)
+	assert.Len(t, stmt.BodyStmts, 2)
+	assert.IsType(t, &ast.DeleteStmt{}, stmt.BodyStmts[0])
+	assert.IsType(t, &ast.UpdateStmt{}, stmt.BodyStmts[1])
+
+	for _, sql := range []string{
+		"CREATE TABLE t1 (id INT)",
+		"CREATE PROCEDURE p1() DELETE FROM t1",
+		"CREATE TRIGGER trg1 ON t1 FOR EACH ROW DELETE FROM t2",
+		"CREATE TRIGGER trg1 AFTER TRUNCATE ON t1 FOR EACH ROW DELETE FROM t2",
+		"CREATE TRIGGER trg1 AFTER INSERT ON t1 DELETE FROM t2",
+		"CREATE TRIGGER trg1 AFTER INSERT ON t1 FOR EACH ROW FOLLOWS",
+	} {
+		_, ok := ParseTriggerStmt(sql)
+		assert.False(t, ok, sql)
+	}
+}
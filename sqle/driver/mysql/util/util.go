@@ -2,18 +2,23 @@ package util
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
-	"regexp"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
 	"github.com/actiontech/sqle/sqle/log"
 	"github.com/actiontech/sqle/sqle/utils"
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"github.com/pingcap/parser/ast"
 	"github.com/pingcap/parser/format"
 	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/parser/opcode"
 	"github.com/pingcap/tidb/types"
 	driver "github.com/pingcap/tidb/types/parser_driver"
 	"github.com/sirupsen/logrus"
@@ -21,15 +26,50 @@ import (
 
 var ErrUnsupportedSqlType = errors.New("unsupported sql type")
 
+// ErrSqlWithParamMarker is returned if the SQL containing the param markers "?" of prepared
+// statements needs to be executed, e.g. EXPLAIN, which fails because the values are unknown.
+var ErrSqlWithParamMarker = errors.New("sql with param markers(?) can not be executed without values")
+
+// GetAffectedRowNum returns the affected rows estimated by the default AffectedRowsEstimator.
 func GetAffectedRowNum(ctx context.Context, originSql string, conn *executor.Executor, explainRecordFunc func(string) ([]*executor.ExplainRecord, error)) (int64, error) {
+	num, _, err := DefaultAffectedRowsEstimator{}.EstimateAffectedRows(ctx, originSql, conn, explainRecordFunc)
+	return num, err
+}
+
+// AffectedRowNumOptions are the options of EstimateAffectedRowNum.
+type AffectedRowNumOptions struct {
+	// AccurateMode improves the estimation when the rows of EXPLAIN are used, i.e. the table
+	// is scanned fully or the rows are more than 100000. For the single table statement, the
+	// table whose rows in information_schema.tables are less than CountMaxTableRows is still
+	// counted by SELECT COUNT(*), otherwise the rows are estimated by the table rows scaled by
+	// the selectivity of the equal predicates, see estimateAffectedRowNumByStatistics.
+	AccurateMode      bool
+	CountMaxTableRows int64
+	// ExplainOnly uses the rows of EXPLAIN and never executes SELECT COUNT, it takes precedence
+	// over AccurateMode.
+	ExplainOnly bool
+}
+
+// EstimateAffectedRowNum is the same as GetAffectedRowNum, it returns the method used as well.
+func EstimateAffectedRowNum(ctx context.Context, originSql string, conn *executor.Executor, explainRecordFunc func(string) ([]*executor.ExplainRecord, error), opts AffectedRowNumOptions) (int64, driverV2.AffectRowsMethod, error) {
+	// 解析器不支持 WITH 子句，去掉 WITH 子句后解析语句，生成的 SELECT COUNT 语句再加上 WITH 子句
+	// 例: with cte as (select id from t2) delete from t1 where id in (select id from cte) 转换为
+	// with cte as (select id from t2) SELECT COUNT(1) FROM `t1` WHERE `id` IN (SELECT `id` FROM `cte`)
+	with, stmtSql, hasWith := splitWithClause(originSql)
+	if hasWith {
+		originSql = stmtSql
+	}
+
 	node, err := ParseOneSql(originSql)
 	if err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
 	var newNode ast.Node
 	var affectedRowSql string
 	var cannotConvert bool
+	// multiTableTargets are the updated or deleted tables of multi-table update and delete
+	var multiTableTargets []*ast.TableName
 
 	// 语法规则文档
 	// select: https://dev.mysql.com/doc/refman/8.0/en/select.html
@@ -58,20 +98,73 @@ func GetAffectedRowNum(ctx context.Context, originSql string, conn *executor.Exe
 				cannotConvert = true
 				originSql, err = restoreToSqlWithFlag(format.DefaultRestoreFlags, unionStmt)
 				if err != nil {
-					return 0, err
+					return 0, "", err
 				}
 			}
 		} else if isCommonInsert {
-			return int64(len(stmt.Lists)), nil
+			// REPLACE 语句与普通 insert 语句相同，按 values 的行数计算
+			// INSERT ... ON DUPLICATE KEY UPDATE 语句无法离线得知插入和更新的行数，按 values 的行数作为上限
+			return int64(len(stmt.Lists)), driverV2.AffectRowsMethodStatement, nil
+		} else if stmt.Setlist != nil {
+			// insert into t1 set name = 'name1'，只影响一行
+			return 1, driverV2.AffectRowsMethodStatement, nil
 		} else {
-			return 0, ErrUnsupportedSqlType
+			return 0, "", ErrUnsupportedSqlType
 		}
 	case *ast.UpdateStmt:
-		newNode = getSelectNodeFromUpdate(stmt)
+		// 多表 update 语句，update t1 join t2 on t1.id = t2.id set t1.name = t2.name
+		// join 后的行数不等于被更新表的行数，使用子查询的方式获取被更新表的行数
+		if targets := getMultiTableUpdateTargets(stmt); len(targets) > 0 {
+			cannotConvert = true
+			multiTableTargets = targets
+			originSql, err = getSelectSqlFromMultiTableDML(ctx, conn, with, stmt.TableRefs, stmt.Where, targets)
+			if err != nil {
+				return 0, "", err
+			}
+		} else {
+			newNode = getSelectNodeFromUpdate(stmt)
+		}
 	case *ast.DeleteStmt:
-		newNode = getSelectNodeFromDelete(stmt)
+		// 多表 delete 语句，delete t1 from t1 join t2 on t1.id = t2.id
+		if stmt.IsMultiTable && stmt.Tables != nil && len(stmt.Tables.Tables) > 0 {
+			cannotConvert = true
+			multiTableTargets = stmt.Tables.Tables
+			originSql, err = getSelectSqlFromMultiTableDML(ctx, conn, with, stmt.TableRefs, stmt.Where, stmt.Tables.Tables)
+			if err != nil {
+				return 0, "", err
+			}
+		} else {
+			newNode = getSelectNodeFromDelete(stmt)
+		}
+	case *ast.CreateTableStmt:
+		// CREATE TABLE ... AS SELECT 语句，按 SELECT 的行数计算插入的行数
+		switch selectStmt := stmt.Select.(type) {
+		case *ast.SelectStmt:
+			if selectStmt.GroupBy == nil && selectStmt.Limit == nil {
+				newNode = getSelectNodeFromSelect(selectStmt)
+				break
+			}
+			cannotConvert = true
+			originSql, err = restoreToSqlWithFlag(format.DefaultRestoreFlags, selectStmt)
+			if err != nil {
+				return 0, "", err
+			}
+		case *ast.UnionStmt:
+			cannotConvert = true
+			originSql, err = restoreToSqlWithFlag(format.DefaultRestoreFlags, selectStmt)
+			if err != nil {
+				return 0, "", err
+			}
+		default:
+			return 0, "", ErrUnsupportedSqlType
+		}
 	default:
-		return 0, ErrUnsupportedSqlType
+		return 0, "", ErrUnsupportedSqlType
+	}
+
+	// 预处理语句的参数值未知，无法执行 SELECT COUNT 和 EXPLAIN 语句
+	if HasParamMarker(originSql) {
+		return 0, "", ErrSqlWithParamMarker
 	}
 
 	// 1. 存在group by或者group by和having都存在的select语句，无法转换为select count语句
@@ -85,12 +178,12 @@ func GetAffectedRowNum(ctx context.Context, originSql string, conn *executor.Exe
 	} else {
 		if newNode == nil {
 			log.NewEntry().Errorf("in GetAffectedRowNum, when getting select node from %v failed", originSql)
-			return 0, fmt.Errorf("get select node from %v failed", originSql)
+			return 0, "", fmt.Errorf("get select node from %v failed", originSql)
 		}
 		sqlBuilder := new(strings.Builder)
 		err = newNode.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, sqlBuilder))
 		if err != nil {
-			return 0, err
+			return 0, "", err
 		}
 
 		affectedRowSql = sqlBuilder.String()
@@ -100,7 +193,10 @@ func GetAffectedRowNum(ctx context.Context, originSql string, conn *executor.Exe
 	// 避免在客户机器上执行不符合预期的sql语句
 	err = checkSql(affectedRowSql)
 	if err != nil {
-		return 0, fmt.Errorf("check sql(%v) failed, origin sql(%v), err: %v", affectedRowSql, originSql, err)
+		return 0, "", fmt.Errorf("check sql(%v) failed, origin sql(%v), err: %v", affectedRowSql, originSql, err)
+	}
+	if hasWith {
+		affectedRowSql = fmt.Sprintf("%s %s", with.Text, affectedRowSql)
 	}
 
 	// explain 全表扫描 (type 为 ALL): 避免执行 SELECT COUNT(1)，直接拿EXPLAIN影响行数作为结果
@@ -113,7 +209,7 @@ func GetAffectedRowNum(ctx context.Context, originSql string, conn *executor.Exe
 	epRecords, err := explainRecordFunc(affectedRowSql)
 	if err != nil {
 		log.NewEntry().Errorf("get execution plan failed, sql: %v, error: %v", originSql, err)
-		return 0, fmt.Errorf("get affected rows sql execution plan failed, affected rows sql statement: %s, error: %v,", affectedRowSql, err)
+		return 0, "", fmt.Errorf("get affected rows sql execution plan failed, affected rows sql statement: %s, error: %w", affectedRowSql, err)
 	}
 
 	var notUseIndex bool
@@ -122,6 +218,10 @@ func GetAffectedRowNum(ctx context.Context, originSql string, conn *executor.Exe
 
 	// 检查是否所有记录都使用了索引
 	for _, record := range epRecords {
+		// 多表 DML 语句转换为子查询后，派生表总是全表扫描，其行数是 join 后的行数，只统计实际的表
+		if len(multiTableTargets) > 0 && isDerivedTableOfExplain(record) {
+			continue
+		}
 		if record.Type == executor.ExplainRecordAccessTypeAll {
 			notUseIndex = true
 		}
@@ -130,34 +230,336 @@ func GetAffectedRowNum(ctx context.Context, originSql string, conn *executor.Exe
 		// 最后一行记录的row作为结果行数
 		affetcCount = record.Rows
 	}
+	// 多表 DML 语句 join 后的行数不等于被更新表的行数，使用被更新表的 EXPLAIN 行数作为结果
+	if rows, ok := getMultiTableDMLTargetRows(epRecords, multiTableTargets); ok {
+		affetcCount = rows
+	}
 
 	// 如果有记录未使用索引，或者统计影响行数大于10W
-	if notUseIndex || estimatedRows > 100000 {
-		return affetcCount, nil
+	if opts.ExplainOnly || notUseIndex || estimatedRows > 100000 {
+		if opts.ExplainOnly || !opts.AccurateMode {
+			return affetcCount, driverV2.AffectRowsMethodExplain, nil
+		}
+		num, method, err := estimateAffectedRowNumAccurately(ctx, conn, newNode, with, affetcCount, opts.CountMaxTableRows)
+		if err != nil {
+			log.NewEntry().Errorf("estimate affected rows accurately failed, use the rows of explain, sql: %v, error: %v", originSql, err)
+			return affetcCount, driverV2.AffectRowsMethodExplain, nil
+		}
+		if method != driverV2.AffectRowsMethodCount {
+			return num, method, nil
+		}
 	}
 
 	_, row, err := conn.Db.QueryWithContext(ctx, affectedRowSql)
 	if err != nil {
-		return 0, fmt.Errorf("get affected rows failed, sql statement: %s, error: %v", affectedRowSql, err)
+		return 0, "", fmt.Errorf("get affected rows failed, sql statement: %s, error: %w", affectedRowSql, err)
 	}
 
 	// 如果下发的 SELECT COUNT(1) 的SQL，返回的结果集为空, 则返回0
 	// 例: SELECT COUNT(1) FROM test LIMIT 10,10 结果集为空
 	if len(row) == 0 {
 		log.NewEntry().Errorf("affected row sql(%v) result row count is 0", affectedRowSql)
-		return 0, nil
+		return 0, driverV2.AffectRowsMethodCount, nil
 	}
 
 	if len(row) < 1 {
-		return 0, fmt.Errorf("affected row sql(%v) result row count(%v) less than 1", affectedRowSql, len(row))
+		return 0, "", fmt.Errorf("affected row sql(%v) result row count(%v) less than 1", affectedRowSql, len(row))
 	}
 
 	affectCount, err := strconv.ParseInt(row[0][0].String, 10, 64)
 	if err != nil {
-		return 0, err
+		return 0, "", err
+	}
+
+	return affectCount, driverV2.AffectRowsMethodCount, nil
+}
+
+// estimateAffectedRowNumAccurately is used by the accurate mode of EstimateAffectedRowNum, node is the
+// converted SELECT COUNT statement and with is its WITH clause which may be nil. The result method is
+// AffectRowsMethodCount if the table is small enough to be counted, and it is AffectRowsMethodExplain
+// with explainRows if the statement is not a single table statement or the table is a common table
+// expression.
+func estimateAffectedRowNumAccurately(ctx context.Context, conn *executor.Executor, node ast.Node, with *withClause, explainRows, countMaxTableRows int64) (int64, driverV2.AffectRowsMethod, error) {
+	stmt, ok := node.(*ast.SelectStmt)
+	if !ok {
+		return explainRows, driverV2.AffectRowsMethodExplain, nil
+	}
+	table := getSingleTableOfSelect(stmt)
+	if table == nil || (with != nil && table.Schema.L == "" && with.hasName(table.Name.L)) {
+		return explainRows, driverV2.AffectRowsMethodExplain, nil
+	}
+	tableRows, err := getTableRowsFromInformationSchema(ctx, conn, table)
+	if err != nil {
+		return 0, "", err
+	}
+	if tableRows < countMaxTableRows {
+		return 0, driverV2.AffectRowsMethodCount, nil
+	}
+	num, err := estimateAffectedRowNumByStatistics(ctx, conn, table, tableRows, stmt.Where)
+	if err != nil {
+		return 0, "", err
+	}
+	return num, driverV2.AffectRowsMethodStatistics, nil
+}
+
+// getSingleTableOfSelect returns the table if the select statement is from a single table, otherwise nil.
+func getSingleTableOfSelect(stmt *ast.SelectStmt) *ast.TableName {
+	if stmt.From == nil || stmt.From.TableRefs == nil || stmt.From.TableRefs.Right != nil {
+		return nil
+	}
+	source, ok := stmt.From.TableRefs.Left.(*ast.TableSource)
+	if !ok {
+		return nil
+	}
+	table, _ := source.Source.(*ast.TableName)
+	return table
+}
+
+// informationSchemaTableCondition returns the condition to filter the table in information_schema,
+// the current database is used if the schema of table is not specified.
+func informationSchemaTableCondition(table *ast.TableName) (string, []interface{}) {
+	if table.Schema.O == "" {
+		return "TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?", []interface{}{table.Name.O}
+	}
+	return "TABLE_SCHEMA = ? AND TABLE_NAME = ?", []interface{}{table.Schema.O, table.Name.O}
+}
+
+func getTableRowsFromInformationSchema(ctx context.Context, conn *executor.Executor, table *ast.TableName) (int64, error) {
+	condition, args := informationSchemaTableCondition(table)
+	_, rows, err := conn.Db.QueryWithContext(ctx, fmt.Sprintf("SELECT TABLE_ROWS FROM information_schema.TABLES WHERE %s", condition), args...)
+	if err != nil {
+		return 0, fmt.Errorf("get table rows of %s failed: %v", table.Name.O, err)
+	}
+	if len(rows) != 1 || len(rows[0]) == 0 || !rows[0][0].Valid {
+		return 0, fmt.Errorf("table rows of %s not found", table.Name.O)
+	}
+	return strconv.ParseInt(rows[0][0].String, 10, 64)
+}
+
+// estimateAffectedRowNumByStatistics estimates the affected rows by the table rows scaled by
+// the selectivity of the predicates in where. Only the column compared with constant values by
+// "=" or "IN" in the AND conditions is taken into account, its selectivity is the count of values
+// divided by the cardinality of the index beginning with the column, the predicates are assumed
+// to be independent. The other predicates are ignored, so the result is an upper bound of them.
+func estimateAffectedRowNumByStatistics(ctx context.Context, conn *executor.Executor, table *ast.TableName, tableRows int64, where ast.ExprNode) (int64, error) {
+	predicates := map[string] /*lower column name*/ int /*count of values*/ {}
+	getEqualPredicates(where, predicates)
+	if len(predicates) == 0 {
+		return tableRows, nil
+	}
+
+	condition, args := informationSchemaTableCondition(table)
+	_, rows, err := conn.Db.QueryWithContext(ctx, fmt.Sprintf("SELECT COLUMN_NAME, MAX(CARDINALITY) FROM information_schema.STATISTICS WHERE %s AND SEQ_IN_INDEX = 1 GROUP BY COLUMN_NAME", condition), args...)
+	if err != nil {
+		return 0, fmt.Errorf("get index cardinality of %s failed: %v", table.Name.O, err)
+	}
+	selectivity := 1.0
+	for _, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+		count, ok := predicates[strings.ToLower(row[0].String)]
+		if !ok {
+			continue
+		}
+		cardinality, err := strconv.ParseFloat(row[1].String, 64)
+		if err != nil || cardinality <= 0 {
+			continue
+		}
+		selectivity *= math.Min(1, float64(count)/cardinality)
+	}
+	return int64(math.Ceil(float64(tableRows) * selectivity)), nil
+}
+
+// getEqualPredicates collects the columns compared with constant values by "=" or "IN" in
+// the AND conditions of expr, the value of predicates is the count of the compared values.
+func getEqualPredicates(expr ast.ExprNode, predicates map[string]int) {
+	addPredicate := func(column string, count int) {
+		if c, ok := predicates[column]; !ok || count < c {
+			predicates[column] = count
+		}
+	}
+	isConstant := func(expr ast.ExprNode) bool {
+		switch expr.(type) {
+		case *driver.ValueExpr, *driver.ParamMarkerExpr:
+			return true
+		}
+		return false
+	}
+
+	switch e := expr.(type) {
+	case *ast.ParenthesesExpr:
+		getEqualPredicates(e.Expr, predicates)
+	case *ast.BinaryOperationExpr:
+		switch e.Op {
+		case opcode.LogicAnd:
+			getEqualPredicates(e.L, predicates)
+			getEqualPredicates(e.R, predicates)
+		case opcode.EQ:
+			if col, ok := e.L.(*ast.ColumnNameExpr); ok && isConstant(e.R) {
+				addPredicate(col.Name.Name.L, 1)
+			} else if col, ok := e.R.(*ast.ColumnNameExpr); ok && isConstant(e.L) {
+				addPredicate(col.Name.Name.L, 1)
+			}
+		}
+	case *ast.PatternInExpr:
+		col, ok := e.Expr.(*ast.ColumnNameExpr)
+		if !ok || e.Not || e.Sel != nil || len(e.List) == 0 {
+			return
+		}
+		for _, value := range e.List {
+			if !isConstant(value) {
+				return
+			}
+		}
+		addPredicate(col.Name.Name.L, len(e.List))
+	}
+}
+
+// IsAffectedRowNumUpperBound reports whether the affected row num estimated by
+// GetAffectedRowNum is only an upper bound, it is true for INSERT ... ON DUPLICATE KEY UPDATE.
+func IsAffectedRowNumUpperBound(node ast.Node) bool {
+	stmt, ok := node.(*ast.InsertStmt)
+	return ok && len(stmt.OnDuplicate) > 0
+}
+
+// getMultiTableUpdateTargets returns the updated tables of multi-table update, the
+// result is nil if the update is not multi-table or the updated tables can't be
+// determined from the set list, e.g. the column is not qualified by table.
+func getMultiTableUpdateTargets(stmt *ast.UpdateStmt) []*ast.TableName {
+	if stmt.TableRefs == nil || stmt.TableRefs.TableRefs == nil || stmt.TableRefs.TableRefs.Right == nil {
+		return nil
+	}
+	targets := []*ast.TableName{}
+	exist := map[string]struct{}{}
+	for _, assignment := range stmt.List {
+		if assignment.Column.Table.L == "" {
+			return nil
+		}
+		key := assignment.Column.Schema.L + "." + assignment.Column.Table.L
+		if _, ok := exist[key]; ok {
+			continue
+		}
+		exist[key] = struct{}{}
+		targets = append(targets, &ast.TableName{Schema: assignment.Column.Schema, Name: assignment.Column.Table})
+	}
+	return targets
+}
+
+// getSelectSqlFromMultiTableDML returns a select sql whose row count is the affected
+// rows of the multi-table update or delete. Each target table is counted by distinct
+// primary keys of the join, or distinct rows if the primary key is unknown, e.g.
+// delete t1, t2 from t1 join t2 on t1.id = t2.id where t1.id > 1 is converted to
+// select 1 from (select distinct t1.id from t1 join t2 on t1.id = t2.id where t1.id > 1) as t_0
+// union all
+// select 1 from (select distinct t2.* from t1 join t2 on t1.id = t2.id where t1.id > 1) as t_1
+func getSelectSqlFromMultiTableDML(ctx context.Context, conn *executor.Executor, with *withClause, refs *ast.TableRefsClause, where ast.ExprNode, targets []*ast.TableName) (string, error) {
+	if refs == nil || refs.TableRefs == nil {
+		return "", fmt.Errorf("table refs of multi-table dml is empty")
+	}
+	from, err := restoreToSqlWithFlag(format.DefaultRestoreFlags, refs.TableRefs)
+	if err != nil {
+		return "", err
+	}
+	if where != nil {
+		whereSql, err := restoreToSqlWithFlag(format.DefaultRestoreFlags, where)
+		if err != nil {
+			return "", err
+		}
+		from = fmt.Sprintf("%s WHERE %s", from, whereSql)
 	}
 
-	return affectCount, nil
+	selects := make([]string, 0, len(targets))
+	for i, target := range targets {
+		table, err := restoreToSqlWithFlag(format.DefaultRestoreFlags, target)
+		if err != nil {
+			return "", err
+		}
+		fields := fmt.Sprintf("%s.*", table)
+		if columns := getMultiTableDMLTargetPrimaryKey(ctx, conn, with, refs, target); len(columns) > 0 {
+			for j, column := range columns {
+				columns[j] = fmt.Sprintf("%s.%s", table, QuoteIdentifier(column))
+			}
+			fields = strings.Join(columns, ",")
+		}
+		selectSql := fmt.Sprintf("SELECT DISTINCT %s FROM %s", fields, from)
+		if len(targets) == 1 {
+			return selectSql, nil
+		}
+		selects = append(selects, fmt.Sprintf("SELECT 1 FROM (%s) AS t_%d", selectSql, i))
+	}
+	return strings.Join(selects, " UNION ALL "), nil
+}
+
+// getMultiTableDMLTargetPrimaryKey returns the primary key columns of the table which the target
+// of multi-table dml refers to, the target is the alias or the name of a table in refs. It returns
+// nil if the target is not a base table or the primary key can not be got from information_schema.
+func getMultiTableDMLTargetPrimaryKey(ctx context.Context, conn *executor.Executor, with *withClause, refs *ast.TableRefsClause, target *ast.TableName) []string {
+	if conn == nil {
+		return nil
+	}
+	var table *ast.TableName
+	for _, source := range GetTableSources(refs.TableRefs) {
+		name, ok := source.Source.(*ast.TableName)
+		if source.AsName.L != "" {
+			if target.Schema.L == "" && source.AsName.L == target.Name.L {
+				table = name
+				break
+			}
+			continue
+		}
+		if ok && name.Name.L == target.Name.L && (target.Schema.L == "" || target.Schema.L == name.Schema.L) {
+			table = name
+			break
+		}
+	}
+	if table == nil || (with != nil && table.Schema.L == "" && with.hasName(table.Name.L)) {
+		return nil
+	}
+
+	condition, args := informationSchemaTableCondition(table)
+	_, rows, err := conn.Db.QueryWithContext(ctx, fmt.Sprintf("SELECT COLUMN_NAME FROM information_schema.STATISTICS WHERE %s AND INDEX_NAME = 'PRIMARY' ORDER BY SEQ_IN_INDEX", condition), args...)
+	if err != nil {
+		log.NewEntry().Errorf("get primary key of %s failed, count the distinct rows instead, error: %v", table.Name.O, err)
+		return nil
+	}
+	columns := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if len(row) == 0 || !row[0].Valid {
+			return nil
+		}
+		columns = append(columns, row[0].String)
+	}
+	return columns
+}
+
+// isDerivedTableOfExplain reports whether the record of execution plan is the derived table or
+// the result of UNION, e.g. "<derived2>" and "<union1,2>".
+func isDerivedTableOfExplain(record *executor.ExplainRecord) bool {
+	return strings.HasPrefix(record.Table, "<derived") || strings.HasPrefix(record.Table, "<union")
+}
+
+// getMultiTableDMLTargetRows returns the sum of the rows of the target tables in the execution
+// plan, ok is false if any of the targets is not found. The rows of the target table are the
+// upper bound of its affected rows, while the rows of the join may be multiplied.
+func getMultiTableDMLTargetRows(records []*executor.ExplainRecord, targets []*ast.TableName) (rows int64, ok bool) {
+	if len(targets) == 0 {
+		return 0, false
+	}
+	for _, target := range targets {
+		found := false
+		for _, record := range records {
+			if strings.EqualFold(record.Table, target.Name.O) {
+				rows += record.Rows
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return rows, true
 }
 
 func getSelectNodeFromDelete(stmt *ast.DeleteStmt) *ast.SelectStmt {
@@ -276,18 +678,66 @@ func checkSql(affectRowSql string) error {
 	return nil
 }
 
-func KillProcess(ctx context.Context, killSQL string, killConn *executor.Executor, logEntry *logrus.Entry) error {
-	killFunc := func() error {
-		_, err := killConn.Db.Exec(killSQL)
-		return err
+// KillProcessCheckInterval is the interval between killing the connection and
+// checking whether it is gone.
+var KillProcessCheckInterval = 500 * time.Millisecond
+
+// KillProcess kills the connection connID and verifies it is gone by polling
+// information_schema.processlist. The connection in certain states may ignore the
+// kill, so it is killed again up to retryTimes times, an error is returned if the
+// connection still exists after that.
+func KillProcess(ctx context.Context, connID string, killConn *executor.Executor, retryTimes int, logEntry *logrus.Entry) error {
+	killSQL := fmt.Sprintf("KILL %v", connID)
+	for i := 0; i <= retryTimes; i++ {
+		err := utils.AsyncCallTimeout(ctx, func() error {
+			_, err := killConn.Db.Exec(killSQL)
+			return err
+		})
+		if isUnknownThreadError(err) {
+			logEntry.Infof("connection %v is gone before exec sql(%v)", connID, killSQL)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("exec sql(%v) failed, err: %w", killSQL, err)
+		}
+		logEntry.Infof("exec sql(%v) successfully", killSQL)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(KillProcessCheckInterval):
+		}
+
+		exist, err := isProcessExist(ctx, connID, killConn)
+		if err != nil {
+			return fmt.Errorf("check connection %v after exec sql(%v) failed, err: %w", connID, killSQL, err)
+		}
+		if !exist {
+			return nil
+		}
+		logEntry.Warnf("connection %v still exists after exec sql(%v), retried %d times", connID, killSQL, i)
 	}
-	err := utils.AsyncCallTimeout(ctx, killFunc)
-	if err != nil {
-		err = fmt.Errorf("exec sql(%v) failed, err: %v", killSQL, err)
+	return fmt.Errorf("connection %v still exists after killing it %d times", connID, retryTimes+1)
+}
+
+func isProcessExist(ctx context.Context, connID string, conn *executor.Executor) (bool, error) {
+	var records []map[string]sql.NullString
+	err := utils.AsyncCallTimeout(ctx, func() error {
+		var err error
+		records, err = conn.Db.Query("SELECT ID FROM information_schema.processlist WHERE ID = ?", connID)
 		return err
+	})
+	if err != nil {
+		return false, err
 	}
-	logEntry.Infof("exec sql(%v) successfully", killSQL)
-	return nil
+	return len(records) > 0, nil
+}
+
+// isUnknownThreadError reports whether the error is "ERROR 1094 (HY000): Unknown thread id",
+// which means the connection to kill does not exist.
+func isUnknownThreadError(err error) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysql.ErrNoSuchThread
 }
 
 func IsGeometryColumn(col *ast.ColumnDef) bool {
@@ -299,19 +749,20 @@ func IsGeometryColumn(col *ast.ColumnDef) bool {
 	return false
 }
 
-// TODO: 暂时使用正则表达式匹配event，后续会修改语法树进行匹配event
-func IsEventSQL(sql string) bool {
-	createPattern := `^CREATE\s+(DEFINER\s?=.+?)?EVENT`
-	createRe := regexp.MustCompile(createPattern)
-	alterPattern := `^ALTER\s+(DEFINER\s?=.+?)?EVENT`
-	alterRe := regexp.MustCompile(alterPattern)
-
-	sql = strings.ToUpper(strings.TrimSpace(sql))
-	if createRe.MatchString(sql) {
+// IsTextOrBlobColumn reports whether the column is of the TEXT/BLOB family or JSON,
+// which can not be indexed by the whole value.
+func IsTextOrBlobColumn(col *ast.ColumnDef) bool {
+	switch col.Tp.Tp {
+	case mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeJSON:
 		return true
-	} else {
-		return alterRe.MatchString(sql)
 	}
+	return false
+}
+
+// IsEventSQL reports whether sql is a CREATE EVENT or ALTER EVENT statement, see ParseEventStmt.
+func IsEventSQL(sql string) bool {
+	_, ok := ParseEventStmt(sql)
+	return ok
 }
 
 func GetTableNameFromTableSource(tableSource *ast.TableSource) string {
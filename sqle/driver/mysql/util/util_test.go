@@ -1,11 +1,20 @@
 package util
 
 import (
+	"context"
+	"fmt"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/actiontech/sqle/sqle/driver/mysql/executor"
+	driverV2 "github.com/actiontech/sqle/sqle/driver/v2"
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"github.com/pingcap/parser/ast"
 	"github.com/pingcap/parser/format"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -50,3 +59,429 @@ func TestGetSelectNodeFromSelect(t *testing.T) {
 		assert.Equal(t, test.expect, sqlBuilder.String())
 	}
 }
+
+func TestGetAffectedRowNum_Insert(t *testing.T) {
+	tests := []struct {
+		sql          string
+		want         int64
+		isUpperBound bool
+	}{
+		{"insert into t1 (id, name) values (1, 'a'), (2, 'b')", 2, false},
+		{"replace into t1 (id, name) values (1, 'a'), (2, 'b'), (3, 'c')", 3, false},
+		{"replace into t1 set id = 1, name = 'a'", 1, false},
+		{"insert into t1 set id = 1, name = 'a'", 1, false},
+		{"insert into t1 (id, name) values (1, 'a'), (2, 'b') on duplicate key update name = values(name)", 2, true},
+		{"insert into t1 set id = 1, name = 'a' on duplicate key update name = 'b'", 1, true},
+	}
+	for _, tt := range tests {
+		num, err := GetAffectedRowNum(context.TODO(), tt.sql, nil, nil)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.want, num, tt.sql)
+
+		node, err := ParseOneSql(tt.sql)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.isUpperBound, IsAffectedRowNumUpperBound(node), tt.sql)
+	}
+}
+
+func TestGetAffectedRowNum_ParamMarker(t *testing.T) {
+	num, err := GetAffectedRowNum(context.TODO(), "insert into t1 (id, name) values (?, ?), (?, ?)", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), num)
+
+	for _, sql := range []string{
+		"update t1 set name = ? where id = ?",
+		"delete from t1 where id in (?, ?)",
+		"select * from t1 where name like ?",
+		"insert into t1 (id, name) select id, name from t2 where id > ?",
+	} {
+		// the statements with param markers are not executed
+		_, err := GetAffectedRowNum(context.TODO(), sql, nil, nil)
+		assert.ErrorIs(t, err, ErrSqlWithParamMarker, sql)
+	}
+}
+
+func TestGetAffectedRowNum_QueryTimeout(t *testing.T) {
+	e, handler, err := executor.NewMockExecutor()
+	assert.NoError(t, err)
+
+	// the timeout of EXPLAIN
+	_, err = GetAffectedRowNum(context.TODO(), "update t1 set name = 'a' where id > 1", e, func(string) ([]*executor.ExplainRecord, error) {
+		return nil, fmt.Errorf("%w, error: context deadline exceeded", executor.ErrQueryTimeout)
+	})
+	assert.ErrorIs(t, err, executor.ErrQueryTimeout)
+
+	// the timeout of SELECT COUNT
+	handler.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(1) FROM `t1` WHERE `id`>1")).WillReturnError(executor.ErrQueryTimeout)
+	_, err = GetAffectedRowNum(context.TODO(), "update t1 set name = 'a' where id > 1", e, func(string) ([]*executor.ExplainRecord, error) {
+		return []*executor.ExplainRecord{{Type: "range", Rows: 10}}, nil
+	})
+	assert.ErrorIs(t, err, executor.ErrQueryTimeout)
+	assert.NoError(t, handler.ExpectationsWereMet())
+}
+
+func TestGetAffectedRowNum_MultiTable(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+	}{
+		{
+			"delete t1 from t1 join t2 on t1.id = t2.id where t2.v1 = 1",
+			"select count(*) from (SELECT DISTINCT `t1`.* FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`id` WHERE `t2`.`v1`=1) as t",
+		},
+		{
+			"delete from a using db1.t1 as a join t2 on a.id = t2.id",
+			"select count(*) from (SELECT DISTINCT `a`.* FROM `db1`.`t1` AS `a` JOIN `t2` ON `a`.`id`=`t2`.`id`) as t",
+		},
+		{
+			"delete t1, t2 from t1 join t2 on t1.id = t2.id",
+			"select count(*) from (SELECT 1 FROM (SELECT DISTINCT `t1`.* FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`id`) AS t_0 UNION ALL SELECT 1 FROM (SELECT DISTINCT `t2`.* FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`id`) AS t_1) as t",
+		},
+		{
+			"update t1 join t2 on t1.id = t2.id set t1.v1 = t2.v1, t1.v2 = 1 where t2.v2 > 1",
+			"select count(*) from (SELECT DISTINCT `t1`.* FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`id` WHERE `t2`.`v2`>1) as t",
+		},
+		{
+			// the updated table can't be determined, count the rows of join
+			"update t1 join t2 on t1.id = t2.id set v1 = 1",
+			"SELECT COUNT(1) FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`id`",
+		},
+		{
+			"delete from t1 where id = 1",
+			"SELECT COUNT(1) FROM `t1` WHERE `id`=1",
+		},
+	}
+	for _, tt := range tests {
+		var affectedRowSql string
+		num, err := GetAffectedRowNum(context.TODO(), tt.sql, nil, func(sql string) ([]*executor.ExplainRecord, error) {
+			affectedRowSql = sql
+			return []*executor.ExplainRecord{{Type: executor.ExplainRecordAccessTypeAll, Rows: 10}}, nil
+		})
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, int64(10), num, tt.sql)
+		assert.Equal(t, tt.want, affectedRowSql, tt.sql)
+	}
+}
+
+func TestGetAffectedRowNum_CreateTableAsSelect(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+	}{
+		{
+			"create table t3 as select id, v1 from t1 where v2 = 1",
+			"SELECT COUNT(1) FROM `t1` WHERE `v2`=1",
+		},
+		{
+			"create table t3 (id int primary key) select id from t1 join t2 using (id)",
+			"SELECT COUNT(1) FROM `t1` JOIN `t2` USING (`id`)",
+		},
+		{
+			"create table t3 as select v1, count(*) from t1 group by v1",
+			"select count(*) from (SELECT `v1`,COUNT(1) FROM `t1` GROUP BY `v1`) as t",
+		},
+		{
+			"create table t3 as select id from t1 union select id from t2",
+			"select count(*) from (SELECT `id` FROM `t1` UNION SELECT `id` FROM `t2`) as t",
+		},
+	}
+	for _, tt := range tests {
+		var affectedRowSql string
+		num, err := GetAffectedRowNum(context.TODO(), tt.sql, nil, func(sql string) ([]*executor.ExplainRecord, error) {
+			affectedRowSql = sql
+			return []*executor.ExplainRecord{{Type: executor.ExplainRecordAccessTypeAll, Rows: 10}}, nil
+		})
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, int64(10), num, tt.sql)
+		assert.Equal(t, tt.want, affectedRowSql, tt.sql)
+	}
+
+	for _, sql := range []string{
+		"create table t3 (id int)",
+		"create table t3 like t1",
+	} {
+		_, err := GetAffectedRowNum(context.TODO(), sql, nil, nil)
+		assert.ErrorIs(t, err, ErrUnsupportedSqlType, sql)
+	}
+}
+
+func TestGetAffectedRowNum_WithClause(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+	}{
+		{
+			"WITH cte AS (SELECT id FROM t2 WHERE v1 > 1) DELETE FROM t1 WHERE id IN (SELECT id FROM cte)",
+			"WITH cte AS (SELECT id FROM t2 WHERE v1 > 1) SELECT COUNT(1) FROM `t1` WHERE `id` IN (SELECT `id` FROM `cte`)",
+		},
+		{
+			"with cte as (select id from t2) update t1 join cte on t1.id = cte.id set t1.v1 = 1",
+			"with cte as (select id from t2) select count(*) from (SELECT DISTINCT `t1`.* FROM `t1` JOIN `cte` ON `t1`.`id`=`cte`.`id`) as t",
+		},
+		{
+			"WITH cte AS (SELECT id, v1 FROM t1) SELECT * FROM cte WHERE v1 = 1",
+			"WITH cte AS (SELECT id, v1 FROM t1) SELECT COUNT(1) FROM `cte` WHERE `v1`=1",
+		},
+		{
+			"WITH RECURSIVE seq (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100) SELECT n FROM seq GROUP BY n",
+			"WITH RECURSIVE seq (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100) select count(*) from (SELECT n FROM seq GROUP BY n) as t",
+		},
+		{
+			"WITH RECURSIVE seq (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100) DELETE FROM t1 WHERE id IN (SELECT n FROM seq);",
+			"WITH RECURSIVE seq (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100) SELECT COUNT(1) FROM `t1` WHERE `id` IN (SELECT `n` FROM `seq`)",
+		},
+	}
+	for _, tt := range tests {
+		var affectedRowSql string
+		num, err := GetAffectedRowNum(context.TODO(), tt.sql, nil, func(sql string) ([]*executor.ExplainRecord, error) {
+			affectedRowSql = sql
+			return []*executor.ExplainRecord{{Type: executor.ExplainRecordAccessTypeAll, Rows: 10}}, nil
+		})
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, int64(10), num, tt.sql)
+		assert.Equal(t, tt.want, affectedRowSql, tt.sql)
+	}
+
+	t.Run("count with clause", func(t *testing.T) {
+		sql := "WITH RECURSIVE seq (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100) DELETE FROM t1 WHERE id IN (SELECT n FROM seq)"
+		countSql := "WITH RECURSIVE seq (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100) SELECT COUNT(1) FROM `t1` WHERE `id` IN (SELECT `n` FROM `seq`)"
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(regexp.QuoteMeta(countSql)).WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow("100"))
+		num, method, err := EstimateAffectedRowNum(context.TODO(), sql, conn, func(sql string) ([]*executor.ExplainRecord, error) {
+			return []*executor.ExplainRecord{{Type: "range", Rows: 100}}, nil
+		}, AffectedRowNumOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), num)
+		assert.Equal(t, driverV2.AffectRowsMethodCount, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("common table expression is not estimated by statistics", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		num, method, err := EstimateAffectedRowNum(context.TODO(), "WITH cte AS (SELECT id, v1 FROM t1) SELECT * FROM cte WHERE v1 = 1", conn, func(sql string) ([]*executor.ExplainRecord, error) {
+			return []*executor.ExplainRecord{{Type: executor.ExplainRecordAccessTypeAll, Rows: 500000}}, nil
+		}, AffectedRowNumOptions{AccurateMode: true, CountMaxTableRows: 100000})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(500000), num)
+		assert.Equal(t, driverV2.AffectRowsMethodExplain, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestEstimateAffectedRowNum(t *testing.T) {
+	tableRowsSQL := regexp.QuoteMeta("SELECT TABLE_ROWS FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?")
+	cardinalitySQL := regexp.QuoteMeta("SELECT COLUMN_NAME, MAX(CARDINALITY) FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND SEQ_IN_INDEX = 1 GROUP BY COLUMN_NAME")
+	countSQL := regexp.QuoteMeta("SELECT COUNT(1) FROM `db1`.`t1` WHERE `status`=1 AND `user_id` IN (1,2) AND `v1`>10")
+	sql := "delete from db1.t1 where status = 1 and user_id in (1, 2) and v1 > 10"
+	explainAll := func(string) ([]*executor.ExplainRecord, error) {
+		return []*executor.ExplainRecord{{Type: executor.ExplainRecordAccessTypeAll, Rows: 500000}}, nil
+	}
+	opts := AffectedRowNumOptions{AccurateMode: true, CountMaxTableRows: 100000}
+
+	t.Run("accurate mode disabled", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		num, method, err := EstimateAffectedRowNum(context.TODO(), sql, conn, explainAll, AffectedRowNumOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(500000), num)
+		assert.Equal(t, driverV2.AffectRowsMethodExplain, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("small table is counted", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(tableRowsSQL).WithArgs("db1", "t1").WillReturnRows(sqlmock.NewRows([]string{"TABLE_ROWS"}).AddRow("50000"))
+		mock.ExpectQuery(countSQL).WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow("12"))
+		num, method, err := EstimateAffectedRowNum(context.TODO(), sql, conn, explainAll, opts)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), num)
+		assert.Equal(t, driverV2.AffectRowsMethodCount, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("large table is estimated by statistics", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(tableRowsSQL).WithArgs("db1", "t1").WillReturnRows(sqlmock.NewRows([]string{"TABLE_ROWS"}).AddRow("1000000"))
+		mock.ExpectQuery(cardinalitySQL).WithArgs("db1", "t1").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "MAX(CARDINALITY)"}).
+			AddRow("id", "1000000").AddRow("status", "4").AddRow("user_id", "1000"))
+		num, method, err := EstimateAffectedRowNum(context.TODO(), sql, conn, explainAll, opts)
+		assert.NoError(t, err)
+		// 1000000 * 1/4 * 2/1000
+		assert.Equal(t, int64(500), num)
+		assert.Equal(t, driverV2.AffectRowsMethodStatistics, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fallback to explain when table rows not found", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(tableRowsSQL).WithArgs("db1", "t1").WillReturnRows(sqlmock.NewRows([]string{"TABLE_ROWS"}))
+		num, method, err := EstimateAffectedRowNum(context.TODO(), sql, conn, explainAll, opts)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(500000), num)
+		assert.Equal(t, driverV2.AffectRowsMethodExplain, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("multi-table statement uses explain", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COLUMN_NAME FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME = 'PRIMARY'")).
+			WithArgs("t1").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+		num, method, err := EstimateAffectedRowNum(context.TODO(), "delete t1 from t1 join t2 on t1.id = t2.id", conn, explainAll, opts)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(500000), num)
+		assert.Equal(t, driverV2.AffectRowsMethodExplain, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("insert values", func(t *testing.T) {
+		num, method, err := EstimateAffectedRowNum(context.TODO(), "insert into t1 (id) values (1), (2)", nil, nil, opts)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), num)
+		assert.Equal(t, driverV2.AffectRowsMethodStatement, method)
+	})
+}
+
+func TestEstimateAffectedRowNum_MultiTableUpdate(t *testing.T) {
+	// the seeded data:
+	// t1(id, v1): (1, 0), (2, 0), (3, 0)
+	// t2(id, t1_id, v1): (1, 1, 'a'), (2, 1, 'b'), (3, 2, 'c'), (4, 2, 'd'), (5, 2, 'e')
+	// "update t1 join t2 on t1.id = t2.t1_id set t1.v1 = 1" updates 2 rows of t1, while the join has 5 rows.
+	sql := "update t1 join t2 on t1.id = t2.t1_id set t1.v1 = 1"
+	pkSQL := regexp.QuoteMeta("SELECT COLUMN_NAME FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME = 'PRIMARY' ORDER BY SEQ_IN_INDEX")
+	explain := func(t *testing.T, want string, records ...*executor.ExplainRecord) func(string) ([]*executor.ExplainRecord, error) {
+		return func(sql string) ([]*executor.ExplainRecord, error) {
+			assert.Equal(t, want, sql)
+			return records, nil
+		}
+	}
+
+	t.Run("count the distinct primary keys of the updated table", func(t *testing.T) {
+		countSQL := "select count(*) from (SELECT DISTINCT `t1`.`id` FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`t1_id`) as t"
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(pkSQL).WithArgs("t1").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+		mock.ExpectQuery(regexp.QuoteMeta(countSQL)).WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow("2"))
+		num, method, err := EstimateAffectedRowNum(context.TODO(), sql, conn, explain(t, countSQL,
+			&executor.ExplainRecord{Table: "<derived2>", Type: "ALL", Rows: 5},
+			&executor.ExplainRecord{Table: "t1", Type: "index", Rows: 3},
+			&executor.ExplainRecord{Table: "t2", Type: "ref", Rows: 2},
+		), AffectedRowNumOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), num)
+		assert.Equal(t, driverV2.AffectRowsMethodCount, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("the rows of the updated table in explain", func(t *testing.T) {
+		countSQL := "select count(*) from (SELECT DISTINCT `t1`.`id` FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`t1_id`) as t"
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(pkSQL).WithArgs("t1").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id"))
+		// the join product and the last row of explain are not the rows of t1
+		num, method, err := EstimateAffectedRowNum(context.TODO(), sql, conn, explain(t, countSQL,
+			&executor.ExplainRecord{Table: "<derived2>", Type: "ALL", Rows: 5},
+			&executor.ExplainRecord{Table: "t1", Type: "ALL", Rows: 3},
+			&executor.ExplainRecord{Table: "t2", Type: "ALL", Rows: 5},
+		), AffectedRowNumOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), num)
+		assert.Equal(t, driverV2.AffectRowsMethodExplain, method)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("composite primary key of the aliased table", func(t *testing.T) {
+		countSQL := "select count(*) from (SELECT DISTINCT `a`.`k1`,`a`.`k2` FROM `db1`.`t1` AS `a` JOIN `t2` AS `b` ON `a`.`k1`=`b`.`t1_id`) as t"
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COLUMN_NAME FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND INDEX_NAME = 'PRIMARY' ORDER BY SEQ_IN_INDEX")).
+			WithArgs("db1", "t1").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("k1").AddRow("k2"))
+		mock.ExpectQuery(regexp.QuoteMeta(countSQL)).WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow("2"))
+		num, _, err := EstimateAffectedRowNum(context.TODO(), "update db1.t1 as a join t2 as b on a.k1 = b.t1_id set a.v1 = 1", conn, explain(t, countSQL,
+			&executor.ExplainRecord{Table: "a", Type: "range", Rows: 3},
+			&executor.ExplainRecord{Table: "b", Type: "ref", Rows: 2},
+		), AffectedRowNumOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), num)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count the distinct rows without primary key", func(t *testing.T) {
+		countSQL := "select count(*) from (SELECT DISTINCT `t1`.* FROM `t1` JOIN `t2` ON `t1`.`id`=`t2`.`t1_id`) as t"
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectQuery(pkSQL).WithArgs("t1").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}))
+		mock.ExpectQuery(regexp.QuoteMeta(countSQL)).WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow("2"))
+		num, _, err := EstimateAffectedRowNum(context.TODO(), sql, conn, explain(t, countSQL,
+			&executor.ExplainRecord{Table: "t1", Type: "index", Rows: 3},
+		), AffectedRowNumOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), num)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestKillProcess(t *testing.T) {
+	interval := KillProcessCheckInterval
+	KillProcessCheckInterval = time.Millisecond
+	defer func() { KillProcessCheckInterval = interval }()
+	entry := logrus.WithField("unittest", "unittest")
+	checkSQL := regexp.QuoteMeta("SELECT ID FROM information_schema.processlist WHERE ID = ?")
+
+	t.Run("killed after retry", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			mock.ExpectExec("KILL 10").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectQuery(checkSQL).WithArgs("10").WillReturnRows(sqlmock.NewRows([]string{"ID"}).AddRow("10"))
+		}
+		mock.ExpectExec("KILL 10").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(checkSQL).WithArgs("10").WillReturnRows(sqlmock.NewRows([]string{"ID"}))
+
+		assert.NoError(t, KillProcess(context.TODO(), "10", conn, 3, entry))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("still exists after retry", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			mock.ExpectExec("KILL 10").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectQuery(checkSQL).WithArgs("10").WillReturnRows(sqlmock.NewRows([]string{"ID"}).AddRow("10"))
+		}
+
+		err = KillProcess(context.TODO(), "10", conn, 1, entry)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "still exists")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("connection is gone", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectExec("KILL 10").WillReturnError(&mysqlDriver.MySQLError{Number: 1094, Message: "Unknown thread id: 10"})
+
+		assert.NoError(t, KillProcess(context.TODO(), "10", conn, 3, entry))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		conn, mock, err := executor.NewMockExecutor()
+		assert.NoError(t, err)
+		mock.ExpectExec("KILL 10").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(checkSQL).WithArgs("10").WillReturnRows(sqlmock.NewRows([]string{"ID"}).AddRow("10"))
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		mock.ExpectExec("KILL 10").WillReturnResult(sqlmock.NewResult(0, 0)).WillDelayFor(time.Second)
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			cancel()
+		}()
+		assert.ErrorIs(t, KillProcess(ctx, "10", conn, 3, entry), context.Canceled)
+	})
+}
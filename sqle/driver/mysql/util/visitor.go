@@ -13,13 +13,40 @@ import (
 type FingerprintVisitor struct{}
 
 func (f *FingerprintVisitor) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
-	if v, ok := n.(*driver.ValueExpr); ok {
-		v.Type.Charset = ""
-		v.SetValue([]byte("?"))
+	switch stmt := n.(type) {
+	case *driver.ValueExpr:
+		stmt.Type.Charset = ""
+		stmt.SetValue([]byte("?"))
+	case *ast.PatternInExpr:
+		// a IN (1, 2, 3) and a IN (1, 2) share the fingerprint a IN (?)
+		if len(stmt.List) > 1 && isValueList(stmt.List) {
+			stmt.List = stmt.List[:1]
+		}
+	case *ast.InsertStmt:
+		// VALUES (1, 2), (3, 4) and VALUES (1, 2) share the fingerprint VALUES (?,?)
+		if len(stmt.Lists) > 1 {
+			stmt.Lists = stmt.Lists[:1]
+		}
 	}
 	return n, false
 }
 
+// isValueList reports whether the exprs are all values or rows of values.
+func isValueList(exprs []ast.ExprNode) bool {
+	for _, expr := range exprs {
+		switch e := expr.(type) {
+		case ast.ValueExpr:
+		case *ast.RowExpr:
+			if !isValueList(e.Values) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func (f *FingerprintVisitor) Leave(n ast.Node) (node ast.Node, ok bool) {
 	return n, true
 }
@@ -40,6 +67,24 @@ func (p *ParamMarkerChecker) Leave(in ast.Node) (node ast.Node, skipChildren boo
 	return in, true
 }
 
+// AggregateFuncChecker checks whether the node contains aggregate functions or window functions.
+type AggregateFuncChecker struct {
+	HasAggregateFunc bool
+}
+
+func (c *AggregateFuncChecker) Enter(in ast.Node) (node ast.Node, skipChildren bool) {
+	switch in.(type) {
+	case *ast.AggregateFuncExpr, *ast.WindowFuncExpr:
+		c.HasAggregateFunc = true
+		return in, true
+	}
+	return in, false
+}
+
+func (c *AggregateFuncChecker) Leave(in ast.Node) (node ast.Node, ok bool) {
+	return in, true
+}
+
 type HasVarChecker struct {
 	HasVar bool
 }
@@ -610,9 +610,15 @@ func (s *PluginImplV2) EstimateSQLAffectRows(ctx context.Context, sql string) (*
 	if err != nil {
 		return nil, err
 	}
+	i18nNote, err := i18nPkg.ConvertStrMap2I18nStr(ar.I18NNote)
+	if err != nil {
+		return nil, fmt.Errorf("PluginImplV2 EstimateSQLAffectRows fail to convert i18nNote to I18nStrMap, error: %v", err)
+	}
 	return &driverV2.EstimatedAffectRows{
 		Count:      ar.Count,
 		ErrMessage: ar.ErrMessage,
+		I18nNote:   i18nNote,
+		Method:     driverV2.AffectRowsMethod(ar.Method),
 	}, nil
 }
 
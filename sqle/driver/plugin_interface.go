@@ -69,6 +69,9 @@ type PluginProcessor interface {
 type GetTableMetaBySQLConf struct {
 	// this SQL should be a single SQL
 	Sql string
+	// WithStatistics requests the statistics of index columns, e.g. cardinality and
+	// selectivity, which is not supported by the plugin drivers yet.
+	WithStatistics bool
 }
 
 type GetTableMetaBySQLResult struct {
@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/actiontech/dms/pkg/dms-common/i18nPkg"
@@ -20,6 +21,9 @@ var (
 	ErrNodesCountExceedOne = errors.New("after parse, nodes count exceed one")
 	ErrSQLIsNotSupported   = errors.New("SQL is not supported")
 	ErrSQLisEmpty          = errors.New("SQL is empty")
+	// ErrTooManyStatements is returned by Audit if the number of the statements exceeds
+	// Config.MaxAuditStatements.
+	ErrTooManyStatements = errors.New("too many statements to audit")
 )
 
 type DSN struct {
@@ -31,6 +35,14 @@ type DSN struct {
 
 	// DatabaseName is the default database to connect.
 	DatabaseName string
+
+	// TLSConfigName is the name of a tls config registered in the database driver,
+	// it is used as the name to register when the cert files below are set.
+	TLSConfigName string
+	// CAPath, ClientCertPath and ClientKeyPath are the PEM files used to build the tls config.
+	CAPath         string
+	ClientCertPath string
+	ClientKeyPath  string
 }
 
 type Rule struct {
@@ -80,8 +92,114 @@ type RuleInfo struct {
 }
 
 type Config struct {
-	DSN   *DSN
-	Rules []*Rule
+	DSN *DSN
+	// ReadDSN is the optional DSN of a read replica of the instance of DSN. If it is set, the
+	// metadata and EXPLAIN queries made by audit are sent to it, while the SQL is still executed
+	// on DSN, which is the primary. Either of them is used for both if only one is set.
+	ReadDSN *DSN
+	Rules   []*Rule
+	// LevelOverrides is an optional map from rule name to level, it is used to bump
+	// or downgrade the level of rules without reconstructing them. The overridden level
+	// takes precedence over Rule.Level, the rules which are not in it keep their own level.
+	LevelOverrides map[string]RuleLevel
+	// ProfileRules enables recording the time spent by each rule during audit, it is
+	// used to find the expensive rules and is disabled by default.
+	ProfileRules bool
+	// IncludeRuleTags and ExcludeRuleTags filter the rules evaluated in audit by their CategoryTags
+	// without changing Rules, e.g. IncludeRuleTags of "security" runs the security rules only. A rule
+	// is evaluated if it has any tag of IncludeRuleTags (or IncludeRuleTags is empty) and none of
+	// ExcludeRuleTags, the tags of all categories are matched case-insensitively. The filter is
+	// applied in addition to the offline filter, i.e. the rules not allowed in offline audit are
+	// still skipped in offline audit even if they are included.
+	IncludeRuleTags []string
+	ExcludeRuleTags []string
+	// RuleParallelism is the maximum number of rules evaluated concurrently when auditing
+	// one statement, the rules are evaluated one by one if it is not greater than 1.
+	RuleParallelism int
+	// TargetVersion is the version of the database which the SQL is audited against, it is
+	// used by the rules depending on the version when the version can not be queried from
+	// the instance, e.g. in offline audit. It is in the format of "SELECT VERSION()", i.e.
+	// "major.minor.patch" optionally followed by a suffix, e.g. "8.0.30", "5.7.44-log" or
+	// "10.6.12-MariaDB". The version of the instance is used if it is empty.
+	TargetVersion string
+	// Locale is the language which the audit messages are resolved to, see AuditResults.ResolveLang.
+	// The messages are not resolved if it is language.Und, which is the default.
+	// The locale set by WithAuditLocale in the context of Audit takes precedence over it.
+	Locale language.Tag
+	// MaxAuditStatements is the max number of statements audited in one Audit call, Audit returns
+	// ErrTooManyStatements if it is exceeded, so that a huge batch doesn't exhaust the memory by the
+	// accumulated results. DefaultMaxAuditStatements is used if it is not positive.
+	MaxAuditStatements int
+}
+
+const DefaultMaxAuditStatements = 100000
+
+// GetMaxAuditStatements returns MaxAuditStatements, or DefaultMaxAuditStatements if it is not positive.
+func (c *Config) GetMaxAuditStatements() int {
+	if c.MaxAuditStatements <= 0 {
+		return DefaultMaxAuditStatements
+	}
+	return c.MaxAuditStatements
+}
+
+// ReadWriteDSN returns the DSN for the read-only queries of audit and the DSN to execute SQL,
+// they are the same if only one of DSN and ReadDSN is set. Both are nil in offline audit.
+func (c *Config) ReadWriteDSN() (read, write *DSN) {
+	switch {
+	case c.DSN == nil:
+		return c.ReadDSN, c.ReadDSN
+	case c.ReadDSN == nil:
+		return c.DSN, c.DSN
+	default:
+		return c.ReadDSN, c.DSN
+	}
+}
+
+// RulesWithLevelOverrides returns Rules whose level is overridden by LevelOverrides.
+func (c *Config) RulesWithLevelOverrides() []*Rule {
+	return ApplyRuleLevelOverrides(c.Rules, c.LevelOverrides)
+}
+
+// MatchRuleTags reports whether the rule with categoryTags passes the tag filter, see
+// Config.IncludeRuleTags and Config.ExcludeRuleTags.
+func MatchRuleTags(categoryTags map[string][]string, include, exclude []string) bool {
+	hasAnyTag := func(tags []string) bool {
+		for _, ruleTags := range categoryTags {
+			for _, ruleTag := range ruleTags {
+				for _, tag := range tags {
+					if strings.EqualFold(ruleTag, tag) {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+	if len(include) > 0 && !hasAnyTag(include) {
+		return false
+	}
+	return !hasAnyTag(exclude)
+}
+
+// ApplyRuleLevelOverrides returns the rules whose level is replaced by overrides, the
+// overridden rules are copied so the rules passed in are not modified. The invalid
+// levels in overrides are ignored.
+func ApplyRuleLevelOverrides(rules []*Rule, overrides map[string]RuleLevel) []*Rule {
+	if len(overrides) == 0 {
+		return rules
+	}
+	result := make([]*Rule, 0, len(rules))
+	for _, rule := range rules {
+		level, ok := overrides[rule.Name]
+		if !ok || !level.IsValid() || level == rule.Level {
+			result = append(result, rule)
+			continue
+		}
+		overridden := *rule
+		overridden.Level = level
+		result = append(result, &overridden)
+	}
+	return result
 }
 
 func NewConfig(dsn *DSN, rules []*Rule) (*Config, error) {
@@ -565,6 +683,8 @@ func (d *DriverGrpcServer) EstimateSQLAffectRows(ctx context.Context, req *proto
 	return &protoV2.EstimateSQLAffectRowsResponse{
 		Count:      ar.Count,
 		ErrMessage: ar.ErrMessage,
+		I18NNote:   ar.I18nNote.StrMap(),
+		Method:     string(ar.Method),
 	}, nil
 }
 
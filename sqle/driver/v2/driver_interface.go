@@ -3,6 +3,7 @@ package driverV2
 import (
 	"context"
 	sqlDriver "database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
@@ -162,6 +163,12 @@ var ruleLevelMap = map[RuleLevel]int{
 	RuleLevelError:  3,
 }
 
+// IsValid reports whether r is one of normal, notice, warn and error.
+func (r RuleLevel) IsValid() bool {
+	_, ok := ruleLevelMap[r]
+	return ok && r != RuleLevelNull
+}
+
 func (r RuleLevel) LessOrEqual(l RuleLevel) bool {
 	return ruleLevelMap[r] <= ruleLevelMap[l]
 }
@@ -188,6 +195,9 @@ type AuditResult struct {
 	RuleName            string
 	ExecutionFailed     bool
 	I18nAuditResultInfo map[language.Tag]AuditResultInfo
+	// ResolvedAuditResultInfo is the info in the language resolved by AuditResults.ResolveLang,
+	// it is nil if the result is not resolved.
+	ResolvedAuditResultInfo *AuditResultInfo
 }
 
 type AuditResultInfo struct {
@@ -219,7 +229,8 @@ func (rs *AuditResults) Message() string {
 	repeatCheck := map[string]struct{}{}
 	messages := []string{}
 	for _, result := range rs.Results {
-		token := result.I18nAuditResultInfo[i18nPkg.DefaultLang].Message + string(result.Level)
+		resultMessage := result.GetResolvedAuditResultInfo().Message
+		token := resultMessage + string(result.Level)
 		if _, ok := repeatCheck[token]; ok {
 			continue
 		}
@@ -228,11 +239,11 @@ func (rs *AuditResults) Message() string {
 		var message string
 		match, _ := regexp.MatchString(fmt.Sprintf(`^\[%s|%s|%s|%s|%s\]`,
 			RuleLevelError, RuleLevelWarn, RuleLevelNotice, RuleLevelNormal, "osc"),
-			result.I18nAuditResultInfo[i18nPkg.DefaultLang].Message)
+			resultMessage)
 		if match {
-			message = result.I18nAuditResultInfo[i18nPkg.DefaultLang].Message
+			message = resultMessage
 		} else {
-			message = fmt.Sprintf("[%s]%s", result.Level, result.I18nAuditResultInfo[i18nPkg.DefaultLang].Message)
+			message = fmt.Sprintf("[%s]%s", result.Level, resultMessage)
 		}
 		messages = append(messages, message)
 	}
@@ -255,6 +266,7 @@ func (rs *AuditResults) AddResultWithError(level RuleLevel, ruleName, errorMsg s
 			// 审核结果规则存在则更新
 			if v.RuleName == ruleName {
 				v.Level = level
+				v.ResolvedAuditResultInfo = nil
 				for langTag, msg := range i18nMsgPattern {
 					if len(args) > 0 {
 						msg = fmt.Sprintf(msg, args...)
@@ -288,6 +300,36 @@ func (rs *AuditResults) AddResultWithError(level RuleLevel, ruleName, errorMsg s
 	rs.Results = append(rs.Results, ar)
 }
 
+// Merge adds the results of other to rs, the result of the rule which is already in rs
+// is updated like AddResultWithError.
+func (rs *AuditResults) Merge(other *AuditResults) {
+	if other == nil || len(other.Results) == 0 {
+		return
+	}
+	defer rs.SortByLevel()
+
+	for _, result := range other.Results {
+		var exist *AuditResult
+		if result.RuleName != "" {
+			for _, v := range rs.Results {
+				if v.RuleName == result.RuleName {
+					exist = v
+					break
+				}
+			}
+		}
+		if exist == nil {
+			rs.Results = append(rs.Results, result)
+			continue
+		}
+		exist.Level = result.Level
+		exist.ResolvedAuditResultInfo = nil
+		for langTag, info := range result.I18nAuditResultInfo {
+			exist.I18nAuditResultInfo[langTag] = info
+		}
+	}
+}
+
 func (rs *AuditResults) SortByLevel() {
 	sort.Slice(rs.Results, func(i, j int) bool {
 		return rs.Results[i].Level.More(rs.Results[j].Level)
@@ -298,6 +340,136 @@ func (rs *AuditResults) HasResult() bool {
 	return len(rs.Results) != 0
 }
 
+// GetAuditResultInfoByLangTag if the lang not exists, return DefaultLang
+func (r *AuditResult) GetAuditResultInfoByLangTag(lang language.Tag) AuditResultInfo {
+	if info, ok := r.I18nAuditResultInfo[lang]; ok {
+		return info
+	}
+	return r.I18nAuditResultInfo[i18nPkg.DefaultLang]
+}
+
+// GetResolvedAuditResultInfo returns the info resolved by AuditResults.ResolveLang, or the
+// info in DefaultLang if the result is not resolved.
+func (r *AuditResult) GetResolvedAuditResultInfo() AuditResultInfo {
+	if r.ResolvedAuditResultInfo != nil {
+		return *r.ResolvedAuditResultInfo
+	}
+	return r.GetAuditResultInfoByLangTag(i18nPkg.DefaultLang)
+}
+
+// ResolveLang resolves the info of each result to the lang, it falls back to DefaultLang
+// like GetAuditResultInfoByLangTag. The resolved info is returned by GetResolvedAuditResultInfo
+// and Message, and I18nAuditResultInfo still keeps the info in all languages.
+func (rs *AuditResults) ResolveLang(lang language.Tag) {
+	for _, result := range rs.Results {
+		info := result.GetAuditResultInfoByLangTag(lang)
+		result.ResolvedAuditResultInfo = &info
+	}
+}
+
+type auditLocaleCtxKey struct{}
+
+// WithAuditLocale returns a copy of ctx which requests the audit messages to be resolved
+// to the lang, see Config.Locale.
+func WithAuditLocale(ctx context.Context, lang language.Tag) context.Context {
+	return context.WithValue(ctx, auditLocaleCtxKey{}, lang)
+}
+
+// AuditLocaleFromContext returns the locale set by WithAuditLocale, it returns false if
+// the locale is not set or is language.Und.
+func AuditLocaleFromContext(ctx context.Context) (language.Tag, bool) {
+	lang, ok := ctx.Value(auditLocaleCtxKey{}).(language.Tag)
+	if !ok || lang == language.Und {
+		return language.Und, false
+	}
+	return lang, true
+}
+
+// AuditResultsJSONVersion is the version of the JSON payload produced by AuditResults.MarshalJSONByLangTag
+// and MarshalNodesAuditResultsJSON. It is increased when a field is removed or its meaning changes,
+// adding a field does not change the version.
+const AuditResultsJSONVersion = 1
+
+type AuditResultJSON struct {
+	RuleName        string    `json:"rule_name"`
+	Level           RuleLevel `json:"level"`
+	Message         string    `json:"message"`
+	ErrorInfo       string    `json:"error_info"`
+	ExecutionFailed bool      `json:"execution_failed"`
+}
+
+type AuditResultsJSON struct {
+	Version int               `json:"version"`
+	Lang    string            `json:"lang"`
+	Level   RuleLevel         `json:"level"`
+	Results []AuditResultJSON `json:"results"`
+}
+
+type NodeAuditResultsJSON struct {
+	Fingerprint string            `json:"fingerprint"`
+	StartLine   uint64            `json:"start_line"`
+	Level       RuleLevel         `json:"level"`
+	Results     []AuditResultJSON `json:"results"`
+}
+
+type NodesAuditResultsJSON struct {
+	Version    int                    `json:"version"`
+	Lang       string                 `json:"lang"`
+	Statements []NodeAuditResultsJSON `json:"statements"`
+}
+
+func (rs *AuditResults) resultsJSONByLangTag(lang language.Tag) []AuditResultJSON {
+	results := make([]AuditResultJSON, 0, len(rs.Results))
+	for _, result := range rs.Results {
+		info := result.GetAuditResultInfoByLangTag(lang)
+		results = append(results, AuditResultJSON{
+			RuleName:        result.RuleName,
+			Level:           result.Level,
+			Message:         info.Message,
+			ErrorInfo:       info.ErrorInfo,
+			ExecutionFailed: result.ExecutionFailed,
+		})
+	}
+	return results
+}
+
+// MarshalJSONByLangTag serializes the audit results to the versioned JSON payload AuditResultsJSON,
+// the messages are in the lang, or in DefaultLang if the lang not exists.
+func (rs *AuditResults) MarshalJSONByLangTag(lang language.Tag) ([]byte, error) {
+	return json.Marshal(AuditResultsJSON{
+		Version: AuditResultsJSONVersion,
+		Lang:    lang.String(),
+		Level:   rs.Level(),
+		Results: rs.resultsJSONByLangTag(lang),
+	})
+}
+
+// MarshalNodesAuditResultsJSON serializes the audit results of the nodes to the versioned JSON payload
+// NodesAuditResultsJSON, results[i] is the audit results of nodes[i] as returned by Driver.Audit.
+func MarshalNodesAuditResultsJSON(nodes []Node, results []*AuditResults, lang language.Tag) ([]byte, error) {
+	if len(nodes) != len(results) {
+		return nil, fmt.Errorf("the number of nodes (%d) does not match the number of audit results (%d)", len(nodes), len(results))
+	}
+	statements := make([]NodeAuditResultsJSON, 0, len(nodes))
+	for i, node := range nodes {
+		rs := results[i]
+		if rs == nil {
+			rs = NewAuditResults()
+		}
+		statements = append(statements, NodeAuditResultsJSON{
+			Fingerprint: node.Fingerprint,
+			StartLine:   node.StartLine,
+			Level:       rs.Level(),
+			Results:     rs.resultsJSONByLangTag(lang),
+		})
+	}
+	return json.Marshal(NodesAuditResultsJSON{
+		Version:    AuditResultsJSONVersion,
+		Lang:       lang.String(),
+		Statements: statements,
+	})
+}
+
 type QueryConf struct {
 	TimeOutSecond uint32
 }
@@ -393,11 +565,18 @@ type IndexesInfo struct {
 	TabularData
 }
 
+// StatisticsInfo is the statistics of index columns, e.g. cardinality and selectivity.
+type StatisticsInfo struct {
+	TabularData
+}
+
 type TableMeta struct {
 	ColumnsInfo    ColumnsInfo
 	IndexesInfo    IndexesInfo
 	CreateTableSQL string
 	Message        string
+	// StatisticsInfo is only filled when it is requested.
+	StatisticsInfo StatisticsInfo
 }
 
 type Table struct {
@@ -408,8 +587,27 @@ type Table struct {
 type EstimatedAffectRows struct {
 	Count      int64
 	ErrMessage string
+	// I18nNote explains how the Count is estimated, e.g. the Count is an upper bound.
+	I18nNote i18nPkg.I18nStr
+	// Method is the method used to get the Count.
+	Method AffectRowsMethod
 }
 
+// AffectRowsMethod is the method used to estimate the affected rows.
+type AffectRowsMethod string
+
+const (
+	// AffectRowsMethodStatement means the rows are counted from the statement, e.g. the values of INSERT.
+	AffectRowsMethodStatement AffectRowsMethod = "statement"
+	// AffectRowsMethodCount means the rows are counted by executing SELECT COUNT(*).
+	AffectRowsMethodCount AffectRowsMethod = "count"
+	// AffectRowsMethodExplain means the rows are estimated by the rows of EXPLAIN.
+	AffectRowsMethodExplain AffectRowsMethod = "explain"
+	// AffectRowsMethodStatistics means the rows are estimated by the table rows and the index
+	// statistics in information_schema.
+	AffectRowsMethodStatistics AffectRowsMethod = "statistics"
+)
+
 type KillProcessInfo struct {
 	ErrMessage string
 }
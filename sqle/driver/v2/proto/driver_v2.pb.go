@@ -1823,8 +1823,10 @@ func (m *EstimateSQLAffectRowsRequest) GetSql() *AffectRowsSQL {
 }
 
 type EstimateSQLAffectRowsResponse struct {
-	Count      int64  `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
-	ErrMessage string `protobuf:"bytes,2,opt,name=errMessage" json:"errMessage,omitempty"`
+	Count      int64             `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+	ErrMessage string            `protobuf:"bytes,2,opt,name=errMessage" json:"errMessage,omitempty"`
+	I18NNote   map[string]string `protobuf:"bytes,3,rep,name=i18nNote" json:"i18nNote,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Method     string            `protobuf:"bytes,4,opt,name=method" json:"method,omitempty"`
 }
 
 func (m *EstimateSQLAffectRowsResponse) Reset()                    { *m = EstimateSQLAffectRowsResponse{} }
@@ -1846,6 +1848,20 @@ func (m *EstimateSQLAffectRowsResponse) GetErrMessage() string {
 	return ""
 }
 
+func (m *EstimateSQLAffectRowsResponse) GetI18NNote() map[string]string {
+	if m != nil {
+		return m.I18NNote
+	}
+	return nil
+}
+
+func (m *EstimateSQLAffectRowsResponse) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
 type KillProcessResponse struct {
 	ErrMessage string `protobuf:"bytes,1,opt,name=errMessage" json:"errMessage,omitempty"`
 }
@@ -2949,182 +2965,184 @@ var _Driver_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("driver_v2.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0xdb, 0x72, 0xdb, 0xc6,
-	0x35, 0xe0, 0x4d, 0xe4, 0xe1, 0xc5, 0xf0, 0x4a, 0x8a, 0x69, 0x46, 0x76, 0x94, 0xf5, 0x25, 0xaa,
-	0x93, 0xc8, 0x8e, 0xdc, 0xe6, 0x62, 0x77, 0x1a, 0xdb, 0x92, 0x12, 0x2b, 0xb6, 0x64, 0x79, 0xa9,
-	0xfa, 0x21, 0x33, 0x19, 0x07, 0x22, 0x96, 0x0a, 0x12, 0x10, 0xa0, 0x00, 0x50, 0x97, 0xf7, 0xce,
-	0xb4, 0x8f, 0xcd, 0x0f, 0xf4, 0x1b, 0xf2, 0x92, 0x76, 0xa6, 0x33, 0xfd, 0x85, 0xce, 0xf4, 0x3b,
-	0xfa, 0xd2, 0x4f, 0xe8, 0xec, 0x05, 0xc0, 0x2e, 0x00, 0xca, 0x12, 0x67, 0xf2, 0x44, 0xe0, 0xdc,
-	0xcf, 0xd9, 0xb3, 0xe7, 0xec, 0x1e, 0x10, 0x2e, 0xd9, 0x81, 0x73, 0x44, 0x83, 0xd7, 0x47, 0x6b,
-	0xab, 0xe3, 0xc0, 0x8f, 0x7c, 0x34, 0xc7, 0x7f, 0x5e, 0xad, 0xe1, 0x39, 0xa8, 0x6e, 0x8e, 0xc6,
-	0xd1, 0x29, 0xbe, 0x0a, 0x73, 0x7d, 0x1a, 0x86, 0x8e, 0xef, 0xa1, 0x0e, 0x94, 0x1c, 0xbb, 0x6b,
-	0x2c, 0x1b, 0x2b, 0x0d, 0x52, 0x72, 0x6c, 0xfc, 0xb3, 0x01, 0x8d, 0x27, 0xd6, 0xe0, 0xc7, 0xc9,
-	0x98, 0xd0, 0x43, 0x74, 0x07, 0xe6, 0x42, 0x41, 0xc8, 0x49, 0x9a, 0x6b, 0xe6, 0xaa, 0x14, 0xb6,
-	0x2a, 0x05, 0x90, 0x98, 0x00, 0x7d, 0x01, 0x9d, 0x7d, 0xce, 0xd8, 0x8f, 0x02, 0x2b, 0xa2, 0x07,
-	0xa7, 0xdd, 0xd2, 0xb2, 0xb1, 0xd2, 0x59, 0xbb, 0x92, 0xb0, 0x3c, 0xd1, 0xd0, 0x24, 0x43, 0x8e,
-	0x4c, 0x28, 0x87, 0x87, 0x6e, 0xb7, 0xcc, 0x6d, 0x61, 0x8f, 0xe8, 0x26, 0xb4, 0x05, 0xcd, 0xb6,
-	0x75, 0x42, 0xfc, 0xe3, 0xb0, 0x5b, 0x59, 0x36, 0x56, 0x2a, 0x44, 0x07, 0xe2, 0x17, 0xa9, 0xc5,
-	0x21, 0x5a, 0x82, 0x86, 0x14, 0x7b, 0xe8, 0x76, 0x8d, 0xe5, 0xf2, 0x4a, 0x83, 0xa4, 0x00, 0x26,
-	0x90, 0x9e, 0xd0, 0xc1, 0x24, 0xa2, 0x84, 0x86, 0x13, 0x37, 0xe2, 0x26, 0x36, 0x88, 0x0e, 0xc4,
-	0xdf, 0x40, 0x8f, 0xd0, 0x81, 0x3f, 0x1a, 0x51, 0xcf, 0xce, 0xd8, 0x7c, 0xc1, 0x98, 0x48, 0x97,
-	0x4a, 0x89, 0x4b, 0xf8, 0x3f, 0xc6, 0x19, 0xc2, 0xc3, 0x82, 0x20, 0x1a, 0x17, 0x0b, 0xe2, 0x87,
-	0x70, 0x59, 0x87, 0xec, 0x39, 0x63, 0xa9, 0x3f, 0x8f, 0x40, 0xcb, 0xd0, 0x8c, 0xac, 0x7d, 0x97,
-	0x86, 0x84, 0x0e, 0x69, 0xd0, 0x2d, 0xf3, 0x78, 0xa9, 0x20, 0x84, 0xa1, 0x15, 0x0e, 0xbe, 0xa7,
-	0x23, 0x4b, 0x92, 0x54, 0x38, 0x89, 0x06, 0xc3, 0xff, 0x36, 0xa0, 0xba, 0x6b, 0x05, 0xd6, 0x88,
-	0xf9, 0xfb, 0x23, 0x3d, 0x95, 0xe9, 0xc4, 0x1e, 0xd1, 0x02, 0x54, 0x8f, 0x2c, 0x77, 0x42, 0xa5,
-	0x0d, 0xe2, 0x05, 0x21, 0xa8, 0xd8, 0x34, 0x1c, 0xc8, 0xb5, 0xe6, 0xcf, 0x0c, 0x16, 0x9d, 0x8e,
-	0x29, 0x5f, 0xe3, 0x06, 0xe1, 0xcf, 0xe8, 0x33, 0xa8, 0x3b, 0x1f, 0x7f, 0xe6, 0x6d, 0x30, 0xda,
-	0xea, 0x72, 0x79, 0xa5, 0xb9, 0xb6, 0x94, 0x04, 0x82, 0x6b, 0x5c, 0xdd, 0x92, 0xe8, 0x4d, 0x2f,
-	0x0a, 0x4e, 0x49, 0x42, 0xdd, 0x7b, 0x08, 0x6d, 0x0d, 0x75, 0x5e, 0xd3, 0x1e, 0x94, 0x3e, 0x33,
-	0xf0, 0x2f, 0x06, 0x94, 0x37, 0xfa, 0x3b, 0xcc, 0xa4, 0xef, 0xfd, 0x30, 0x92, 0x4c, 0xfc, 0x99,
-	0xc1, 0xc6, 0x7e, 0x10, 0x67, 0x0e, 0x7f, 0x66, 0xb0, 0x49, 0xc8, 0xe3, 0xc7, 0x61, 0xec, 0x19,
-	0xf5, 0xa0, 0x3e, 0xb6, 0xc2, 0xf0, 0xd8, 0x0f, 0x6c, 0xe9, 0x52, 0xf2, 0xce, 0x70, 0xb6, 0x15,
-	0x59, 0xfb, 0x56, 0x48, 0xbb, 0x55, 0x81, 0x8b, 0xdf, 0xd1, 0x03, 0x30, 0x2d, 0xdb, 0x76, 0x22,
-	0xc7, 0xf7, 0x2c, 0x97, 0xfb, 0x18, 0x76, 0x6b, 0xdc, 0xf5, 0x8e, 0xee, 0x3a, 0xc9, 0xd1, 0xe1,
-	0x9f, 0xca, 0x50, 0x21, 0x13, 0x97, 0xc7, 0xd7, 0xb3, 0x46, 0x34, 0x36, 0x9c, 0x3d, 0x27, 0x31,
-	0x2f, 0x29, 0x31, 0x5f, 0x80, 0xaa, 0x4b, 0x8f, 0x68, 0xbc, 0xe9, 0xc4, 0x0b, 0x33, 0x6f, 0xc0,
-	0x52, 0xc4, 0x0f, 0x4e, 0x63, 0xd3, 0xe3, 0x77, 0x74, 0x1b, 0x6a, 0x63, 0x61, 0x54, 0xb5, 0xd0,
-	0x28, 0x89, 0x45, 0xd7, 0x01, 0x2c, 0xcf, 0xf3, 0x23, 0x8b, 0x19, 0xd8, 0xad, 0x71, 0x29, 0x0a,
-	0x04, 0xdd, 0x83, 0xc6, 0x8f, 0x9e, 0x7f, 0xec, 0x52, 0xfb, 0x80, 0x76, 0xe7, 0xf8, 0x3e, 0x42,
-	0x89, 0xa8, 0x67, 0x31, 0x86, 0xa4, 0x44, 0x68, 0x1d, 0x5a, 0x6c, 0x75, 0x99, 0x7f, 0x5b, 0xde,
-	0xd0, 0xef, 0xd6, 0xb9, 0xfe, 0x77, 0x13, 0x26, 0x86, 0xe0, 0xe9, 0x10, 0x53, 0x88, 0x94, 0xd0,
-	0x98, 0x50, 0x17, 0xe6, 0x8e, 0x68, 0xc0, 0x37, 0x6f, 0x63, 0xd9, 0x58, 0x69, 0x93, 0xf8, 0xb5,
-	0xf7, 0x0a, 0x2e, 0xe7, 0x98, 0x0b, 0x92, 0xe6, 0x03, 0x35, 0x69, 0x9a, 0x6b, 0x8b, 0x89, 0x7a,
-	0x95, 0x59, 0xcd, 0xa5, 0xbf, 0x1a, 0xd0, 0x52, 0x71, 0xc9, 0x3a, 0x18, 0xca, 0x3a, 0xa8, 0x11,
-	0x2f, 0x65, 0x22, 0xae, 0x47, 0xb2, 0x7c, 0x76, 0x24, 0x2b, 0xe7, 0x88, 0x24, 0xbe, 0x05, 0x8d,
-	0x04, 0xce, 0x22, 0x32, 0xf0, 0xbd, 0x88, 0x7a, 0x71, 0x9a, 0xc7, 0xaf, 0xf8, 0x97, 0x12, 0xb4,
-	0xb7, 0x69, 0xc4, 0x76, 0x79, 0x38, 0xf6, 0xbd, 0x90, 0x32, 0x53, 0xc6, 0xee, 0xe4, 0xc0, 0xf1,
-	0x76, 0xd2, 0xe4, 0x52, 0x20, 0xe8, 0x1e, 0xcc, 0xc7, 0x79, 0xbc, 0x41, 0x87, 0xd6, 0xc4, 0x8d,
-	0x76, 0xe3, 0xad, 0x52, 0x26, 0x45, 0x28, 0xf4, 0x35, 0x74, 0x63, 0xf0, 0xe3, 0x6c, 0xd6, 0x97,
-	0x0b, 0x13, 0x6c, 0x2a, 0x3d, 0xba, 0x01, 0xd5, 0x60, 0xe2, 0xd2, 0x90, 0xd7, 0xa8, 0xe6, 0x5a,
-	0x5b, 0xcb, 0x0c, 0x22, 0x70, 0x68, 0x1b, 0x16, 0xa9, 0xc7, 0xea, 0x9b, 0xfd, 0x62, 0x2c, 0xb8,
-	0xb7, 0x7d, 0x7b, 0xe2, 0x52, 0x9e, 0xce, 0x6a, 0x9d, 0xd5, 0xd1, 0xa4, 0x98, 0x8b, 0x2d, 0xa6,
-	0xeb, 0x1f, 0xf8, 0x3c, 0xc1, 0x5b, 0x84, 0x3f, 0x63, 0x02, 0xcd, 0x2d, 0xcf, 0x89, 0x08, 0x3d,
-	0x9c, 0xd0, 0x30, 0x42, 0xd7, 0xa1, 0x6c, 0x87, 0x71, 0xaf, 0x68, 0x25, 0xf2, 0x37, 0xfa, 0x3b,
-	0x84, 0x21, 0x52, 0xb3, 0x4b, 0xd3, 0xcd, 0xc6, 0x0f, 0xa0, 0x25, 0x64, 0xca, 0x95, 0xb8, 0x40,
-	0x13, 0x62, 0xbc, 0xeb, 0xae, 0x1f, 0xd2, 0xd8, 0xa0, 0x8b, 0xf0, 0x3e, 0x02, 0xf4, 0xcc, 0x71,
-	0xdd, 0xdd, 0xc0, 0x1f, 0xd0, 0x30, 0x9c, 0x45, 0xc2, 0x7b, 0xd0, 0xd8, 0xb5, 0x82, 0x90, 0xda,
-	0xfd, 0x97, 0xcf, 0x59, 0xbd, 0x39, 0x9c, 0xd0, 0x20, 0xde, 0x51, 0xe2, 0x05, 0x7f, 0x07, 0x2d,
-	0x4e, 0x32, 0x83, 0x78, 0x74, 0x33, 0xed, 0xb0, 0x6a, 0xde, 0x27, 0x2a, 0x45, 0xd7, 0xfd, 0x8b,
-	0x01, 0x95, 0x1d, 0xdf, 0xe6, 0xeb, 0x15, 0xd1, 0x93, 0xa4, 0xa2, 0xb3, 0xe7, 0xa4, 0xf1, 0x94,
-	0x94, 0xc6, 0xb3, 0x0c, 0xcd, 0xa1, 0xe3, 0x1d, 0xd0, 0x60, 0x1c, 0x38, 0x5e, 0x24, 0x77, 0x9d,
-	0x0a, 0x62, 0x07, 0x8d, 0x30, 0xb2, 0x82, 0xe8, 0xb9, 0xe3, 0x51, 0x79, 0x2e, 0x49, 0x01, 0x6c,
-	0x57, 0xed, 0x5b, 0xd1, 0xe0, 0xfb, 0x2d, 0x9b, 0x17, 0xf8, 0x0a, 0x89, 0x5f, 0xf1, 0x6f, 0xa1,
-	0x2d, 0x9d, 0x95, 0x4b, 0x79, 0x03, 0xaa, 0x9e, 0x6f, 0xd3, 0x90, 0x9f, 0x56, 0xd4, 0xf5, 0x67,
-	0x06, 0x13, 0x81, 0xc3, 0xcb, 0x50, 0x7f, 0x3c, 0xb1, 0x9d, 0x68, 0x7a, 0x10, 0x2d, 0x68, 0x71,
-	0x8a, 0x59, 0x82, 0x78, 0x0b, 0x2a, 0xe1, 0xa1, 0x1b, 0x67, 0xe0, 0xe5, 0x84, 0x30, 0x56, 0x49,
-	0x38, 0x1a, 0xef, 0xc0, 0x3c, 0xab, 0x64, 0x52, 0x0d, 0x3b, 0x2a, 0xc5, 0x35, 0x75, 0x44, 0xc3,
-	0xd0, 0x3a, 0x88, 0x4b, 0x42, 0xfc, 0x8a, 0xae, 0x01, 0xd0, 0x20, 0xf0, 0x83, 0xd7, 0x0e, 0x2b,
-	0xd8, 0x22, 0xbe, 0x0d, 0x0e, 0x61, 0x8c, 0xf8, 0xbf, 0x25, 0x68, 0x2a, 0xc2, 0xce, 0x10, 0x94,
-	0xf4, 0xa9, 0x92, 0xda, 0xa7, 0xde, 0x81, 0x06, 0xdb, 0x1d, 0xaf, 0x79, 0xab, 0x13, 0x4b, 0x54,
-	0x67, 0x00, 0x5e, 0x8b, 0x5e, 0xc3, 0xbc, 0x93, 0x37, 0x56, 0xd6, 0x86, 0x8f, 0x74, 0x17, 0x05,
-	0x7e, 0xb5, 0xc0, 0x39, 0xd1, 0x43, 0x8a, 0x24, 0xa1, 0xdf, 0x80, 0x29, 0x8e, 0x8d, 0x8e, 0xef,
-	0xbd, 0x1e, 0x5a, 0x8e, 0x4b, 0xc5, 0x5a, 0xd7, 0xc9, 0xa5, 0x04, 0xfe, 0x25, 0x07, 0x67, 0xe2,
-	0x50, 0xcb, 0xc4, 0xa1, 0x67, 0x43, 0x77, 0x9a, 0xea, 0x82, 0x0e, 0xb4, 0xa6, 0x77, 0xa0, 0x25,
-	0xad, 0x03, 0x65, 0x64, 0xa8, 0x8d, 0xe8, 0x0f, 0x49, 0x82, 0x30, 0x6c, 0x88, 0x56, 0x61, 0x2e,
-	0x10, 0x8f, 0x32, 0xf3, 0x16, 0x8a, 0x82, 0x42, 0x62, 0x22, 0xfc, 0x35, 0xb4, 0x63, 0xb8, 0x48,
-	0xdc, 0xcf, 0xa1, 0x65, 0x29, 0x02, 0xa5, 0x94, 0xc5, 0x22, 0x29, 0x21, 0xd1, 0x48, 0xf1, 0xfb,
-	0x70, 0x69, 0x87, 0x52, 0x9b, 0xf8, 0xae, 0xcb, 0x0e, 0xa5, 0xd3, 0xb3, 0xda, 0x87, 0xc5, 0xaf,
-	0xa8, 0xa7, 0xd0, 0xcd, 0x92, 0xde, 0x77, 0xd4, 0x1a, 0xd1, 0x4d, 0xf7, 0x97, 0x6e, 0x81, 0xa8,
-	0x14, 0x77, 0x45, 0x8e, 0x2b, 0xf0, 0xb3, 0x73, 0x1c, 0xff, 0xa9, 0x04, 0xcd, 0x37, 0xfa, 0xa1,
-	0xf2, 0x97, 0xf4, 0xd4, 0x96, 0x79, 0x9a, 0x51, 0x28, 0x9b, 0x5f, 0x9a, 0xa7, 0x0a, 0x7e, 0xb5,
-	0xc0, 0x40, 0x25, 0x4f, 0x33, 0x98, 0x38, 0xbb, 0x8a, 0x18, 0x2e, 0x9a, 0x5d, 0x19, 0x19, 0x6a,
-	0x76, 0x3d, 0x82, 0xb7, 0xb3, 0x0b, 0x25, 0xd3, 0xe4, 0xb6, 0x88, 0xbe, 0x58, 0xa5, 0x85, 0x22,
-	0x87, 0x44, 0xe4, 0x3f, 0x87, 0xe6, 0xae, 0xe3, 0x1d, 0xcc, 0xd2, 0x63, 0xde, 0x85, 0xb9, 0xcd,
-	0x13, 0x3a, 0x98, 0x9e, 0x46, 0xdf, 0x42, 0x93, 0x11, 0xcc, 0x92, 0x3c, 0x58, 0x4d, 0x9e, 0x94,
-	0x4e, 0xea, 0x13, 0xa6, 0xff, 0x6c, 0x00, 0x08, 0xf9, 0xbc, 0x8e, 0x61, 0x68, 0xb9, 0x56, 0x18,
-	0x6d, 0x79, 0x21, 0x0d, 0xa2, 0x2d, 0x71, 0xbb, 0x2e, 0x13, 0x0d, 0xc6, 0xee, 0x69, 0xea, 0xfb,
-	0x26, 0x2b, 0x06, 0xf1, 0x3d, 0x2d, 0x87, 0x60, 0x12, 0x03, 0xff, 0x38, 0x7c, 0x3c, 0x1c, 0xd2,
-	0x41, 0x44, 0x6d, 0x5e, 0xec, 0xca, 0x44, 0x83, 0x31, 0x89, 0xea, 0xbb, 0x90, 0x28, 0x8e, 0xef,
-	0x79, 0x04, 0xb6, 0xc1, 0x64, 0x16, 0x3f, 0x61, 0x5d, 0x69, 0xb6, 0xbe, 0xab, 0xb6, 0x8c, 0x7c,
-	0x5c, 0x44, 0xc7, 0x78, 0x04, 0x97, 0x14, 0x2d, 0x3c, 0x38, 0x1f, 0x65, 0xcb, 0xce, 0xbc, 0xc6,
-	0x9b, 0xad, 0x3a, 0x0f, 0xa1, 0x25, 0xc1, 0x22, 0x9b, 0x3e, 0x80, 0x9a, 0x40, 0x49, 0x13, 0x0b,
-	0xb9, 0x25, 0x09, 0xfe, 0x16, 0x1a, 0x7b, 0x27, 0xbf, 0x9e, 0x77, 0x0f, 0x01, 0x98, 0x78, 0x69,
-	0xd9, 0x05, 0x1d, 0x5b, 0x86, 0xfa, 0x4b, 0x96, 0x9b, 0xd3, 0x93, 0xf6, 0x63, 0x68, 0x70, 0x8a,
-	0x75, 0xdf, 0x1b, 0xa2, 0x9b, 0xd0, 0x8e, 0x9c, 0x11, 0xf5, 0x27, 0x51, 0x9f, 0x0e, 0x7c, 0x4f,
-	0x24, 0x55, 0x9b, 0xe8, 0x40, 0xfc, 0x67, 0x03, 0x5a, 0x9c, 0x67, 0x16, 0xa7, 0x6f, 0xa8, 0x99,
-	0x9e, 0x1e, 0x02, 0x62, 0x2b, 0xc5, 0x48, 0xe6, 0x36, 0x54, 0x06, 0xbe, 0x37, 0xe4, 0x19, 0xa8,
-	0x1e, 0xb8, 0x12, 0x4b, 0x09, 0xc7, 0x63, 0x1b, 0xda, 0xd2, 0x90, 0xa4, 0x0c, 0xd4, 0x06, 0xbe,
-	0x3b, 0x19, 0x79, 0x32, 0x3a, 0xb9, 0x8b, 0xa3, 0xc0, 0xa2, 0x0f, 0xa0, 0xc2, 0xb2, 0x55, 0x86,
-	0xfe, 0x8a, 0xae, 0x40, 0x06, 0xd1, 0x3f, 0x26, 0x9c, 0x08, 0xaf, 0x43, 0x47, 0x87, 0xa3, 0x8f,
-	0xa1, 0xc6, 0x8b, 0x52, 0xbc, 0x08, 0x57, 0x8b, 0x04, 0xbc, 0x62, 0x14, 0x44, 0x12, 0xe2, 0x15,
-	0x30, 0xb3, 0xb8, 0x74, 0x36, 0x60, 0x28, 0xb3, 0x01, 0x8c, 0xd9, 0x36, 0x1f, 0xbb, 0x96, 0xe3,
-	0x4d, 0x5f, 0xb5, 0x01, 0x74, 0x24, 0xcd, 0x6c, 0x27, 0x31, 0x65, 0x0d, 0xd4, 0x04, 0x8a, 0xb5,
-	0x8a, 0x82, 0xf3, 0x8a, 0xed, 0x2b, 0xa9, 0x44, 0xc6, 0x77, 0x1d, 0xda, 0x03, 0xd7, 0x0a, 0x43,
-	0x47, 0x66, 0x9a, 0xd4, 0x75, 0x2d, 0x2b, 0x63, 0x5d, 0x25, 0x22, 0x3a, 0x0f, 0x7e, 0x04, 0x0b,
-	0x45, 0x64, 0x68, 0x05, 0x2a, 0xec, 0xda, 0x95, 0x2b, 0xe2, 0x7b, 0xd6, 0xfe, 0xc4, 0xb5, 0x82,
-	0x0d, 0x2b, 0xb2, 0x08, 0xa7, 0xc0, 0x8f, 0x61, 0xfe, 0x2b, 0x1a, 0x6d, 0xc8, 0x3b, 0xda, 0x4c,
-	0x37, 0x86, 0xeb, 0x50, 0x8f, 0xf9, 0x8b, 0x06, 0x19, 0xf8, 0x2b, 0x58, 0xd0, 0x55, 0xc8, 0x08,
-	0xdc, 0x85, 0x46, 0x7c, 0x37, 0x8c, 0x57, 0x3f, 0xcd, 0xe2, 0x98, 0x9c, 0xa4, 0x34, 0xf8, 0x3e,
-	0x54, 0xf7, 0xd8, 0xa5, 0xae, 0x70, 0x5c, 0xf2, 0x36, 0xd4, 0xc4, 0x90, 0x4b, 0x56, 0x65, 0xf9,
-	0x86, 0x0f, 0xb8, 0x83, 0x9c, 0x8f, 0x5d, 0x8e, 0x67, 0xab, 0x2e, 0x55, 0x3e, 0x62, 0x93, 0xcb,
-	0xdc, 0x51, 0xc3, 0xc9, 0xae, 0x7c, 0x1c, 0x89, 0x9f, 0x72, 0x37, 0x15, 0x45, 0xd2, 0xcd, 0x7b,
-	0xd0, 0x88, 0x62, 0xa0, 0xd4, 0x85, 0x74, 0x09, 0x9c, 0x3c, 0x25, 0xc2, 0xff, 0x32, 0xa0, 0x91,
-	0x20, 0xd0, 0x27, 0xd0, 0x14, 0x5b, 0x2d, 0xe4, 0x07, 0x8d, 0xec, 0x92, 0xae, 0xa7, 0x38, 0xa2,
-	0x12, 0x32, 0x3e, 0xc7, 0xb3, 0xe9, 0x09, 0x15, 0x7c, 0xa5, 0x0c, 0xdf, 0x56, 0x8a, 0x23, 0x2a,
-	0x21, 0xba, 0x0d, 0x9d, 0x41, 0x40, 0xad, 0x88, 0x72, 0x13, 0xfa, 0x2f, 0x9f, 0xcb, 0xa3, 0x7a,
-	0x06, 0xaa, 0x1e, 0x91, 0x2a, 0xfa, 0x11, 0xeb, 0x53, 0x68, 0x2a, 0x56, 0x5d, 0x20, 0x19, 0x3f,
-	0x65, 0x37, 0xf1, 0xd4, 0x92, 0xf3, 0x33, 0xfe, 0xd3, 0x80, 0x4b, 0x0a, 0xf4, 0x29, 0xb5, 0xec,
-	0x73, 0xcf, 0xd4, 0x9e, 0x28, 0x33, 0x4b, 0x71, 0x8a, 0xbb, 0x5d, 0xa4, 0x89, 0xc9, 0xfc, 0x75,
-	0xa6, 0x97, 0xef, 0x6b, 0xb6, 0x13, 0xff, 0x38, 0x64, 0xc4, 0x4e, 0x44, 0x47, 0xa1, 0x9c, 0x88,
-	0x8b, 0x17, 0xec, 0x43, 0x53, 0x21, 0x44, 0x6b, 0x30, 0x27, 0xd7, 0x5b, 0xee, 0x9e, 0xee, 0x34,
-	0xbb, 0x49, 0x4c, 0x88, 0x3e, 0xd4, 0xaa, 0x75, 0x21, 0x03, 0x33, 0x40, 0x96, 0xeb, 0x9b, 0xac,
-	0x99, 0x47, 0x81, 0xc5, 0x8e, 0x21, 0xd3, 0x2b, 0xe8, 0x21, 0xf4, 0x24, 0x15, 0xcf, 0x8d, 0x2f,
-	0x03, 0x7f, 0x34, 0xe3, 0xc1, 0xff, 0x7d, 0xb5, 0x9a, 0x2e, 0x2a, 0x95, 0x30, 0xb5, 0x41, 0xd4,
-	0xd3, 0x4d, 0x78, 0xa7, 0x50, 0x65, 0xda, 0xbb, 0xc4, 0x4c, 0x3c, 0xd7, 0xbb, 0xc4, 0x8e, 0x95,
-	0x58, 0x7c, 0x0b, 0xda, 0xe2, 0x94, 0xc5, 0x7c, 0x9e, 0xee, 0x60, 0x04, 0x4b, 0x9b, 0x61, 0xe4,
-	0x8c, 0xac, 0x88, 0x25, 0x7e, 0xca, 0x31, 0x8b, 0x8b, 0x2b, 0xaa, 0x8b, 0x6f, 0xa7, 0x77, 0x2f,
-	0xd5, 0x0c, 0xe1, 0xe3, 0x1f, 0xe1, 0xda, 0x14, 0xad, 0xd2, 0xcb, 0x05, 0xa8, 0x0e, 0xfc, 0x89,
-	0x9c, 0x03, 0x96, 0x89, 0x78, 0x41, 0xd7, 0xf9, 0xdd, 0x75, 0x5b, 0xbb, 0xbc, 0x28, 0x10, 0xfc,
-	0x3b, 0x98, 0xd7, 0x26, 0x44, 0xe9, 0xa8, 0x50, 0x61, 0x33, 0x72, 0x6c, 0x3f, 0x19, 0x70, 0x35,
-	0xae, 0xc9, 0x2f, 0xf6, 0x7f, 0xa0, 0x03, 0x71, 0x5f, 0x9d, 0x21, 0x02, 0x4f, 0xe1, 0xb2, 0x2c,
-	0xe9, 0x7d, 0x5e, 0xa1, 0x65, 0x75, 0x62, 0xeb, 0xd4, 0xcb, 0x96, 0xff, 0x94, 0x82, 0xe4, 0x99,
-	0x70, 0x04, 0x97, 0x73, 0x74, 0xcc, 0x11, 0x51, 0xf9, 0xd5, 0x99, 0x67, 0x0a, 0x41, 0x5f, 0x40,
-	0xc7, 0xd6, 0xfc, 0xc8, 0x9d, 0x5c, 0x74, 0x37, 0x49, 0x86, 0x1c, 0xef, 0x42, 0x47, 0xa7, 0x60,
-	0x2a, 0x7d, 0xfe, 0xa4, 0xaa, 0x4c, 0x21, 0x29, 0x7e, 0x2f, 0x1d, 0x5b, 0x29, 0x10, 0x7c, 0x08,
-	0x4b, 0xb1, 0x44, 0xe1, 0x88, 0xd4, 0x1c, 0xaf, 0xcd, 0x4b, 0x58, 0xb0, 0x0b, 0xf0, 0x32, 0xb9,
-	0xaf, 0xe5, 0x0c, 0xd7, 0x84, 0x14, 0xb2, 0xe2, 0xbf, 0x19, 0xb0, 0x50, 0x44, 0xfe, 0xc6, 0xf0,
-	0x2d, 0x41, 0x43, 0xbc, 0x6d, 0x6c, 0x3c, 0x8f, 0x27, 0x44, 0x09, 0x40, 0x59, 0x5b, 0x19, 0x1b,
-	0x46, 0x55, 0x2e, 0x5e, 0xdb, 0x94, 0x82, 0xe4, 0x99, 0x70, 0x90, 0xac, 0x6d, 0x0a, 0x2c, 0x58,
-	0x3b, 0x91, 0x6d, 0xe7, 0x5d, 0x3b, 0x66, 0xbd, 0x9f, 0xd8, 0x25, 0xad, 0x4f, 0x00, 0xf8, 0x1f,
-	0x4a, 0x8e, 0x6f, 0x38, 0xc3, 0xe1, 0xb6, 0x6f, 0x3b, 0xc3, 0x99, 0x8e, 0xe6, 0x6b, 0xd0, 0x1e,
-	0x58, 0xae, 0xb3, 0x1f, 0x58, 0x11, 0xb5, 0x37, 0xfa, 0x3b, 0x72, 0xbf, 0xeb, 0xd3, 0x64, 0x9d,
-	0x04, 0x3d, 0x80, 0xba, 0xbf, 0xff, 0x03, 0xcb, 0xe1, 0x78, 0x94, 0x7e, 0x3d, 0xeb, 0x16, 0x33,
-	0x4a, 0xd9, 0x12, 0x09, 0x3d, 0xbb, 0xd0, 0x2e, 0x16, 0xd2, 0xb0, 0x6e, 0x9e, 0xae, 0xb1, 0xb2,
-	0xa6, 0x19, 0x28, 0x5a, 0x05, 0x34, 0xf0, 0x47, 0x63, 0x2b, 0xa0, 0xb6, 0x42, 0x2b, 0x42, 0x54,
-	0x80, 0x29, 0x58, 0x8a, 0xf2, 0xc5, 0xb6, 0x91, 0x05, 0xdd, 0x82, 0x58, 0x8b, 0x84, 0xdf, 0x04,
-	0x53, 0xe6, 0x54, 0x82, 0xc9, 0x5d, 0x0f, 0xfa, 0x19, 0x02, 0x92, 0x63, 0xc1, 0x04, 0xcc, 0x2c,
-	0xd5, 0x1b, 0xf3, 0xfb, 0x3a, 0xc0, 0x88, 0x53, 0xf6, 0x5f, 0x3e, 0x17, 0x6d, 0xb2, 0x41, 0x14,
-	0xc8, 0x9d, 0x2d, 0xe8, 0xe8, 0x5f, 0x74, 0x51, 0x1d, 0x2a, 0x3b, 0xbe, 0x47, 0xcd, 0xb7, 0x50,
-	0x07, 0x80, 0xd0, 0x23, 0x1a, 0x84, 0xb4, 0x7f, 0xe8, 0x9a, 0x06, 0xba, 0x04, 0xcd, 0x17, 0x81,
-	0x73, 0xe0, 0x78, 0x96, 0x4b, 0xfc, 0x63, 0xb3, 0x84, 0x5a, 0x50, 0xdf, 0xb6, 0xbc, 0x89, 0xe5,
-	0xba, 0xa7, 0x66, 0xf9, 0xce, 0xff, 0x0c, 0xe8, 0xe4, 0x3e, 0x4f, 0x74, 0xf4, 0xa9, 0x8c, 0xf9,
-	0x16, 0x6a, 0x40, 0x95, 0x5f, 0x77, 0x4c, 0x03, 0x35, 0x61, 0x4e, 0x1e, 0xf7, 0xcd, 0x12, 0x32,
-	0xa1, 0xa5, 0x9e, 0x37, 0xcd, 0x32, 0xba, 0x02, 0xf3, 0x05, 0x5d, 0xd1, 0xac, 0xa0, 0xab, 0xb0,
-	0x58, 0xd8, 0x4a, 0xcc, 0x2a, 0xb3, 0x51, 0x69, 0x07, 0x66, 0x0d, 0xb5, 0xa1, 0x91, 0x8c, 0x00,
-	0xcc, 0x39, 0xe6, 0x1d, 0x3b, 0xd9, 0x98, 0x75, 0xd4, 0xd5, 0x8e, 0xf1, 0xc9, 0xa6, 0x34, 0x1b,
-	0x68, 0x09, 0xba, 0x0a, 0x26, 0x0d, 0x36, 0x53, 0x0e, 0x08, 0xa0, 0x26, 0x22, 0x66, 0x36, 0xd7,
-	0xfe, 0xde, 0x84, 0xda, 0x06, 0xff, 0xbb, 0x03, 0xba, 0x0b, 0x55, 0xfe, 0xb1, 0x0a, 0xa5, 0xcd,
-	0x99, 0xff, 0xd9, 0xa1, 0x97, 0x36, 0x45, 0xfd, 0x63, 0xd6, 0x7d, 0xa8, 0x6c, 0x79, 0x4e, 0x84,
-	0xd4, 0x23, 0x6c, 0x32, 0x3e, 0xef, 0x2d, 0x66, 0xa0, 0x92, 0x69, 0x15, 0xaa, 0xfc, 0x5b, 0x0a,
-	0x4a, 0xf1, 0xea, 0xb7, 0x95, 0x5e, 0x46, 0x39, 0x7a, 0xaa, 0x85, 0x03, 0xbd, 0x93, 0x7e, 0x98,
-	0xcb, 0x7d, 0x55, 0xe9, 0x2d, 0x15, 0x23, 0xa5, 0xe6, 0x4f, 0xf8, 0x37, 0x76, 0x4d, 0xb3, 0xfa,
-	0xd1, 0x44, 0x71, 0x53, 0xff, 0xbc, 0xf0, 0x09, 0x54, 0xf9, 0x20, 0x16, 0xe5, 0x06, 0xb3, 0x59,
-	0x3e, 0x7d, 0xba, 0xfb, 0x32, 0x9b, 0x3a, 0x28, 0x2d, 0x1f, 0x85, 0x23, 0xd9, 0xde, 0xbb, 0x53,
-	0xf1, 0x52, 0xe4, 0x87, 0x50, 0xd9, 0x75, 0xbc, 0x03, 0x25, 0xe2, 0xca, 0xc0, 0x2f, 0x17, 0xba,
-	0xfb, 0x50, 0x61, 0x89, 0xa3, 0x50, 0x2b, 0x23, 0xbc, 0xde, 0x62, 0x76, 0xb8, 0x22, 0x54, 0x3c,
-	0x52, 0xb2, 0x0d, 0x5d, 0xd5, 0x68, 0xd4, 0x51, 0x57, 0xaf, 0x5b, 0x84, 0x92, 0xf3, 0xa9, 0xd2,
-	0xde, 0x09, 0x52, 0x6e, 0x54, 0xf1, 0x00, 0xa9, 0x37, 0xaf, 0xc1, 0xd2, 0xf0, 0xf2, 0xdd, 0xa4,
-	0x84, 0x57, 0x1d, 0xc0, 0x28, 0xe1, 0xd5, 0xc7, 0x21, 0xbf, 0x4f, 0xb6, 0x1e, 0xba, 0x92, 0xbd,
-	0xa2, 0x17, 0x19, 0xa9, 0x5f, 0xf6, 0x07, 0x70, 0x65, 0xca, 0x9f, 0x48, 0xd0, 0x8d, 0x74, 0xc2,
-	0x3a, 0xf5, 0x3f, 0x2c, 0xbd, 0x73, 0x10, 0x85, 0xe8, 0x5e, 0xbc, 0xd1, 0x94, 0x68, 0x24, 0x7f,
-	0x0d, 0xea, 0xe5, 0x61, 0x21, 0x7a, 0xc6, 0x4b, 0x48, 0x72, 0x33, 0x47, 0x4b, 0x4a, 0x46, 0xe4,
-	0x66, 0x02, 0xbd, 0x6b, 0x53, 0xb0, 0xd2, 0xc7, 0x67, 0x7a, 0x3d, 0xd2, 0x85, 0x65, 0xef, 0xdf,
-	0xba, 0xb0, 0xfc, 0xa5, 0xf9, 0xbb, 0xc2, 0x52, 0xa6, 0x04, 0x6b, 0xfa, 0x8d, 0xa3, 0x77, 0xf3,
-	0x6c, 0x22, 0xa9, 0x61, 0x38, 0xa5, 0x26, 0xa2, 0x5b, 0x29, 0xfb, 0x19, 0x87, 0xfe, 0xde, 0xed,
-	0x37, 0x91, 0x49, 0x3d, 0x56, 0x71, 0xd9, 0x44, 0x78, 0x4a, 0xa3, 0x54, 0x8e, 0xd5, 0xbd, 0x5b,
-	0x67, 0x1f, 0xed, 0x52, 0x15, 0x53, 0xeb, 0x6f, 0x81, 0x9a, 0xdc, 0xc9, 0xa6, 0xf7, 0xde, 0x59,
-	0x34, 0x5c, 0xc5, 0x93, 0xd6, 0x37, 0xb0, 0x7a, 0xf7, 0xa1, 0x24, 0xdb, 0xaf, 0xf1, 0x87, 0xfb,
-	0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x74, 0xc8, 0xfb, 0xbb, 0x26, 0x00, 0x00,
+	// 2860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0xdb, 0x6e, 0xdc, 0xc6,
+	0x35, 0xdc, 0x9b, 0x76, 0xcf, 0x5e, 0x44, 0x8f, 0x24, 0x9b, 0x66, 0x24, 0x47, 0x19, 0xdb, 0x8a,
+	0xea, 0x24, 0xb2, 0x23, 0xa7, 0xb9, 0xd8, 0x45, 0x6b, 0x5b, 0x52, 0x62, 0xc5, 0x96, 0x2c, 0xcd,
+	0x0a, 0x7e, 0x08, 0x10, 0x38, 0xd4, 0x72, 0x56, 0x66, 0xc2, 0x25, 0x57, 0x24, 0xd7, 0x92, 0xde,
+	0x0b, 0xb4, 0x8f, 0xcd, 0x0f, 0xf4, 0x1b, 0xf2, 0x92, 0x16, 0x28, 0xd0, 0x5f, 0x28, 0xd0, 0xef,
+	0xe8, 0x4b, 0x3f, 0xa0, 0x0f, 0xc5, 0x5c, 0x48, 0x0e, 0xb9, 0x5c, 0x59, 0x5a, 0x20, 0x4f, 0xe2,
+	0x9c, 0xfb, 0x39, 0x73, 0xe6, 0x9c, 0x99, 0xb3, 0x82, 0x59, 0x3b, 0x70, 0xde, 0xd0, 0xe0, 0xd5,
+	0x9b, 0xf5, 0xb5, 0x61, 0xe0, 0x47, 0x3e, 0x9a, 0xe1, 0x7f, 0x5e, 0xae, 0xe3, 0x19, 0xa8, 0x6e,
+	0x0d, 0x86, 0xd1, 0x19, 0xbe, 0x0e, 0x33, 0x5d, 0x1a, 0x86, 0x8e, 0xef, 0xa1, 0x0e, 0x94, 0x1c,
+	0xdb, 0xd0, 0x96, 0xb5, 0xd5, 0x06, 0x29, 0x39, 0x36, 0xfe, 0x59, 0x83, 0xc6, 0x13, 0xab, 0xf7,
+	0xe3, 0x68, 0x48, 0xe8, 0x31, 0xba, 0x03, 0x33, 0xa1, 0x20, 0xe4, 0x24, 0xcd, 0x75, 0x7d, 0x4d,
+	0x0a, 0x5b, 0x93, 0x02, 0x48, 0x4c, 0x80, 0xfe, 0x00, 0x9d, 0x43, 0xce, 0xd8, 0x8d, 0x02, 0x2b,
+	0xa2, 0x47, 0x67, 0x46, 0x69, 0x59, 0x5b, 0xed, 0xac, 0x5f, 0x4b, 0x58, 0x9e, 0x64, 0xd0, 0x24,
+	0x47, 0x8e, 0x74, 0x28, 0x87, 0xc7, 0xae, 0x51, 0xe6, 0xb6, 0xb0, 0x4f, 0x74, 0x0b, 0xda, 0x82,
+	0x66, 0xc7, 0x3a, 0x25, 0xfe, 0x49, 0x68, 0x54, 0x96, 0xb5, 0xd5, 0x0a, 0xc9, 0x02, 0xf1, 0x8b,
+	0xd4, 0xe2, 0x10, 0x2d, 0x42, 0x43, 0x8a, 0x3d, 0x76, 0x0d, 0x6d, 0xb9, 0xbc, 0xda, 0x20, 0x29,
+	0x80, 0x09, 0xa4, 0xa7, 0xb4, 0x37, 0x8a, 0x28, 0xa1, 0xe1, 0xc8, 0x8d, 0xb8, 0x89, 0x0d, 0x92,
+	0x05, 0xe2, 0x6f, 0xc1, 0x24, 0xb4, 0xe7, 0x0f, 0x06, 0xd4, 0xb3, 0x73, 0x36, 0x5f, 0x32, 0x26,
+	0xd2, 0xa5, 0x52, 0xe2, 0x12, 0xfe, 0xb7, 0x76, 0x8e, 0xf0, 0xb0, 0x20, 0x88, 0xda, 0xe5, 0x82,
+	0xf8, 0x11, 0x5c, 0xc9, 0x42, 0x0e, 0x9c, 0xa1, 0xd4, 0x3f, 0x8e, 0x40, 0xcb, 0xd0, 0x8c, 0xac,
+	0x43, 0x97, 0x86, 0x84, 0xf6, 0x69, 0x60, 0x94, 0x79, 0xbc, 0x54, 0x10, 0xc2, 0xd0, 0x0a, 0x7b,
+	0xaf, 0xe9, 0xc0, 0x92, 0x24, 0x15, 0x4e, 0x92, 0x81, 0xe1, 0x7f, 0x69, 0x50, 0xdd, 0xb3, 0x02,
+	0x6b, 0xc0, 0xfc, 0xfd, 0x91, 0x9e, 0xc9, 0x74, 0x62, 0x9f, 0x68, 0x1e, 0xaa, 0x6f, 0x2c, 0x77,
+	0x44, 0xa5, 0x0d, 0x62, 0x81, 0x10, 0x54, 0x6c, 0x1a, 0xf6, 0xe4, 0x5e, 0xf3, 0x6f, 0x06, 0x8b,
+	0xce, 0x86, 0x94, 0xef, 0x71, 0x83, 0xf0, 0x6f, 0xf4, 0x05, 0xd4, 0x9d, 0x4f, 0xbe, 0xf0, 0x36,
+	0x19, 0x6d, 0x75, 0xb9, 0xbc, 0xda, 0x5c, 0x5f, 0x4c, 0x02, 0xc1, 0x35, 0xae, 0x6d, 0x4b, 0xf4,
+	0x96, 0x17, 0x05, 0x67, 0x24, 0xa1, 0x36, 0x1f, 0x42, 0x3b, 0x83, 0xba, 0xa8, 0x69, 0x0f, 0x4a,
+	0x5f, 0x68, 0xf8, 0x17, 0x0d, 0xca, 0x9b, 0xdd, 0x5d, 0x66, 0xd2, 0x6b, 0x3f, 0x8c, 0x24, 0x13,
+	0xff, 0x66, 0xb0, 0xa1, 0x1f, 0xc4, 0x99, 0xc3, 0xbf, 0x19, 0x6c, 0x14, 0xf2, 0xf8, 0x71, 0x18,
+	0xfb, 0x46, 0x26, 0xd4, 0x87, 0x56, 0x18, 0x9e, 0xf8, 0x81, 0x2d, 0x5d, 0x4a, 0xd6, 0x0c, 0x67,
+	0x5b, 0x91, 0x75, 0x68, 0x85, 0xd4, 0xa8, 0x0a, 0x5c, 0xbc, 0x46, 0x0f, 0x40, 0xb7, 0x6c, 0xdb,
+	0x89, 0x1c, 0xdf, 0xb3, 0x5c, 0xee, 0x63, 0x68, 0xd4, 0xb8, 0xeb, 0x9d, 0xac, 0xeb, 0x64, 0x8c,
+	0x0e, 0xff, 0x54, 0x86, 0x0a, 0x19, 0xb9, 0x3c, 0xbe, 0x9e, 0x35, 0xa0, 0xb1, 0xe1, 0xec, 0x3b,
+	0x89, 0x79, 0x49, 0x89, 0xf9, 0x3c, 0x54, 0x5d, 0xfa, 0x86, 0xc6, 0x87, 0x4e, 0x2c, 0x98, 0x79,
+	0x3d, 0x96, 0x22, 0x7e, 0x70, 0x16, 0x9b, 0x1e, 0xaf, 0xd1, 0x0a, 0xd4, 0x86, 0xc2, 0xa8, 0x6a,
+	0xa1, 0x51, 0x12, 0x8b, 0x6e, 0x00, 0x58, 0x9e, 0xe7, 0x47, 0x16, 0x33, 0xd0, 0xa8, 0x71, 0x29,
+	0x0a, 0x04, 0xdd, 0x83, 0xc6, 0x8f, 0x9e, 0x7f, 0xe2, 0x52, 0xfb, 0x88, 0x1a, 0x33, 0xfc, 0x1c,
+	0xa1, 0x44, 0xd4, 0xb3, 0x18, 0x43, 0x52, 0x22, 0xb4, 0x01, 0x2d, 0xb6, 0xbb, 0xcc, 0xbf, 0x6d,
+	0xaf, 0xef, 0x1b, 0x75, 0xae, 0xff, 0xbd, 0x84, 0x89, 0x21, 0x78, 0x3a, 0xc4, 0x14, 0x22, 0x25,
+	0x32, 0x4c, 0xc8, 0x80, 0x99, 0x37, 0x34, 0xe0, 0x87, 0xb7, 0xb1, 0xac, 0xad, 0xb6, 0x49, 0xbc,
+	0x34, 0x5f, 0xc2, 0x95, 0x31, 0xe6, 0x82, 0xa4, 0xf9, 0x50, 0x4d, 0x9a, 0xe6, 0xfa, 0x42, 0xa2,
+	0x5e, 0x65, 0x56, 0x73, 0xe9, 0x2f, 0x1a, 0xb4, 0x54, 0x5c, 0xb2, 0x0f, 0x9a, 0xb2, 0x0f, 0x6a,
+	0xc4, 0x4b, 0xb9, 0x88, 0x67, 0x23, 0x59, 0x3e, 0x3f, 0x92, 0x95, 0x0b, 0x44, 0x12, 0xdf, 0x86,
+	0x46, 0x02, 0x67, 0x11, 0xe9, 0xf9, 0x5e, 0x44, 0xbd, 0x38, 0xcd, 0xe3, 0x25, 0xfe, 0xa5, 0x04,
+	0xed, 0x1d, 0x1a, 0xb1, 0x53, 0x1e, 0x0e, 0x7d, 0x2f, 0xa4, 0xcc, 0x94, 0xa1, 0x3b, 0x3a, 0x72,
+	0xbc, 0xdd, 0x34, 0xb9, 0x14, 0x08, 0xba, 0x07, 0x73, 0x71, 0x1e, 0x6f, 0xd2, 0xbe, 0x35, 0x72,
+	0xa3, 0xbd, 0xf8, 0xa8, 0x94, 0x49, 0x11, 0x0a, 0x7d, 0x03, 0x46, 0x0c, 0x7e, 0x9c, 0xcf, 0xfa,
+	0x72, 0x61, 0x82, 0x4d, 0xa4, 0x47, 0x37, 0xa1, 0x1a, 0x8c, 0x5c, 0x1a, 0xf2, 0x1a, 0xd5, 0x5c,
+	0x6f, 0x67, 0x32, 0x83, 0x08, 0x1c, 0xda, 0x81, 0x05, 0xea, 0xb1, 0xfa, 0x66, 0xbf, 0x18, 0x0a,
+	0xee, 0x1d, 0xdf, 0x1e, 0xb9, 0x94, 0xa7, 0xb3, 0x5a, 0x67, 0xb3, 0x68, 0x52, 0xcc, 0xc5, 0x36,
+	0xd3, 0xf5, 0x8f, 0x7c, 0x9e, 0xe0, 0x2d, 0xc2, 0xbf, 0x31, 0x81, 0xe6, 0xb6, 0xe7, 0x44, 0x84,
+	0x1e, 0x8f, 0x68, 0x18, 0xa1, 0x1b, 0x50, 0xb6, 0xc3, 0xb8, 0x57, 0xb4, 0x12, 0xf9, 0x9b, 0xdd,
+	0x5d, 0xc2, 0x10, 0xa9, 0xd9, 0xa5, 0xc9, 0x66, 0xe3, 0x07, 0xd0, 0x12, 0x32, 0xe5, 0x4e, 0x5c,
+	0xa2, 0x09, 0x31, 0xde, 0x0d, 0xd7, 0x0f, 0x69, 0x6c, 0xd0, 0x65, 0x78, 0x1f, 0x01, 0x7a, 0xe6,
+	0xb8, 0xee, 0x5e, 0xe0, 0xf7, 0x68, 0x18, 0x4e, 0x23, 0xe1, 0x7d, 0x68, 0xec, 0x59, 0x41, 0x48,
+	0xed, 0xee, 0xfe, 0x73, 0x56, 0x6f, 0x8e, 0x47, 0x34, 0x88, 0x4f, 0x94, 0x58, 0xe0, 0xef, 0xa1,
+	0xc5, 0x49, 0xa6, 0x10, 0x8f, 0x6e, 0xa5, 0x1d, 0x56, 0xcd, 0xfb, 0x44, 0xa5, 0xe8, 0xba, 0x7f,
+	0xd6, 0xa0, 0xb2, 0xeb, 0xdb, 0x7c, 0xbf, 0x22, 0x7a, 0x9a, 0x54, 0x74, 0xf6, 0x9d, 0x34, 0x9e,
+	0x92, 0xd2, 0x78, 0x96, 0xa1, 0xd9, 0x77, 0xbc, 0x23, 0x1a, 0x0c, 0x03, 0xc7, 0x8b, 0xe4, 0xa9,
+	0x53, 0x41, 0xec, 0xa2, 0x11, 0x46, 0x56, 0x10, 0x3d, 0x77, 0x3c, 0x2a, 0xef, 0x25, 0x29, 0x80,
+	0x9d, 0xaa, 0x43, 0x2b, 0xea, 0xbd, 0xde, 0xb6, 0x79, 0x81, 0xaf, 0x90, 0x78, 0x89, 0x3f, 0x85,
+	0xb6, 0x74, 0x56, 0x6e, 0xe5, 0x4d, 0xa8, 0x7a, 0xbe, 0x4d, 0x43, 0x43, 0xcb, 0xed, 0x3f, 0x33,
+	0x98, 0x08, 0x1c, 0x5e, 0x86, 0xfa, 0xe3, 0x91, 0xed, 0x44, 0x93, 0x83, 0x68, 0x41, 0x8b, 0x53,
+	0x4c, 0x13, 0xc4, 0xdb, 0x50, 0x09, 0x8f, 0xdd, 0x38, 0x03, 0xaf, 0x24, 0x84, 0xb1, 0x4a, 0xc2,
+	0xd1, 0x78, 0x17, 0xe6, 0x58, 0x25, 0x93, 0x6a, 0xd8, 0x55, 0x29, 0xae, 0xa9, 0x03, 0x1a, 0x86,
+	0xd6, 0x51, 0x5c, 0x12, 0xe2, 0x25, 0x5a, 0x02, 0xa0, 0x41, 0xe0, 0x07, 0xaf, 0x1c, 0x56, 0xb0,
+	0x45, 0x7c, 0x1b, 0x1c, 0xc2, 0x18, 0xf1, 0x7f, 0x4a, 0xd0, 0x54, 0x84, 0x9d, 0x23, 0x28, 0xe9,
+	0x53, 0x25, 0xb5, 0x4f, 0xbd, 0x0b, 0x0d, 0x76, 0x3a, 0x5e, 0xf1, 0x56, 0x27, 0xb6, 0xa8, 0xce,
+	0x00, 0xbc, 0x16, 0xbd, 0x82, 0x39, 0x67, 0xdc, 0x58, 0x59, 0x1b, 0x3e, 0xce, 0xba, 0x28, 0xf0,
+	0x6b, 0x05, 0xce, 0x89, 0x1e, 0x52, 0x24, 0x09, 0xfd, 0x06, 0x74, 0x71, 0x6d, 0x74, 0x7c, 0xef,
+	0x55, 0xdf, 0x72, 0x5c, 0x2a, 0xf6, 0xba, 0x4e, 0x66, 0x13, 0xf8, 0x57, 0x1c, 0x9c, 0x8b, 0x43,
+	0x2d, 0x17, 0x07, 0xd3, 0x06, 0x63, 0x92, 0xea, 0x82, 0x0e, 0xb4, 0x9e, 0xed, 0x40, 0x8b, 0x99,
+	0x0e, 0x94, 0x93, 0xa1, 0x36, 0xa2, 0xdf, 0x43, 0x4b, 0xc1, 0x86, 0x68, 0x0d, 0x66, 0x02, 0xf1,
+	0x29, 0x33, 0x6f, 0xbe, 0x28, 0x28, 0x24, 0x26, 0xc2, 0xdf, 0x40, 0x3b, 0x86, 0x8b, 0xc4, 0xfd,
+	0x12, 0x5a, 0x96, 0x22, 0x50, 0x4a, 0x59, 0x28, 0x92, 0x12, 0x92, 0x0c, 0x29, 0xfe, 0x00, 0x66,
+	0x77, 0x29, 0xb5, 0x89, 0xef, 0xba, 0xec, 0x52, 0x3a, 0x39, 0xab, 0x7d, 0x58, 0xf8, 0x9a, 0x7a,
+	0x0a, 0xdd, 0x34, 0xe9, 0x7d, 0x47, 0xad, 0x11, 0x46, 0x7a, 0xbe, 0xb2, 0x16, 0x88, 0x4a, 0x71,
+	0x57, 0xe4, 0xb8, 0x02, 0x3f, 0x3f, 0xc7, 0xf1, 0x1f, 0x4b, 0xd0, 0x7c, 0xab, 0x1f, 0x2a, 0x7f,
+	0x29, 0x9b, 0xda, 0x32, 0x4f, 0x73, 0x0a, 0x8d, 0x72, 0x2e, 0x4f, 0x15, 0xfc, 0x5a, 0x81, 0x81,
+	0x4a, 0x9e, 0xe6, 0x30, 0x71, 0x76, 0x15, 0x31, 0x5c, 0x36, 0xbb, 0x72, 0x32, 0xd4, 0xec, 0x7a,
+	0x04, 0x57, 0xf3, 0x1b, 0x25, 0xd3, 0x64, 0x45, 0x44, 0x5f, 0xec, 0xd2, 0x7c, 0x91, 0x43, 0x22,
+	0xf2, 0x5f, 0x42, 0x73, 0xcf, 0xf1, 0x8e, 0xa6, 0xe9, 0x31, 0xef, 0xc1, 0xcc, 0xd6, 0x29, 0xed,
+	0x4d, 0x4e, 0xa3, 0xef, 0xa0, 0xc9, 0x08, 0xa6, 0x49, 0x1e, 0xac, 0x26, 0x4f, 0x4a, 0x27, 0xf5,
+	0x09, 0xd3, 0x7f, 0xd6, 0x00, 0x84, 0x7c, 0x5e, 0xc7, 0x30, 0xb4, 0x5c, 0x2b, 0x8c, 0xb6, 0xbd,
+	0x90, 0x06, 0xd1, 0xb6, 0x78, 0x5d, 0x97, 0x49, 0x06, 0xc6, 0xde, 0x69, 0xea, 0x7a, 0x8b, 0x15,
+	0x83, 0xf8, 0x9d, 0x36, 0x86, 0x60, 0x12, 0x03, 0xff, 0x24, 0x7c, 0xdc, 0xef, 0xd3, 0x5e, 0x44,
+	0x6d, 0x5e, 0xec, 0xca, 0x24, 0x03, 0x63, 0x12, 0xd5, 0xb5, 0x90, 0x28, 0xae, 0xef, 0xe3, 0x08,
+	0x6c, 0x83, 0xce, 0x2c, 0x7e, 0xc2, 0xba, 0xd2, 0x74, 0x7d, 0x57, 0x6d, 0x19, 0xe3, 0x71, 0x11,
+	0x1d, 0xe3, 0x11, 0xcc, 0x2a, 0x5a, 0x78, 0x70, 0x3e, 0xce, 0x97, 0x9d, 0xb9, 0x0c, 0x6f, 0xbe,
+	0xea, 0x3c, 0x84, 0x96, 0x04, 0x8b, 0x6c, 0xfa, 0x10, 0x6a, 0x02, 0x25, 0x4d, 0x2c, 0xe4, 0x96,
+	0x24, 0xf8, 0x3b, 0x68, 0x1c, 0x9c, 0xfe, 0x7a, 0xde, 0x3d, 0x04, 0x38, 0x38, 0x4d, 0x2c, 0xbb,
+	0xa4, 0x63, 0xcb, 0x50, 0xdf, 0x67, 0xb9, 0x39, 0x39, 0x69, 0x3f, 0x81, 0x06, 0xa7, 0xd8, 0xf0,
+	0xbd, 0x3e, 0x9b, 0x5c, 0x44, 0xce, 0x80, 0xfa, 0xa3, 0xa8, 0x4b, 0x7b, 0xbe, 0x27, 0x92, 0xaa,
+	0x4d, 0xb2, 0x40, 0xfc, 0x27, 0x0d, 0x5a, 0x9c, 0x67, 0x1a, 0xa7, 0x6f, 0xaa, 0x99, 0x9e, 0x5e,
+	0x02, 0x62, 0x2b, 0xc5, 0x48, 0x66, 0x05, 0x2a, 0x3d, 0xdf, 0xeb, 0x1b, 0xe5, 0xdc, 0x85, 0x2b,
+	0xb1, 0x94, 0x70, 0x3c, 0xb6, 0xa1, 0x2d, 0x0d, 0x49, 0xca, 0x40, 0xad, 0xe7, 0xbb, 0xa3, 0x81,
+	0x67, 0x68, 0x85, 0xf7, 0x7a, 0x89, 0x45, 0x1f, 0x42, 0x85, 0x65, 0xab, 0x0c, 0xfd, 0xb5, 0xac,
+	0x02, 0x19, 0x44, 0xff, 0x84, 0x70, 0x22, 0xbc, 0x01, 0x9d, 0x2c, 0x1c, 0x7d, 0x02, 0x35, 0x5e,
+	0x94, 0xe2, 0x4d, 0xb8, 0x5e, 0x24, 0xe0, 0x25, 0xa3, 0x20, 0x92, 0x10, 0xaf, 0x82, 0x9e, 0xc7,
+	0xa5, 0xb3, 0x01, 0x4d, 0x99, 0x0d, 0x60, 0xcc, 0x8e, 0xf9, 0xd0, 0xb5, 0x1c, 0x6f, 0xf2, 0xae,
+	0xf5, 0xa0, 0x23, 0x69, 0xa6, 0xbb, 0x89, 0x29, 0x7b, 0xa0, 0x26, 0x50, 0xac, 0x55, 0x14, 0x9c,
+	0x97, 0x30, 0x2b, 0x41, 0x49, 0x7c, 0x37, 0xa0, 0xdd, 0x73, 0xad, 0x30, 0x74, 0x64, 0xa6, 0x49,
+	0x5d, 0x4b, 0x79, 0x19, 0x1b, 0x2a, 0x11, 0xc9, 0xf2, 0xe0, 0x47, 0x30, 0x5f, 0x44, 0x86, 0x56,
+	0xa1, 0xc2, 0x9e, 0x5d, 0x63, 0x45, 0xfc, 0xc0, 0x3a, 0x1c, 0xb9, 0x56, 0xb0, 0x69, 0x45, 0x16,
+	0xe1, 0x14, 0xf8, 0x31, 0xcc, 0x7d, 0x4d, 0xa3, 0x4d, 0xf9, 0x46, 0x9b, 0xea, 0xc5, 0x70, 0x03,
+	0xea, 0x31, 0x7f, 0xd1, 0x20, 0x03, 0x7f, 0x0d, 0xf3, 0x59, 0x15, 0x32, 0x02, 0x77, 0xa1, 0x11,
+	0xbf, 0x0d, 0xe3, 0xdd, 0x4f, 0xb3, 0x38, 0x26, 0x27, 0x29, 0x0d, 0xbe, 0x0f, 0xd5, 0x03, 0xf6,
+	0xa8, 0x2b, 0xd2, 0x82, 0xae, 0x42, 0x4d, 0x0c, 0xb9, 0x64, 0x55, 0x96, 0x2b, 0x7c, 0xc4, 0x1d,
+	0xe4, 0x7c, 0xec, 0x71, 0x3c, 0x5d, 0x75, 0xa9, 0xf2, 0x11, 0x9b, 0xdc, 0xe6, 0x8e, 0x1a, 0x4e,
+	0xf6, 0xe4, 0xe3, 0x48, 0xfc, 0x94, 0xbb, 0xa9, 0x28, 0x92, 0x6e, 0xde, 0x83, 0x46, 0x14, 0x03,
+	0x0d, 0x2d, 0x77, 0x0c, 0x53, 0xf2, 0x94, 0x08, 0xff, 0x53, 0x83, 0x46, 0x82, 0x40, 0x9f, 0x41,
+	0x53, 0x1c, 0xb5, 0x90, 0x5f, 0x34, 0xf2, 0x5b, 0xba, 0x91, 0xe2, 0x88, 0x4a, 0xc8, 0xf8, 0x1c,
+	0xcf, 0xa6, 0xa7, 0x54, 0xf0, 0x95, 0x72, 0x7c, 0xdb, 0x29, 0x8e, 0xa8, 0x84, 0x68, 0x05, 0x3a,
+	0xbd, 0x80, 0x5a, 0x11, 0xe5, 0x26, 0x74, 0xf7, 0x9f, 0xcb, 0xab, 0x7a, 0x0e, 0xaa, 0x5e, 0x91,
+	0x2a, 0xd9, 0x2b, 0xd6, 0xe7, 0xd0, 0x54, 0xac, 0xba, 0x44, 0x32, 0x7e, 0xce, 0x5e, 0xe2, 0xa9,
+	0x25, 0x17, 0x67, 0xfc, 0x87, 0x06, 0xb3, 0x0a, 0xf4, 0x29, 0xb5, 0xec, 0x0b, 0xcf, 0xd4, 0x9e,
+	0x28, 0x33, 0x4b, 0x71, 0x8b, 0x5b, 0x29, 0xd2, 0xc4, 0x64, 0xfe, 0x3a, 0xd3, 0xcb, 0x0f, 0x32,
+	0xb6, 0xb3, 0x11, 0x39, 0x23, 0x76, 0x22, 0x3a, 0x08, 0xe5, 0x44, 0x5c, 0x2c, 0xb0, 0x0f, 0x4d,
+	0x85, 0x10, 0xad, 0xb3, 0x49, 0x10, 0x0f, 0xb3, 0x3c, 0x3d, 0xc6, 0x24, 0xbb, 0x49, 0x4c, 0x88,
+	0x3e, 0xca, 0x54, 0xeb, 0x42, 0x06, 0x66, 0x80, 0x2c, 0xd7, 0xb7, 0x58, 0x33, 0x8f, 0x02, 0x8b,
+	0x5d, 0x43, 0x26, 0x57, 0xd0, 0x63, 0x30, 0x25, 0x15, 0xcf, 0x8d, 0xaf, 0x02, 0x7f, 0x30, 0xe5,
+	0xc5, 0xff, 0x03, 0xb5, 0x9a, 0x2e, 0x28, 0x95, 0x30, 0xb5, 0x41, 0xd4, 0xd3, 0x2d, 0x78, 0xb7,
+	0x50, 0x65, 0xda, 0xbb, 0xc4, 0x4c, 0x7c, 0xac, 0x77, 0x89, 0x13, 0x2b, 0xb1, 0xf8, 0x36, 0xb4,
+	0xc5, 0x2d, 0x8b, 0xf9, 0x3c, 0xd9, 0xc1, 0x08, 0x16, 0xb7, 0xc2, 0xc8, 0x19, 0x58, 0x11, 0x4b,
+	0xfc, 0x94, 0x63, 0x1a, 0x17, 0x57, 0x55, 0x17, 0xaf, 0xa6, 0x6f, 0x2f, 0xd5, 0x0c, 0xe1, 0xe3,
+	0xff, 0x34, 0x58, 0x9a, 0xa0, 0x56, 0xba, 0x39, 0x0f, 0xd5, 0x9e, 0x3f, 0x92, 0x83, 0xc0, 0x32,
+	0x11, 0x0b, 0x36, 0xf4, 0xa3, 0x41, 0xb0, 0x93, 0x79, 0xbd, 0x28, 0x10, 0xb4, 0x27, 0xf2, 0x7d,
+	0xd7, 0x8f, 0xa8, 0xcc, 0xf7, 0x4f, 0xd3, 0x48, 0x9f, 0xa7, 0x6f, 0x6d, 0x5b, 0xb2, 0x29, 0xd9,
+	0xcf, 0x96, 0xac, 0xf4, 0x0e, 0x68, 0xf4, 0xda, 0x8f, 0x07, 0xe7, 0x72, 0x15, 0x9f, 0x8a, 0x84,
+	0xe5, 0x52, 0xa7, 0xe2, 0xb7, 0x30, 0x97, 0x99, 0x64, 0xa5, 0x23, 0x4d, 0xc5, 0x3b, 0x2d, 0xef,
+	0x1d, 0xfe, 0x49, 0x83, 0xeb, 0x71, 0xef, 0x78, 0x71, 0xf8, 0x03, 0xed, 0x89, 0x77, 0xf5, 0x14,
+	0x3b, 0xf5, 0x14, 0xae, 0xc8, 0xd6, 0xd3, 0xe5, 0x9d, 0x44, 0x56, 0x51, 0x16, 0x30, 0x33, 0xdf,
+	0xa6, 0x52, 0x0a, 0x32, 0xce, 0x84, 0x23, 0xb8, 0x32, 0x46, 0xc7, 0x1c, 0x11, 0x1d, 0x4a, 0x9d,
+	0xcd, 0xa6, 0x10, 0xf6, 0xcb, 0x92, 0x9d, 0xf1, 0x63, 0xec, 0x86, 0x95, 0x75, 0x93, 0xe4, 0xc8,
+	0xf1, 0x1e, 0x74, 0xb2, 0x14, 0x4c, 0xa5, 0xcf, 0xbf, 0x54, 0x95, 0x29, 0x24, 0xc5, 0x1f, 0xa4,
+	0xe3, 0x35, 0x05, 0x82, 0x8f, 0x61, 0x31, 0x96, 0x28, 0x1c, 0x91, 0x9a, 0xe3, 0xbd, 0xd9, 0x87,
+	0x79, 0xbb, 0x00, 0x2f, 0x0f, 0xe1, 0xd2, 0x98, 0xe1, 0x19, 0x21, 0x85, 0xac, 0xf8, 0xaf, 0x1a,
+	0xcc, 0x17, 0x91, 0xbf, 0x35, 0x7c, 0x6c, 0xdc, 0xc7, 0x57, 0x9b, 0x9b, 0xcf, 0xe3, 0x49, 0x56,
+	0x02, 0x50, 0xf6, 0x56, 0xc6, 0x86, 0x51, 0x95, 0x8b, 0xf7, 0x36, 0xa5, 0x20, 0xe3, 0x4c, 0x38,
+	0x48, 0xf6, 0x36, 0x05, 0x16, 0xec, 0x9d, 0xc8, 0xb6, 0x8b, 0xee, 0x1d, 0xb3, 0xde, 0x4f, 0xec,
+	0x92, 0xd6, 0x27, 0x00, 0xfc, 0x77, 0x25, 0xc7, 0x37, 0x9d, 0x7e, 0x7f, 0xc7, 0xb7, 0x9d, 0xfe,
+	0x54, 0x4f, 0x88, 0x75, 0x68, 0xf7, 0x2c, 0xd7, 0x39, 0x0c, 0xac, 0x88, 0xda, 0x9b, 0xdd, 0x5d,
+	0xa3, 0x54, 0x30, 0xf5, 0xce, 0x92, 0xa0, 0x07, 0x50, 0xf7, 0x0f, 0x7f, 0x60, 0x39, 0x1c, 0x8f,
+	0xfc, 0x6f, 0xe4, 0xdd, 0x62, 0x46, 0x29, 0x47, 0x22, 0xa1, 0x67, 0x0f, 0xef, 0x85, 0x42, 0x1a,
+	0x76, 0xeb, 0x48, 0xf7, 0x58, 0xd9, 0xd3, 0x1c, 0x14, 0xad, 0x01, 0xea, 0xf9, 0x83, 0xa1, 0x15,
+	0x50, 0x5b, 0xa1, 0x15, 0x21, 0x2a, 0xc0, 0x14, 0x6c, 0x45, 0xf9, 0x72, 0xc7, 0xc8, 0x02, 0xa3,
+	0x20, 0xd6, 0x22, 0xe1, 0xb7, 0x40, 0x97, 0x39, 0x95, 0x60, 0xc6, 0x9e, 0x31, 0xdd, 0x1c, 0x01,
+	0x19, 0x63, 0xc1, 0x04, 0xf4, 0x3c, 0xd5, 0x5b, 0xf3, 0xfb, 0x06, 0xc0, 0x80, 0x53, 0x76, 0xf7,
+	0x9f, 0x8b, 0x76, 0xde, 0x20, 0x0a, 0xe4, 0xce, 0x36, 0x74, 0xb2, 0xbf, 0x3c, 0xa3, 0x3a, 0x1b,
+	0xa9, 0x7b, 0x54, 0x7f, 0x07, 0x75, 0x00, 0x08, 0x65, 0xbf, 0xa3, 0xd1, 0xee, 0xb1, 0xab, 0x6b,
+	0x68, 0x16, 0x9a, 0x2f, 0x02, 0xe7, 0xc8, 0xf1, 0x2c, 0x97, 0xf8, 0x27, 0x7a, 0x09, 0xb5, 0xa0,
+	0xbe, 0x63, 0x79, 0x23, 0xcb, 0x75, 0xcf, 0xf4, 0xf2, 0x9d, 0xff, 0x6a, 0xd0, 0x19, 0xfb, 0x19,
+	0xa5, 0x93, 0x9d, 0x1e, 0xe9, 0xef, 0xa0, 0x06, 0x54, 0xf9, 0xb3, 0x4c, 0xd7, 0x50, 0x13, 0x66,
+	0xe4, 0xb3, 0x44, 0x2f, 0x21, 0x1d, 0x5a, 0xea, 0xbd, 0x58, 0x2f, 0xa3, 0x6b, 0x30, 0x57, 0xd0,
+	0xbd, 0xf5, 0x0a, 0xba, 0x0e, 0x0b, 0x85, 0x1d, 0x48, 0xaf, 0x32, 0x1b, 0x95, 0x76, 0xa0, 0xd7,
+	0x50, 0x1b, 0x1a, 0xc9, 0xa8, 0x42, 0x9f, 0x61, 0xde, 0xb1, 0x5e, 0xa3, 0xd7, 0x91, 0x91, 0x79,
+	0x6e, 0x24, 0x87, 0x52, 0x6f, 0xa0, 0x45, 0x30, 0x14, 0x4c, 0x1a, 0x6c, 0xa6, 0x1c, 0x10, 0x40,
+	0x4d, 0x44, 0x4c, 0x6f, 0xae, 0xff, 0xad, 0x09, 0xb5, 0x4d, 0xfe, 0x6f, 0x19, 0xe8, 0x2e, 0x54,
+	0x99, 0xd9, 0x21, 0x4a, 0x2f, 0x11, 0xfc, 0x9f, 0x32, 0xcc, 0xb4, 0x79, 0x67, 0x7f, 0x74, 0xbb,
+	0x0f, 0x15, 0xf6, 0xd3, 0x0f, 0x52, 0xaf, 0xda, 0xc9, 0x98, 0xdf, 0x5c, 0xc8, 0x41, 0x25, 0xd3,
+	0x1a, 0x54, 0xf9, 0x6f, 0x3e, 0x28, 0xc5, 0xab, 0xbf, 0x01, 0x99, 0x39, 0xe5, 0xe8, 0x69, 0x26,
+	0x1c, 0xe8, 0xdd, 0xf4, 0x07, 0xc4, 0xb1, 0x5f, 0x7f, 0xcc, 0xc5, 0x62, 0xa4, 0xd4, 0xfc, 0x19,
+	0xff, 0x5f, 0x80, 0x8c, 0x66, 0xf5, 0xc7, 0x1d, 0xf3, 0x6a, 0x1e, 0x9c, 0xf2, 0xf1, 0x81, 0x31,
+	0x1a, 0x1b, 0x20, 0xe7, 0xf9, 0xb2, 0x53, 0xe8, 0xfd, 0x7c, 0xea, 0xa0, 0xb4, 0x7c, 0x14, 0x8e,
+	0x8e, 0xcd, 0xf7, 0x26, 0xe2, 0xa5, 0xc8, 0x8f, 0xa0, 0xc2, 0x26, 0x91, 0x4a, 0xc4, 0x95, 0xc1,
+	0xe4, 0x58, 0xe8, 0xee, 0x43, 0x85, 0x25, 0x8e, 0x42, 0xad, 0x8c, 0x1a, 0xcd, 0x85, 0x1c, 0x54,
+	0xaa, 0x78, 0xa4, 0x64, 0x1b, 0xba, 0x9e, 0xa1, 0x51, 0x47, 0x72, 0xa6, 0x51, 0x84, 0x92, 0x73,
+	0xb4, 0xd2, 0xc1, 0x29, 0x52, 0x5e, 0x7e, 0xf1, 0xa0, 0xcb, 0x9c, 0xcb, 0xc0, 0xd2, 0xf0, 0xf2,
+	0xd3, 0xa4, 0x84, 0x57, 0x1d, 0x14, 0x99, 0x57, 0xf3, 0x60, 0xc9, 0xf7, 0xbb, 0xe4, 0xe8, 0xa1,
+	0x6b, 0xf9, 0x51, 0x42, 0x91, 0x91, 0xd9, 0xa1, 0x44, 0x0f, 0xae, 0x4d, 0xf8, 0x67, 0x17, 0x74,
+	0x33, 0x61, 0x9a, 0xfc, 0xbf, 0x36, 0xe6, 0x05, 0x88, 0x42, 0x74, 0x2f, 0x3e, 0x68, 0x4a, 0x34,
+	0x92, 0x7f, 0x61, 0x32, 0xc7, 0x61, 0x21, 0x7a, 0x06, 0x2d, 0xe5, 0xe0, 0x86, 0x68, 0x51, 0xc9,
+	0x88, 0xb1, 0xd9, 0x85, 0xb9, 0x34, 0x01, 0x2b, 0x7d, 0x7c, 0x96, 0xad, 0x47, 0x59, 0x61, 0xf9,
+	0x39, 0x81, 0xb9, 0x34, 0x01, 0x2b, 0x85, 0x7d, 0x5f, 0x58, 0xca, 0x94, 0x60, 0x4d, 0x7e, 0x19,
+	0x99, 0xb7, 0xce, 0x27, 0x92, 0x1a, 0xfa, 0x13, 0x6a, 0x22, 0xba, 0xfd, 0xb6, 0x5b, 0xbb, 0xd0,
+	0xb2, 0x72, 0xb1, 0xcb, 0x3d, 0xb2, 0x8a, 0xcb, 0x26, 0xc2, 0x13, 0x1a, 0xa5, 0x72, 0xad, 0x36,
+	0x6f, 0x9f, 0x7f, 0xb5, 0x4b, 0x55, 0x4c, 0xac, 0xbf, 0x05, 0x6a, 0xc6, 0x6e, 0x36, 0xe6, 0xfb,
+	0xe7, 0xd1, 0x70, 0x15, 0x4f, 0x5a, 0xdf, 0xc2, 0xda, 0xdd, 0x87, 0x92, 0xec, 0xb0, 0xc6, 0x3f,
+	0xee, 0xff, 0x7f, 0x00, 0xa0, 0xa6, 0x39, 0x82, 0x63, 0x27, 0x00, 0x00,
 }
@@ -67,6 +67,11 @@ func (e *CodeError) Error() string {
 	return e.err.Error()
 }
 
+// Unwrap returns the wrapped error, so errors.Is and errors.As can check it.
+func (e *CodeError) Unwrap() error {
+	return e.err
+}
+
 func (e *CodeError) Code() int {
 	if e.err == nil {
 		return int(StatusOK)